@@ -601,6 +601,16 @@ func executeStrategyFromConfig(c *cli.Context) error {
 			RiskFreeRate: defaultConfig.StatisticSettings.RiskFreeRate.String(),
 		},
 	}
+	if mc := defaultConfig.StatisticSettings.MonteCarlo; mc != nil {
+		cfg.StatisticSettings.MonteCarlo = &btrpc.MonteCarloSettings{
+			Method:               mc.Method,
+			Simulations:          mc.Simulations,
+			SkipTradeProbability: mc.SkipTradeProbability.String(),
+			ConfidenceLevel:      mc.ConfidenceLevel.String(),
+			RuinThreshold:        mc.RuinThreshold.String(),
+			Seed:                 mc.Seed,
+		}
+	}
 
 	var dnr bool
 	if c.IsSet("donotrunimmediately") {
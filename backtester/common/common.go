@@ -126,6 +126,10 @@ func RegisterBacktesterSubLoggers() error {
 	if err != nil {
 		return err
 	}
+	Optimiser, err = log.NewSubLogger("Optimiser")
+	if err != nil {
+		return err
+	}
 
 	// Set to existing registered sub-loggers
 	Config = log.ConfigMgr
@@ -72,6 +72,7 @@ var (
 	Holdings           *log.SubLogger
 	Data               *log.SubLogger
 	FundManager        *log.SubLogger
+	Optimiser          *log.SubLogger
 )
 
 // Directioner dictates the side of an order
@@ -50,7 +50,23 @@ func (c *Config) Validate() error {
 	if err != nil {
 		return err
 	}
-	return c.validateMinMaxes()
+	err = c.validateSubStrategies()
+	if err != nil {
+		return err
+	}
+	err = c.validateMinMaxes()
+	if err != nil {
+		return err
+	}
+	err = c.validateMonteCarloSettings()
+	if err != nil {
+		return err
+	}
+	err = c.validateRiskSettings()
+	if err != nil {
+		return err
+	}
+	return c.validateOptimisationSettings()
 }
 
 // validate ensures no one sets bad config values on purpose
@@ -101,6 +117,153 @@ func (c *Config) validateMinMaxes() (err error) {
 	return nil
 }
 
+// validateMonteCarloSettings ensures simulations can be run against the USD
+// totals of a run
+func (c *Config) validateMonteCarloSettings() error {
+	m := c.StatisticSettings.MonteCarlo
+	if m == nil {
+		return nil
+	}
+	if c.StrategySettings.DisableUSDTracking {
+		return fmt.Errorf("%w monte carlo analysis requires USD tracking", errFeatureIncompatible)
+	}
+	if m.Method != ShuffleResampling && m.Method != BootstrapResampling {
+		return fmt.Errorf("%w '%v'", errMonteCarloMethodUnsupported, m.Method)
+	}
+	if m.Simulations <= 0 {
+		return fmt.Errorf("%w simulations must be greater than zero", errInvalidMonteCarloSettings)
+	}
+	if m.SkipTradeProbability.IsNegative() || m.SkipTradeProbability.GreaterThanOrEqual(decimal.NewFromInt(1)) {
+		return fmt.Errorf("%w skip trade probability must be at least 0 and less than 1", errInvalidMonteCarloSettings)
+	}
+	if !m.ConfidenceLevel.IsPositive() || m.ConfidenceLevel.GreaterThanOrEqual(decimal.NewFromInt(1)) {
+		return fmt.Errorf("%w confidence level must be between 0 and 1", errInvalidMonteCarloSettings)
+	}
+	if !m.RuinThreshold.IsPositive() || m.RuinThreshold.GreaterThan(decimal.NewFromInt(100)) {
+		return fmt.Errorf("%w ruin threshold must be greater than 0 and at most 100", errInvalidMonteCarloSettings)
+	}
+	return nil
+}
+
+// validateRiskSettings ensures the benchmark can be loaded and the risk
+// metric settings are usable
+func (c *Config) validateRiskSettings() error {
+	s := &c.StatisticSettings
+	if s.RollingWindow < 0 || s.RollingWindow == 1 {
+		return fmt.Errorf("%w rolling window must be at least 2 candles", errInvalidRiskSettings)
+	}
+	if s.ValueAtRiskConfidenceLevel.IsNegative() || s.ValueAtRiskConfidenceLevel.GreaterThanOrEqual(decimal.NewFromInt(1)) {
+		return fmt.Errorf("%w value at risk confidence level must be between 0 and 1", errInvalidRiskSettings)
+	}
+	b := s.Benchmark
+	if b == nil {
+		return nil
+	}
+	if c.DataSettings.LiveData != nil {
+		return fmt.Errorf("%w benchmarks cannot be used with live data", errFeatureIncompatible)
+	}
+	if (len(b.Currencies) == 0) == (b.CSVPath == "") {
+		return fmt.Errorf("%w set either benchmark currencies or a csv path", errInvalidBenchmark)
+	}
+	if len(b.Currencies) > 0 && (c.DataSettings.CSVData != nil || c.DataSettings.TickData != nil) {
+		return fmt.Errorf("%w benchmark currencies require api or database data", errFeatureIncompatible)
+	}
+	for i := range b.Currencies {
+		b.Currencies[i].ExchangeName = strings.ToLower(b.Currencies[i].ExchangeName)
+		if b.Currencies[i].ExchangeName == "" {
+			return fmt.Errorf("%w benchmark currency %v", errUnsetExchange, i)
+		}
+		if !b.Currencies[i].Asset.IsValid() || b.Currencies[i].Base.IsEmpty() || b.Currencies[i].Quote.IsEmpty() {
+			return fmt.Errorf("%w benchmark currency %v", errUnsetCurrency, i)
+		}
+		exchangeUsed := false
+		for j := range c.CurrencySettings {
+			if strings.EqualFold(c.CurrencySettings[j].ExchangeName, b.Currencies[i].ExchangeName) {
+				exchangeUsed = true
+				break
+			}
+		}
+		if !exchangeUsed {
+			return fmt.Errorf("%w benchmark exchange %v must be used by a currency setting", errInvalidBenchmark, b.Currencies[i].ExchangeName)
+		}
+		if !b.Currencies[i].Weight.IsPositive() {
+			return fmt.Errorf("%w %v %v %v-%v weight must be greater than zero", errInvalidBenchmark, b.Currencies[i].ExchangeName, b.Currencies[i].Asset, b.Currencies[i].Base, b.Currencies[i].Quote)
+		}
+		for j := range b.Currencies[:i] {
+			if b.Currencies[j].ExchangeName == b.Currencies[i].ExchangeName &&
+				b.Currencies[j].Asset == b.Currencies[i].Asset &&
+				b.Currencies[j].Base.Equal(b.Currencies[i].Base) &&
+				b.Currencies[j].Quote.Equal(b.Currencies[i].Quote) {
+				return fmt.Errorf("%w %v %v %v-%v is set more than once", errInvalidBenchmark, b.Currencies[i].ExchangeName, b.Currencies[i].Asset, b.Currencies[i].Base, b.Currencies[i].Quote)
+			}
+		}
+	}
+	return nil
+}
+
+// validateOptimisationSettings ensures the parameters can generate custom
+// settings and the data can be split when walk-forward is enabled
+func (c *Config) validateOptimisationSettings() error {
+	o := c.OptimisationSettings
+	if o == nil {
+		return nil
+	}
+	if c.DataSettings.LiveData != nil {
+		return fmt.Errorf("%w optimisation cannot be used with live data", errFeatureIncompatible)
+	}
+	switch o.Method {
+	case GridSearch:
+	case RandomSearch, BayesianSearch:
+		if o.Iterations <= 0 {
+			return errOptimisationIterationsUnset
+		}
+	default:
+		return fmt.Errorf("%w '%v'", errOptimisationMethodUnsupported, o.Method)
+	}
+	switch o.Metric {
+	case SharpeRatioMetric, SortinoRatioMetric, MaxDrawdownMetric, CAGRMetric:
+	default:
+		return fmt.Errorf("%w '%v'", errOptimisationMetricUnsupported, o.Metric)
+	}
+	if o.MaxParallelTasks < 0 {
+		return fmt.Errorf("%w max parallel tasks", errSizeLessThanZero)
+	}
+	if len(o.Parameters) == 0 {
+		return errNoOptimisationParameters
+	}
+	names := make(map[string]bool, len(o.Parameters))
+	for i := range o.Parameters {
+		p := &o.Parameters[i]
+		switch {
+		case p.Name == "":
+			return fmt.Errorf("%w name unset", errInvalidOptimisationParameter)
+		case names[p.Name]:
+			return fmt.Errorf("%w '%v' set more than once", errInvalidOptimisationParameter, p.Name)
+		case len(p.Values) > 0:
+		case p.Maximum < p.Minimum:
+			return fmt.Errorf("%w '%v' maximum %v is less than minimum %v", errInvalidOptimisationParameter, p.Name, p.Maximum, p.Minimum)
+		case p.Step < 0:
+			return fmt.Errorf("%w '%v' step %w", errInvalidOptimisationParameter, p.Name, errSizeLessThanZero)
+		case p.Step == 0 && o.Method == GridSearch:
+			return fmt.Errorf("%w '%v' grid search requires values or a step", errInvalidOptimisationParameter, p.Name)
+		}
+		names[p.Name] = true
+	}
+	if o.WalkForward == nil {
+		return nil
+	}
+	if o.WalkForward.Windows <= 0 {
+		return fmt.Errorf("%w windows must be greater than zero", errInvalidWalkForward)
+	}
+	if !o.WalkForward.OutOfSampleRatio.IsPositive() || o.WalkForward.OutOfSampleRatio.GreaterThanOrEqual(decimal.NewFromInt(1)) {
+		return fmt.Errorf("%w out-of-sample ratio must be between 0 and 1", errInvalidWalkForward)
+	}
+	if c.DataSettings.APIData == nil && c.DataSettings.DatabaseData == nil && c.DataSettings.TickData == nil {
+		return fmt.Errorf("%w walk-forward requires api, database or tick data", errFeatureIncompatible)
+	}
+	return nil
+}
+
 func (c *Config) validateStrategySettings() error {
 	if c.FundingSettings.UseExchangeLevelFunding && !c.StrategySettings.SimultaneousSignalProcessing {
 		return errSimultaneousProcessingRequired
@@ -123,14 +286,127 @@ func (c *Config) validateStrategySettings() error {
 			}
 		}
 	}
+	if c.DataSettings.TickData != nil {
+		if c.DataSettings.TickData.Directory == "" {
+			return errTickDataDirectoryUnset
+		}
+		if !c.StrategySettings.DisableUSDTracking {
+			// USD tracking matches prices by candle time which ticks do not share
+			return fmt.Errorf("%w tick data requires USD tracking to be disabled", errFeatureIncompatible)
+		}
+	}
+	if strings.EqualFold(c.StrategySettings.Name, strategies.MultiStrategyName) {
+		return nil
+	}
+	if len(c.StrategySettings.Strategies) > 0 {
+		return fmt.Errorf("%w strategies can only be set for %v", errFeatureIncompatible, strategies.MultiStrategyName)
+	}
+	if !isSupportedStrategy(c.StrategySettings.Name) {
+		return fmt.Errorf("strategty %v %w", c.StrategySettings.Name, base.ErrStrategyNotFound)
+	}
+	return nil
+}
+
+func isSupportedStrategy(name string) bool {
 	strats := strategies.GetSupportedStrategies()
 	for i := range strats {
-		if strings.EqualFold(strats[i].Name(), c.StrategySettings.Name) {
-			return nil
+		if strings.EqualFold(strats[i].Name(), name) {
+			return true
 		}
 	}
+	return false
+}
 
-	return fmt.Errorf("strategty %v %w", c.StrategySettings.Name, base.ErrStrategyNotFound)
+// validateSubStrategies ensures every currency of a multi-strategy run is
+// traded by exactly one strategy and allocations do not exceed the funding
+func (c *Config) validateSubStrategies() error {
+	if !strings.EqualFold(c.StrategySettings.Name, strategies.MultiStrategyName) {
+		return nil
+	}
+	if len(c.StrategySettings.Strategies) == 0 {
+		return errNoSubStrategies
+	}
+	if len(c.StrategySettings.CustomSettings) > 0 {
+		return fmt.Errorf("%w %v custom settings must be set per strategy", base.ErrCustomSettingsUnsupported, strategies.MultiStrategyName)
+	}
+	if c.OptimisationSettings != nil {
+		return fmt.Errorf("%w optimisation cannot be used with %v", errFeatureIncompatible, strategies.MultiStrategyName)
+	}
+	nicknames := make(map[string]bool, len(c.StrategySettings.Strategies))
+	assigned := make(map[int]string, len(c.CurrencySettings))
+	var totalAllocation decimal.Decimal
+	for i := range c.StrategySettings.Strategies {
+		sub := &c.StrategySettings.Strategies[i]
+		if strings.EqualFold(sub.Name, strategies.MultiStrategyName) || !isSupportedStrategy(sub.Name) {
+			return fmt.Errorf("strategy %v %w", sub.Name, base.ErrStrategyNotFound)
+		}
+		if sub.Nickname == "" {
+			sub.Nickname = sub.Name
+		}
+		if nicknames[strings.ToLower(sub.Nickname)] {
+			return fmt.Errorf("%w '%v', set a nickname to run a strategy more than once", errDuplicateStrategyNickname, sub.Nickname)
+		}
+		nicknames[strings.ToLower(sub.Nickname)] = true
+		if sub.Allocation.IsNegative() || sub.Allocation.GreaterThan(decimal.NewFromInt(1)) {
+			return fmt.Errorf("%w '%v' %v", errInvalidAllocation, sub.Nickname, sub.Allocation)
+		}
+		totalAllocation = totalAllocation.Add(sub.Allocation)
+		if len(sub.Currencies) == 0 {
+			return fmt.Errorf("%w for '%v'", errNoCurrencySettings, sub.Nickname)
+		}
+		for j := range sub.Currencies {
+			sub.Currencies[j].ExchangeName = strings.ToLower(sub.Currencies[j].ExchangeName)
+			index := c.currencySettingsIndex(&sub.Currencies[j])
+			if index == -1 {
+				return fmt.Errorf("'%v' %v %v %v-%v %w",
+					sub.Nickname,
+					sub.Currencies[j].ExchangeName,
+					sub.Currencies[j].Asset,
+					sub.Currencies[j].Base,
+					sub.Currencies[j].Quote,
+					errNoCurrencySettings)
+			}
+			if owner, ok := assigned[index]; ok {
+				return fmt.Errorf("%v %v %v-%v %w '%v' and '%v'",
+					sub.Currencies[j].ExchangeName,
+					sub.Currencies[j].Asset,
+					sub.Currencies[j].Base,
+					sub.Currencies[j].Quote,
+					errCurrencyAssignedTwice,
+					owner,
+					sub.Nickname)
+			}
+			assigned[index] = sub.Nickname
+		}
+	}
+	if totalAllocation.GreaterThan(decimal.NewFromInt(1)) {
+		return fmt.Errorf("%w total %v", errInvalidAllocation, totalAllocation)
+	}
+	for i := range c.CurrencySettings {
+		if _, ok := assigned[i]; !ok {
+			return fmt.Errorf("%v %v %v-%v %w",
+				c.CurrencySettings[i].ExchangeName,
+				c.CurrencySettings[i].Asset,
+				c.CurrencySettings[i].Base,
+				c.CurrencySettings[i].Quote,
+				errCurrencyNotAssigned)
+		}
+	}
+	return nil
+}
+
+// currencySettingsIndex returns the index of the currency setting matching
+// the strategy currency or -1 when there is none
+func (c *Config) currencySettingsIndex(sc *StrategyCurrency) int {
+	for i := range c.CurrencySettings {
+		if strings.EqualFold(c.CurrencySettings[i].ExchangeName, sc.ExchangeName) &&
+			c.CurrencySettings[i].Asset == sc.Asset &&
+			c.CurrencySettings[i].Base.Equal(sc.Base) &&
+			c.CurrencySettings[i].Quote.Equal(sc.Quote) {
+			return i
+		}
+	}
+	return -1
 }
 
 // validateDate checks whether someone has set a date poorly in their config
@@ -145,6 +421,11 @@ func (c *Config) validateDate() error {
 			return err
 		}
 	}
+	if c.DataSettings.TickData != nil {
+		if err := gctcommon.StartEndTimeCheck(c.DataSettings.TickData.StartDate, c.DataSettings.TickData.EndDate); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -225,6 +506,16 @@ func (c *Config) PrintSetting() {
 	log.Infoln(common.Config, common.CMDColours.H1+"------------------Backtester Settings------------------------"+common.CMDColours.Default)
 	log.Infoln(common.Config, common.CMDColours.H2+"------------------Strategy Settings--------------------------"+common.CMDColours.Default)
 	log.Infof(common.Config, "Strategy: %s", c.StrategySettings.Name)
+	for i := range c.StrategySettings.Strategies {
+		sub := &c.StrategySettings.Strategies[i]
+		log.Infof(common.Config, "Strategy %v: %v, allocation: %v", sub.Nickname, sub.Name, sub.Allocation)
+		for j := range sub.Currencies {
+			log.Infof(common.Config, "%v currency: %v %v %v-%v", sub.Nickname, sub.Currencies[j].ExchangeName, sub.Currencies[j].Asset, sub.Currencies[j].Base, sub.Currencies[j].Quote)
+		}
+		for k, v := range sub.CustomSettings {
+			log.Infof(common.Config, "%v custom variable %s: %v", sub.Nickname, k, v)
+		}
+	}
 	if len(c.StrategySettings.CustomSettings) > 0 {
 		log.Infoln(common.Config, "Custom strategy variables:")
 		for k, v := range c.StrategySettings.CustomSettings {
@@ -326,6 +617,14 @@ func (c *Config) PrintSetting() {
 		log.Infof(common.Config, "Interval: %v", c.DataSettings.Interval)
 		log.Infof(common.Config, "CSV file: %v", c.DataSettings.CSVData.FullPath)
 	}
+	if c.DataSettings.TickData != nil {
+		log.Infoln(common.Config, common.CMDColours.H2+"------------------Tick Settings------------------------------"+common.CMDColours.Default)
+		log.Infof(common.Config, "Interval: %v", c.DataSettings.Interval)
+		log.Infof(common.Config, "Directory: %v", c.DataSettings.TickData.Directory)
+		log.Infof(common.Config, "Start date: %v", c.DataSettings.TickData.StartDate.Format(time.DateTime))
+		log.Infof(common.Config, "End date: %v", c.DataSettings.TickData.EndDate.Format(time.DateTime))
+		log.Infof(common.Config, "Orderbook depth: %v", c.DataSettings.TickData.OrderbookDepth)
+	}
 	if c.DataSettings.DatabaseData != nil {
 		log.Infoln(common.Config, common.CMDColours.H2+"------------------Database Settings--------------------------"+common.CMDColours.Default)
 		log.Infof(common.Config, "Data type: %v", c.DataSettings.DataType)
@@ -333,4 +632,43 @@ func (c *Config) PrintSetting() {
 		log.Infof(common.Config, "Start date: %v", c.DataSettings.DatabaseData.StartDate.Format(time.DateTime))
 		log.Infof(common.Config, "End date: %v", c.DataSettings.DatabaseData.EndDate.Format(time.DateTime))
 	}
+	if c.StatisticSettings.MonteCarlo != nil {
+		log.Infoln(common.Config, common.CMDColours.H2+"------------------Monte Carlo Settings-----------------------"+common.CMDColours.Default)
+		log.Infof(common.Config, "Method: %v", c.StatisticSettings.MonteCarlo.Method)
+		log.Infof(common.Config, "Simulations: %v", c.StatisticSettings.MonteCarlo.Simulations)
+		log.Infof(common.Config, "Skip trade probability: %v", c.StatisticSettings.MonteCarlo.SkipTradeProbability)
+		log.Infof(common.Config, "Confidence level: %v", c.StatisticSettings.MonteCarlo.ConfidenceLevel)
+		log.Infof(common.Config, "Ruin threshold: %v%%", c.StatisticSettings.MonteCarlo.RuinThreshold)
+	}
+	if c.StatisticSettings.Benchmark != nil {
+		log.Infoln(common.Config, common.CMDColours.H2+"------------------Benchmark Settings-------------------------"+common.CMDColours.Default)
+		if c.StatisticSettings.Benchmark.CSVPath != "" {
+			log.Infof(common.Config, "CSV path: %v", c.StatisticSettings.Benchmark.CSVPath)
+		}
+		for i := range c.StatisticSettings.Benchmark.Currencies {
+			b := &c.StatisticSettings.Benchmark.Currencies[i]
+			log.Infof(common.Config, "%v %v %v-%v weight: %v", b.ExchangeName, b.Asset, b.Base, b.Quote, b.Weight)
+		}
+	}
+	if c.OptimisationSettings != nil {
+		log.Infoln(common.Config, common.CMDColours.H2+"------------------Optimisation Settings----------------------"+common.CMDColours.Default)
+		log.Infof(common.Config, "Method: %v", c.OptimisationSettings.Method)
+		log.Infof(common.Config, "Metric: %v", c.OptimisationSettings.Metric)
+		if c.OptimisationSettings.Method != GridSearch {
+			log.Infof(common.Config, "Iterations: %v", c.OptimisationSettings.Iterations)
+		}
+		for i := range c.OptimisationSettings.Parameters {
+			p := &c.OptimisationSettings.Parameters[i]
+			if len(p.Values) > 0 {
+				log.Infof(common.Config, "%v: %v", p.Name, p.Values)
+				continue
+			}
+			log.Infof(common.Config, "%v: %v to %v step %v", p.Name, p.Minimum, p.Maximum, p.Step)
+		}
+		if c.OptimisationSettings.WalkForward != nil {
+			log.Infof(common.Config, "Walk-forward windows: %v", c.OptimisationSettings.WalkForward.Windows)
+			log.Infof(common.Config, "Walk-forward out-of-sample ratio: %v", c.OptimisationSettings.WalkForward.OutOfSampleRatio)
+			log.Infof(common.Config, "Walk-forward anchored: %v", c.OptimisationSettings.WalkForward.Anchored)
+		}
+	}
 }
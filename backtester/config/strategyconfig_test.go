@@ -10,6 +10,7 @@ import (
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/top2bottom2"
 	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
@@ -104,6 +105,17 @@ func TestValidateDate(t *testing.T) {
 	if !errors.Is(err, nil) {
 		t.Errorf("received: %v, expected: %v", err, nil)
 	}
+	c.DataSettings.TickData = &TickData{}
+	err = c.validateDate()
+	if !errors.Is(err, gctcommon.ErrDateUnset) {
+		t.Errorf("received: %v, expected: %v", err, gctcommon.ErrDateUnset)
+	}
+	c.DataSettings.TickData.StartDate = time.Now()
+	c.DataSettings.TickData.EndDate = c.DataSettings.TickData.StartDate.Add(time.Minute)
+	err = c.validateDate()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
 }
 
 func TestValidateCurrencySettings(t *testing.T) {
@@ -353,6 +365,315 @@ func TestValidateStrategySettings(t *testing.T) {
 	if !errors.Is(err, errExchangeLevelFundingRequired) {
 		t.Errorf("received %v expected %v", err, errExchangeLevelFundingRequired)
 	}
+
+	c.FundingSettings = FundingSettings{}
+	c.DataSettings.TickData = &TickData{}
+	err = c.validateStrategySettings()
+	if !errors.Is(err, errTickDataDirectoryUnset) {
+		t.Errorf("received %v expected %v", err, errTickDataDirectoryUnset)
+	}
+	c.DataSettings.TickData.Directory = "capture"
+	err = c.validateStrategySettings()
+	if !errors.Is(err, errFeatureIncompatible) {
+		t.Errorf("received %v expected %v", err, errFeatureIncompatible)
+	}
+	c.StrategySettings.DisableUSDTracking = true
+	err = c.validateStrategySettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+}
+
+func TestValidateSubStrategies(t *testing.T) {
+	t.Parallel()
+	c := &Config{StrategySettings: StrategySettings{Name: dca}}
+	err := c.validateSubStrategies()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	c.StrategySettings.Strategies = []SubStrategySettings{{Name: dca}}
+	err = c.validateStrategySettings()
+	if !errors.Is(err, errFeatureIncompatible) {
+		t.Errorf("received %v expected %v", err, errFeatureIncompatible)
+	}
+
+	c.StrategySettings = StrategySettings{Name: strategies.MultiStrategyName}
+	err = c.validateStrategySettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	err = c.validateSubStrategies()
+	if !errors.Is(err, errNoSubStrategies) {
+		t.Errorf("received %v expected %v", err, errNoSubStrategies)
+	}
+	c.StrategySettings.Strategies = []SubStrategySettings{{Name: strategies.MultiStrategyName}}
+	err = c.validateSubStrategies()
+	if !errors.Is(err, base.ErrStrategyNotFound) {
+		t.Errorf("received %v expected %v", err, base.ErrStrategyNotFound)
+	}
+
+	c.CurrencySettings = []CurrencySettings{
+		{ExchangeName: mainExchange, Asset: asset.Spot, Base: currency.BTC, Quote: currency.USDT},
+		{ExchangeName: mainExchange, Asset: asset.Spot, Base: currency.ETH, Quote: currency.USDT},
+	}
+	btc := StrategyCurrency{ExchangeName: "Binance", Asset: asset.Spot, Base: currency.BTC, Quote: currency.USDT}
+	eth := StrategyCurrency{ExchangeName: mainExchange, Asset: asset.Spot, Base: currency.ETH, Quote: currency.USDT}
+	c.StrategySettings.Strategies = []SubStrategySettings{
+		{Name: dca, Allocation: decimal.NewFromFloat(0.5), Currencies: []StrategyCurrency{btc}},
+		{Name: dca, Allocation: decimal.NewFromFloat(0.5), Currencies: []StrategyCurrency{eth}},
+	}
+	err = c.validateSubStrategies()
+	if !errors.Is(err, errDuplicateStrategyNickname) {
+		t.Errorf("received %v expected %v", err, errDuplicateStrategyNickname)
+	}
+	c.StrategySettings.Strategies[1].Nickname = "dca-eth"
+	c.StrategySettings.Strategies[1].Allocation = decimal.NewFromFloat(0.6)
+	err = c.validateSubStrategies()
+	if !errors.Is(err, errInvalidAllocation) {
+		t.Errorf("received %v expected %v", err, errInvalidAllocation)
+	}
+	c.StrategySettings.Strategies[1].Allocation = decimal.NewFromFloat(0.5)
+	c.StrategySettings.Strategies[1].Currencies = []StrategyCurrency{btc}
+	err = c.validateSubStrategies()
+	if !errors.Is(err, errCurrencyAssignedTwice) {
+		t.Errorf("received %v expected %v", err, errCurrencyAssignedTwice)
+	}
+	c.StrategySettings.Strategies[1].Currencies = []StrategyCurrency{{ExchangeName: mainExchange, Asset: asset.Spot, Base: currency.LTC, Quote: currency.USDT}}
+	err = c.validateSubStrategies()
+	if !errors.Is(err, errNoCurrencySettings) {
+		t.Errorf("received %v expected %v", err, errNoCurrencySettings)
+	}
+	c.StrategySettings.Strategies[1].Currencies = nil
+	err = c.validateSubStrategies()
+	if !errors.Is(err, errNoCurrencySettings) {
+		t.Errorf("received %v expected %v", err, errNoCurrencySettings)
+	}
+	c.StrategySettings.Strategies = c.StrategySettings.Strategies[:1]
+	err = c.validateSubStrategies()
+	if !errors.Is(err, errCurrencyNotAssigned) {
+		t.Errorf("received %v expected %v", err, errCurrencyNotAssigned)
+	}
+	c.StrategySettings.Strategies = append(c.StrategySettings.Strategies, SubStrategySettings{Name: "rsi", Currencies: []StrategyCurrency{eth}})
+	err = c.validateSubStrategies()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	if c.StrategySettings.Strategies[0].Nickname != dca || c.StrategySettings.Strategies[0].Currencies[0].ExchangeName != mainExchange {
+		t.Errorf("received %v %v expected the nickname and exchange name to be set", c.StrategySettings.Strategies[0].Nickname, c.StrategySettings.Strategies[0].Currencies[0].ExchangeName)
+	}
+
+	c.StrategySettings.CustomSettings = map[string]interface{}{"rsi-low": 30}
+	err = c.validateSubStrategies()
+	if !errors.Is(err, base.ErrCustomSettingsUnsupported) {
+		t.Errorf("received %v expected %v", err, base.ErrCustomSettingsUnsupported)
+	}
+	c.StrategySettings.CustomSettings = nil
+	c.OptimisationSettings = &OptimisationSettings{}
+	err = c.validateSubStrategies()
+	if !errors.Is(err, errFeatureIncompatible) {
+		t.Errorf("received %v expected %v", err, errFeatureIncompatible)
+	}
+}
+
+func TestValidateMonteCarloSettings(t *testing.T) {
+	t.Parallel()
+	c := &Config{}
+	err := c.validateMonteCarloSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	c.StrategySettings.DisableUSDTracking = true
+	c.StatisticSettings.MonteCarlo = &MonteCarloSettings{}
+	err = c.validateMonteCarloSettings()
+	if !errors.Is(err, errFeatureIncompatible) {
+		t.Errorf("received %v expected %v", err, errFeatureIncompatible)
+	}
+	c.StrategySettings.DisableUSDTracking = false
+	err = c.validateMonteCarloSettings()
+	if !errors.Is(err, errMonteCarloMethodUnsupported) {
+		t.Errorf("received %v expected %v", err, errMonteCarloMethodUnsupported)
+	}
+	c.StatisticSettings.MonteCarlo.Method = BootstrapResampling
+	err = c.validateMonteCarloSettings()
+	if !errors.Is(err, errInvalidMonteCarloSettings) {
+		t.Errorf("received %v expected %v", err, errInvalidMonteCarloSettings)
+	}
+	c.StatisticSettings.MonteCarlo.Simulations = 1000
+	c.StatisticSettings.MonteCarlo.SkipTradeProbability = decimal.NewFromInt(1)
+	err = c.validateMonteCarloSettings()
+	if !errors.Is(err, errInvalidMonteCarloSettings) {
+		t.Errorf("received %v expected %v", err, errInvalidMonteCarloSettings)
+	}
+	c.StatisticSettings.MonteCarlo.SkipTradeProbability = decimal.NewFromFloat(0.1)
+	err = c.validateMonteCarloSettings()
+	if !errors.Is(err, errInvalidMonteCarloSettings) {
+		t.Errorf("received %v expected %v", err, errInvalidMonteCarloSettings)
+	}
+	c.StatisticSettings.MonteCarlo.ConfidenceLevel = decimal.NewFromFloat(0.95)
+	c.StatisticSettings.MonteCarlo.RuinThreshold = decimal.NewFromInt(101)
+	err = c.validateMonteCarloSettings()
+	if !errors.Is(err, errInvalidMonteCarloSettings) {
+		t.Errorf("received %v expected %v", err, errInvalidMonteCarloSettings)
+	}
+	c.StatisticSettings.MonteCarlo.RuinThreshold = decimal.NewFromInt(50)
+	err = c.validateMonteCarloSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+}
+
+func TestValidateRiskSettings(t *testing.T) {
+	t.Parallel()
+	c := &Config{}
+	err := c.validateRiskSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	c.StatisticSettings.RollingWindow = 1
+	err = c.validateRiskSettings()
+	if !errors.Is(err, errInvalidRiskSettings) {
+		t.Errorf("received %v expected %v", err, errInvalidRiskSettings)
+	}
+	c.StatisticSettings.RollingWindow = 30
+	c.StatisticSettings.ValueAtRiskConfidenceLevel = decimal.NewFromInt(1)
+	err = c.validateRiskSettings()
+	if !errors.Is(err, errInvalidRiskSettings) {
+		t.Errorf("received %v expected %v", err, errInvalidRiskSettings)
+	}
+	c.StatisticSettings.ValueAtRiskConfidenceLevel = decimal.NewFromFloat(0.99)
+	c.StatisticSettings.Benchmark = &BenchmarkSettings{}
+	c.DataSettings.LiveData = &LiveData{}
+	err = c.validateRiskSettings()
+	if !errors.Is(err, errFeatureIncompatible) {
+		t.Errorf("received %v expected %v", err, errFeatureIncompatible)
+	}
+	c.DataSettings.LiveData = nil
+	err = c.validateRiskSettings()
+	if !errors.Is(err, errInvalidBenchmark) {
+		t.Errorf("received %v expected %v", err, errInvalidBenchmark)
+	}
+	c.StatisticSettings.Benchmark.CSVPath = "index.csv"
+	err = c.validateRiskSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+
+	c.StatisticSettings.Benchmark = &BenchmarkSettings{
+		Currencies: []BenchmarkCurrency{{}},
+	}
+	c.DataSettings.CSVData = &CSVData{}
+	err = c.validateRiskSettings()
+	if !errors.Is(err, errFeatureIncompatible) {
+		t.Errorf("received %v expected %v", err, errFeatureIncompatible)
+	}
+	c.DataSettings.CSVData = nil
+	err = c.validateRiskSettings()
+	if !errors.Is(err, errUnsetExchange) {
+		t.Errorf("received %v expected %v", err, errUnsetExchange)
+	}
+	c.StatisticSettings.Benchmark.Currencies[0].ExchangeName = "BINANCE"
+	err = c.validateRiskSettings()
+	if !errors.Is(err, errUnsetCurrency) {
+		t.Errorf("received %v expected %v", err, errUnsetCurrency)
+	}
+	c.StatisticSettings.Benchmark.Currencies[0].Asset = asset.Spot
+	c.StatisticSettings.Benchmark.Currencies[0].Base = currency.ETH
+	c.StatisticSettings.Benchmark.Currencies[0].Quote = currency.USDT
+	err = c.validateRiskSettings()
+	if !errors.Is(err, errInvalidBenchmark) {
+		t.Errorf("received %v expected %v", err, errInvalidBenchmark)
+	}
+	if c.StatisticSettings.Benchmark.Currencies[0].ExchangeName != mainExchange {
+		t.Errorf("received %v expected %v", c.StatisticSettings.Benchmark.Currencies[0].ExchangeName, mainExchange)
+	}
+	c.CurrencySettings = []CurrencySettings{{ExchangeName: mainExchange}}
+	err = c.validateRiskSettings()
+	if !errors.Is(err, errInvalidBenchmark) {
+		t.Errorf("received %v expected %v", err, errInvalidBenchmark)
+	}
+	c.StatisticSettings.Benchmark.Currencies[0].Weight = decimal.NewFromInt(1)
+	err = c.validateRiskSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	c.StatisticSettings.Benchmark.Currencies = append(c.StatisticSettings.Benchmark.Currencies, c.StatisticSettings.Benchmark.Currencies[0])
+	err = c.validateRiskSettings()
+	if !errors.Is(err, errInvalidBenchmark) {
+		t.Errorf("received %v expected %v", err, errInvalidBenchmark)
+	}
+}
+
+func TestValidateOptimisationSettings(t *testing.T) {
+	t.Parallel()
+	c := &Config{}
+	err := c.validateOptimisationSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	c.OptimisationSettings = &OptimisationSettings{}
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errOptimisationMethodUnsupported) {
+		t.Errorf("received %v expected %v", err, errOptimisationMethodUnsupported)
+	}
+	c.OptimisationSettings.Method = RandomSearch
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errOptimisationIterationsUnset) {
+		t.Errorf("received %v expected %v", err, errOptimisationIterationsUnset)
+	}
+	c.OptimisationSettings.Iterations = 10
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errOptimisationMetricUnsupported) {
+		t.Errorf("received %v expected %v", err, errOptimisationMetricUnsupported)
+	}
+	c.OptimisationSettings.Metric = SortinoRatioMetric
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errNoOptimisationParameters) {
+		t.Errorf("received %v expected %v", err, errNoOptimisationParameters)
+	}
+	c.OptimisationSettings.Parameters = []OptimisationParameter{{Name: "rsi-low", Minimum: 10, Maximum: 5}}
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errInvalidOptimisationParameter) {
+		t.Errorf("received %v expected %v", err, errInvalidOptimisationParameter)
+	}
+	c.OptimisationSettings.Parameters[0].Maximum = 20
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	c.OptimisationSettings.Method = GridSearch
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errInvalidOptimisationParameter) {
+		t.Errorf("received %v expected %v", err, errInvalidOptimisationParameter)
+	}
+	c.OptimisationSettings.Parameters[0].Step = 5
+	c.OptimisationSettings.Parameters = append(c.OptimisationSettings.Parameters, OptimisationParameter{Name: "rsi-low", Values: []interface{}{1.0}})
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errInvalidOptimisationParameter) {
+		t.Errorf("received %v expected %v", err, errInvalidOptimisationParameter)
+	}
+	c.OptimisationSettings.Parameters[1].Name = "rsi-high"
+	c.OptimisationSettings.WalkForward = &WalkForward{Windows: 2, OutOfSampleRatio: decimal.NewFromInt(1)}
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errInvalidWalkForward) {
+		t.Errorf("received %v expected %v", err, errInvalidWalkForward)
+	}
+	c.OptimisationSettings.WalkForward.OutOfSampleRatio = decimal.NewFromFloat(0.2)
+	c.DataSettings.CSVData = &CSVData{}
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errFeatureIncompatible) {
+		t.Errorf("received %v expected %v", err, errFeatureIncompatible)
+	}
+	c.DataSettings.CSVData = nil
+	c.DataSettings.APIData = &APIData{}
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	c.DataSettings.LiveData = &LiveData{}
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errFeatureIncompatible) {
+		t.Errorf("received %v expected %v", err, errFeatureIncompatible)
+	}
 }
 
 func TestPrintSettings(t *testing.T) {
@@ -401,6 +722,11 @@ func TestPrintSettings(t *testing.T) {
 				StartDate: startDate,
 				EndDate:   endDate,
 			},
+			TickData: &TickData{
+				Directory: "fake",
+				StartDate: startDate,
+				EndDate:   endDate,
+			},
 		},
 		PortfolioSettings: PortfolioSettings{
 			BuySide:  minMax,
@@ -1017,6 +1343,82 @@ func TestGenerateConfigForRSIAPICustomSettings(t *testing.T) {
 	}
 }
 
+func TestGenerateConfigForRSIAPIOptimisation(t *testing.T) {
+	if !saveConfig {
+		t.Skip("saveConfig false, skipping")
+	}
+	cfg := Config{
+		Nickname: "TestGenerateRSICandleAPIOptimisationStrat",
+		Goal:     "To demonstrate optimising the RSI strategy's custom settings with walk-forward analysis",
+		StrategySettings: StrategySettings{
+			Name: "rsi",
+			CustomSettings: map[string]interface{}{
+				"rsi-low":    30.0,
+				"rsi-high":   70.0,
+				"rsi-period": 14,
+			},
+		},
+		CurrencySettings: []CurrencySettings{
+			{
+				ExchangeName: mainExchange,
+				Asset:        asset.Spot,
+				Base:         mainCurrencyPair.Base,
+				Quote:        mainCurrencyPair.Quote,
+				SpotDetails: &SpotDetails{
+					InitialQuoteFunds: initialFunds100000,
+				},
+				BuySide:  minMax,
+				SellSide: minMax,
+				MakerFee: &makerFee,
+				TakerFee: &takerFee,
+			},
+		},
+		DataSettings: DataSettings{
+			Interval: kline.ThreeHour,
+			DataType: common.CandleStr,
+			APIData: &APIData{
+				StartDate:        startDate,
+				EndDate:          endDate.Add(time.Hour), // Now divisible by 3 hour candle
+				InclusiveEndDate: false,
+			},
+		},
+		PortfolioSettings: PortfolioSettings{
+			BuySide:  minMax,
+			SellSide: minMax,
+		},
+		StatisticSettings: StatisticSettings{
+			RiskFreeRate: decimal.NewFromFloat(0.03),
+		},
+		OptimisationSettings: &OptimisationSettings{
+			Method: GridSearch,
+			Metric: SharpeRatioMetric,
+			Parameters: []OptimisationParameter{
+				{Name: "rsi-low", Minimum: 20, Maximum: 40, Step: 5},
+				{Name: "rsi-high", Minimum: 60, Maximum: 80, Step: 5},
+				{Name: "rsi-period", Values: []interface{}{7.0, 14.0, 21.0}},
+			},
+			WalkForward: &WalkForward{
+				Windows:          4,
+				OutOfSampleRatio: decimal.NewFromFloat(0.25),
+			},
+		},
+	}
+	if saveConfig {
+		result, err := json.MarshalIndent(cfg, "", " ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := os.Getwd()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.WriteFile(filepath.Join(p, "strategyexamples", "rsi-api-candles-optimisation.strat"), result, file.DefaultPermissionOctal)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
+
 func TestGenerateConfigForDCACSVCandles(t *testing.T) {
 	if !saveConfig {
 		t.Skip("saveConfig false, skipping")
@@ -1483,3 +1885,259 @@ func TestGenerateConfigForLiveCashAndCarry(t *testing.T) {
 		}
 	}
 }
+
+func TestGenerateConfigForRSIAPIMonteCarlo(t *testing.T) {
+	if !saveConfig {
+		t.Skip("saveConfig false, skipping")
+	}
+	cfg := Config{
+		Nickname: "TestGenerateRSICandleAPIMonteCarloStrat",
+		Goal:     "To demonstrate resampling the trades of the RSI strategy to estimate its range of outcomes",
+		StrategySettings: StrategySettings{
+			Name: "rsi",
+			CustomSettings: map[string]interface{}{
+				"rsi-low":    30.0,
+				"rsi-high":   70.0,
+				"rsi-period": 14,
+			},
+		},
+		CurrencySettings: []CurrencySettings{
+			{
+				ExchangeName: mainExchange,
+				Asset:        asset.Spot,
+				Base:         mainCurrencyPair.Base,
+				Quote:        mainCurrencyPair.Quote,
+				SpotDetails: &SpotDetails{
+					InitialQuoteFunds: initialFunds100000,
+				},
+				BuySide:  minMax,
+				SellSide: minMax,
+				MakerFee: &makerFee,
+				TakerFee: &takerFee,
+			},
+		},
+		DataSettings: DataSettings{
+			Interval: kline.OneDay,
+			DataType: common.CandleStr,
+			APIData: &APIData{
+				StartDate:        startDate,
+				EndDate:          endDate,
+				InclusiveEndDate: false,
+			},
+		},
+		PortfolioSettings: PortfolioSettings{
+			BuySide:  minMax,
+			SellSide: minMax,
+		},
+		StatisticSettings: StatisticSettings{
+			RiskFreeRate: decimal.NewFromFloat(0.03),
+			MonteCarlo: &MonteCarloSettings{
+				Method:               ShuffleResampling,
+				Simulations:          5000,
+				SkipTradeProbability: decimal.NewFromFloat(0.1),
+				ConfidenceLevel:      decimal.NewFromFloat(0.95),
+				RuinThreshold:        decimal.NewFromInt(20),
+			},
+		},
+	}
+	if saveConfig {
+		result, err := json.MarshalIndent(cfg, "", " ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := os.Getwd()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.WriteFile(filepath.Join(p, "strategyexamples", "rsi-api-candles-monte-carlo.strat"), result, file.DefaultPermissionOctal)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestGenerateConfigForMultiStrategy(t *testing.T) {
+	if !saveConfig {
+		t.Skip("saveConfig false, skipping")
+	}
+	cfg := Config{
+		Nickname: "ExampleMultiStrategyDCAAndRSI",
+		Goal:     "To demonstrate running the DCA and RSI strategies together from a shared pool of funds, each with its own currencies and allocation",
+		StrategySettings: StrategySettings{
+			Name:                         strategies.MultiStrategyName,
+			SimultaneousSignalProcessing: true,
+			Strategies: []SubStrategySettings{
+				{
+					Name:       dca,
+					Allocation: decimal.NewFromFloat(0.4),
+					Currencies: []StrategyCurrency{
+						{
+							ExchangeName: mainExchange,
+							Asset:        asset.Spot,
+							Base:         mainCurrencyPair.Base,
+							Quote:        mainCurrencyPair.Quote,
+						},
+					},
+				},
+				{
+					Name:       "rsi",
+					Allocation: decimal.NewFromFloat(0.6),
+					Currencies: []StrategyCurrency{
+						{
+							ExchangeName: mainExchange,
+							Asset:        asset.Spot,
+							Base:         currency.ETH,
+							Quote:        mainCurrencyPair.Quote,
+						},
+					},
+					CustomSettings: map[string]interface{}{
+						"rsi-low":    30.0,
+						"rsi-high":   70.0,
+						"rsi-period": 14,
+					},
+				},
+			},
+		},
+		FundingSettings: FundingSettings{
+			UseExchangeLevelFunding: true,
+			ExchangeLevelFunding: []ExchangeLevelFunding{
+				{
+					ExchangeName: mainExchange,
+					Asset:        asset.Spot,
+					Currency:     mainCurrencyPair.Quote,
+					InitialFunds: decimal.NewFromInt(100000),
+				},
+			},
+		},
+		CurrencySettings: []CurrencySettings{
+			{
+				ExchangeName: mainExchange,
+				Asset:        asset.Spot,
+				Base:         mainCurrencyPair.Base,
+				Quote:        mainCurrencyPair.Quote,
+				BuySide:      minMax,
+				SellSide:     minMax,
+				MakerFee:     &makerFee,
+				TakerFee:     &takerFee,
+			},
+			{
+				ExchangeName: mainExchange,
+				Asset:        asset.Spot,
+				Base:         currency.ETH,
+				Quote:        mainCurrencyPair.Quote,
+				BuySide:      minMax,
+				SellSide:     minMax,
+				MakerFee:     &makerFee,
+				TakerFee:     &takerFee,
+			},
+		},
+		DataSettings: DataSettings{
+			Interval: kline.OneDay,
+			DataType: common.CandleStr,
+			APIData: &APIData{
+				StartDate:        startDate,
+				EndDate:          endDate,
+				InclusiveEndDate: false,
+			},
+		},
+		PortfolioSettings: PortfolioSettings{
+			BuySide:  minMax,
+			SellSide: minMax,
+		},
+		StatisticSettings: StatisticSettings{
+			RiskFreeRate: decimal.NewFromFloat(0.03),
+		},
+	}
+	if saveConfig {
+		result, err := json.MarshalIndent(cfg, "", " ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := os.Getwd()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.WriteFile(filepath.Join(p, "strategyexamples", "multi-strategy-dca-rsi-api-candles.strat"), result, file.DefaultPermissionOctal)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestGenerateConfigForDCAAPICandlesBenchmark(t *testing.T) {
+	if !saveConfig {
+		t.Skip("saveConfig false, skipping")
+	}
+	cfg := Config{
+		Nickname: "ExampleStrategyDCAAPICandlesBenchmark",
+		Goal:     "To demonstrate comparing the DCA strategy to a weighted basket of currencies",
+		StrategySettings: StrategySettings{
+			Name: dca,
+		},
+		CurrencySettings: []CurrencySettings{
+			{
+				ExchangeName: mainExchange,
+				Asset:        asset.Spot,
+				Base:         mainCurrencyPair.Base,
+				Quote:        mainCurrencyPair.Quote,
+				SpotDetails: &SpotDetails{
+					InitialQuoteFunds: initialFunds100000,
+				},
+				BuySide:  minMax,
+				SellSide: minMax,
+				MakerFee: &makerFee,
+				TakerFee: &takerFee,
+			},
+		},
+		DataSettings: DataSettings{
+			Interval: kline.OneDay,
+			DataType: common.CandleStr,
+			APIData: &APIData{
+				StartDate:        startDate,
+				EndDate:          endDate,
+				InclusiveEndDate: false,
+			},
+		},
+		PortfolioSettings: PortfolioSettings{
+			BuySide:  minMax,
+			SellSide: minMax,
+		},
+		StatisticSettings: StatisticSettings{
+			RiskFreeRate: decimal.NewFromFloat(0.03),
+			Benchmark: &BenchmarkSettings{
+				Currencies: []BenchmarkCurrency{
+					{
+						ExchangeName: mainExchange,
+						Asset:        asset.Spot,
+						Base:         currency.BTC,
+						Quote:        currency.USDT,
+						Weight:       decimal.NewFromFloat(0.6),
+					},
+					{
+						ExchangeName: mainExchange,
+						Asset:        asset.Spot,
+						Base:         currency.ETH,
+						Quote:        currency.USDT,
+						Weight:       decimal.NewFromFloat(0.4),
+					},
+				},
+			},
+			RollingWindow:              14,
+			ValueAtRiskConfidenceLevel: decimal.NewFromFloat(0.95),
+		},
+	}
+	if saveConfig {
+		result, err := json.MarshalIndent(cfg, "", " ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := os.Getwd()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.WriteFile(filepath.Join(p, "strategyexamples", "dca-api-candles-benchmark.strat"), result, file.DefaultPermissionOctal)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
@@ -26,6 +26,49 @@ var (
 	errMinMaxEqual                      = errors.New("minimum and maximum limits cannot be equal")
 	errPerpetualsUnsupported            = errors.New("perpetual futures not yet supported")
 	errFeatureIncompatible              = errors.New("feature is not compatible")
+	errTickDataDirectoryUnset           = errors.New("tick data directory unset, please check your config")
+	errOptimisationMethodUnsupported    = errors.New("unsupported optimisation method")
+	errOptimisationMetricUnsupported    = errors.New("unsupported optimisation metric")
+	errNoOptimisationParameters         = errors.New("no optimisation parameters set, please check your config")
+	errInvalidOptimisationParameter     = errors.New("invalid optimisation parameter")
+	errOptimisationIterationsUnset      = errors.New("optimisation iterations must be greater than zero")
+	errInvalidWalkForward               = errors.New("invalid walk-forward settings")
+	errMonteCarloMethodUnsupported      = errors.New("unsupported monte carlo resampling method")
+	errInvalidMonteCarloSettings        = errors.New("invalid monte carlo settings")
+	errNoSubStrategies                  = errors.New("no strategies set for multi-strategy, please check your config")
+	errDuplicateStrategyNickname        = errors.New("duplicate strategy nickname")
+	errCurrencyNotAssigned              = errors.New("currency is not assigned to a strategy")
+	errCurrencyAssignedTwice            = errors.New("currency is assigned to more than one strategy")
+	errInvalidAllocation                = errors.New("invalid strategy allocation, allocations must be between 0 and 1 and cannot total more than 1")
+	errInvalidBenchmark                 = errors.New("invalid benchmark settings")
+	errInvalidRiskSettings              = errors.New("invalid risk metric settings")
+)
+
+// Optimisation methods
+const (
+	// GridSearch runs every combination of parameter values
+	GridSearch = "grid"
+	// RandomSearch runs randomly sampled parameter values
+	RandomSearch = "random"
+	// BayesianSearch runs randomly sampled parameter values before favouring
+	// values close to the best scoring runs
+	BayesianSearch = "bayesian"
+)
+
+// Optimisation metrics
+const (
+	SharpeRatioMetric  = "sharpe-ratio"
+	SortinoRatioMetric = "sortino-ratio"
+	MaxDrawdownMetric  = "max-drawdown"
+	CAGRMetric         = "cagr"
+)
+
+// Monte Carlo resampling methods
+const (
+	// ShuffleResampling reorders every trade of the run
+	ShuffleResampling = "shuffle"
+	// BootstrapResampling draws trades from the run with replacement
+	BootstrapResampling = "bootstrap"
 )
 
 // Config defines what is in an individual strategy config
@@ -38,6 +81,8 @@ type Config struct {
 	DataSettings      DataSettings       `json:"data-settings"`
 	PortfolioSettings PortfolioSettings  `json:"portfolio-settings"`
 	StatisticSettings StatisticSettings  `json:"statistic-settings"`
+
+	OptimisationSettings *OptimisationSettings `json:"optimisation-settings,omitempty"`
 }
 
 // DataSettings is a container for each type of data retrieval setting.
@@ -50,6 +95,7 @@ type DataSettings struct {
 	DatabaseData            *DatabaseData  `json:"database-data,omitempty"`
 	LiveData                *LiveData      `json:"live-data,omitempty"`
 	CSVData                 *CSVData       `json:"csv-data,omitempty"`
+	TickData                *TickData      `json:"tick-data,omitempty"`
 }
 
 // FundingSettings contains funding details for individual currencies
@@ -69,6 +115,32 @@ type StrategySettings struct {
 	// bool language is opposite to encourage use by default
 	DisableUSDTracking bool                   `json:"disable-usd-tracking"`
 	CustomSettings     map[string]interface{} `json:"custom-settings,omitempty"`
+	// Strategies are run together when the name is multi-strategy, with
+	// each strategy trading its own currencies from the shared funding
+	Strategies []SubStrategySettings `json:"strategies,omitempty"`
+}
+
+// SubStrategySettings defines one of the strategies of a multi-strategy run,
+// the currencies it trades and the share of funding it can buy with
+type SubStrategySettings struct {
+	Name string `json:"name"`
+	// Nickname identifies the strategy in the results, defaulting to the
+	// name, so the same strategy can be run more than once
+	Nickname string `json:"nickname,omitempty"`
+	// Allocation is the fraction of each quote currency's initial funds the
+	// strategy can hold in spot positions. Zero leaves buys unrestricted
+	Allocation     decimal.Decimal        `json:"allocation"`
+	Currencies     []StrategyCurrency     `json:"currencies"`
+	CustomSettings map[string]interface{} `json:"custom-settings,omitempty"`
+}
+
+// StrategyCurrency references a currency setting by its exchange, asset and
+// pair
+type StrategyCurrency struct {
+	ExchangeName string        `json:"exchange-name"`
+	Asset        asset.Item    `json:"asset"`
+	Base         currency.Code `json:"base"`
+	Quote        currency.Code `json:"quote"`
 }
 
 // ExchangeLevelFunding allows the portfolio manager to access
@@ -89,7 +161,50 @@ type ExchangeLevelFunding struct {
 // StatisticSettings adjusts ratios where
 // proper data is currently lacking
 type StatisticSettings struct {
-	RiskFreeRate decimal.Decimal `json:"risk-free-rate"`
+	RiskFreeRate decimal.Decimal     `json:"risk-free-rate"`
+	MonteCarlo   *MonteCarloSettings `json:"monte-carlo,omitempty"`
+	Benchmark    *BenchmarkSettings  `json:"benchmark,omitempty"`
+	// RollingWindow is the number of candles used for the rolling sharpe
+	// ratio. Zero uses the statistics package default
+	RollingWindow int64 `json:"rolling-window,omitempty"`
+	// ValueAtRiskConfidenceLevel is the confidence level for value at risk
+	// and expected shortfall. Zero uses the statistics package default
+	ValueAtRiskConfidenceLevel decimal.Decimal `json:"value-at-risk-confidence-level"`
+}
+
+// BenchmarkSettings sets what the returns of a run are compared against
+// instead of buying and holding each currency. Either a basket of currencies
+// or a CSV file can be used
+type BenchmarkSettings struct {
+	Currencies []BenchmarkCurrency `json:"currencies,omitempty"`
+	// CSVPath is a file of unix timestamp and value rows, such as an index
+	CSVPath string `json:"csv-path,omitempty"`
+}
+
+// BenchmarkCurrency is a currency in a benchmark basket. The basket's return
+// for each candle is the weighted average return of its currencies
+type BenchmarkCurrency struct {
+	ExchangeName string          `json:"exchange-name"`
+	Asset        asset.Item      `json:"asset"`
+	Base         currency.Code   `json:"base"`
+	Quote        currency.Code   `json:"quote"`
+	Weight       decimal.Decimal `json:"weight"`
+}
+
+// MonteCarloSettings resamples the trades of a run to produce distributions
+// of final PNL, max drawdown and risk of ruin
+type MonteCarloSettings struct {
+	Method      string `json:"method"`
+	Simulations int64  `json:"simulations"`
+	// SkipTradeProbability is the chance each trade is left out of a simulation
+	SkipTradeProbability decimal.Decimal `json:"skip-trade-probability"`
+	// ConfidenceLevel sets the width of the reported confidence intervals
+	// eg 0.95 reports the 2.5th and 97.5th percentiles
+	ConfidenceLevel decimal.Decimal `json:"confidence-level"`
+	// RuinThreshold is the percentage loss of the initial USD value at which a
+	// simulation is considered ruined
+	RuinThreshold decimal.Decimal `json:"ruin-threshold"`
+	Seed          int64           `json:"seed,omitempty"`
 }
 
 // PortfolioSettings act as a global protector for strategies
@@ -179,6 +294,53 @@ type CSVData struct {
 	FullPath string `json:"full-path"`
 }
 
+// TickData defines all fields to configure trade and orderbook data captured
+// by the GoCryptoTrader market data capture subsystem. Each trade and
+// orderbook change is processed as its own event. OrderbookDepth limits the
+// price levels held by each event to reduce memory usage, all levels are held
+// when it is zero
+type TickData struct {
+	Directory      string    `json:"directory"`
+	StartDate      time.Time `json:"start-date"`
+	EndDate        time.Time `json:"end-date"`
+	OrderbookDepth int       `json:"orderbook-depth"`
+}
+
+// OptimisationSettings runs the strategy once for each set of custom settings
+// generated from the parameters and ranks the runs by the metric. Custom
+// settings not covered by a parameter are kept for every run
+type OptimisationSettings struct {
+	Method           string                  `json:"method"`
+	Metric           string                  `json:"metric"`
+	Iterations       int64                   `json:"iterations,omitempty"`
+	MaxParallelTasks int64                   `json:"max-parallel-tasks,omitempty"`
+	Seed             int64                   `json:"seed,omitempty"`
+	Parameters       []OptimisationParameter `json:"parameters"`
+	WalkForward      *WalkForward            `json:"walk-forward,omitempty"`
+}
+
+// OptimisationParameter is a custom setting to optimise. Either Values are
+// used as is, or values are generated from Minimum to Maximum inclusive in
+// increments of Step. Random and bayesian searches sample any value in the
+// range when Step is zero
+type OptimisationParameter struct {
+	Name    string        `json:"name"`
+	Minimum float64       `json:"minimum,omitempty"`
+	Maximum float64       `json:"maximum,omitempty"`
+	Step    float64       `json:"step,omitempty"`
+	Values  []interface{} `json:"values,omitempty"`
+}
+
+// WalkForward splits the date range into windows, each optimised over its
+// in-sample period with the best custom settings then run over the following
+// out-of-sample period. Anchored windows all start their in-sample period at
+// the start date
+type WalkForward struct {
+	Windows          int64           `json:"windows"`
+	OutOfSampleRatio decimal.Decimal `json:"out-of-sample-ratio"`
+	Anchored         bool            `json:"anchored"`
+}
+
 // DatabaseData defines all fields to configure database based data
 type DatabaseData struct {
 	StartDate        time.Time       `json:"start-date"`
@@ -144,7 +144,8 @@ func (b *Base) SetStream(s []Event) error {
 	b.m.Lock()
 	defer b.m.Unlock()
 
-	sort.Slice(s, func(i, j int) bool {
+	// stable sorting keeps events sharing a time, such as ticks, in order
+	sort.SliceStable(s, func(i, j int) bool {
 		return s[i].GetTime().Before(s[j].GetTime())
 	})
 	for x := range s {
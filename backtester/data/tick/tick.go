@@ -0,0 +1,199 @@
+package tick
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/tick"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/capture"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// NewDataFromTicks returns a new struct
+func NewDataFromTicks() *DataFromTicks {
+	return &DataFromTicks{
+		DataFromKline: kline.NewDataFromKline(),
+	}
+}
+
+// Load sets the tick data to the stream for processing
+func (d *DataFromTicks) Load() error {
+	if len(d.Ticks) == 0 {
+		return errNoTickData
+	}
+	var underlyingPair currency.Pair
+	if d.Item != nil {
+		underlyingPair = d.Item.UnderlyingPair
+	}
+	ticks := make([]data.Event, len(d.Ticks))
+	for i := range d.Ticks {
+		d.Ticks[i].UnderlyingPair = underlyingPair
+		ticks[i] = d.Ticks[i]
+	}
+	return d.SetStream(ticks)
+}
+
+// LoadData reads trades and orderbook changes between the start and end from
+// files written by the market data capture subsystem. Each tick holds the
+// orderbook reconstructed at its time, limited to the orderbook depth when it
+// is above zero. Candles of the interval are built from the tick prices
+func LoadData(dir, exchangeName string, interval gctkline.Interval, fPair currency.Pair, a asset.Item, start, end time.Time, orderbookDepth int) (*DataFromTicks, error) {
+	if interval <= 0 {
+		return nil, gctkline.ErrInvalidInterval
+	}
+	k := capture.Key{Exchange: exchangeName, Asset: a, Pair: fPair}
+	r := capture.NewReader(dir)
+	// The orderbook is reconstructed up until the start so that ticks at the
+	// start of the range can be filled against it
+	depth, err := r.Depth(k, start.Add(-time.Nanosecond))
+	if err != nil && !errors.Is(err, capture.ErrNoSnapshot) {
+		return nil, err
+	}
+	var book *orderbook.Base
+	if depth != nil {
+		book, err = retrieveOrderbook(depth, orderbookDepth)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp := NewDataFromTicks()
+	err = r.Read(k, start, end, func(rec *capture.Record) error {
+		t := &tick.Tick{
+			Base: &event.Base{
+				Exchange:     exchangeName,
+				Time:         rec.Time.UTC(),
+				Interval:     interval,
+				CurrencyPair: fPair,
+				AssetType:    a,
+			},
+		}
+		if rec.Kind == capture.KindTrade {
+			td := rec.Trade(k)
+			t.Kind = tick.Trade
+			t.Price = decimal.NewFromFloat(td.Price)
+			t.Amount = decimal.NewFromFloat(td.Amount)
+			t.Side = td.Side
+		} else {
+			if rec.Kind == capture.KindSnapshot && depth == nil {
+				depth = capture.NewDepth(k)
+			}
+			if depth == nil {
+				// updates before the first snapshot cannot be applied
+				return nil
+			}
+			if applyErr := capture.Apply(depth, rec); applyErr != nil {
+				return applyErr
+			}
+			var retrieveErr error
+			book, retrieveErr = retrieveOrderbook(depth, orderbookDepth)
+			if retrieveErr != nil {
+				return retrieveErr
+			}
+			t.Kind = tick.Book
+			t.Price = midPrice(book)
+			if t.Price.IsZero() {
+				// an empty orderbook has no price to act upon
+				return nil
+			}
+		}
+		t.Orderbook = book
+		resp.Ticks = append(resp.Ticks, t)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not read tick data for %v %v %v, %w", exchangeName, a, fPair, err)
+	}
+	if len(resp.Ticks) == 0 {
+		return nil, fmt.Errorf("%w for %v %v %v between %v and %v", errNoTickData, exchangeName, a, fPair, start, end)
+	}
+
+	resp.Item = &gctkline.Item{
+		Exchange: exchangeName,
+		Pair:     fPair,
+		Asset:    a,
+		Interval: interval,
+		Candles:  ticksToCandles(resp.Ticks, interval),
+	}
+	resp.RangeHolder, err = gctkline.CalculateCandleDateRanges(
+		resp.Item.Candles[0].Time,
+		resp.Item.Candles[len(resp.Item.Candles)-1].Time.Add(interval.Duration()),
+		interval,
+		0)
+	if err != nil {
+		return nil, err
+	}
+	return resp, resp.RangeHolder.SetHasDataFromCandles(resp.Item.Candles)
+}
+
+func retrieveOrderbook(d *orderbook.Depth, orderbookDepth int) (*orderbook.Base, error) {
+	b, err := d.Retrieve()
+	if err != nil {
+		return nil, err
+	}
+	if orderbookDepth > 0 {
+		if len(b.Bids) > orderbookDepth {
+			b.Bids = b.Bids[:orderbookDepth]
+		}
+		if len(b.Asks) > orderbookDepth {
+			b.Asks = b.Asks[:orderbookDepth]
+		}
+	}
+	return b, nil
+}
+
+// midPrice returns the price between the best bid and ask, or the best price
+// of the only side with liquidity
+func midPrice(b *orderbook.Base) decimal.Decimal {
+	switch {
+	case len(b.Bids) > 0 && len(b.Asks) > 0:
+		return decimal.NewFromFloat(b.Bids[0].Price).Add(decimal.NewFromFloat(b.Asks[0].Price)).Div(decimal.NewFromInt(2))
+	case len(b.Bids) > 0:
+		return decimal.NewFromFloat(b.Bids[0].Price)
+	case len(b.Asks) > 0:
+		return decimal.NewFromFloat(b.Asks[0].Price)
+	}
+	return decimal.Zero
+}
+
+// ticksToCandles builds candles of the interval from the tick prices and
+// traded amounts. Intervals without ticks are left empty so that they are
+// treated as missing data
+func ticksToCandles(ticks []*tick.Tick, interval gctkline.Interval) []gctkline.Candle {
+	var candles []gctkline.Candle
+	for i := range ticks {
+		candleTime := ticks[i].Time.Truncate(interval.Duration())
+		price := ticks[i].Price.InexactFloat64()
+		amount := ticks[i].Amount.InexactFloat64()
+		if len(candles) > 0 {
+			last := &candles[len(candles)-1]
+			if last.Time.Equal(candleTime) {
+				last.High = max(last.High, price)
+				last.Low = min(last.Low, price)
+				last.Close = price
+				last.Volume += amount
+				continue
+			}
+			for gap := last.Time.Add(interval.Duration()); gap.Before(candleTime); gap = gap.Add(interval.Duration()) {
+				candles = append(candles, gctkline.Candle{Time: gap})
+			}
+		}
+		candles = append(candles, gctkline.Candle{
+			Time:   candleTime,
+			Open:   price,
+			High:   price,
+			Low:    price,
+			Close:  price,
+			Volume: amount,
+		})
+	}
+	return candles
+}
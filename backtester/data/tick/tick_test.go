@@ -0,0 +1,101 @@
+package tick
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/tick"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/capture"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+const testExchange = "binance"
+
+var (
+	testPair  = currency.NewPair(currency.BTC, currency.USDT)
+	testStart = time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+)
+
+func writeTestCapture(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	w, err := capture.NewWriter(dir)
+	require.NoError(t, err)
+	k := capture.Key{Exchange: testExchange, Asset: asset.Spot, Pair: testPair}
+	book1 := &orderbook.Base{
+		Bids:        orderbook.Items{{Price: 99, Amount: 1}, {Price: 98, Amount: 2}},
+		Asks:        orderbook.Items{{Price: 101, Amount: 1}, {Price: 102, Amount: 2}},
+		LastUpdated: testStart.Add(-time.Minute),
+	}
+	require.NoError(t, w.Write(k, capture.SnapshotRecord(book1)))
+	require.NoError(t, w.Write(k, capture.TradeRecord(&trade.Data{TID: "1", Side: order.Buy, Price: 101, Amount: 0.5, Timestamp: testStart})))
+	book2 := &orderbook.Base{
+		Bids:        orderbook.Items{{Price: 100, Amount: 1}, {Price: 99, Amount: 1}, {Price: 98, Amount: 2}},
+		Asks:        book1.Asks,
+		LastUpdated: testStart.Add(time.Second),
+	}
+	require.NoError(t, w.Write(k, capture.UpdateRecord(book1, book2)))
+	require.NoError(t, w.Write(k, capture.TradeRecord(&trade.Data{TID: "2", Side: order.Sell, Price: 100, Amount: 1, Timestamp: testStart.Add(time.Minute * 30)})))
+	require.NoError(t, w.Close())
+	return dir
+}
+
+func TestLoadData(t *testing.T) {
+	t.Parallel()
+	_, err := LoadData(t.TempDir(), testExchange, 0, testPair, asset.Spot, testStart, testStart.Add(time.Hour), 0)
+	assert.ErrorIs(t, err, gctkline.ErrInvalidInterval)
+	_, err = LoadData(t.TempDir(), testExchange, gctkline.FifteenMin, testPair, asset.Spot, testStart, testStart.Add(time.Hour), 0)
+	assert.ErrorIs(t, err, errNoTickData)
+
+	d, err := LoadData(writeTestCapture(t), testExchange, gctkline.FifteenMin, testPair, asset.Spot, testStart, testStart.Add(time.Hour), 1)
+	require.NoError(t, err)
+	require.Len(t, d.Ticks, 3)
+
+	assert.Equal(t, tick.Trade, d.Ticks[0].Kind)
+	assert.Equal(t, order.Buy, d.Ticks[0].Side)
+	assert.Equal(t, "101", d.Ticks[0].Price.String())
+	require.NotNil(t, d.Ticks[0].Orderbook, "the orderbook before the start should be reconstructed")
+	assert.Equal(t, orderbook.Items{{Price: 99, Amount: 1}}, d.Ticks[0].Orderbook.Bids, "orderbooks should be limited to the depth")
+
+	assert.Equal(t, tick.Book, d.Ticks[1].Kind)
+	assert.Equal(t, "100.5", d.Ticks[1].Price.String(), "orderbook changes should be priced at the mid price")
+	assert.Equal(t, 100.0, d.Ticks[1].Orderbook.Bids[0].Price)
+	assert.Same(t, d.Ticks[1].Orderbook, d.Ticks[2].Orderbook, "trades should hold the latest orderbook")
+
+	require.Len(t, d.Item.Candles, 3)
+	assert.Equal(t, gctkline.Candle{Time: testStart, Open: 101, High: 101, Low: 100.5, Close: 100.5, Volume: 0.5}, d.Item.Candles[0])
+	assert.Zero(t, d.Item.Candles[1].Close, "intervals without ticks should be empty")
+	assert.True(t, d.RangeHolder.HasDataAtDate(testStart.Add(time.Second)))
+	assert.False(t, d.RangeHolder.HasDataAtDate(testStart.Add(time.Minute*15)))
+
+	d.Item.UnderlyingPair = currency.NewPair(currency.BTC, currency.USD)
+	require.NoError(t, d.Load())
+	ev, err := d.Next()
+	require.NoError(t, err)
+	assert.Equal(t, d.Item.UnderlyingPair, ev.GetUnderlyingPair())
+	closes, err := d.StreamClose()
+	require.NoError(t, err)
+	assert.Len(t, closes, 1)
+	hasData, err := d.HasDataAtTime(ev.GetTime())
+	require.NoError(t, err)
+	assert.True(t, hasData)
+
+	assert.ErrorIs(t, NewDataFromTicks().Load(), errNoTickData)
+}
+
+func TestLoadDataWithoutSnapshot(t *testing.T) {
+	t.Parallel()
+	d, err := LoadData(writeTestCapture(t), testExchange, gctkline.FifteenMin, testPair, asset.Spot, testStart.Add(-time.Minute*2), testStart.Add(time.Hour), 0)
+	require.NoError(t, err)
+	require.Len(t, d.Ticks, 4)
+	assert.Equal(t, tick.Book, d.Ticks[0].Kind, "the snapshot within the range should be the first tick")
+	assert.Equal(t, "100", d.Ticks[0].Price.String())
+	assert.Len(t, d.Ticks[0].Orderbook.Bids, 2)
+}
@@ -0,0 +1,18 @@
+package tick
+
+import (
+	"errors"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/tick"
+)
+
+var errNoTickData = errors.New("no tick data provided")
+
+// DataFromTicks is a struct which implements the data.Streamer interface
+// It streams recorded trades and orderbook changes as tick events. Candles
+// built from the ticks are held for reporting
+type DataFromTicks struct {
+	*kline.DataFromKline
+	Ticks []*tick.Tick
+}
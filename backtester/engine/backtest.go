@@ -376,7 +376,11 @@ func (bt *BackTest) processSimultaneousDataEvents() error {
 			return nil
 		default:
 			log.Errorf(common.Backtester, "OnSimultaneousSignals %v", err)
-			return nil
+			if len(signals) == 0 {
+				return nil
+			}
+			// signals for the currencies which did not error can still be
+			// processed, such as those from other strategies of a multi-strategy
 		}
 	}
 	for i := range signals {
@@ -770,9 +774,13 @@ func (bt *BackTest) GenerateSummary() (*TaskSummary, error) {
 	}
 	bt.m.Lock()
 	defer bt.m.Unlock()
-	return &TaskSummary{
+	summary := &TaskSummary{
 		MetaData: bt.MetaData,
-	}, nil
+	}
+	if stats, ok := bt.Statistic.(*statistics.Statistic); ok && bt.MetaData.Closed {
+		summary.MonteCarlo = stats.MonteCarlo
+	}
+	return summary, nil
 }
 
 // SetupMetaData will populate metadata fields
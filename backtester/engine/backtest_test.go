@@ -46,8 +46,11 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/binance"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/binanceus"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/capture"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 )
 
 const testExchange = "binanceus"
@@ -247,6 +250,58 @@ func TestLoadDataCSV(t *testing.T) {
 	}
 }
 
+func TestLoadTickData(t *testing.T) {
+	t.Parallel()
+	bt := BackTest{
+		Reports: &report.Data{},
+	}
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	dir := t.TempDir()
+	w, err := capture.NewWriter(dir)
+	assert.NoError(t, err)
+	k := capture.Key{Exchange: testExchange, Asset: asset.Spot, Pair: cp}
+	err = w.Write(k, capture.SnapshotRecord(&orderbook.Base{
+		Bids:        orderbook.Items{{Price: 99, Amount: 1}},
+		Asks:        orderbook.Items{{Price: 101, Amount: 1}},
+		LastUpdated: start,
+	}))
+	assert.NoError(t, err)
+	err = w.Write(k, capture.TradeRecord(&trade.Data{TID: "1", Side: gctorder.Buy, Price: 101, Amount: 1, Timestamp: start.Add(time.Second)}))
+	assert.NoError(t, err)
+	assert.NoError(t, w.Close())
+
+	cfg := &config.Config{
+		DataSettings: config.DataSettings{
+			TickData: &config.TickData{
+				Directory: dir,
+				StartDate: start,
+				EndDate:   start.Add(time.Hour),
+			},
+			CSVData: &config.CSVData{},
+		},
+	}
+	_, err = bt.loadTickData(cfg, nil, cp, asset.Spot)
+	assert.ErrorIs(t, err, engine.ErrExchangeNotFound)
+
+	em := engine.ExchangeManager{}
+	exch, err := em.NewExchangeByName(testExchange)
+	assert.NoError(t, err)
+	exch.SetDefaults()
+	_, err = bt.loadTickData(cfg, exch, cp, asset.Spot)
+	assert.ErrorIs(t, err, errAmbiguousDataSource)
+
+	cfg.DataSettings.CSVData = nil
+	_, err = bt.loadTickData(cfg, exch, cp, asset.Spot)
+	assert.ErrorIs(t, err, errIntervalUnset)
+
+	cfg.DataSettings.Interval = gctkline.OneMin
+	d, err := bt.loadTickData(cfg, exch, cp, asset.Spot)
+	assert.NoError(t, err)
+	assert.Len(t, d.Ticks, 2)
+	assert.Len(t, d.Item.Candles, 1)
+}
+
 func TestLoadDataDatabase(t *testing.T) {
 	t.Parallel()
 	bt := BackTest{
@@ -1632,6 +1687,26 @@ func TestGenerateSummary(t *testing.T) {
 		t.Errorf("received '%v' expected '%v'", sum.MetaData.ID, id)
 	}
 
+	bt.Statistic = &statistics.Statistic{MonteCarlo: &statistics.MonteCarloResults{Simulations: 1337}}
+	sum, err = bt.GenerateSummary()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if sum.MonteCarlo != nil {
+		t.Error("expected monte carlo results to be unset until the task has closed")
+	}
+	bt.MetaData.Closed = true
+	sum, err = bt.GenerateSummary()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if sum.MonteCarlo == nil || sum.MonteCarlo.Simulations != 1337 {
+		t.Errorf("received '%v' expected monte carlo results", sum.MonteCarlo)
+	}
+	if rpcSum := convertSummary(sum); rpcSum.MonteCarlo == nil || rpcSum.MonteCarlo.Simulations != 1337 {
+		t.Errorf("received '%v' expected monte carlo results", rpcSum.MonteCarlo)
+	}
+
 	bt = nil
 	_, err = bt.GenerateSummary()
 	if !errors.Is(err, gctcommon.ErrNilPointer) {
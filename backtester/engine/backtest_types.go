@@ -56,6 +56,8 @@ type BackTest struct {
 // rather than passing entire contents around
 type TaskSummary struct {
 	MetaData TaskMetaData
+	// MonteCarlo is set once a task with monte carlo settings has finished
+	MonteCarlo *statistics.MonteCarloResults
 }
 
 // TaskMetaData contains details about a run such as when it was loaded
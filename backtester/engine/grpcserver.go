@@ -17,6 +17,7 @@ import (
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/btrpc"
 	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
 	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -187,9 +188,38 @@ func convertSummary(task *TaskSummary) *btrpc.TaskSummary {
 	if !task.MetaData.DateEnded.IsZero() {
 		taskSummary.DateEnded = task.MetaData.DateEnded.Format(gctcommon.SimpleTimeFormatWithTimezone)
 	}
+	if task.MonteCarlo != nil {
+		taskSummary.MonteCarlo = &btrpc.MonteCarloResults{
+			Method:               task.MonteCarlo.Method,
+			Simulations:          task.MonteCarlo.Simulations,
+			Trades:               int64(task.MonteCarlo.Trades),
+			SkipTradeProbability: task.MonteCarlo.SkipTradeProbability.String(),
+			ConfidenceLevel:      task.MonteCarlo.ConfidenceLevel.String(),
+			RuinThreshold:        task.MonteCarlo.RuinThreshold.String(),
+			InitialValue:         task.MonteCarlo.InitialValue.String(),
+			FinalPnl:             convertDistribution(&task.MonteCarlo.FinalPNL),
+			MaxDrawdown:          convertDistribution(&task.MonteCarlo.MaxDrawdown),
+			RiskOfRuin:           task.MonteCarlo.RiskOfRuin.String(),
+			RiskOfRuinLowerBound: task.MonteCarlo.RiskOfRuinLowerBound.String(),
+			RiskOfRuinUpperBound: task.MonteCarlo.RiskOfRuinUpperBound.String(),
+		}
+	}
 	return taskSummary
 }
 
+// convertDistribution converts a monte carlo distribution into a RPC format
+func convertDistribution(d *statistics.Distribution) *btrpc.Distribution {
+	return &btrpc.Distribution{
+		Mean:              d.Mean.String(),
+		StandardDeviation: d.StandardDeviation.String(),
+		Minimum:           d.Minimum.String(),
+		Median:            d.Median.String(),
+		Maximum:           d.Maximum.String(),
+		LowerBound:        d.LowerBound.String(),
+		UpperBound:        d.UpperBound.String(),
+	}
+}
+
 // ExecuteStrategyFromFile will backtest a strategy from the filepath provided
 func (s *GRPCServer) ExecuteStrategyFromFile(_ context.Context, request *btrpc.ExecuteStrategyFromFileRequest) (*btrpc.ExecuteStrategyResponse, error) {
 	if s.config == nil {
@@ -224,6 +254,8 @@ func (s *GRPCServer) ExecuteStrategyFromFile(_ context.Context, request *btrpc.E
 			cfg.DataSettings.DatabaseData.StartDate = request.StartTimeOverride.AsTime()
 		} else if cfg.DataSettings.APIData != nil {
 			cfg.DataSettings.APIData.StartDate = request.StartTimeOverride.AsTime()
+		} else if cfg.DataSettings.TickData != nil {
+			cfg.DataSettings.TickData.StartDate = request.StartTimeOverride.AsTime()
 		}
 	}
 	eto := request.EndTimeOverride.AsTime()
@@ -232,6 +264,8 @@ func (s *GRPCServer) ExecuteStrategyFromFile(_ context.Context, request *btrpc.E
 			cfg.DataSettings.DatabaseData.EndDate = request.EndTimeOverride.AsTime()
 		} else if cfg.DataSettings.APIData != nil {
 			cfg.DataSettings.APIData.EndDate = request.EndTimeOverride.AsTime()
+		} else if cfg.DataSettings.TickData != nil {
+			cfg.DataSettings.TickData.EndDate = request.EndTimeOverride.AsTime()
 		}
 	}
 	err = cfg.Validate()
@@ -296,6 +330,28 @@ func (s *GRPCServer) ExecuteStrategyFromConfig(_ context.Context, request *btrpc
 	if err != nil {
 		return nil, err
 	}
+	var monteCarlo *config.MonteCarloSettings
+	if mc := request.Config.StatisticSettings.MonteCarlo; mc != nil {
+		monteCarlo = &config.MonteCarloSettings{
+			Method:      mc.Method,
+			Simulations: mc.Simulations,
+			Seed:        mc.Seed,
+		}
+		if mc.SkipTradeProbability != "" {
+			monteCarlo.SkipTradeProbability, err = decimal.NewFromString(mc.SkipTradeProbability)
+			if err != nil {
+				return nil, fmt.Errorf("monte carlo skip trade probability %w", err)
+			}
+		}
+		monteCarlo.ConfidenceLevel, err = decimal.NewFromString(mc.ConfidenceLevel)
+		if err != nil {
+			return nil, fmt.Errorf("monte carlo confidence level %w", err)
+		}
+		monteCarlo.RuinThreshold, err = decimal.NewFromString(mc.RuinThreshold)
+		if err != nil {
+			return nil, fmt.Errorf("monte carlo ruin threshold %w", err)
+		}
+	}
 	maximumOrdersWithLeverageRatio, err := decimal.NewFromString(request.Config.PortfolioSettings.Leverage.MaximumOrdersWithLeverageRatio)
 	if err != nil {
 		return nil, err
@@ -366,6 +422,51 @@ func (s *GRPCServer) ExecuteStrategyFromConfig(_ context.Context, request *btrpc
 		customSettings[request.Config.StrategySettings.CustomSettings[i].KeyField] = request.Config.StrategySettings.CustomSettings[i].KeyValue
 	}
 
+	var subStrategies []config.SubStrategySettings
+	if len(request.Config.StrategySettings.Strategies) > 0 {
+		subStrategies = make([]config.SubStrategySettings, len(request.Config.StrategySettings.Strategies))
+	}
+	for i, sub := range request.Config.StrategySettings.Strategies {
+		if sub == nil {
+			return nil, fmt.Errorf("%w strategy %v", gctcommon.ErrNilPointer, i)
+		}
+		var allocation decimal.Decimal
+		if sub.Allocation != "" {
+			allocation, err = decimal.NewFromString(sub.Allocation)
+			if err != nil {
+				return nil, err
+			}
+		}
+		currencies := make([]config.StrategyCurrency, len(sub.Currencies))
+		for j := range sub.Currencies {
+			var a asset.Item
+			a, err = asset.New(sub.Currencies[j].Asset)
+			if err != nil {
+				return nil, err
+			}
+			currencies[j] = config.StrategyCurrency{
+				ExchangeName: sub.Currencies[j].ExchangeName,
+				Asset:        a,
+				Base:         currency.NewCode(sub.Currencies[j].Base),
+				Quote:        currency.NewCode(sub.Currencies[j].Quote),
+			}
+		}
+		var subCustomSettings map[string]interface{}
+		if len(sub.CustomSettings) > 0 {
+			subCustomSettings = make(map[string]interface{}, len(sub.CustomSettings))
+			for j := range sub.CustomSettings {
+				subCustomSettings[sub.CustomSettings[j].KeyField] = sub.CustomSettings[j].KeyValue
+			}
+		}
+		subStrategies[i] = config.SubStrategySettings{
+			Name:           sub.Name,
+			Nickname:       sub.Nickname,
+			Allocation:     allocation,
+			Currencies:     currencies,
+			CustomSettings: subCustomSettings,
+		}
+	}
+
 	configSettings := make([]config.CurrencySettings, len(request.Config.CurrencySettings))
 	for i := range request.Config.CurrencySettings {
 		var currencySettingBuySideMinimumSize, currencySettingBuySideMaximumSize,
@@ -588,6 +689,7 @@ func (s *GRPCServer) ExecuteStrategyFromConfig(_ context.Context, request *btrpc
 			SimultaneousSignalProcessing: request.Config.StrategySettings.UseSimultaneousSignalProcessing,
 			DisableUSDTracking:           request.Config.StrategySettings.DisableUsdTracking,
 			CustomSettings:               customSettings,
+			Strategies:                   subStrategies,
 		},
 		FundingSettings: config.FundingSettings{
 			UseExchangeLevelFunding: request.Config.FundingSettings.UseExchangeLevelFunding,
@@ -622,6 +724,7 @@ func (s *GRPCServer) ExecuteStrategyFromConfig(_ context.Context, request *btrpc
 		},
 		StatisticSettings: config.StatisticSettings{
 			RiskFreeRate: rfr,
+			MonteCarlo:   monteCarlo,
 		},
 	}
 
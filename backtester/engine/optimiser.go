@@ -0,0 +1,605 @@
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math"
+	"math/rand"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// NewOptimiser returns an optimiser for a strategy config with optimisation
+// settings. Each run is added to the task manager
+func NewOptimiser(cfg *config.Config, btCfg *config.BacktesterConfig, manager *TaskManager) (*Optimiser, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("%w strategy config", gctcommon.ErrNilPointer)
+	}
+	if btCfg == nil {
+		return nil, fmt.Errorf("%w backtester config", gctcommon.ErrNilPointer)
+	}
+	if manager == nil {
+		return nil, fmt.Errorf("%w TaskManager", gctcommon.ErrNilPointer)
+	}
+	if cfg.OptimisationSettings == nil {
+		return nil, errOptimisationSettingsUnset
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	parallelism := int(cfg.OptimisationSettings.MaxParallelTasks)
+	if parallelism == 0 {
+		parallelism = runtime.NumCPU()
+	}
+	if cfg.DataSettings.DatabaseData != nil {
+		// tasks share the global database connection, which each task starts
+		// and stops
+		parallelism = 1
+	}
+	seed := cfg.OptimisationSettings.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	o := &Optimiser{
+		cfg:         cfg,
+		btCfg:       btCfg,
+		manager:     manager,
+		parallelism: parallelism,
+		rand:        rand.New(rand.NewSource(seed)), //nolint:gosec // Sampling does not need to be cryptographically secure
+	}
+	o.runTrial = o.runBacktest
+	return o, nil
+}
+
+// Run optimises the strategy over the config's date range, or over each
+// walk-forward window when enabled
+func (o *Optimiser) Run() (*OptimisationResult, error) {
+	if o == nil {
+		return nil, fmt.Errorf("%w Optimiser", gctcommon.ErrNilPointer)
+	}
+	settings := o.cfg.OptimisationSettings
+	resp := &OptimisationResult{
+		Strategy: o.cfg.StrategySettings.Name,
+		Method:   settings.Method,
+		Metric:   settings.Metric,
+	}
+	if settings.WalkForward == nil {
+		var err error
+		resp.Trials, err = o.optimise(o.cfg)
+		return resp, err
+	}
+
+	start, end := dataDateRange(o.cfg)
+	windows, err := walkForwardWindows(start, end, o.cfg.DataSettings.Interval, settings.WalkForward)
+	if err != nil {
+		return nil, err
+	}
+	outOfSample := make([]*Trial, 0, len(windows))
+	for i := range windows {
+		log.Infof(common.Optimiser, "Optimising walk-forward window %v/%v in-sample %v to %v",
+			i+1, len(windows), windows[i].InSampleStart.Format(time.DateTime), windows[i].InSampleEnd.Format(time.DateTime))
+		var inSampleCfg *config.Config
+		inSampleCfg, err = withDateRange(o.cfg, windows[i].InSampleStart, windows[i].InSampleEnd)
+		if err != nil {
+			return nil, err
+		}
+		var trials []*Trial
+		trials, err = o.optimise(inSampleCfg)
+		if err != nil {
+			return nil, fmt.Errorf("walk-forward window %v: %w", i+1, err)
+		}
+		windows[i].InSampleTrials = len(trials)
+		windows[i].InSample = trials[0]
+
+		var outOfSampleCfg *config.Config
+		outOfSampleCfg, err = withDateRange(o.cfg, windows[i].OutOfSampleStart, windows[i].OutOfSampleEnd)
+		if err != nil {
+			return nil, err
+		}
+		windows[i].OutOfSample = o.runTrials(outOfSampleCfg, []map[string]interface{}{trials[0].Parameters})[0]
+		if windows[i].OutOfSample.Error == "" {
+			outOfSample = append(outOfSample, windows[i].OutOfSample)
+		}
+	}
+	resp.WalkForward = windows
+	if len(outOfSample) > 0 {
+		resp.OutOfSample = averageStatistics(outOfSample)
+	}
+	return resp, nil
+}
+
+// optimise runs the config with the custom settings generated by the search
+// method and returns the trials ranked by score
+func (o *Optimiser) optimise(cfg *config.Config) ([]*Trial, error) {
+	settings := o.cfg.OptimisationSettings
+	var trials []*Trial
+	switch settings.Method {
+	case config.GridSearch:
+		trials = o.runTrials(cfg, gridSettings(settings.Parameters))
+	case config.RandomSearch:
+		sets := make([]map[string]interface{}, settings.Iterations)
+		for i := range sets {
+			sets[i] = o.randomSettings()
+		}
+		trials = o.runTrials(cfg, sets)
+	case config.BayesianSearch:
+		trials = o.bayesianSearch(cfg)
+	default:
+		return nil, fmt.Errorf("%w '%v'", errUnsupportedOptimisationMethod, settings.Method)
+	}
+	rankTrials(trials)
+	if len(trials) == 0 || trials[0].Error != "" {
+		return nil, errNoSuccessfulTrials
+	}
+	return trials, nil
+}
+
+// bayesianSearch starts with a quarter of the iterations sampled randomly,
+// then runs each batch with the candidates which best balance a high expected
+// score, estimated from the scores of nearby trials, against being far from
+// previous trials
+func (o *Optimiser) bayesianSearch(cfg *config.Config) []*Trial {
+	iterations := int(o.cfg.OptimisationSettings.Iterations)
+	initial := min(iterations, max(iterations/4, o.parallelism, 1))
+	sets := make([]map[string]interface{}, initial)
+	for i := range sets {
+		sets[i] = o.randomSettings()
+	}
+	trials := o.runTrials(cfg, sets)
+	for len(trials) < iterations {
+		batch := min(o.parallelism, iterations-len(trials))
+		candidates := make([]map[string]interface{}, bayesianCandidates)
+		scores := make([]float64, bayesianCandidates)
+		for i := range candidates {
+			candidates[i] = o.randomSettings()
+			scores[i] = o.acquisition(candidates[i], trials)
+		}
+		sort.Sort(byScore{candidates, scores})
+		trials = append(trials, o.runTrials(cfg, candidates[:batch])...)
+	}
+	return trials
+}
+
+// acquisition returns the upper confidence bound of the candidate's score.
+// The expected score is the average of the normalised scores of successful
+// trials weighted by their closeness to the candidate, and the uncertainty
+// shrinks as the weights grow
+func (o *Optimiser) acquisition(candidate map[string]interface{}, trials []*Trial) float64 {
+	const bandwidth = 0.2
+	lowest, highest := math.Inf(1), math.Inf(-1)
+	for i := range trials {
+		if trials[i].Error != "" {
+			continue
+		}
+		s := trials[i].Score.InexactFloat64()
+		lowest = math.Min(lowest, s)
+		highest = math.Max(highest, s)
+	}
+	var weights, weighted float64
+	for i := range trials {
+		if trials[i].Error != "" {
+			continue
+		}
+		normalised := 0.5
+		if highest > lowest {
+			normalised = (trials[i].Score.InexactFloat64() - lowest) / (highest - lowest)
+		}
+		distance := o.distance(candidate, trials[i].Parameters)
+		w := math.Exp(-distance * distance / (2 * bandwidth * bandwidth))
+		weights += w
+		weighted += w * normalised
+	}
+	expected := 0.5
+	if weights > 0 {
+		expected = weighted / weights
+	}
+	return expected + 1/(1+weights)
+}
+
+// distance returns the euclidean distance between two sets of custom
+// settings with each parameter scaled from 0 to 1
+func (o *Optimiser) distance(a, b map[string]interface{}) float64 {
+	var sum float64
+	params := o.cfg.OptimisationSettings.Parameters
+	for i := range params {
+		d := parameterPosition(&params[i], a[params[i].Name]) - parameterPosition(&params[i], b[params[i].Name])
+		sum += d * d
+	}
+	return math.Sqrt(sum)
+}
+
+// parameterPosition scales a parameter value from 0 to 1
+func parameterPosition(p *config.OptimisationParameter, v interface{}) float64 {
+	if len(p.Values) > 0 {
+		if len(p.Values) == 1 {
+			return 0
+		}
+		for i := range p.Values {
+			if p.Values[i] == v {
+				return float64(i) / float64(len(p.Values)-1)
+			}
+		}
+		return 0
+	}
+	f, ok := v.(float64)
+	if !ok || p.Maximum == p.Minimum {
+		return 0
+	}
+	return (f - p.Minimum) / (p.Maximum - p.Minimum)
+}
+
+// randomSettings samples a value for each parameter. Ranges with a step are
+// sampled on the step
+func (o *Optimiser) randomSettings() map[string]interface{} {
+	params := o.cfg.OptimisationSettings.Parameters
+	resp := make(map[string]interface{}, len(params))
+	for i := range params {
+		p := &params[i]
+		switch {
+		case len(p.Values) > 0:
+			resp[p.Name] = p.Values[o.rand.Intn(len(p.Values))]
+		case p.Step > 0:
+			resp[p.Name] = stepValue(p, o.rand.Int63n(stepCount(p)+1))
+		default:
+			resp[p.Name] = p.Minimum + o.rand.Float64()*(p.Maximum-p.Minimum)
+		}
+	}
+	return resp
+}
+
+// gridSettings returns every combination of parameter values
+func gridSettings(params []config.OptimisationParameter) []map[string]interface{} {
+	sets := []map[string]interface{}{{}}
+	for i := range params {
+		values := params[i].Values
+		if len(values) == 0 {
+			steps := stepCount(&params[i])
+			values = make([]interface{}, 0, steps+1)
+			for j := int64(0); j <= steps; j++ {
+				values = append(values, stepValue(&params[i], j))
+			}
+		}
+		next := make([]map[string]interface{}, 0, len(sets)*len(values))
+		for j := range sets {
+			for k := range values {
+				set := make(map[string]interface{}, len(sets[j])+1)
+				maps.Copy(set, sets[j])
+				set[params[i].Name] = values[k]
+				next = append(next, set)
+			}
+		}
+		sets = next
+	}
+	return sets
+}
+
+// stepCount returns the number of steps from the minimum which do not exceed
+// the maximum
+func stepCount(p *config.OptimisationParameter) int64 {
+	return decimal.NewFromFloat(p.Maximum).Sub(decimal.NewFromFloat(p.Minimum)).Div(decimal.NewFromFloat(p.Step)).IntPart()
+}
+
+// stepValue returns the value of the parameter at the step. Decimals are used
+// so that values such as 0.3 are not generated as 0.30000000000000004
+func stepValue(p *config.OptimisationParameter, step int64) float64 {
+	return decimal.NewFromFloat(p.Minimum).Add(decimal.NewFromFloat(p.Step).Mul(decimal.NewFromInt(step))).InexactFloat64()
+}
+
+// runTrials runs the config once for each set of custom settings, running up
+// to the optimiser's parallelism at once
+func (o *Optimiser) runTrials(cfg *config.Config, sets []map[string]interface{}) []*Trial {
+	start, end := dataDateRange(cfg)
+	trials := make([]*Trial, len(sets))
+	sem := make(chan struct{}, o.parallelism)
+	var wg sync.WaitGroup
+	for i := range sets {
+		trials[i] = &Trial{
+			Parameters: sets[i],
+			StartDate:  start,
+			EndDate:    end,
+		}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(t *Trial) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if err := o.score(cfg, t); err != nil {
+				t.Error = err.Error()
+				log.Warnf(common.Optimiser, "Trial %v failed: %v", t.Parameters, err)
+			}
+		}(trials[i])
+	}
+	wg.Wait()
+	return trials
+}
+
+func (o *Optimiser) score(cfg *config.Config, t *Trial) error {
+	trialCfg, err := copyConfig(cfg)
+	if err != nil {
+		return err
+	}
+	if trialCfg.StrategySettings.CustomSettings == nil {
+		trialCfg.StrategySettings.CustomSettings = make(map[string]interface{}, len(t.Parameters))
+	}
+	maps.Copy(trialCfg.StrategySettings.CustomSettings, t.Parameters)
+	stats, err := o.runTrial(trialCfg)
+	if err != nil {
+		return err
+	}
+	t.Statistics, err = trialStatistics(stats)
+	if err != nil {
+		return err
+	}
+	t.Score = t.Statistics.metric(o.cfg.OptimisationSettings.Metric)
+	return nil
+}
+
+// runBacktest runs the config as a task of the task manager, without
+// generating a report, and returns its statistics once completed
+func (o *Optimiser) runBacktest(cfg *config.Config) (*statistics.Statistic, error) {
+	bt, err := NewBacktesterFromConfigs(cfg, &config.BacktesterConfig{Verbose: o.btCfg.Verbose})
+	if err != nil {
+		return nil, err
+	}
+	err = o.manager.AddTask(bt)
+	if err != nil {
+		return nil, err
+	}
+	err = bt.ExecuteStrategy(true)
+	if err != nil {
+		return nil, err
+	}
+	if !bt.hasProcessedAnEvent {
+		return nil, errNoTrialResults
+	}
+	stats, ok := bt.Statistic.(*statistics.Statistic)
+	if !ok {
+		return nil, fmt.Errorf("%w %T", gctcommon.ErrTypeAssertFailure, bt.Statistic)
+	}
+	return stats, nil
+}
+
+// trialStatistics uses the USD totals when available, otherwise averages each
+// currency's statistics
+func trialStatistics(s *statistics.Statistic) (TrialStatistics, error) {
+	if s == nil {
+		return TrialStatistics{}, errNoTrialResults
+	}
+	if s.FundingStatistics != nil && s.FundingStatistics.TotalUSDStatistics != nil {
+		t := s.FundingStatistics.TotalUSDStatistics
+		resp := TrialStatistics{
+			MaxDrawdown:              t.MaxDrawdown.DrawdownPercent,
+			CompoundAnnualGrowthRate: t.CompoundAnnualGrowthRate,
+		}
+		if t.ArithmeticRatios != nil {
+			resp.SharpeRatio = t.ArithmeticRatios.SharpeRatio
+			resp.SortinoRatio = t.ArithmeticRatios.SortinoRatio
+		}
+		return resp, nil
+	}
+	if len(s.ExchangeAssetPairStatistics) == 0 {
+		return TrialStatistics{}, errNoTrialResults
+	}
+	var resp TrialStatistics
+	for _, stats := range s.ExchangeAssetPairStatistics {
+		if stats.ArithmeticRatios != nil {
+			resp.SharpeRatio = resp.SharpeRatio.Add(stats.ArithmeticRatios.SharpeRatio)
+			resp.SortinoRatio = resp.SortinoRatio.Add(stats.ArithmeticRatios.SortinoRatio)
+		}
+		resp.CompoundAnnualGrowthRate = resp.CompoundAnnualGrowthRate.Add(stats.CompoundAnnualGrowthRate)
+		// drawdowns are negative percentages
+		resp.MaxDrawdown = decimal.Min(resp.MaxDrawdown, stats.MaxDrawdown.DrawdownPercent)
+	}
+	count := decimal.NewFromInt(int64(len(s.ExchangeAssetPairStatistics)))
+	resp.SharpeRatio = resp.SharpeRatio.Div(count)
+	resp.SortinoRatio = resp.SortinoRatio.Div(count)
+	resp.CompoundAnnualGrowthRate = resp.CompoundAnnualGrowthRate.Div(count)
+	return resp, nil
+}
+
+// metric returns the statistic for the metric, where higher values are better
+func (t *TrialStatistics) metric(m string) decimal.Decimal {
+	switch m {
+	case config.SharpeRatioMetric:
+		return t.SharpeRatio
+	case config.SortinoRatioMetric:
+		return t.SortinoRatio
+	case config.MaxDrawdownMetric:
+		return t.MaxDrawdown
+	case config.CAGRMetric:
+		return t.CompoundAnnualGrowthRate
+	}
+	return decimal.Zero
+}
+
+func averageStatistics(trials []*Trial) *TrialStatistics {
+	var resp TrialStatistics
+	for i := range trials {
+		resp.SharpeRatio = resp.SharpeRatio.Add(trials[i].Statistics.SharpeRatio)
+		resp.SortinoRatio = resp.SortinoRatio.Add(trials[i].Statistics.SortinoRatio)
+		resp.MaxDrawdown = resp.MaxDrawdown.Add(trials[i].Statistics.MaxDrawdown)
+		resp.CompoundAnnualGrowthRate = resp.CompoundAnnualGrowthRate.Add(trials[i].Statistics.CompoundAnnualGrowthRate)
+	}
+	count := decimal.NewFromInt(int64(len(trials)))
+	resp.SharpeRatio = resp.SharpeRatio.Div(count)
+	resp.SortinoRatio = resp.SortinoRatio.Div(count)
+	resp.MaxDrawdown = resp.MaxDrawdown.Div(count)
+	resp.CompoundAnnualGrowthRate = resp.CompoundAnnualGrowthRate.Div(count)
+	return &resp
+}
+
+// rankTrials sorts trials by score with failed trials last
+func rankTrials(trials []*Trial) {
+	sort.SliceStable(trials, func(i, j int) bool {
+		if (trials[i].Error == "") != (trials[j].Error == "") {
+			return trials[i].Error == ""
+		}
+		return trials[i].Score.GreaterThan(trials[j].Score)
+	})
+}
+
+// walkForwardWindows splits the date range into windows which each have an
+// in-sample period followed by an out-of-sample period. Windows step forward
+// by the out-of-sample period so that the out-of-sample periods cover the end
+// of the date range without overlapping
+func walkForwardWindows(start, end time.Time, interval gctkline.Interval, wf *config.WalkForward) ([]*WalkForwardWindow, error) {
+	if wf == nil {
+		return nil, fmt.Errorf("%w walk-forward settings", gctcommon.ErrNilPointer)
+	}
+	if start.IsZero() || !end.After(start) {
+		return nil, fmt.Errorf("%w walk-forward requires a date range", gctcommon.ErrDateUnset)
+	}
+	ratio := wf.OutOfSampleRatio.InexactFloat64()
+	total := end.Sub(start)
+	outOfSample := time.Duration(float64(total) * ratio / (float64(wf.Windows)*ratio + 1 - ratio)).Truncate(interval.Duration())
+	inSample := total - outOfSample*time.Duration(wf.Windows)
+	if outOfSample < interval.Duration() || inSample < interval.Duration() {
+		return nil, fmt.Errorf("%w %v", errWalkForwardWindowTooSmall, interval)
+	}
+	windows := make([]*WalkForwardWindow, wf.Windows)
+	for i := range windows {
+		w := &WalkForwardWindow{
+			OutOfSampleStart: start.Add(inSample + outOfSample*time.Duration(i)),
+		}
+		w.OutOfSampleEnd = w.OutOfSampleStart.Add(outOfSample)
+		w.InSampleEnd = w.OutOfSampleStart
+		w.InSampleStart = w.OutOfSampleStart.Add(-inSample)
+		if wf.Anchored {
+			w.InSampleStart = start
+		}
+		windows[i] = w
+	}
+	return windows, nil
+}
+
+// dataDateRange returns the date range of the config's data source. CSV data
+// has no configured range
+func dataDateRange(cfg *config.Config) (start, end time.Time) {
+	switch {
+	case cfg.DataSettings.APIData != nil:
+		return cfg.DataSettings.APIData.StartDate, cfg.DataSettings.APIData.EndDate
+	case cfg.DataSettings.DatabaseData != nil:
+		return cfg.DataSettings.DatabaseData.StartDate, cfg.DataSettings.DatabaseData.EndDate
+	case cfg.DataSettings.TickData != nil:
+		return cfg.DataSettings.TickData.StartDate, cfg.DataSettings.TickData.EndDate
+	}
+	return time.Time{}, time.Time{}
+}
+
+// withDateRange returns a copy of the config using the date range. The end
+// date is only inclusive when it is the config's end date, so that windows do
+// not share a candle
+func withDateRange(cfg *config.Config, start, end time.Time) (*config.Config, error) {
+	resp, err := copyConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case resp.DataSettings.APIData != nil:
+		d := resp.DataSettings.APIData
+		d.InclusiveEndDate = d.InclusiveEndDate && end.Equal(d.EndDate)
+		d.StartDate, d.EndDate = start, end
+	case resp.DataSettings.DatabaseData != nil:
+		d := resp.DataSettings.DatabaseData
+		d.InclusiveEndDate = d.InclusiveEndDate && end.Equal(d.EndDate)
+		d.StartDate, d.EndDate = start, end
+	case resp.DataSettings.TickData != nil:
+		resp.DataSettings.TickData.StartDate, resp.DataSettings.TickData.EndDate = start, end
+	}
+	return resp, nil
+}
+
+// copyConfig deep copies the config without its optimisation settings so
+// that trials can change their settings independently. Monte Carlo analysis
+// is not used to rank trials, so it is removed too
+func copyConfig(cfg *config.Config) (*config.Config, error) {
+	b, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+	var resp config.Config
+	err = json.Unmarshal(b, &resp)
+	if err != nil {
+		return nil, err
+	}
+	resp.OptimisationSettings = nil
+	resp.StatisticSettings.MonteCarlo = nil
+	return &resp, nil
+}
+
+// PrintResults logs the best trials, or the results of each walk-forward
+// window
+func (r *OptimisationResult) PrintResults(limit int) {
+	log.Infoln(common.Optimiser, common.CMDColours.H1+"------------------Optimisation Results-----------------------"+common.CMDColours.Default)
+	log.Infof(common.Optimiser, "Strategy: %v Method: %v Metric: %v", r.Strategy, r.Method, r.Metric)
+	for i := range r.Trials {
+		if i == limit {
+			break
+		}
+		log.Infof(common.Optimiser, "%v. %v", i+1, r.Trials[i])
+	}
+	for i := range r.WalkForward {
+		w := r.WalkForward[i]
+		log.Infoln(common.Optimiser, common.CMDColours.H2+fmt.Sprintf("------------------Walk-Forward Window %v------------------------", i+1)+common.CMDColours.Default)
+		log.Infof(common.Optimiser, "In-sample %v to %v, best of %v trials: %v", w.InSampleStart.Format(time.DateTime), w.InSampleEnd.Format(time.DateTime), w.InSampleTrials, w.InSample)
+		log.Infof(common.Optimiser, "Out-of-sample %v to %v: %v", w.OutOfSampleStart.Format(time.DateTime), w.OutOfSampleEnd.Format(time.DateTime), w.OutOfSample)
+	}
+	if r.OutOfSample != nil {
+		log.Infoln(common.Optimiser, common.CMDColours.H2+"------------------Out-of-Sample Average----------------------"+common.CMDColours.Default)
+		log.Infof(common.Optimiser, "%v", r.OutOfSample)
+	}
+}
+
+// Save writes the result as JSON to the directory and returns the file path
+func (r *OptimisationResult) Save(dir string) (string, error) {
+	b, err := json.MarshalIndent(r, "", " ")
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, fmt.Sprintf("optimisation-%v-%v.json", strings.ToLower(r.Strategy), time.Now().Format("2006-01-02-15-04-05")))
+	err = file.Write(path, b)
+	if err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// String returns the trial's parameters and statistics, or its error
+func (t *Trial) String() string {
+	if t == nil {
+		return "no trial"
+	}
+	if t.Error != "" {
+		return fmt.Sprintf("%v error: %v", t.Parameters, t.Error)
+	}
+	return fmt.Sprintf("%v score: %v %v", t.Parameters, t.Score.Round(4), &t.Statistics)
+}
+
+// String returns the statistics rounded for logging
+func (t *TrialStatistics) String() string {
+	return fmt.Sprintf("sharpe: %v sortino: %v max drawdown: %v%% cagr: %v%%",
+		t.SharpeRatio.Round(4), t.SortinoRatio.Round(4), t.MaxDrawdown.Round(2), t.CompoundAnnualGrowthRate.Round(2))
+}
+
+func (b byScore) Len() int           { return len(b.sets) }
+func (b byScore) Less(i, j int) bool { return b.scores[i] > b.scores[j] }
+func (b byScore) Swap(i, j int) {
+	b.sets[i], b.sets[j] = b.sets[j], b.sets[i]
+	b.scores[i], b.scores[j] = b.scores[j], b.scores[i]
+}
@@ -0,0 +1,313 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/rsi"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+var optimiserStart = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func optimiserConfig(o *config.OptimisationSettings) *config.Config {
+	return &config.Config{
+		StrategySettings: config.StrategySettings{
+			Name:           rsi.Name,
+			CustomSettings: map[string]interface{}{"rsi-low": 30.0},
+		},
+		CurrencySettings: []config.CurrencySettings{
+			{
+				ExchangeName: testExchange,
+				Asset:        asset.Spot,
+				Base:         currency.BTC,
+				Quote:        currency.USDT,
+				SpotDetails: &config.SpotDetails{
+					InitialQuoteFunds: &leet,
+				},
+			},
+		},
+		DataSettings: config.DataSettings{
+			Interval: gctkline.OneDay,
+			APIData: &config.APIData{
+				StartDate:        optimiserStart,
+				EndDate:          optimiserStart.AddDate(0, 0, 100),
+				InclusiveEndDate: true,
+			},
+		},
+		OptimisationSettings: o,
+	}
+}
+
+// fakeTrialRunner scores the rsi-period with a sharpe ratio peaking at 15
+type fakeTrialRunner struct {
+	m    sync.Mutex
+	cfgs []*config.Config
+}
+
+func (f *fakeTrialRunner) run(cfg *config.Config) (*statistics.Statistic, error) {
+	f.m.Lock()
+	f.cfgs = append(f.cfgs, cfg)
+	f.m.Unlock()
+	period, ok := cfg.StrategySettings.CustomSettings["rsi-period"].(float64)
+	if !ok {
+		return nil, gctcommon.ErrTypeAssertFailure
+	}
+	if period == 11 {
+		return nil, errDastardlyReason
+	}
+	sharpe := decimal.NewFromFloat(100 - (period-15)*(period-15))
+	return &statistics.Statistic{
+		FundingStatistics: &statistics.FundingStatistics{
+			TotalUSDStatistics: &statistics.TotalFundingStatistics{
+				ArithmeticRatios:         &statistics.Ratios{SharpeRatio: sharpe, SortinoRatio: sharpe.Mul(decimal.NewFromInt(2))},
+				MaxDrawdown:              statistics.Swing{DrawdownPercent: decimal.NewFromFloat(-period)},
+				CompoundAnnualGrowthRate: decimal.NewFromFloat(period),
+			},
+		},
+	}, nil
+}
+
+var errDastardlyReason = errors.New("some dastardly reason")
+
+func TestNewOptimiser(t *testing.T) {
+	t.Parallel()
+	_, err := NewOptimiser(nil, &config.BacktesterConfig{}, NewTaskManager())
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+	_, err = NewOptimiser(&config.Config{}, nil, NewTaskManager())
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+	_, err = NewOptimiser(&config.Config{}, &config.BacktesterConfig{}, nil)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+	_, err = NewOptimiser(&config.Config{}, &config.BacktesterConfig{}, NewTaskManager())
+	assert.ErrorIs(t, err, errOptimisationSettingsUnset)
+
+	cfg := optimiserConfig(&config.OptimisationSettings{
+		Method:     config.GridSearch,
+		Metric:     config.SharpeRatioMetric,
+		Parameters: []config.OptimisationParameter{{Name: "rsi-period", Minimum: 10, Maximum: 20, Step: 1}},
+	})
+	o, err := NewOptimiser(cfg, &config.BacktesterConfig{}, NewTaskManager())
+	require.NoError(t, err)
+	assert.Positive(t, o.parallelism)
+	assert.NotNil(t, o.runTrial)
+
+	cfg.DataSettings.APIData = nil
+	cfg.DataSettings.DatabaseData = &config.DatabaseData{StartDate: optimiserStart, EndDate: optimiserStart.AddDate(0, 0, 1)}
+	o, err = NewOptimiser(cfg, &config.BacktesterConfig{}, NewTaskManager())
+	require.NoError(t, err)
+	assert.Equal(t, 1, o.parallelism, "database tasks should not run in parallel")
+}
+
+func TestGridSettings(t *testing.T) {
+	t.Parallel()
+	sets := gridSettings([]config.OptimisationParameter{
+		{Name: "a", Minimum: 0.1, Maximum: 0.35, Step: 0.1},
+		{Name: "b", Values: []interface{}{"x", true}},
+	})
+	require.Len(t, sets, 6)
+	assert.Equal(t, map[string]interface{}{"a": 0.1, "b": "x"}, sets[0])
+	assert.Equal(t, map[string]interface{}{"a": 0.3, "b": true}, sets[5], "steps should not accumulate float errors")
+}
+
+func TestRandomSettings(t *testing.T) {
+	t.Parallel()
+	o, err := NewOptimiser(optimiserConfig(&config.OptimisationSettings{
+		Method:     config.RandomSearch,
+		Metric:     config.CAGRMetric,
+		Iterations: 1,
+		Seed:       1337,
+		Parameters: []config.OptimisationParameter{
+			{Name: "stepped", Minimum: 1, Maximum: 2, Step: 0.5},
+			{Name: "continuous", Minimum: 1, Maximum: 2},
+			{Name: "values", Values: []interface{}{"x"}},
+		},
+	}), &config.BacktesterConfig{}, NewTaskManager())
+	require.NoError(t, err)
+	for i := 0; i < 50; i++ {
+		s := o.randomSettings()
+		assert.Contains(t, []interface{}{1.0, 1.5, 2.0}, s["stepped"])
+		assert.GreaterOrEqual(t, s["continuous"], 1.0)
+		assert.LessOrEqual(t, s["continuous"], 2.0)
+		assert.Equal(t, "x", s["values"])
+	}
+}
+
+func TestOptimiserRun(t *testing.T) {
+	t.Parallel()
+	for _, method := range []string{config.GridSearch, config.RandomSearch, config.BayesianSearch} {
+		t.Run(method, func(t *testing.T) {
+			t.Parallel()
+			cfg := optimiserConfig(&config.OptimisationSettings{
+				Method:           method,
+				Metric:           config.SharpeRatioMetric,
+				Iterations:       40,
+				MaxParallelTasks: 4,
+				Seed:             1337,
+				Parameters:       []config.OptimisationParameter{{Name: "rsi-period", Minimum: 10, Maximum: 20, Step: 1}},
+			})
+			o, err := NewOptimiser(cfg, &config.BacktesterConfig{}, NewTaskManager())
+			require.NoError(t, err)
+			f := &fakeTrialRunner{}
+			o.runTrial = f.run
+			result, err := o.Run()
+			require.NoError(t, err)
+			assert.Equal(t, rsi.Name, result.Strategy)
+			require.NotEmpty(t, result.Trials)
+			assert.Equal(t, 15.0, result.Trials[0].Parameters["rsi-period"], "the best trial should be ranked first")
+			assert.Equal(t, "100", result.Trials[0].Score.String())
+			if method == config.GridSearch {
+				assert.Len(t, result.Trials, 11)
+				last := result.Trials[len(result.Trials)-1]
+				assert.Equal(t, errDastardlyReason.Error(), last.Error, "failed trials should be ranked last")
+			} else {
+				assert.Len(t, result.Trials, 40)
+			}
+			for i := range f.cfgs {
+				assert.Nil(t, f.cfgs[i].OptimisationSettings, "trials should not be optimised")
+				assert.Equal(t, 30.0, f.cfgs[i].StrategySettings.CustomSettings["rsi-low"], "custom settings should be kept")
+			}
+			assert.NotContains(t, cfg.StrategySettings.CustomSettings, "rsi-period", "the config should not be modified")
+		})
+	}
+
+	cfg := optimiserConfig(&config.OptimisationSettings{
+		Method:     config.GridSearch,
+		Metric:     config.MaxDrawdownMetric,
+		Parameters: []config.OptimisationParameter{{Name: "rsi-period", Values: []interface{}{11.0}}},
+	})
+	o, err := NewOptimiser(cfg, &config.BacktesterConfig{}, NewTaskManager())
+	require.NoError(t, err)
+	o.runTrial = (&fakeTrialRunner{}).run
+	_, err = o.Run()
+	assert.ErrorIs(t, err, errNoSuccessfulTrials)
+}
+
+func TestOptimiserRunWalkForward(t *testing.T) {
+	t.Parallel()
+	cfg := optimiserConfig(&config.OptimisationSettings{
+		Method: config.GridSearch,
+		Metric: config.CAGRMetric,
+		Parameters: []config.OptimisationParameter{
+			{Name: "rsi-period", Values: []interface{}{12.0, 14.0}},
+		},
+		WalkForward: &config.WalkForward{
+			Windows:          3,
+			OutOfSampleRatio: decimal.NewFromFloat(0.25),
+		},
+	})
+	o, err := NewOptimiser(cfg, &config.BacktesterConfig{}, NewTaskManager())
+	require.NoError(t, err)
+	f := &fakeTrialRunner{}
+	o.runTrial = f.run
+	result, err := o.Run()
+	require.NoError(t, err)
+	assert.Empty(t, result.Trials)
+	require.Len(t, result.WalkForward, 3)
+	for i, w := range result.WalkForward {
+		assert.Equal(t, 2, w.InSampleTrials)
+		assert.Equal(t, 14.0, w.InSample.Parameters["rsi-period"])
+		assert.Equal(t, w.InSample.Parameters, w.OutOfSample.Parameters, "the best in-sample settings should be used out-of-sample")
+		assert.Equal(t, w.OutOfSampleStart, w.OutOfSample.StartDate)
+		assert.Equal(t, w.OutOfSampleEnd, w.OutOfSample.EndDate)
+		assert.Equal(t, w.InSampleStart, w.InSample.StartDate)
+		if i > 0 {
+			assert.Equal(t, result.WalkForward[i-1].OutOfSampleEnd, w.OutOfSampleStart)
+		}
+	}
+	require.NotNil(t, result.OutOfSample)
+	assert.Equal(t, "14", result.OutOfSample.CompoundAnnualGrowthRate.String())
+	// 2 in-sample trials and an out-of-sample trial for each window
+	assert.Len(t, f.cfgs, 9)
+	for i := range f.cfgs {
+		d := f.cfgs[i].DataSettings.APIData
+		assert.Equal(t, d.EndDate.Equal(cfg.DataSettings.APIData.EndDate), d.InclusiveEndDate, "only the final window should include its end date")
+	}
+}
+
+func TestWalkForwardWindows(t *testing.T) {
+	t.Parallel()
+	_, err := walkForwardWindows(optimiserStart, optimiserStart, gctkline.OneDay, &config.WalkForward{})
+	assert.ErrorIs(t, err, gctcommon.ErrDateUnset)
+	_, err = walkForwardWindows(optimiserStart, optimiserStart.AddDate(0, 0, 3), gctkline.OneDay, &config.WalkForward{Windows: 5, OutOfSampleRatio: decimal.NewFromFloat(0.5)})
+	assert.ErrorIs(t, err, errWalkForwardWindowTooSmall)
+
+	end := optimiserStart.AddDate(0, 0, 100)
+	windows, err := walkForwardWindows(optimiserStart, end, gctkline.OneDay, &config.WalkForward{Windows: 4, OutOfSampleRatio: decimal.NewFromFloat(0.2)})
+	require.NoError(t, err)
+	require.Len(t, windows, 4)
+	// 100 days split into an in-sample period 4 times the out-of-sample period
+	// followed by 4 out-of-sample periods of 12 days
+	assert.Equal(t, optimiserStart, windows[0].InSampleStart)
+	assert.Equal(t, optimiserStart.AddDate(0, 0, 52), windows[0].OutOfSampleStart)
+	assert.Equal(t, windows[0].OutOfSampleStart, windows[0].InSampleEnd)
+	assert.Equal(t, optimiserStart.AddDate(0, 0, 64), windows[1].OutOfSampleStart)
+	assert.Equal(t, optimiserStart.AddDate(0, 0, 12), windows[1].InSampleStart)
+	assert.Equal(t, end, windows[3].OutOfSampleEnd)
+
+	windows, err = walkForwardWindows(optimiserStart, end, gctkline.OneDay, &config.WalkForward{Windows: 4, OutOfSampleRatio: decimal.NewFromFloat(0.2), Anchored: true})
+	require.NoError(t, err)
+	assert.Equal(t, optimiserStart, windows[3].InSampleStart, "anchored windows should start at the start date")
+}
+
+func TestTrialStatistics(t *testing.T) {
+	t.Parallel()
+	_, err := trialStatistics(nil)
+	assert.ErrorIs(t, err, errNoTrialResults)
+	_, err = trialStatistics(&statistics.Statistic{})
+	assert.ErrorIs(t, err, errNoTrialResults)
+
+	s := &statistics.Statistic{
+		ExchangeAssetPairStatistics: map[key.ExchangePairAsset]*statistics.CurrencyPairStatistic{
+			{Exchange: testExchange, Base: currency.BTC.Item, Quote: currency.USDT.Item, Asset: asset.Spot}: {
+				ArithmeticRatios:         &statistics.Ratios{SharpeRatio: decimal.NewFromInt(1), SortinoRatio: decimal.NewFromInt(2)},
+				MaxDrawdown:              statistics.Swing{DrawdownPercent: decimal.NewFromInt(-10)},
+				CompoundAnnualGrowthRate: decimal.NewFromInt(20),
+			},
+			{Exchange: testExchange, Base: currency.ETH.Item, Quote: currency.USDT.Item, Asset: asset.Spot}: {
+				ArithmeticRatios:         &statistics.Ratios{SharpeRatio: decimal.NewFromInt(3), SortinoRatio: decimal.NewFromInt(4)},
+				MaxDrawdown:              statistics.Swing{DrawdownPercent: decimal.NewFromInt(-30)},
+				CompoundAnnualGrowthRate: decimal.NewFromInt(10),
+			},
+		},
+	}
+	stats, err := trialStatistics(s)
+	require.NoError(t, err)
+	assert.Equal(t, "2", stats.SharpeRatio.String())
+	assert.Equal(t, "3", stats.SortinoRatio.String())
+	assert.Equal(t, "-30", stats.MaxDrawdown.String(), "the largest drawdown should be kept")
+	assert.Equal(t, "15", stats.CompoundAnnualGrowthRate.String())
+	assert.Equal(t, stats.MaxDrawdown, stats.metric(config.MaxDrawdownMetric))
+	assert.Equal(t, stats.SortinoRatio, stats.metric(config.SortinoRatioMetric))
+}
+
+func TestWithDateRange(t *testing.T) {
+	t.Parallel()
+	cfg := optimiserConfig(&config.OptimisationSettings{})
+	c, err := withDateRange(cfg, optimiserStart.AddDate(0, 0, 1), optimiserStart.AddDate(0, 0, 2))
+	require.NoError(t, err)
+	assert.Nil(t, c.OptimisationSettings)
+	assert.Equal(t, optimiserStart.AddDate(0, 0, 1), c.DataSettings.APIData.StartDate)
+	assert.False(t, c.DataSettings.APIData.InclusiveEndDate)
+	assert.True(t, cfg.DataSettings.APIData.InclusiveEndDate, "the config should not be modified")
+	assert.Equal(t, optimiserStart, cfg.DataSettings.APIData.StartDate, "the config should not be modified")
+}
+
+func TestOptimisationResultSave(t *testing.T) {
+	t.Parallel()
+	r := &OptimisationResult{Strategy: rsi.Name, Trials: []*Trial{{Parameters: map[string]interface{}{"rsi-period": 14.0}}}}
+	r.PrintResults(1)
+	path, err := r.Save(t.TempDir())
+	require.NoError(t, err)
+	assert.FileExists(t, path)
+}
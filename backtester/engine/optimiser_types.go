@@ -0,0 +1,88 @@
+package engine
+
+import (
+	"errors"
+	"math/rand"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
+)
+
+// bayesianCandidates is the number of random custom settings scored by the
+// bayesian search for each run it selects
+const bayesianCandidates = 100
+
+var (
+	errOptimisationSettingsUnset     = errors.New("optimisation settings unset")
+	errNoSuccessfulTrials            = errors.New("no optimisation trials completed successfully")
+	errNoTrialResults                = errors.New("task produced no statistics")
+	errUnsupportedOptimisationMethod = errors.New("unsupported optimisation method")
+	errWalkForwardWindowTooSmall     = errors.New("walk-forward out-of-sample period is shorter than the candle interval")
+)
+
+// Optimiser runs a strategy config once for each set of custom settings
+// generated from its optimisation settings and ranks the results. Each run is
+// added to the task manager so it can be listed while the optimiser runs
+type Optimiser struct {
+	cfg         *config.Config
+	btCfg       *config.BacktesterConfig
+	manager     *TaskManager
+	parallelism int
+	rand        *rand.Rand
+	// runTrial executes a config and returns its statistics
+	runTrial func(*config.Config) (*statistics.Statistic, error)
+}
+
+// OptimisationResult holds the ranked trials of an optimisation, or the
+// windows of a walk-forward optimisation
+type OptimisationResult struct {
+	Strategy    string               `json:"strategy"`
+	Method      string               `json:"method"`
+	Metric      string               `json:"metric"`
+	Trials      []*Trial             `json:"trials,omitempty"`
+	WalkForward []*WalkForwardWindow `json:"walk-forward,omitempty"`
+	// OutOfSample averages the out-of-sample statistics of every walk-forward
+	// window
+	OutOfSample *TrialStatistics `json:"out-of-sample,omitempty"`
+}
+
+// Trial is a single run of the strategy with a set of custom settings
+type Trial struct {
+	Parameters map[string]interface{} `json:"parameters"`
+	StartDate  time.Time              `json:"start-date"`
+	EndDate    time.Time              `json:"end-date"`
+	Statistics TrialStatistics        `json:"statistics"`
+	// Score is the statistic chosen as the optimisation metric
+	Score decimal.Decimal `json:"score"`
+	Error string          `json:"error,omitempty"`
+}
+
+// TrialStatistics are the statistics trials can be ranked by. USD totals are
+// used when USD tracking is enabled, otherwise the currency statistics are
+// averaged with the largest drawdown kept
+type TrialStatistics struct {
+	SharpeRatio              decimal.Decimal `json:"sharpe-ratio"`
+	SortinoRatio             decimal.Decimal `json:"sortino-ratio"`
+	MaxDrawdown              decimal.Decimal `json:"max-drawdown"`
+	CompoundAnnualGrowthRate decimal.Decimal `json:"compound-annual-growth-rate"`
+}
+
+// WalkForwardWindow holds the best in-sample trial of a window and its
+// performance over the out-of-sample period which follows
+type WalkForwardWindow struct {
+	InSampleStart    time.Time `json:"in-sample-start"`
+	InSampleEnd      time.Time `json:"in-sample-end"`
+	OutOfSampleStart time.Time `json:"out-of-sample-start"`
+	OutOfSampleEnd   time.Time `json:"out-of-sample-end"`
+	InSampleTrials   int       `json:"in-sample-trials"`
+	InSample         *Trial    `json:"in-sample"`
+	OutOfSample      *Trial    `json:"out-of-sample"`
+}
+
+// byScore sorts candidate custom settings by descending score
+type byScore struct {
+	sets   []map[string]interface{}
+	scores []float64
+}
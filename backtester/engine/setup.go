@@ -17,6 +17,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline/api"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline/csv"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline/database"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/tick"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/eventholder"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange/slippage"
@@ -381,19 +382,31 @@ func (bt *BackTest) SetupFromConfig(cfg *config.Config, templatePath, output str
 		return err
 	}
 
-	bt.Strategy, err = strategies.LoadStrategyByName(cfg.StrategySettings.Name, cfg.StrategySettings.SimultaneousSignalProcessing)
-	if err != nil {
-		return err
-	}
-	bt.MetaData.Strategy = bt.Strategy.Name()
-	bt.Strategy.SetDefaults()
-
-	if cfg.StrategySettings.CustomSettings != nil {
-		err = bt.Strategy.SetCustomSettings(cfg.StrategySettings.CustomSettings)
-		if err != nil && !errors.Is(err, base.ErrCustomSettingsUnsupported) {
+	var strategyStats []*statistics.StrategyStatistic
+	if strings.EqualFold(cfg.StrategySettings.Name, strategies.MultiStrategyName) {
+		var multi *strategies.MultiStrategy
+		multi, err = loadMultiStrategy(cfg)
+		if err != nil {
+			return err
+		}
+		bt.Strategy = multi
+		subs := multi.Strategies()
+		for i := range subs {
+			strategyStats = append(strategyStats, &statistics.StrategyStatistic{
+				Nickname:   subs[i].Nickname,
+				Strategy:   subs[i].Strategy.Name(),
+				Allocation: subs[i].Allocation,
+				Currencies: subs[i].Currencies,
+			})
+		}
+	} else {
+		bt.Strategy, err = loadStrategy(cfg.StrategySettings.Name, cfg.StrategySettings.SimultaneousSignalProcessing, cfg.StrategySettings.CustomSettings)
+		if err != nil {
 			return err
 		}
 	}
+	bt.MetaData.Strategy = bt.Strategy.Name()
+
 	stats := &statistics.Statistic{
 		StrategyName:                bt.Strategy.Name(),
 		StrategyNickname:            cfg.Nickname,
@@ -403,6 +416,10 @@ func (bt *BackTest) SetupFromConfig(cfg *config.Config, templatePath, output str
 		RiskFreeRate:                cfg.StatisticSettings.RiskFreeRate,
 		CandleInterval:              cfg.DataSettings.Interval,
 		FundManager:                 bt.Funding,
+		MonteCarloSettings:          cfg.StatisticSettings.MonteCarlo,
+		StrategyStatistics:          strategyStats,
+		RollingWindow:               cfg.StatisticSettings.RollingWindow,
+		ValueAtRiskConfidenceLevel:  cfg.StatisticSettings.ValueAtRiskConfidenceLevel,
 	}
 	bt.Statistic = stats
 	reports.Statistics = stats
@@ -455,6 +472,13 @@ func (bt *BackTest) SetupFromConfig(cfg *config.Config, templatePath, output str
 		return err
 	}
 
+	if cfg.StatisticSettings.Benchmark != nil {
+		stats.Benchmark, err = bt.loadBenchmark(cfg)
+		if err != nil {
+			return err
+		}
+	}
+
 	bt.Exchange = e
 	for i := range e.CurrencySettings {
 		err = p.SetCurrencySettingsMap(&e.CurrencySettings[i])
@@ -483,6 +507,90 @@ func (bt *BackTest) SetupFromConfig(cfg *config.Config, templatePath, output str
 	return nil
 }
 
+// loadStrategy loads a strategy by name and applies its defaults and custom
+// settings
+func loadStrategy(name string, useSimultaneousProcessing bool, customSettings map[string]interface{}) (strategies.Handler, error) {
+	strategy, err := strategies.LoadStrategyByName(name, useSimultaneousProcessing)
+	if err != nil {
+		return nil, err
+	}
+	strategy.SetDefaults()
+	if customSettings != nil {
+		err = strategy.SetCustomSettings(customSettings)
+		if err != nil && !errors.Is(err, base.ErrCustomSettingsUnsupported) {
+			return nil, err
+		}
+	}
+	return strategy, nil
+}
+
+// loadMultiStrategy loads each strategy of a multi-strategy config along with
+// the currencies it trades
+func loadMultiStrategy(cfg *config.Config) (*strategies.MultiStrategy, error) {
+	subs := make([]*strategies.SubStrategy, len(cfg.StrategySettings.Strategies))
+	for i := range cfg.StrategySettings.Strategies {
+		sub := &cfg.StrategySettings.Strategies[i]
+		strategy, err := loadStrategy(sub.Name, cfg.StrategySettings.SimultaneousSignalProcessing, sub.CustomSettings)
+		if err != nil {
+			return nil, fmt.Errorf("%v %w", sub.Nickname, err)
+		}
+		subs[i] = &strategies.SubStrategy{
+			Nickname:   sub.Nickname,
+			Strategy:   strategy,
+			Allocation: sub.Allocation,
+			Currencies: make([]key.ExchangePairAsset, len(sub.Currencies)),
+		}
+		for j := range sub.Currencies {
+			subs[i].Currencies[j] = key.ExchangePairAsset{
+				Exchange: strings.ToLower(sub.Currencies[j].ExchangeName),
+				Base:     sub.Currencies[j].Base.Item,
+				Quote:    sub.Currencies[j].Quote.Item,
+				Asset:    sub.Currencies[j].Asset,
+			}
+		}
+	}
+	return strategies.NewMultiStrategy(subs, cfg.StrategySettings.SimultaneousSignalProcessing)
+}
+
+// loadBenchmark loads the benchmark the results are compared against, either
+// from a CSV file or from the data of a basket of currencies
+func (bt *BackTest) loadBenchmark(cfg *config.Config) (*statistics.Benchmark, error) {
+	b := cfg.StatisticSettings.Benchmark
+	if b.CSVPath != "" {
+		return statistics.LoadBenchmarkFromCSV(b.CSVPath)
+	}
+	weights := make([]decimal.Decimal, len(b.Currencies))
+	values := make([][]statistics.ValueAtTime, len(b.Currencies))
+	names := make([]string, len(b.Currencies))
+	for i := range b.Currencies {
+		exch, pair, a, err := bt.loadExchangePairAssetBase(b.Currencies[i].ExchangeName, b.Currencies[i].Base, b.Currencies[i].Quote, b.Currencies[i].Asset)
+		if err != nil {
+			return nil, err
+		}
+		exchBase := exch.GetBase()
+		exchangeAsset, ok := exchBase.CurrencyPairs.Pairs[a]
+		if !ok {
+			return nil, fmt.Errorf("%v %v %w", exch.GetName(), a, asset.ErrNotSupported)
+		}
+		exchangeAsset.Enabled = exchangeAsset.Enabled.Add(pair)
+		klineData, err := bt.loadData(cfg, exch, pair, a, true)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = make([]statistics.ValueAtTime, len(klineData.Item.Candles))
+		for j := range klineData.Item.Candles {
+			values[i][j] = statistics.ValueAtTime{
+				Time:  klineData.Item.Candles[j].Time,
+				Value: decimal.NewFromFloat(klineData.Item.Candles[j].Close),
+				Set:   true,
+			}
+		}
+		weights[i] = b.Currencies[i].Weight
+		names[i] = fmt.Sprintf("%v %v %v", exch.GetName(), a, pair)
+	}
+	return statistics.NewBasketBenchmark(strings.Join(names, ", "), weights, values)
+}
+
 func (bt *BackTest) setupExchangeSettings(cfg *config.Config) (*exchange.Exchange, error) {
 	log.Infoln(common.Setup, "Setting exchange settings...")
 
@@ -498,9 +606,21 @@ func (bt *BackTest) setupExchangeSettings(cfg *config.Config) (*exchange.Exchang
 		}
 
 		exchangeName := strings.ToLower(exch.GetName())
-		klineData, err := bt.loadData(cfg, exch, pair, a, cfg.CurrencySettings[i].USDTrackingPair)
-		if err != nil {
-			return nil, err
+		var klineData *kline.DataFromKline
+		var dataHandler data.Handler
+		if cfg.DataSettings.TickData != nil {
+			var tickData *tick.DataFromTicks
+			tickData, err = bt.loadTickData(cfg, exch, pair, a)
+			if err != nil {
+				return nil, err
+			}
+			klineData, dataHandler = tickData.DataFromKline, tickData
+		} else {
+			klineData, err = bt.loadData(cfg, exch, pair, a, cfg.CurrencySettings[i].USDTrackingPair)
+			if err != nil {
+				return nil, err
+			}
+			dataHandler = klineData
 		}
 		if bt.LiveDataHandler == nil {
 			err = bt.Funding.AddUSDTrackingData(klineData)
@@ -514,7 +634,7 @@ func (bt *BackTest) setupExchangeSettings(cfg *config.Config) (*exchange.Exchang
 				continue
 			}
 
-			err = bt.DataHolder.SetDataForCurrency(exchangeName, a, pair, klineData)
+			err = bt.DataHolder.SetDataForCurrency(exchangeName, a, pair, dataHandler)
 			if err != nil {
 				return nil, err
 			}
@@ -875,6 +995,57 @@ func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange,
 	return resp, nil
 }
 
+// loadTickData loads trades and orderbook changes captured by the market data
+// capture subsystem, which are processed as individual events
+func (bt *BackTest) loadTickData(cfg *config.Config, exch gctexchange.IBotExchange, fPair currency.Pair, a asset.Item) (*tick.DataFromTicks, error) {
+	if exch == nil {
+		return nil, engine.ErrExchangeNotFound
+	}
+	if cfg.DataSettings.DatabaseData != nil ||
+		cfg.DataSettings.LiveData != nil ||
+		cfg.DataSettings.APIData != nil ||
+		cfg.DataSettings.CSVData != nil {
+		return nil, errAmbiguousDataSource
+	}
+	if cfg.DataSettings.Interval <= 0 {
+		return nil, errIntervalUnset
+	}
+	log.Infof(common.Setup, "Loading tick data for %v %v %v...\n", exch.GetName(), a, fPair)
+	resp, err := tick.LoadData(
+		cfg.DataSettings.TickData.Directory,
+		strings.ToLower(exch.GetName()),
+		cfg.DataSettings.Interval,
+		fPair,
+		a,
+		cfg.DataSettings.TickData.StartDate,
+		cfg.DataSettings.TickData.EndDate,
+		cfg.DataSettings.TickData.OrderbookDepth)
+	if err != nil {
+		return nil, fmt.Errorf("%v. Please check your market data capture directory", err)
+	}
+	summary := resp.RangeHolder.DataSummary(false)
+	if len(summary) > 0 {
+		log.Warnf(common.Setup, "%v", summary)
+	}
+	if a.IsFutures() {
+		var curr currency.Code
+		curr, _, err = exch.GetCollateralCurrencyForContract(a, fPair)
+		if err != nil {
+			return nil, err
+		}
+		resp.Item.UnderlyingPair = currency.NewPair(fPair.Base, curr)
+	}
+	err = resp.Load()
+	if err != nil {
+		return nil, err
+	}
+	err = bt.Reports.SetKlineData(resp.Item)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 func loadDatabaseData(cfg *config.Config, name string, fPair currency.Pair, a asset.Item, dataType int64, isUSDTrackingPair bool) (*kline.DataFromKline, error) {
 	if cfg == nil || cfg.DataSettings.DatabaseData == nil {
 		return nil, errors.New("nil config data received")
@@ -13,12 +13,14 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange/slippage"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/tick"
 	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
 	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/engine"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 )
 
 // Reset returns the exchange to initial settings
@@ -80,36 +82,47 @@ func (e *Exchange) ExecuteOrder(o order.Event, dh data.Handler, om *engine.Order
 			return f, nil
 		}
 	} else {
-		slippageRate := slippage.EstimateSlippagePercentage(cs.MinimumSlippageRate, cs.MaximumSlippageRate)
-		if cs.SkipCandleVolumeFitting || o.GetAssetType().IsFutures() || o.GetDirection() == gctorder.ClosePosition {
-			f.VolumeAdjustedPrice = f.ClosePrice
-			amount = f.Amount
-		} else {
-			var latest data.Event
-			latest, err = dh.Latest()
+		var latest data.Event
+		latest, err = dh.Latest()
+		if err != nil {
+			return nil, err
+		}
+		if t, ok := latest.(tick.Event); ok && t.GetOrderbook() != nil && o.GetDirection() != gctorder.ClosePosition {
+			// tick data holds the orderbook at the time of the order, so the
+			// order is filled against it rather than estimating slippage
+			price, amount, err = fillFromOrderbook(f, t.GetOrderbook(), amount)
 			if err != nil {
-				return nil, err
+				setCannotPurchaseDirection(f)
+				return f, err
+			}
+			adjustedPrice = price
+		} else {
+			slippageRate := slippage.EstimateSlippagePercentage(cs.MinimumSlippageRate, cs.MaximumSlippageRate)
+			if cs.SkipCandleVolumeFitting || o.GetAssetType().IsFutures() || o.GetDirection() == gctorder.ClosePosition {
+				f.VolumeAdjustedPrice = f.ClosePrice
+				amount = f.Amount
+			} else {
+				adjustedPrice, adjustedAmount = ensureOrderFitsWithinHLV(price, amount, latest.GetHighPrice(), latest.GetLowPrice(), latest.GetVolume())
+				if !amount.Equal(adjustedAmount) {
+					f.AppendReasonf("Order size shrunk from %v to %v to fit candle", amount, adjustedAmount)
+					amount = adjustedAmount
+				}
+				if !adjustedPrice.Equal(price) {
+					f.AppendReasonf("Price adjusted fitting to candle from %v to %v", price, adjustedPrice)
+					price = adjustedPrice
+					f.VolumeAdjustedPrice = price
+				}
 			}
-			adjustedPrice, adjustedAmount = ensureOrderFitsWithinHLV(price, amount, latest.GetHighPrice(), latest.GetLowPrice(), latest.GetVolume())
-			if !amount.Equal(adjustedAmount) {
-				f.AppendReasonf("Order size shrunk from %v to %v to fit candle", amount, adjustedAmount)
-				amount = adjustedAmount
+			adjustedPrice, err = applySlippageToPrice(f.GetDirection(), price, slippageRate)
+			if err != nil {
+				return f, err
 			}
 			if !adjustedPrice.Equal(price) {
-				f.AppendReasonf("Price adjusted fitting to candle from %v to %v", price, adjustedPrice)
+				f.AppendReasonf("Price has slipped from %v to %v", price, adjustedPrice)
 				price = adjustedPrice
-				f.VolumeAdjustedPrice = price
 			}
+			f.Slippage = slippageRate.Mul(decimal.NewFromInt(100)).Sub(decimal.NewFromInt(100))
 		}
-		adjustedPrice, err = applySlippageToPrice(f.GetDirection(), price, slippageRate)
-		if err != nil {
-			return f, err
-		}
-		if !adjustedPrice.Equal(price) {
-			f.AppendReasonf("Price has slipped from %v to %v", price, adjustedPrice)
-			price = adjustedPrice
-		}
-		f.Slippage = slippageRate.Mul(decimal.NewFromInt(100)).Sub(decimal.NewFromInt(100))
 	}
 
 	adjustedAmount = reduceAmountToFitPortfolioLimit(adjustedPrice, amount, allocatedFunds, f.GetDirection())
@@ -452,6 +465,56 @@ func ensureOrderFitsWithinHLV(price, amount, high, low, volume decimal.Decimal)
 	return adjustedPrice, adjustedAmount
 }
 
+// fillFromOrderbook walks the side of the orderbook the order takes liquidity
+// from and returns the average price and the amount the orderbook can fill.
+// Slippage is recorded against the order's close price
+func fillFromOrderbook(f *fill.Fill, ob *orderbook.Base, amount decimal.Decimal) (price, filled decimal.Decimal, err error) {
+	var levels orderbook.Items
+	var buying bool
+	switch f.GetDirection() {
+	case gctorder.Buy, gctorder.Bid, gctorder.Long:
+		levels = ob.Asks
+		buying = true
+	case gctorder.Sell, gctorder.Ask, gctorder.Short:
+		levels = ob.Bids
+	default:
+		return decimal.Zero, decimal.Zero, fmt.Errorf("%v %w", f.GetDirection(), gctorder.ErrSideIsInvalid)
+	}
+	remaining := amount
+	var cost decimal.Decimal
+	for i := range levels {
+		if !remaining.IsPositive() {
+			break
+		}
+		taken := decimal.Min(remaining, decimal.NewFromFloat(levels[i].Amount))
+		cost = cost.Add(taken.Mul(decimal.NewFromFloat(levels[i].Price)))
+		remaining = remaining.Sub(taken)
+	}
+	filled = amount.Sub(remaining)
+	if !filled.IsPositive() {
+		f.AppendReason("Orderbook has no liquidity to fill the order")
+		return decimal.Zero, decimal.Zero, errNoOrderbookLiquidity
+	}
+	if !filled.Equal(amount) {
+		f.AppendReasonf("Order size shrunk from %v to %v to fit orderbook liquidity", amount, filled)
+	}
+	price = cost.Div(filled)
+	f.VolumeAdjustedPrice = price
+	if f.ClosePrice.IsPositive() {
+		// matches the slippage rate convention where negative values are
+		// unfavourable to the order
+		movement := price.Sub(f.ClosePrice).Div(f.ClosePrice).Mul(decimal.NewFromInt(100))
+		if buying {
+			movement = movement.Neg()
+		}
+		f.Slippage = movement
+	}
+	if !price.Equal(f.ClosePrice) {
+		f.AppendReasonf("Filled against orderbook at average price %v from %v", price, f.ClosePrice)
+	}
+	return price, filled, nil
+}
+
 func calculateExchangeFee(price, amount, fee decimal.Decimal) decimal.Decimal {
 	return fee.Mul(price).Mul(amount)
 }
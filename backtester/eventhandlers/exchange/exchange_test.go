@@ -28,6 +28,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 )
 
 const testExchange = "binance"
@@ -582,6 +583,58 @@ func TestApplySlippageToPrice(t *testing.T) {
 	}
 }
 
+func TestFillFromOrderbook(t *testing.T) {
+	t.Parallel()
+	ob := &orderbook.Base{
+		Bids: orderbook.Items{{Price: 99, Amount: 1}, {Price: 98, Amount: 1}},
+		Asks: orderbook.Items{{Price: 101, Amount: 1}, {Price: 103, Amount: 1}},
+	}
+	f := &fill.Fill{Base: &event.Base{}, Direction: gctorder.Buy, ClosePrice: decimal.NewFromInt(100)}
+	price, filled, err := fillFromOrderbook(f, ob, decimal.NewFromInt(2))
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !price.Equal(decimal.NewFromInt(102)) {
+		t.Errorf("received: %v, expected: %v", price, decimal.NewFromInt(102))
+	}
+	if !filled.Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received: %v, expected: %v", filled, decimal.NewFromInt(2))
+	}
+	if !f.Slippage.Equal(decimal.NewFromInt(-2)) {
+		t.Errorf("received: %v, expected: %v", f.Slippage, decimal.NewFromInt(-2))
+	}
+	if !f.VolumeAdjustedPrice.Equal(price) {
+		t.Errorf("received: %v, expected: %v", f.VolumeAdjustedPrice, price)
+	}
+
+	f = &fill.Fill{Base: &event.Base{}, Direction: gctorder.Sell, ClosePrice: decimal.NewFromInt(100)}
+	price, filled, err = fillFromOrderbook(f, ob, decimal.NewFromInt(3))
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !price.Equal(decimal.NewFromFloat(98.5)) {
+		t.Errorf("received: %v, expected: %v", price, decimal.NewFromFloat(98.5))
+	}
+	if !filled.Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received: %v, expected: %v", filled, decimal.NewFromInt(2))
+	}
+	if !f.Slippage.Equal(decimal.NewFromFloat(-1.5)) {
+		t.Errorf("received: %v, expected: %v", f.Slippage, decimal.NewFromFloat(-1.5))
+	}
+
+	f = &fill.Fill{Base: &event.Base{}, Direction: gctorder.Sell}
+	_, _, err = fillFromOrderbook(f, &orderbook.Base{}, decimal.NewFromInt(1))
+	if !errors.Is(err, errNoOrderbookLiquidity) {
+		t.Errorf("received '%v' expected '%v'", err, errNoOrderbookLiquidity)
+	}
+
+	f = &fill.Fill{Base: &event.Base{}, Direction: gctorder.ClosePosition}
+	_, _, err = fillFromOrderbook(f, ob, decimal.NewFromInt(1))
+	if !errors.Is(err, gctorder.ErrSideIsInvalid) {
+		t.Errorf("received '%v' expected '%v'", err, gctorder.ErrSideIsInvalid)
+	}
+}
+
 func TestReduceAmountToFitPortfolioLimit(t *testing.T) {
 	t.Parallel()
 	initialPrice := decimal.NewFromInt(100)
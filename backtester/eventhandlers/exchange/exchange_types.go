@@ -23,6 +23,7 @@ var (
 	errNilCurrencySettings     = errors.New("received nil currency settings")
 	errInvalidDirection        = errors.New("received invalid order direction")
 	errNoCurrencySettingsFound = errors.New("no currency settings found")
+	errNoOrderbookLiquidity    = errors.New("no orderbook liquidity to fill order")
 )
 
 // ExecutionHandler interface dictates what functions are required to submit an order
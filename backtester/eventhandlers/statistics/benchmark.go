@@ -0,0 +1,303 @@
+package statistics
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/common/convert"
+	gctmath "github.com/thrasher-corp/gocryptotrader/common/math"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// DefaultRollingWindow is the number of candles used for the rolling sharpe
+// ratio when unset
+const DefaultRollingWindow = 30
+
+// DefaultValueAtRiskConfidenceLevel is the confidence level used for value at
+// risk and expected shortfall when unset
+var DefaultValueAtRiskConfidenceLevel = decimal.NewFromFloat(0.95)
+
+var (
+	errNoBenchmarkValues   = errors.New("benchmark has no values")
+	errBenchmarkWeightsLen = errors.New("benchmark weights do not match the number of currencies")
+	errNotEnoughValues     = errors.New("at least two values are required")
+)
+
+// benchmarkBaseValue is the starting value of a basket benchmark
+var benchmarkBaseValue = decimal.NewFromInt(100)
+
+// NewBasketBenchmark creates a benchmark from the values of several
+// currencies. The basket's return for each time is the weighted average return
+// of the currencies which have values, so the weights are rebalanced every
+// candle. A single currency creates a benchmark of its own returns
+func NewBasketBenchmark(name string, weights []decimal.Decimal, values [][]ValueAtTime) (*Benchmark, error) {
+	if len(values) == 0 {
+		return nil, errNoBenchmarkValues
+	}
+	if len(weights) != len(values) {
+		return nil, errBenchmarkWeightsLen
+	}
+	var times []time.Time
+	seen := make(map[int64]bool)
+	for i := range values {
+		sortValuesByTime(values[i])
+		for j := range values[i] {
+			if seen[values[i][j].Time.UnixNano()] {
+				continue
+			}
+			seen[values[i][j].Time.UnixNano()] = true
+			times = append(times, values[i][j].Time)
+		}
+	}
+	if len(times) == 0 {
+		return nil, errNoBenchmarkValues
+	}
+	sort.Slice(times, func(i, j int) bool {
+		return times[i].Before(times[j])
+	})
+	resp := &Benchmark{
+		Name:   name,
+		Values: make([]ValueAtTime, len(times)),
+	}
+	resp.Values[0] = ValueAtTime{Time: times[0], Value: benchmarkBaseValue, Set: true}
+	for i := 1; i < len(times); i++ {
+		var weightedReturn, totalWeight decimal.Decimal
+		for j := range values {
+			previous, ok := valueAt(values[j], times[i-1])
+			if !ok || previous.IsZero() {
+				continue
+			}
+			current, _ := valueAt(values[j], times[i])
+			weightedReturn = weightedReturn.Add(current.Sub(previous).Div(previous).Mul(weights[j]))
+			totalWeight = totalWeight.Add(weights[j])
+		}
+		value := resp.Values[i-1].Value
+		if !totalWeight.IsZero() {
+			value = value.Mul(decimal.NewFromInt(1).Add(weightedReturn.Div(totalWeight)))
+		}
+		resp.Values[i] = ValueAtTime{Time: times[i], Value: value, Set: true}
+	}
+	return resp, nil
+}
+
+// LoadBenchmarkFromCSV loads a benchmark from a file of unix timestamp and
+// value rows, such as the closing values of an index
+func LoadBenchmarkFromCSV(path string) (*Benchmark, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := f.Close(); closeErr != nil {
+			log.Errorln(common.Statistics, closeErr)
+		}
+	}()
+	resp := &Benchmark{
+		Name: strings.TrimSuffix(filepath.Base(path), ".csv"),
+	}
+	reader := csv.NewReader(f)
+	for row := 1; ; row++ {
+		var record []string
+		record, err = reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not read benchmark csv %v, %w", path, err)
+		}
+		if len(record) < 2 {
+			return nil, fmt.Errorf("benchmark csv %v row %v requires a timestamp and value", path, row)
+		}
+		var timestamp int64
+		timestamp, err = strconv.ParseInt(strings.TrimSpace(record[0]), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("benchmark csv %v row %v invalid timestamp %w", path, row, err)
+		}
+		var value decimal.Decimal
+		value, err = decimal.NewFromString(strings.TrimSpace(record[1]))
+		if err != nil {
+			return nil, fmt.Errorf("benchmark csv %v row %v invalid value %w", path, row, err)
+		}
+		resp.Values = append(resp.Values, ValueAtTime{Time: time.Unix(timestamp, 0).UTC(), Value: value, Set: true})
+	}
+	if len(resp.Values) == 0 {
+		return nil, fmt.Errorf("%w %v", errNoBenchmarkValues, path)
+	}
+	sortValuesByTime(resp.Values)
+	return resp, nil
+}
+
+// CalculateRiskMetrics calculates the returns of the values at each time and
+// compares them to the benchmark when set, along with their value at risk,
+// expected shortfall and rolling sharpe ratio
+func CalculateRiskMetrics(values []ValueAtTime, benchmark *Benchmark, riskFreeRatePerCandle decimal.Decimal, rollingWindow int64, confidenceLevel decimal.Decimal) (*RiskMetrics, error) {
+	if len(values) < 2 {
+		return nil, errNotEnoughValues
+	}
+	if rollingWindow <= 1 {
+		rollingWindow = DefaultRollingWindow
+	}
+	if confidenceLevel.IsZero() {
+		confidenceLevel = DefaultValueAtRiskConfidenceLevel
+	}
+	sortValuesByTime(values)
+	returns := make([]decimal.Decimal, len(values)-1)
+	for i := 1; i < len(values); i++ {
+		if values[i-1].Value.IsZero() {
+			continue
+		}
+		returns[i-1] = values[i].Value.Sub(values[i-1].Value).Div(values[i-1].Value)
+	}
+	oneHundred := decimal.NewFromInt(100)
+	resp := &RiskMetrics{
+		ConfidenceLevel: confidenceLevel,
+		RollingWindow:   rollingWindow,
+	}
+	valueAtRisk, err := gctmath.DecimalHistoricalValueAtRisk(returns, confidenceLevel)
+	if err != nil {
+		return nil, err
+	}
+	resp.ValueAtRisk = valueAtRisk.Mul(oneHundred)
+	expectedShortfall, err := gctmath.DecimalExpectedShortfall(returns, confidenceLevel)
+	if err != nil {
+		return nil, err
+	}
+	resp.ExpectedShortfall = expectedShortfall.Mul(oneHundred)
+
+	for i := int(rollingWindow) - 1; i < len(returns); i++ {
+		window := returns[i-int(rollingWindow)+1 : i+1]
+		var average, sharpe decimal.Decimal
+		average, err = gctmath.DecimalArithmeticMean(window)
+		if err != nil {
+			return nil, err
+		}
+		sharpe, err = gctmath.DecimalSharpeRatio(window, riskFreeRatePerCandle, average)
+		if err != nil {
+			return nil, err
+		}
+		resp.RollingSharpeRatio = append(resp.RollingSharpeRatio, ValueAtTime{Time: values[i+1].Time, Value: sharpe, Set: true})
+	}
+
+	if benchmark == nil {
+		return resp, nil
+	}
+	if len(benchmark.Values) == 0 {
+		return nil, fmt.Errorf("%w %v", errNoBenchmarkValues, benchmark.Name)
+	}
+	resp.Benchmark = benchmark.Name
+	benchmarkRates := make([]decimal.Decimal, len(returns))
+	for i := 1; i < len(values); i++ {
+		previous, ok := valueAt(benchmark.Values, values[i-1].Time)
+		if !ok || previous.IsZero() {
+			continue
+		}
+		current, _ := valueAt(benchmark.Values, values[i].Time)
+		benchmarkRates[i-1] = current.Sub(previous).Div(previous)
+	}
+	if first, ok := valueAt(benchmark.Values, values[0].Time); ok && !first.IsZero() {
+		last, _ := valueAt(benchmark.Values, values[len(values)-1].Time)
+		resp.BenchmarkMovement = last.Sub(first).Div(first).Mul(oneHundred)
+	}
+
+	averageReturn, err := gctmath.DecimalArithmeticMean(returns)
+	if err != nil {
+		return nil, err
+	}
+	averageBenchmark, err := gctmath.DecimalArithmeticMean(benchmarkRates)
+	if err != nil {
+		return nil, err
+	}
+	resp.Beta, err = gctmath.DecimalBeta(returns, benchmarkRates)
+	if err != nil {
+		return nil, err
+	}
+	// Jensen's alpha is the return above what the benchmark and beta predict
+	expectedReturn := riskFreeRatePerCandle.Add(resp.Beta.Mul(averageBenchmark.Sub(riskFreeRatePerCandle)))
+	resp.Alpha = averageReturn.Sub(expectedReturn).Mul(oneHundred)
+	resp.InformationRatio, err = gctmath.DecimalInformationRatio(returns, benchmarkRates, averageReturn, averageBenchmark)
+	if err != nil {
+		return nil, err
+	}
+	trackingError, err := gctmath.DecimalTrackingError(returns, benchmarkRates)
+	if err != nil {
+		return nil, err
+	}
+	resp.TrackingError = trackingError.Mul(oneHundred)
+	return resp, nil
+}
+
+// PrintResults outputs the risk metrics to the log
+func (r *RiskMetrics) PrintResults(sep string) {
+	if r == nil {
+		return
+	}
+	if r.Benchmark != "" {
+		log.Infof(common.Statistics, "%s Benchmark: %v", sep, r.Benchmark)
+		log.Infof(common.Statistics, "%s Benchmark movement: %s%%", sep, convert.DecimalToHumanFriendlyString(r.BenchmarkMovement, 8, ".", ","))
+		log.Infof(common.Statistics, "%s Alpha per candle: %s%%", sep, convert.DecimalToHumanFriendlyString(r.Alpha.Round(8), 8, ".", ","))
+		log.Infof(common.Statistics, "%s Beta: %s", sep, convert.DecimalToHumanFriendlyString(r.Beta.Round(8), 8, ".", ","))
+		log.Infof(common.Statistics, "%s Information ratio: %s", sep, convert.DecimalToHumanFriendlyString(r.InformationRatio.Round(8), 8, ".", ","))
+		log.Infof(common.Statistics, "%s Tracking error per candle: %s%%", sep, convert.DecimalToHumanFriendlyString(r.TrackingError.Round(8), 8, ".", ","))
+	}
+	confidence := r.ConfidenceLevel.Mul(decimal.NewFromInt(100))
+	log.Infof(common.Statistics, "%s Value at risk per candle at %v%% confidence: %s%%", sep, confidence, convert.DecimalToHumanFriendlyString(r.ValueAtRisk.Round(8), 8, ".", ","))
+	log.Infof(common.Statistics, "%s Expected shortfall per candle at %v%% confidence: %s%%", sep, confidence, convert.DecimalToHumanFriendlyString(r.ExpectedShortfall.Round(8), 8, ".", ","))
+	if len(r.RollingSharpeRatio) > 0 {
+		latest := r.RollingSharpeRatio[len(r.RollingSharpeRatio)-1]
+		log.Infof(common.Statistics, "%s Latest %v candle rolling sharpe ratio: %s at %v", sep, r.RollingWindow, convert.DecimalToHumanFriendlyString(latest.Value.Round(8), 8, ".", ","), latest.Time)
+	}
+}
+
+// valueAt returns the latest value at or before the time
+func valueAt(values []ValueAtTime, t time.Time) (decimal.Decimal, bool) {
+	i := sort.Search(len(values), func(i int) bool {
+		return values[i].Time.After(t)
+	})
+	if i == 0 {
+		return decimal.Zero, false
+	}
+	return values[i-1].Value, true
+}
+
+func sortValuesByTime(values []ValueAtTime) {
+	sort.SliceStable(values, func(i, j int) bool {
+		return values[i].Time.Before(values[j].Time)
+	})
+}
+
+// calculateRiskMetrics compares the currency's total value to the benchmark,
+// or to buying and holding the currency when there is no benchmark
+func (c *CurrencyPairStatistic) calculateRiskMetrics(benchmark *Benchmark, riskFreeRatePerCandle decimal.Decimal, rollingWindow int64, confidenceLevel decimal.Decimal) (*RiskMetrics, error) {
+	if len(c.Events) == 0 {
+		return nil, errCurrencyStatisticsUnset
+	}
+	values := make([]ValueAtTime, 0, len(c.Events))
+	var closes []ValueAtTime
+	for i := range c.Events {
+		if c.Events[i].DataEvent == nil {
+			return nil, fmt.Errorf("%w %v", errNoDataAtOffset, c.Events[i].Offset)
+		}
+		values = append(values, ValueAtTime{Time: c.Events[i].Time, Value: c.Events[i].Holdings.TotalValue, Set: true})
+		if benchmark == nil {
+			closes = append(closes, ValueAtTime{Time: c.Events[i].Time, Value: c.Events[i].ClosePrice, Set: true})
+		}
+	}
+	if benchmark == nil {
+		first := c.Events[0].DataEvent
+		benchmark = &Benchmark{
+			Name:   fmt.Sprintf("%v %v %v buy and hold", first.GetExchange(), first.GetAssetType(), first.Pair()),
+			Values: closes,
+		}
+	}
+	return CalculateRiskMetrics(values, benchmark, riskFreeRatePerCandle, rollingWindow, confidenceLevel)
+}
@@ -0,0 +1,161 @@
+package statistics
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+)
+
+func valuesOverTime(tt time.Time, values ...float64) []ValueAtTime {
+	resp := make([]ValueAtTime, len(values))
+	for i := range values {
+		resp[i] = ValueAtTime{Time: tt.Add(time.Hour * time.Duration(i)), Value: decimal.NewFromFloat(values[i])}
+	}
+	return resp
+}
+
+func TestNewBasketBenchmark(t *testing.T) {
+	t.Parallel()
+	_, err := NewBasketBenchmark("test", nil, nil)
+	if !errors.Is(err, errNoBenchmarkValues) {
+		t.Errorf("received %v expected %v", err, errNoBenchmarkValues)
+	}
+	tt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, err = NewBasketBenchmark("test", nil, [][]ValueAtTime{valuesOverTime(tt, 1)})
+	if !errors.Is(err, errBenchmarkWeightsLen) {
+		t.Errorf("received %v expected %v", err, errBenchmarkWeightsLen)
+	}
+
+	b, err := NewBasketBenchmark("test",
+		[]decimal.Decimal{decimal.NewFromInt(3), decimal.NewFromInt(1)},
+		[][]ValueAtTime{
+			valuesOverTime(tt, 100, 110, 121),
+			// the second currency has no value at the first time
+			valuesOverTime(tt.Add(time.Hour), 50, 25),
+		})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received %v expected %v", err, nil)
+	}
+	// the first return only has the first currency, the second return is
+	// 0.75 * 10% + 0.25 * -50%
+	expected := []float64{100, 110, 104.5}
+	if len(b.Values) != len(expected) {
+		t.Fatalf("received %v expected %v", len(b.Values), len(expected))
+	}
+	for i := range expected {
+		if !b.Values[i].Value.Equal(decimal.NewFromFloat(expected[i])) {
+			t.Errorf("received %v expected %v at %v", b.Values[i].Value, expected[i], i)
+		}
+	}
+}
+
+func TestLoadBenchmarkFromCSV(t *testing.T) {
+	t.Parallel()
+	_, err := LoadBenchmarkFromCSV(filepath.Join(t.TempDir(), "missing.csv"))
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("received %v expected %v", err, os.ErrNotExist)
+	}
+	path := filepath.Join(t.TempDir(), "index.csv")
+	err = os.WriteFile(path, []byte("1640998800,105.5\n1640995200,100\n"), file.DefaultPermissionOctal)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := LoadBenchmarkFromCSV(path)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received %v expected %v", err, nil)
+	}
+	if b.Name != "index" {
+		t.Errorf("received %v expected %v", b.Name, "index")
+	}
+	if len(b.Values) != 2 || !b.Values[0].Value.Equal(decimal.NewFromInt(100)) || !b.Values[1].Time.Equal(time.Unix(1640998800, 0)) {
+		t.Errorf("received %v expected values sorted by time", b.Values)
+	}
+
+	err = os.WriteFile(path, []byte("1640995200,abc\n"), file.DefaultPermissionOctal)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = LoadBenchmarkFromCSV(path)
+	if err == nil {
+		t.Error("expected an error for an invalid value")
+	}
+}
+
+func TestCalculateRiskMetrics(t *testing.T) {
+	t.Parallel()
+	tt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, err := CalculateRiskMetrics(valuesOverTime(tt, 1), nil, decimal.Zero, 0, decimal.Zero)
+	if !errors.Is(err, errNotEnoughValues) {
+		t.Errorf("received %v expected %v", err, errNotEnoughValues)
+	}
+
+	values := valuesOverTime(tt, 100, 110, 99, 108.9, 98.01)
+	r, err := CalculateRiskMetrics(values, nil, decimal.Zero, 2, decimal.NewFromFloat(0.5))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received %v expected %v", err, nil)
+	}
+	if r.Benchmark != "" || !r.Beta.IsZero() {
+		t.Errorf("received %v %v expected no benchmark comparison", r.Benchmark, r.Beta)
+	}
+	// returns alternate between 10% and -10%
+	if !r.ValueAtRisk.Equal(decimal.NewFromInt(10)) || !r.ExpectedShortfall.Equal(decimal.NewFromInt(10)) {
+		t.Errorf("received %v %v expected 10 10", r.ValueAtRisk, r.ExpectedShortfall)
+	}
+	if len(r.RollingSharpeRatio) != 3 || !r.RollingSharpeRatio[0].Time.Equal(values[2].Time) {
+		t.Errorf("received %v expected three rolling sharpe ratios starting at %v", r.RollingSharpeRatio, values[2].Time)
+	}
+
+	// the benchmark moves half as much as the values
+	benchmark := &Benchmark{
+		Name:   "test",
+		Values: valuesOverTime(tt, 100, 105, 99.75, 104.7375, 99.500625),
+	}
+	r, err = CalculateRiskMetrics(values, benchmark, decimal.Zero, 0, decimal.Zero)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received %v expected %v", err, nil)
+	}
+	if r.RollingWindow != DefaultRollingWindow || !r.ConfidenceLevel.Equal(DefaultValueAtRiskConfidenceLevel) {
+		t.Errorf("received %v %v expected defaults", r.RollingWindow, r.ConfidenceLevel)
+	}
+	if len(r.RollingSharpeRatio) != 0 {
+		t.Errorf("received %v expected no rolling sharpe ratios with fewer returns than the window", len(r.RollingSharpeRatio))
+	}
+	if !r.Beta.Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received %v expected %v", r.Beta, 2)
+	}
+	if !r.Alpha.IsZero() {
+		t.Errorf("received %v expected %v", r.Alpha, 0)
+	}
+	if !r.TrackingError.Equal(decimal.NewFromInt(5)) {
+		t.Errorf("received %v expected %v", r.TrackingError, 5)
+	}
+	if !r.BenchmarkMovement.Equal(decimal.NewFromFloat(-0.499375)) {
+		t.Errorf("received %v expected %v", r.BenchmarkMovement, -0.499375)
+	}
+	r.PrintResults("test |\t")
+
+	_, err = CalculateRiskMetrics(values, &Benchmark{}, decimal.Zero, 0, decimal.Zero)
+	if !errors.Is(err, errNoBenchmarkValues) {
+		t.Errorf("received %v expected %v", err, errNoBenchmarkValues)
+	}
+}
+
+func TestValueAt(t *testing.T) {
+	t.Parallel()
+	tt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	values := valuesOverTime(tt, 1, 2)
+	if _, ok := valueAt(values, tt.Add(-time.Hour)); ok {
+		t.Error("expected no value before the first time")
+	}
+	if v, ok := valueAt(values, tt.Add(time.Minute)); !ok || !v.Equal(decimal.NewFromInt(1)) {
+		t.Errorf("received %v %v expected the previous value to be carried forward", v, ok)
+	}
+	if v, ok := valueAt(values, tt.Add(time.Hour*5)); !ok || !v.Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received %v %v expected %v", v, ok, 2)
+	}
+}
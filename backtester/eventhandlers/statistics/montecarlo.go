@@ -0,0 +1,237 @@
+package statistics
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/convert"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// CalculateMonteCarlo splits the USD value of a run into trades, where each
+// trade is the change in value between fills, and reruns them in resampled
+// orders to show how dependent the results are on the sequence of trades
+func CalculateMonteCarlo(settings *config.MonteCarloSettings, currStats map[key.ExchangePairAsset]*CurrencyPairStatistic, funding *FundingStatistics) (*MonteCarloResults, error) {
+	if settings == nil {
+		return nil, fmt.Errorf("%w monte carlo settings", gctcommon.ErrNilPointer)
+	}
+	if settings.Simulations <= 0 {
+		return nil, fmt.Errorf("%w simulations", errReceivedNoData)
+	}
+	if funding == nil || funding.TotalUSDStatistics == nil || len(funding.TotalUSDStatistics.HoldingValues) == 0 {
+		return nil, fmt.Errorf("%w USD holding values", errMissingSnapshots)
+	}
+	values := funding.TotalUSDStatistics.HoldingValues
+	trades := tradePNLs(currStats, values)
+	if len(trades) == 0 {
+		return nil, errNoTrades
+	}
+	seed := settings.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	r := rand.New(rand.NewSource(seed)) //nolint:gosec // resampling does not need a cryptographically secure source
+
+	initial := values[0].Value.InexactFloat64()
+	ruinLevel := initial * (1 - settings.RuinThreshold.InexactFloat64()/100)
+	skip := settings.SkipTradeProbability.InexactFloat64()
+	finalPNLs := make([]float64, settings.Simulations)
+	drawdowns := make([]float64, settings.Simulations)
+	var ruined int64
+	sequence := make([]float64, len(trades))
+	for i := range finalPNLs {
+		switch settings.Method {
+		case config.BootstrapResampling:
+			for j := range sequence {
+				sequence[j] = trades[r.Intn(len(trades))]
+			}
+		default:
+			copy(sequence, trades)
+			r.Shuffle(len(sequence), func(a, b int) {
+				sequence[a], sequence[b] = sequence[b], sequence[a]
+			})
+		}
+		var wasRuined bool
+		finalPNLs[i], drawdowns[i], wasRuined = simulateTrades(sequence, initial, ruinLevel, skip, r)
+		if wasRuined {
+			ruined++
+		}
+	}
+
+	confidence := settings.ConfidenceLevel.InexactFloat64()
+	ruinRate := float64(ruined) / float64(settings.Simulations)
+	ruinLower, ruinUpper := wilsonInterval(ruinRate, float64(settings.Simulations), confidence)
+	return &MonteCarloResults{
+		Method:               settings.Method,
+		Simulations:          settings.Simulations,
+		Trades:               len(trades),
+		SkipTradeProbability: settings.SkipTradeProbability,
+		ConfidenceLevel:      settings.ConfidenceLevel,
+		RuinThreshold:        settings.RuinThreshold,
+		InitialValue:         values[0].Value,
+		FinalPNL:             calculateDistribution(finalPNLs, confidence),
+		MaxDrawdown:          calculateDistribution(drawdowns, confidence),
+		RiskOfRuin:           decimal.NewFromFloat(ruinRate * 100),
+		RiskOfRuinLowerBound: decimal.NewFromFloat(ruinLower * 100),
+		RiskOfRuinUpperBound: decimal.NewFromFloat(ruinUpper * 100),
+	}, nil
+}
+
+// tradePNLs returns the change in USD value between each fill, along with the
+// change before the first fill and after the last
+func tradePNLs(currStats map[key.ExchangePairAsset]*CurrencyPairStatistic, values []ValueAtTime) []float64 {
+	var fillTimes []time.Time
+	for _, stats := range currStats {
+		for i := range stats.Events {
+			if stats.Events[i].FillEvent == nil || !stats.Events[i].FillEvent.GetAmount().IsPositive() {
+				continue
+			}
+			fillTimes = append(fillTimes, stats.Events[i].FillEvent.GetTime())
+		}
+	}
+	if len(fillTimes) == 0 {
+		return nil
+	}
+	sort.Slice(fillTimes, func(i, j int) bool {
+		return fillTimes[i].Before(fillTimes[j])
+	})
+
+	var trades []float64
+	previous := values[0].Value
+	next := 1
+	for i := range fillTimes {
+		current, advanced := previous, false
+		for next < len(values) && !values[next].Time.After(fillTimes[i]) {
+			current = values[next].Value
+			next++
+			advanced = true
+		}
+		if !advanced {
+			// fills at the same time are part of the same trade
+			continue
+		}
+		trades = append(trades, current.Sub(previous).InexactFloat64())
+		previous = current
+	}
+	if next < len(values) {
+		trades = append(trades, values[len(values)-1].Value.Sub(previous).InexactFloat64())
+	}
+	return trades
+}
+
+// simulateTrades applies each trade to the initial value, skipping trades by
+// the skip probability, and returns the final PNL, the largest percentage
+// drop from a high and whether the value ever fell to the ruin level
+func simulateTrades(trades []float64, initial, ruinLevel, skip float64, r *rand.Rand) (finalPNL, maxDrawdown float64, ruined bool) {
+	value, highest := initial, initial
+	for i := range trades {
+		if skip > 0 && r.Float64() < skip {
+			continue
+		}
+		value += trades[i]
+		if value > highest {
+			highest = value
+		}
+		if highest > 0 {
+			if drawdown := (value - highest) / highest * 100; drawdown < maxDrawdown {
+				maxDrawdown = drawdown
+			}
+		}
+		if value <= ruinLevel {
+			ruined = true
+		}
+	}
+	return value - initial, maxDrawdown, ruined
+}
+
+// calculateDistribution sorts the values and summarises them with the
+// percentiles of the confidence level
+func calculateDistribution(values []float64, confidence float64) Distribution {
+	if len(values) == 0 {
+		return Distribution{}
+	}
+	sort.Float64s(values)
+	var sum float64
+	for i := range values {
+		sum += values[i]
+	}
+	mean := sum / float64(len(values))
+	var variance float64
+	for i := range values {
+		variance += (values[i] - mean) * (values[i] - mean)
+	}
+	if len(values) > 1 {
+		variance /= float64(len(values) - 1)
+	}
+	tail := (1 - confidence) / 2
+	return Distribution{
+		Mean:              decimal.NewFromFloat(mean),
+		StandardDeviation: decimal.NewFromFloat(math.Sqrt(variance)),
+		Minimum:           decimal.NewFromFloat(values[0]),
+		Median:            decimal.NewFromFloat(percentile(values, 0.5)),
+		Maximum:           decimal.NewFromFloat(values[len(values)-1]),
+		LowerBound:        decimal.NewFromFloat(percentile(values, tail)),
+		UpperBound:        decimal.NewFromFloat(percentile(values, 1-tail)),
+	}
+}
+
+// percentile interpolates between the closest ranks of sorted values
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 1 {
+		return sorted[0]
+	}
+	rank := p * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(rank-float64(lower))
+}
+
+// wilsonInterval returns the Wilson score interval of a proportion, which
+// unlike the normal approximation stays within 0 and 1 when no or every
+// simulation is ruined
+func wilsonInterval(proportion, samples, confidence float64) (lower, upper float64) {
+	z := math.Sqrt2 * math.Erfinv(confidence)
+	denominator := 1 + z*z/samples
+	centre := (proportion + z*z/(2*samples)) / denominator
+	margin := z * math.Sqrt(proportion*(1-proportion)/samples+z*z/(4*samples*samples)) / denominator
+	return math.Max(0, centre-margin), math.Min(1, centre+margin)
+}
+
+// PrintResults outputs the monte carlo distributions to the log
+func (m *MonteCarloResults) PrintResults() {
+	if m == nil {
+		return
+	}
+	log.Infoln(common.Statistics, common.CMDColours.H2+"------------------Monte Carlo--------------------------------"+common.CMDColours.Default)
+	sep := "Monte Carlo |\t"
+	log.Infof(common.Statistics, "%s Method: %v", sep, m.Method)
+	log.Infof(common.Statistics, "%s Simulations: %v of %v trades", sep, m.Simulations, m.Trades)
+	log.Infof(common.Statistics, "%s Skip trade probability: %v", sep, m.SkipTradeProbability)
+	cl := m.ConfidenceLevel.Mul(decimal.NewFromInt(100))
+	log.Infof(common.Statistics, "%s Final PNL: mean $%s, median $%s, %v%% interval $%s to $%s", sep,
+		convert.DecimalToHumanFriendlyString(m.FinalPNL.Mean, 2, ".", ","),
+		convert.DecimalToHumanFriendlyString(m.FinalPNL.Median, 2, ".", ","),
+		cl,
+		convert.DecimalToHumanFriendlyString(m.FinalPNL.LowerBound, 2, ".", ","),
+		convert.DecimalToHumanFriendlyString(m.FinalPNL.UpperBound, 2, ".", ","))
+	log.Infof(common.Statistics, "%s Max drawdown: mean %s%%, median %s%%, %v%% interval %s%% to %s%%", sep,
+		convert.DecimalToHumanFriendlyString(m.MaxDrawdown.Mean, 4, ".", ","),
+		convert.DecimalToHumanFriendlyString(m.MaxDrawdown.Median, 4, ".", ","),
+		cl,
+		convert.DecimalToHumanFriendlyString(m.MaxDrawdown.LowerBound, 4, ".", ","),
+		convert.DecimalToHumanFriendlyString(m.MaxDrawdown.UpperBound, 4, ".", ","))
+	log.Infof(common.Statistics, "%s Risk of losing %v%%: %s%%, %v%% interval %s%% to %s%%", sep,
+		m.RuinThreshold,
+		convert.DecimalToHumanFriendlyString(m.RiskOfRuin, 4, ".", ","),
+		cl,
+		convert.DecimalToHumanFriendlyString(m.RiskOfRuinLowerBound, 4, ".", ","),
+		convert.DecimalToHumanFriendlyString(m.RiskOfRuinUpperBound, 4, ".", ","))
+}
@@ -0,0 +1,189 @@
+package statistics
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+func monteCarloTestData() (map[key.ExchangePairAsset]*CurrencyPairStatistic, *FundingStatistics) {
+	tt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	values := []int64{1000, 1000, 1100, 1050, 900, 950, 1200}
+	usd := &TotalFundingStatistics{}
+	for i := range values {
+		usd.HoldingValues = append(usd.HoldingValues, ValueAtTime{Time: tt.Add(time.Hour * time.Duration(i)), Value: decimal.NewFromInt(values[i])})
+	}
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	stats := &CurrencyPairStatistic{}
+	for _, offset := range []int{1, 2, 4, 4} {
+		stats.Events = append(stats.Events, DataAtOffset{
+			FillEvent: &fill.Fill{
+				Base:   &event.Base{Time: tt.Add(time.Hour * time.Duration(offset))},
+				Amount: decimal.NewFromInt(1),
+			},
+		})
+	}
+	// fills which were not filled are not trades
+	stats.Events = append(stats.Events, DataAtOffset{
+		FillEvent: &fill.Fill{Base: &event.Base{Time: tt.Add(time.Hour * 5)}},
+	})
+	return map[key.ExchangePairAsset]*CurrencyPairStatistic{
+		{Exchange: testExchange, Base: p.Base.Item, Quote: p.Quote.Item, Asset: asset.Spot}: stats,
+	}, &FundingStatistics{TotalUSDStatistics: usd}
+}
+
+func TestTradePNLs(t *testing.T) {
+	t.Parallel()
+	currStats, funding := monteCarloTestData()
+	trades := tradePNLs(currStats, funding.TotalUSDStatistics.HoldingValues)
+	expected := []float64{0, 100, -200, 300}
+	if len(trades) != len(expected) {
+		t.Fatalf("received %v expected %v", trades, expected)
+	}
+	var sum float64
+	for i := range expected {
+		if trades[i] != expected[i] {
+			t.Errorf("received %v expected %v", trades[i], expected[i])
+		}
+		sum += trades[i]
+	}
+	if sum != 200 {
+		t.Errorf("received %v expected %v, trades should sum to the change in value", sum, 200)
+	}
+
+	if trades = tradePNLs(nil, funding.TotalUSDStatistics.HoldingValues); trades != nil {
+		t.Errorf("received %v expected nil", trades)
+	}
+}
+
+func TestCalculateMonteCarlo(t *testing.T) {
+	t.Parallel()
+	currStats, funding := monteCarloTestData()
+	_, err := CalculateMonteCarlo(nil, currStats, funding)
+	if !errors.Is(err, common.ErrNilPointer) {
+		t.Errorf("received %v expected %v", err, common.ErrNilPointer)
+	}
+	settings := &config.MonteCarloSettings{
+		Method:          config.ShuffleResampling,
+		Simulations:     500,
+		ConfidenceLevel: decimal.NewFromFloat(0.9),
+		RuinThreshold:   decimal.NewFromInt(15),
+		Seed:            1337,
+	}
+	_, err = CalculateMonteCarlo(settings, currStats, nil)
+	if !errors.Is(err, errMissingSnapshots) {
+		t.Errorf("received %v expected %v", err, errMissingSnapshots)
+	}
+	_, err = CalculateMonteCarlo(settings, nil, funding)
+	if !errors.Is(err, errNoTrades) {
+		t.Errorf("received %v expected %v", err, errNoTrades)
+	}
+
+	resp, err := CalculateMonteCarlo(settings, currStats, funding)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received %v expected %v", err, nil)
+	}
+	if resp.Trades != 4 {
+		t.Errorf("received %v expected %v", resp.Trades, 4)
+	}
+	if !resp.FinalPNL.Minimum.Equal(decimal.NewFromInt(200)) || !resp.FinalPNL.Maximum.Equal(decimal.NewFromInt(200)) {
+		t.Errorf("received %v to %v expected 200, shuffling should not change the final PNL", resp.FinalPNL.Minimum, resp.FinalPNL.Maximum)
+	}
+	if !resp.MaxDrawdown.Minimum.LessThan(resp.MaxDrawdown.Maximum) {
+		t.Error("expected shuffling to vary the max drawdown")
+	}
+	if resp.MaxDrawdown.Maximum.IsPositive() {
+		t.Errorf("received %v expected drawdowns to be negative", resp.MaxDrawdown.Maximum)
+	}
+	if !resp.RiskOfRuin.IsPositive() || resp.RiskOfRuin.GreaterThanOrEqual(decimal.NewFromInt(100)) {
+		t.Errorf("received %v expected some but not every simulation to lose 15%%", resp.RiskOfRuin)
+	}
+	if resp.RiskOfRuinLowerBound.GreaterThan(resp.RiskOfRuin) || resp.RiskOfRuinUpperBound.LessThan(resp.RiskOfRuin) {
+		t.Errorf("received %v outside of %v to %v", resp.RiskOfRuin, resp.RiskOfRuinLowerBound, resp.RiskOfRuinUpperBound)
+	}
+
+	again, err := CalculateMonteCarlo(settings, currStats, funding)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received %v expected %v", err, nil)
+	}
+	if !again.MaxDrawdown.Mean.Equal(resp.MaxDrawdown.Mean) || !again.RiskOfRuin.Equal(resp.RiskOfRuin) {
+		t.Error("expected the same seed to produce the same results")
+	}
+
+	settings.Method = config.BootstrapResampling
+	settings.SkipTradeProbability = decimal.NewFromFloat(0.25)
+	resp, err = CalculateMonteCarlo(settings, currStats, funding)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received %v expected %v", err, nil)
+	}
+	if !resp.FinalPNL.Minimum.LessThan(resp.FinalPNL.Maximum) {
+		t.Error("expected bootstrapping to vary the final PNL")
+	}
+	if resp.FinalPNL.LowerBound.LessThan(resp.FinalPNL.Minimum) || resp.FinalPNL.UpperBound.GreaterThan(resp.FinalPNL.Maximum) {
+		t.Error("expected the confidence interval to be within the minimum and maximum")
+	}
+	resp.PrintResults()
+}
+
+func TestSimulateTrades(t *testing.T) {
+	t.Parallel()
+	r := rand.New(rand.NewSource(1)) //nolint:gosec // used for testing
+	pnl, drawdown, ruined := simulateTrades([]float64{100, -220, 20}, 1000, 900, 0, r)
+	if pnl != -100 {
+		t.Errorf("received %v expected %v", pnl, -100)
+	}
+	if drawdown != -20 {
+		t.Errorf("received %v expected %v", drawdown, -20)
+	}
+	if !ruined {
+		t.Error("expected falling to 880 to be ruined")
+	}
+	pnl, drawdown, ruined = simulateTrades([]float64{100, -220, 20}, 1000, 900, 0.9999999, r)
+	if pnl != 0 || drawdown != 0 || ruined {
+		t.Errorf("received %v %v %v expected every trade to be skipped", pnl, drawdown, ruined)
+	}
+}
+
+func TestCalculateDistribution(t *testing.T) {
+	t.Parallel()
+	d := calculateDistribution(nil, 0.95)
+	if !d.Mean.IsZero() {
+		t.Errorf("received %v expected 0", d.Mean)
+	}
+	d = calculateDistribution([]float64{5, 1, 4, 2, 3}, 0.5)
+	if !d.Mean.Equal(decimal.NewFromInt(3)) || !d.Median.Equal(decimal.NewFromInt(3)) {
+		t.Errorf("received mean %v median %v expected 3", d.Mean, d.Median)
+	}
+	if !d.Minimum.Equal(decimal.NewFromInt(1)) || !d.Maximum.Equal(decimal.NewFromInt(5)) {
+		t.Errorf("received %v to %v expected 1 to 5", d.Minimum, d.Maximum)
+	}
+	if !d.LowerBound.Equal(decimal.NewFromInt(2)) || !d.UpperBound.Equal(decimal.NewFromInt(4)) {
+		t.Errorf("received %v to %v expected 2 to 4", d.LowerBound, d.UpperBound)
+	}
+	if sd := d.StandardDeviation.InexactFloat64(); math.Abs(sd-math.Sqrt(2.5)) > 1e-9 {
+		t.Errorf("received %v expected %v", sd, math.Sqrt(2.5))
+	}
+}
+
+func TestWilsonInterval(t *testing.T) {
+	t.Parallel()
+	lower, upper := wilsonInterval(0, 100, 0.95)
+	if lower != 0 || upper <= 0 || upper > 0.05 {
+		t.Errorf("received %v to %v expected 0 to less than 0.05", lower, upper)
+	}
+	lower, upper = wilsonInterval(0.5, 100, 0.95)
+	if math.Abs(lower-0.4038) > 0.001 || math.Abs(upper-0.5962) > 0.001 {
+		t.Errorf("received %v to %v expected 0.4038 to 0.5962", lower, upper)
+	}
+}
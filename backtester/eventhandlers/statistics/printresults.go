@@ -14,6 +14,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
 	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/convert"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
@@ -65,6 +66,40 @@ func (s *Statistic) PrintTotalResults() {
 		log.Infof(common.Statistics, "Best performing market movement: %v %v %v %v%%", s.BestMarketMovement.Exchange, s.BestMarketMovement.Asset, s.BestMarketMovement.Pair, convert.DecimalToHumanFriendlyString(s.BestMarketMovement.MarketMovement, 2, ".", ","))
 		log.Infof(common.Statistics, "Best performing strategy movement: %v %v %v %v%%\n\n", s.BestStrategyResults.Exchange, s.BestStrategyResults.Asset, s.BestStrategyResults.Pair, convert.DecimalToHumanFriendlyString(s.BestStrategyResults.StrategyMovement, 2, ".", ","))
 	}
+	s.printRiskMetrics()
+}
+
+// printRiskMetrics outputs the risk metrics of each currency followed by the
+// USD totals of the whole portfolio
+func (s *Statistic) printRiskMetrics() {
+	keys := make([]key.ExchangePairAsset, 0, len(s.ExchangeAssetPairStatistics))
+	for k, v := range s.ExchangeAssetPairStatistics {
+		if v.RiskMetrics != nil {
+			keys = append(keys, k)
+		}
+	}
+	var usdMetrics *RiskMetrics
+	if s.FundingStatistics != nil && s.FundingStatistics.TotalUSDStatistics != nil {
+		usdMetrics = s.FundingStatistics.TotalUSDStatistics.RiskMetrics
+	}
+	if len(keys) == 0 && usdMetrics == nil {
+		return
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].Exchange != keys[j].Exchange {
+			return keys[i].Exchange < keys[j].Exchange
+		}
+		if keys[i].Asset != keys[j].Asset {
+			return keys[i].Asset.String() < keys[j].Asset.String()
+		}
+		return keys[i].Pair().String() < keys[j].Pair().String()
+	})
+	log.Infoln(common.Statistics, common.CMDColours.H3+"------------------Risk Metrics-------------------------------"+common.CMDColours.Default)
+	for i := range keys {
+		sep := fmt.Sprintf("%v %v %v |\t", fSIL(keys[i].Exchange, limit12), fSIL(keys[i].Asset.String(), limit10), fSIL(keys[i].Pair().String(), limit14))
+		s.ExchangeAssetPairStatistics[keys[i]].RiskMetrics.PrintResults(sep)
+	}
+	usdMetrics.PrintResults("USD Tracking Total |\t")
 }
 
 // PrintAllEventsChronologically outputs all event details in the CMD
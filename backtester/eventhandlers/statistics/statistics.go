@@ -47,6 +47,13 @@ func (s *Statistic) Reset() error {
 	s.FundingStatistics = nil
 	s.FundManager = nil
 	s.HasCollateral = false
+	s.MonteCarloSettings = nil
+	s.MonteCarlo = nil
+	s.StrategyStatistics = nil
+	s.CombinedStrategyStatistics = nil
+	s.Benchmark = nil
+	s.RollingWindow = 0
+	s.ValueAtRiskConfidenceLevel = decimal.Zero
 	return nil
 }
 
@@ -223,6 +230,10 @@ func (s *Statistic) CalculateAllResults() error {
 	currCount := 0
 	finalResults := make([]FinalResultsHolder, 0, len(s.ExchangeAssetPairStatistics))
 	var err error
+	var riskFreeRatePerCandle decimal.Decimal
+	if intervalsPerYear := s.CandleInterval.IntervalsPerYear(); intervalsPerYear > 0 {
+		riskFreeRatePerCandle = s.RiskFreeRate.Div(decimal.NewFromFloat(intervalsPerYear))
+	}
 	for mapKey, stats := range s.ExchangeAssetPairStatistics {
 		currCount++
 		last := stats.Events[len(stats.Events)-1]
@@ -233,6 +244,10 @@ func (s *Statistic) CalculateAllResults() error {
 		if err != nil {
 			log.Errorln(common.Statistics, err)
 		}
+		stats.RiskMetrics, err = stats.calculateRiskMetrics(s.Benchmark, riskFreeRatePerCandle, s.RollingWindow, s.ValueAtRiskConfidenceLevel)
+		if err != nil {
+			log.Errorf(common.Statistics, "Could not calculate risk metrics for %v %v %v-%v: %v", mapKey.Exchange, mapKey.Asset, mapKey.Base, mapKey.Quote, err)
+		}
 		stats.FinalHoldings = last.Holdings
 		stats.InitialHoldings = stats.Events[0].Holdings
 		if last.ComplianceSnapshot == nil {
@@ -275,12 +290,38 @@ func (s *Statistic) CalculateAllResults() error {
 	if err != nil {
 		return err
 	}
+	if usdStats := s.FundingStatistics.TotalUSDStatistics; usdStats != nil {
+		usdStats.RiskMetrics, err = CalculateRiskMetrics(usdStats.HoldingValues, s.Benchmark, riskFreeRatePerCandle, s.RollingWindow, s.ValueAtRiskConfidenceLevel)
+		if err != nil {
+			log.Errorf(common.Statistics, "Could not calculate USD total risk metrics: %v", err)
+		}
+	}
+	if len(s.StrategyStatistics) > 0 {
+		s.CombinedStrategyStatistics, err = CalculateStrategyStatistics(s.StrategyStatistics, s.ExchangeAssetPairStatistics)
+		if err != nil {
+			log.Errorf(common.Statistics, "Could not attribute results to strategies: %v", err)
+		} else {
+			log.Infoln(common.Statistics, common.CMDColours.H2+"------------------Strategy Attribution-----------------------"+common.CMDColours.Default)
+			for i := range s.StrategyStatistics {
+				s.StrategyStatistics[i].PrintResults()
+			}
+			s.CombinedStrategyStatistics.PrintResults()
+		}
+	}
+	if s.MonteCarloSettings != nil {
+		s.MonteCarlo, err = CalculateMonteCarlo(s.MonteCarloSettings, s.ExchangeAssetPairStatistics, s.FundingStatistics)
+		if err != nil {
+			log.Errorf(common.Statistics, "Could not run monte carlo analysis: %v", err)
+		} else {
+			s.MonteCarlo.PrintResults()
+		}
+	}
 	if currCount > 1 {
 		s.BiggestDrawdown = s.GetTheBiggestDrawdownAcrossCurrencies(finalResults)
 		s.BestMarketMovement = s.GetBestMarketPerformer(finalResults)
 		s.BestStrategyResults = s.GetBestStrategyPerformer(finalResults)
-		s.PrintTotalResults()
 	}
+	s.PrintTotalResults()
 
 	return nil
 }
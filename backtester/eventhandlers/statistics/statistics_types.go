@@ -6,6 +6,7 @@ import (
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/compliance"
@@ -30,6 +31,7 @@ var (
 	errNoRelevantStatsFound        = errors.New("no relevant currency pair statistics found")
 	errReceivedNoData              = errors.New("received no data")
 	errNoDataAtOffset              = errors.New("no data found at offset")
+	errNoTrades                    = errors.New("no trades to resample")
 )
 
 // Statistic holds all statistical information for a backtester run, from drawdowns to ratios.
@@ -57,6 +59,13 @@ type Statistic struct {
 	FundingStatistics           *FundingStatistics                               `json:"funding-statistics"`
 	FundManager                 funding.IFundingManager                          `json:"-"`
 	HasCollateral               bool                                             `json:"has-collateral"`
+	MonteCarloSettings          *config.MonteCarloSettings                       `json:"-"`
+	MonteCarlo                  *MonteCarloResults                               `json:"monte-carlo,omitempty"`
+	StrategyStatistics          []*StrategyStatistic                             `json:"strategy-statistics,omitempty"`
+	CombinedStrategyStatistics  *StrategyStatistic                               `json:"combined-strategy-statistics,omitempty"`
+	Benchmark                   *Benchmark                                       `json:"benchmark,omitempty"`
+	RollingWindow               int64                                            `json:"rolling-window"`
+	ValueAtRiskConfidenceLevel  decimal.Decimal                                  `json:"value-at-risk-confidence-level"`
 }
 
 // FinalResultsHolder holds important stats about a currency's performance
@@ -177,6 +186,7 @@ type CurrencyPairStatistic struct {
 	HighestCommittedFunds ValueAtTime         `json:"highest-committed-funds"`
 	GeometricRatios       *Ratios             `json:"geometric-ratios"`
 	ArithmeticRatios      *Ratios             `json:"arithmetic-ratios"`
+	RiskMetrics           *RiskMetrics        `json:"risk-metrics,omitempty"`
 	InitialHoldings       holdings.Holding    `json:"initial-holdings-holdings"`
 	FinalHoldings         holdings.Holding    `json:"final-holdings"`
 	FinalOrders           compliance.Snapshot `json:"final-orders"`
@@ -262,4 +272,89 @@ type TotalFundingStatistics struct {
 	DidStrategyBeatTheMarket bool            `json:"did-strategy-beat-the-market"`
 	DidStrategyMakeProfit    bool            `json:"did-strategy-make-profit"`
 	HoldingValueDifference   decimal.Decimal `json:"holding-value-difference"`
+	RiskMetrics              *RiskMetrics    `json:"risk-metrics,omitempty"`
+}
+
+// Benchmark is a series of values the returns of a run are compared against
+type Benchmark struct {
+	Name   string        `json:"name"`
+	Values []ValueAtTime `json:"-"`
+}
+
+// RiskMetrics compares returns to a benchmark and measures the size of losses.
+// Alpha, tracking error, value at risk and expected shortfall are percentages
+// per candle
+type RiskMetrics struct {
+	Benchmark         string          `json:"benchmark,omitempty"`
+	BenchmarkMovement decimal.Decimal `json:"benchmark-movement"`
+	Alpha             decimal.Decimal `json:"alpha"`
+	Beta              decimal.Decimal `json:"beta"`
+	InformationRatio  decimal.Decimal `json:"information-ratio"`
+	TrackingError     decimal.Decimal `json:"tracking-error"`
+	ConfidenceLevel   decimal.Decimal `json:"confidence-level"`
+	// ValueAtRisk is the loss which was not exceeded at the confidence level
+	ValueAtRisk decimal.Decimal `json:"value-at-risk"`
+	// ExpectedShortfall is the average loss beyond the value at risk
+	ExpectedShortfall  decimal.Decimal `json:"expected-shortfall"`
+	RollingWindow      int64           `json:"rolling-window"`
+	RollingSharpeRatio []ValueAtTime   `json:"rolling-sharpe-ratio"`
+}
+
+// MonteCarloResults holds the distributions of running the trades of a
+// backtesting run in resampled orders
+type MonteCarloResults struct {
+	Method               string          `json:"method"`
+	Simulations          int64           `json:"simulations"`
+	Trades               int             `json:"trades"`
+	SkipTradeProbability decimal.Decimal `json:"skip-trade-probability"`
+	ConfidenceLevel      decimal.Decimal `json:"confidence-level"`
+	RuinThreshold        decimal.Decimal `json:"ruin-threshold"`
+	InitialValue         decimal.Decimal `json:"initial-value"`
+	FinalPNL             Distribution    `json:"final-pnl"`
+	// MaxDrawdown is the percentage drop from the highest value of each
+	// simulation, so lower values are worse
+	MaxDrawdown Distribution `json:"max-drawdown"`
+	// RiskOfRuin is the percentage of simulations which lost the ruin threshold
+	// of the initial value, with its confidence interval
+	RiskOfRuin           decimal.Decimal `json:"risk-of-ruin"`
+	RiskOfRuinLowerBound decimal.Decimal `json:"risk-of-ruin-lower-bound"`
+	RiskOfRuinUpperBound decimal.Decimal `json:"risk-of-ruin-upper-bound"`
+}
+
+// Distribution summarises the results of every simulation. The lower and upper
+// bounds are the percentiles of the confidence interval
+type Distribution struct {
+	Mean              decimal.Decimal `json:"mean"`
+	StandardDeviation decimal.Decimal `json:"standard-deviation"`
+	Minimum           decimal.Decimal `json:"minimum"`
+	Median            decimal.Decimal `json:"median"`
+	Maximum           decimal.Decimal `json:"maximum"`
+	LowerBound        decimal.Decimal `json:"lower-bound"`
+	UpperBound        decimal.Decimal `json:"upper-bound"`
+}
+
+// StrategyStatistic attributes the orders and trading PNL of a multi-strategy
+// run to one of its strategies, or combines every strategy
+type StrategyStatistic struct {
+	Nickname      string                  `json:"nickname"`
+	Strategy      string                  `json:"strategy"`
+	Allocation    decimal.Decimal         `json:"allocation"`
+	Currencies    []key.ExchangePairAsset `json:"-"`
+	CurrencyNames []string                `json:"currencies"`
+	BuyOrders     int64                   `json:"buy-orders"`
+	SellOrders    int64                   `json:"sell-orders"`
+	TotalOrders   int64                   `json:"total-orders"`
+	// Results holds the PNL for each quote currency traded
+	Results []StrategyPNL `json:"results"`
+}
+
+// StrategyPNL is the trading PNL of a strategy in a single quote currency
+type StrategyPNL struct {
+	Currency   currency.Code   `json:"currency"`
+	FinalPNL   decimal.Decimal `json:"final-pnl"`
+	HighestPNL ValueAtTime     `json:"highest-pnl"`
+	LowestPNL  ValueAtTime     `json:"lowest-pnl"`
+	// MaxDrawdown is the largest fall in PNL from a previous high
+	MaxDrawdown decimal.Decimal `json:"max-drawdown"`
+	PNLOverTime []ValueAtTime   `json:"pnl-over-time"`
 }
@@ -0,0 +1,195 @@
+package statistics
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/convert"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// CombinedStrategiesNickname is the nickname of the statistic combining every
+// strategy of a multi-strategy run
+const CombinedStrategiesNickname = "combined"
+
+// CalculateStrategyStatistics attributes the orders and trading PNL of each
+// currency to the strategy which traded it, and returns the combined results
+// of every strategy so their joint drawdown can be compared to each strategy
+func CalculateStrategyStatistics(strats []*StrategyStatistic, currStats map[key.ExchangePairAsset]*CurrencyPairStatistic) (*StrategyStatistic, error) {
+	if len(strats) == 0 {
+		return nil, fmt.Errorf("%w strategy statistics", errReceivedNoData)
+	}
+	combined := &StrategyStatistic{
+		Nickname: CombinedStrategiesNickname,
+	}
+	combinedPNL := make(map[*currency.Item][][]ValueAtTime)
+	for i := range strats {
+		if strats[i] == nil {
+			return nil, fmt.Errorf("%w strategy statistic", gctcommon.ErrNilPointer)
+		}
+		strats[i].BuyOrders, strats[i].SellOrders, strats[i].TotalOrders = 0, 0, 0
+		strats[i].CurrencyNames = make([]string, 0, len(strats[i].Currencies))
+		pnl := make(map[*currency.Item][][]ValueAtTime)
+		for _, k := range strats[i].Currencies {
+			strats[i].CurrencyNames = append(strats[i].CurrencyNames, fmt.Sprintf("%v %v %v", k.Exchange, k.Asset, k.Pair()))
+			stats, ok := currStats[k]
+			if !ok {
+				continue
+			}
+			strats[i].BuyOrders += stats.BuyOrders
+			strats[i].SellOrders += stats.SellOrders
+			strats[i].TotalOrders += stats.TotalOrders
+			pnl[k.Quote] = append(pnl[k.Quote], tradingPNL(k.Asset, stats.Events))
+		}
+		strats[i].Results = summarisePNL(pnl)
+		for j := range strats[i].Results {
+			quote := strats[i].Results[j].Currency.Item
+			combinedPNL[quote] = append(combinedPNL[quote], strats[i].Results[j].PNLOverTime)
+		}
+		combined.Allocation = combined.Allocation.Add(strats[i].Allocation)
+		combined.CurrencyNames = append(combined.CurrencyNames, strats[i].CurrencyNames...)
+		combined.BuyOrders += strats[i].BuyOrders
+		combined.SellOrders += strats[i].SellOrders
+		combined.TotalOrders += strats[i].TotalOrders
+	}
+	combined.Results = summarisePNL(combinedPNL)
+	return combined, nil
+}
+
+// tradingPNL returns the PNL of a currency's trades at each event. Spot PNL is
+// the quote spent and received plus the value of the base bought or sold,
+// futures PNL is the realised and unrealised PNL of the position
+func tradingPNL(a asset.Item, events []DataAtOffset) []ValueAtTime {
+	resp := make([]ValueAtTime, 0, len(events))
+	var position, cash decimal.Decimal
+	for i := range events {
+		if a.IsFutures() {
+			var pnl decimal.Decimal
+			if events[i].PNL != nil {
+				pnl = events[i].PNL.GetRealisedPNL().PNL.Add(events[i].PNL.GetUnrealisedPNL().PNL)
+			}
+			resp = append(resp, ValueAtTime{Time: events[i].Time, Value: pnl})
+			continue
+		}
+		if fe := events[i].FillEvent; fe != nil && fe.GetAmount().IsPositive() {
+			value := fe.GetAmount().Mul(fe.GetPurchasePrice())
+			switch fe.GetDirection() {
+			case gctorder.Buy, gctorder.Bid:
+				position = position.Add(fe.GetAmount())
+				cash = cash.Sub(value)
+			case gctorder.Sell, gctorder.Ask:
+				position = position.Sub(fe.GetAmount())
+				cash = cash.Add(value)
+			}
+			cash = cash.Sub(fe.GetExchangeFee())
+		}
+		resp = append(resp, ValueAtTime{Time: events[i].Time, Value: cash.Add(position.Mul(events[i].ClosePrice))})
+	}
+	return resp
+}
+
+// summarisePNL sums the PNL of each quote currency at every time and
+// calculates the highs, lows and drawdown of the total
+func summarisePNL(pnl map[*currency.Item][][]ValueAtTime) []StrategyPNL {
+	resp := make([]StrategyPNL, 0, len(pnl))
+	for quote, series := range pnl {
+		total := sumPNLOverTime(series)
+		if len(total) == 0 {
+			continue
+		}
+		result := StrategyPNL{
+			Currency:    quote.Currency(),
+			FinalPNL:    total[len(total)-1].Value,
+			HighestPNL:  total[0],
+			LowestPNL:   total[0],
+			PNLOverTime: total,
+		}
+		var high decimal.Decimal
+		for i := range total {
+			if total[i].Value.GreaterThan(result.HighestPNL.Value) {
+				result.HighestPNL = total[i]
+			}
+			if total[i].Value.LessThan(result.LowestPNL.Value) {
+				result.LowestPNL = total[i]
+			}
+			if total[i].Value.GreaterThan(high) {
+				high = total[i].Value
+			}
+			if drawdown := total[i].Value.Sub(high); drawdown.LessThan(result.MaxDrawdown) {
+				result.MaxDrawdown = drawdown
+			}
+		}
+		resp = append(resp, result)
+	}
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].Currency.String() < resp[j].Currency.String()
+	})
+	return resp
+}
+
+// sumPNLOverTime adds each series together at every time any of them has a
+// value, carrying a series' last value forward when it has none at that time
+func sumPNLOverTime(series [][]ValueAtTime) []ValueAtTime {
+	times := make(map[int64]time.Time)
+	for i := range series {
+		for j := range series[i] {
+			times[series[i][j].Time.UnixNano()] = series[i][j].Time
+		}
+	}
+	unique := make([]time.Time, 0, len(times))
+	for _, t := range times {
+		unique = append(unique, t)
+	}
+	sort.Slice(unique, func(i, j int) bool {
+		return unique[i].Before(unique[j])
+	})
+	resp := make([]ValueAtTime, len(unique))
+	for i := range series {
+		var last decimal.Decimal
+		next := 0
+		for j := range unique {
+			for next < len(series[i]) && !series[i][next].Time.After(unique[j]) {
+				last = series[i][next].Value
+				next++
+			}
+			resp[j].Time = unique[j]
+			resp[j].Value = resp[j].Value.Add(last)
+		}
+	}
+	return resp
+}
+
+// PrintResults outputs the attributed orders and PNL of the strategy to the log
+func (s *StrategyStatistic) PrintResults() {
+	if s == nil {
+		return
+	}
+	sep := fmt.Sprintf("%v |\t", s.Nickname)
+	if s.Strategy != "" {
+		log.Infof(common.Statistics, "%s Strategy: %v", sep, s.Strategy)
+	}
+	if !s.Allocation.IsZero() {
+		log.Infof(common.Statistics, "%s Allocation: %v%%", sep, s.Allocation.Mul(decimal.NewFromInt(100)))
+	}
+	for i := range s.CurrencyNames {
+		log.Infof(common.Statistics, "%s Currency: %v", sep, s.CurrencyNames[i])
+	}
+	log.Infof(common.Statistics, "%s Buy orders: %v", sep, convert.IntToHumanFriendlyString(s.BuyOrders, ","))
+	log.Infof(common.Statistics, "%s Sell orders: %v", sep, convert.IntToHumanFriendlyString(s.SellOrders, ","))
+	log.Infof(common.Statistics, "%s Total orders: %v", sep, convert.IntToHumanFriendlyString(s.TotalOrders, ","))
+	for i := range s.Results {
+		r := &s.Results[i]
+		log.Infof(common.Statistics, "%s %v PNL: %s", sep, r.Currency, convert.DecimalToHumanFriendlyString(r.FinalPNL, 8, ".", ","))
+		log.Infof(common.Statistics, "%s %v highest PNL: %s at %v", sep, r.Currency, convert.DecimalToHumanFriendlyString(r.HighestPNL.Value, 8, ".", ","), r.HighestPNL.Time)
+		log.Infof(common.Statistics, "%s %v lowest PNL: %s at %v", sep, r.Currency, convert.DecimalToHumanFriendlyString(r.LowestPNL.Value, 8, ".", ","), r.LowestPNL.Time)
+		log.Infof(common.Statistics, "%s %v max drawdown: %s", sep, r.Currency, convert.DecimalToHumanFriendlyString(r.MaxDrawdown, 8, ".", ","))
+	}
+}
@@ -0,0 +1,160 @@
+package statistics
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func spotEvents(tt time.Time, closes []int64, fills map[int]*fill.Fill) []DataAtOffset {
+	events := make([]DataAtOffset, len(closes))
+	for i := range closes {
+		events[i] = DataAtOffset{
+			Offset:     int64(i + 1),
+			Time:       tt.Add(time.Hour * time.Duration(i)),
+			ClosePrice: decimal.NewFromInt(closes[i]),
+		}
+		if f, ok := fills[i]; ok {
+			f.Base = &event.Base{Time: events[i].Time}
+			events[i].FillEvent = f
+		}
+	}
+	return events
+}
+
+func TestTradingPNL(t *testing.T) {
+	t.Parallel()
+	tt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	events := spotEvents(tt, []int64{100, 110, 120, 90}, map[int]*fill.Fill{
+		0: {Direction: gctorder.Buy, Amount: decimal.NewFromInt(2), PurchasePrice: decimal.NewFromInt(100), ExchangeFee: decimal.NewFromInt(1)},
+		2: {Direction: gctorder.Sell, Amount: decimal.NewFromInt(1), PurchasePrice: decimal.NewFromInt(120)},
+		// orders which were not filled do not change the position
+		3: {Direction: gctorder.Sell},
+	})
+	pnl := tradingPNL(asset.Spot, events)
+	expected := []int64{-1, 19, 39, 9}
+	if len(pnl) != len(expected) {
+		t.Fatalf("received %v expected %v", len(pnl), len(expected))
+	}
+	for i := range expected {
+		if !pnl[i].Value.Equal(decimal.NewFromInt(expected[i])) {
+			t.Errorf("received %v expected %v at %v", pnl[i].Value, expected[i], i)
+		}
+	}
+
+	futuresEvents := []DataAtOffset{
+		{Time: tt},
+		{Time: tt.Add(time.Hour), PNL: &portfolio.PNLSummary{Result: futures.PNLResult{RealisedPNL: decimal.NewFromInt(5), UnrealisedPNL: decimal.NewFromInt(-2)}}},
+	}
+	pnl = tradingPNL(asset.Futures, futuresEvents)
+	if !pnl[0].Value.IsZero() || !pnl[1].Value.Equal(decimal.NewFromInt(3)) {
+		t.Errorf("received %v %v expected 0 3", pnl[0].Value, pnl[1].Value)
+	}
+}
+
+func TestSumPNLOverTime(t *testing.T) {
+	t.Parallel()
+	tt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	resp := sumPNLOverTime([][]ValueAtTime{
+		{{Time: tt, Value: decimal.NewFromInt(1)}, {Time: tt.Add(time.Hour * 2), Value: decimal.NewFromInt(3)}},
+		{{Time: tt.Add(time.Hour), Value: decimal.NewFromInt(10)}, {Time: tt.Add(time.Hour * 2), Value: decimal.NewFromInt(20)}},
+	})
+	expected := []int64{1, 11, 23}
+	if len(resp) != len(expected) {
+		t.Fatalf("received %v expected %v", len(resp), len(expected))
+	}
+	for i := range expected {
+		if !resp[i].Value.Equal(decimal.NewFromInt(expected[i])) {
+			t.Errorf("received %v expected %v at %v", resp[i].Value, expected[i], i)
+		}
+		if !resp[i].Time.Equal(tt.Add(time.Hour * time.Duration(i))) {
+			t.Errorf("received %v expected %v", resp[i].Time, tt.Add(time.Hour*time.Duration(i)))
+		}
+	}
+}
+
+func TestCalculateStrategyStatistics(t *testing.T) {
+	t.Parallel()
+	_, err := CalculateStrategyStatistics(nil, nil)
+	if !errors.Is(err, errReceivedNoData) {
+		t.Errorf("received %v expected %v", err, errReceivedNoData)
+	}
+	_, err = CalculateStrategyStatistics([]*StrategyStatistic{nil}, nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received %v expected %v", err, gctcommon.ErrNilPointer)
+	}
+
+	tt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	btc := key.ExchangePairAsset{Exchange: testExchange, Base: currency.BTC.Item, Quote: currency.USDT.Item, Asset: asset.Spot}
+	eth := key.ExchangePairAsset{Exchange: testExchange, Base: currency.ETH.Item, Quote: currency.USDT.Item, Asset: asset.Spot}
+	currStats := map[key.ExchangePairAsset]*CurrencyPairStatistic{
+		btc: {
+			BuyOrders:   1,
+			TotalOrders: 1,
+			Events: spotEvents(tt, []int64{100, 80, 120}, map[int]*fill.Fill{
+				0: {Direction: gctorder.Buy, Amount: decimal.NewFromInt(1), PurchasePrice: decimal.NewFromInt(100)},
+			}),
+		},
+		eth: {
+			SellOrders:  1,
+			TotalOrders: 1,
+			Events: spotEvents(tt, []int64{10, 5, 20}, map[int]*fill.Fill{
+				0: {Direction: gctorder.Sell, Amount: decimal.NewFromInt(2), PurchasePrice: decimal.NewFromInt(10)},
+			}),
+		},
+	}
+	strats := []*StrategyStatistic{
+		{Nickname: "dca", Strategy: "dollarcostaverage", Allocation: decimal.NewFromFloat(0.5), Currencies: []key.ExchangePairAsset{btc}},
+		{Nickname: "rsi", Strategy: "rsi", Allocation: decimal.NewFromFloat(0.25), Currencies: []key.ExchangePairAsset{eth}},
+	}
+	combined, err := CalculateStrategyStatistics(strats, currStats)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received %v expected %v", err, nil)
+	}
+	if strats[0].BuyOrders != 1 || strats[1].SellOrders != 1 || combined.TotalOrders != 2 {
+		t.Errorf("received %v %v %v expected orders to be attributed", strats[0].BuyOrders, strats[1].SellOrders, combined.TotalOrders)
+	}
+	if len(strats[0].Results) != 1 || !strats[0].Results[0].Currency.Equal(currency.USDT) {
+		t.Fatalf("received %v expected a USDT result", strats[0].Results)
+	}
+	dca := strats[0].Results[0]
+	if !dca.FinalPNL.Equal(decimal.NewFromInt(20)) || !dca.MaxDrawdown.Equal(decimal.NewFromInt(-20)) {
+		t.Errorf("received %v %v expected 20 -20", dca.FinalPNL, dca.MaxDrawdown)
+	}
+	if !dca.LowestPNL.Value.Equal(decimal.NewFromInt(-20)) || !dca.LowestPNL.Time.Equal(tt.Add(time.Hour)) {
+		t.Errorf("received %v expected -20 at %v", dca.LowestPNL, tt.Add(time.Hour))
+	}
+	rsi := strats[1].Results[0]
+	if !rsi.FinalPNL.Equal(decimal.NewFromInt(-20)) || !rsi.HighestPNL.Value.Equal(decimal.NewFromInt(10)) {
+		t.Errorf("received %v %v expected -20 10", rsi.FinalPNL, rsi.HighestPNL.Value)
+	}
+	if len(combined.Results) != 1 {
+		t.Fatalf("received %v expected 1", len(combined.Results))
+	}
+	joint := combined.Results[0]
+	// the BTC gains offset the ETH losses
+	expected := []int64{0, -10, 0}
+	for i := range expected {
+		if !joint.PNLOverTime[i].Value.Equal(decimal.NewFromInt(expected[i])) {
+			t.Errorf("received %v expected %v at %v", joint.PNLOverTime[i].Value, expected[i], i)
+		}
+	}
+	if !joint.MaxDrawdown.Equal(decimal.NewFromInt(-10)) {
+		t.Errorf("received %v expected %v", joint.MaxDrawdown, -10)
+	}
+	if !combined.Allocation.Equal(decimal.NewFromFloat(0.75)) || len(combined.CurrencyNames) != 2 {
+		t.Errorf("received %v %v expected 0.75 and two currencies", combined.Allocation, combined.CurrencyNames)
+	}
+	combined.PrintResults()
+}
@@ -0,0 +1,106 @@
+package strategies
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+var errNoNewCandles = errors.New("no new candles")
+
+// LiveStrategy runs a strategy in the GoCryptoTrader engine. Each closed
+// candle is appended to the strategy's data before it is signalled, funding
+// and portfolio handlers are not provided as the engine sizes orders from
+// the exchange's holdings
+type LiveStrategy struct {
+	Handler
+	data   *kline.DataFromKline
+	latest time.Time
+}
+
+// LoadLiveStrategy loads a strategy by name with its custom settings applied
+// for the engine's strategy manager
+func LoadLiveStrategy(name string, customSettings map[string]interface{}) (engine.LiveStrategy, error) {
+	strategy, err := LoadStrategyByName(name, false)
+	if err != nil {
+		return nil, err
+	}
+	strategy.SetDefaults()
+	if customSettings != nil {
+		err = strategy.SetCustomSettings(customSettings)
+		if err != nil && !errors.Is(err, base.ErrCustomSettingsUnsupported) {
+			return nil, err
+		}
+	}
+	return &LiveStrategy{Handler: strategy}, nil
+}
+
+// OnCandles appends the candles closed since the last signal and returns the
+// strategy's decision on the latest
+func (l *LiveStrategy) OnCandles(d *engine.LiveStrategyData) (*engine.LiveStrategySignal, error) {
+	if d == nil {
+		return nil, fmt.Errorf("%w live strategy data", gctcommon.ErrNilPointer)
+	}
+	var candles []gctkline.Candle
+	for i := range d.Candles {
+		if d.Candles[i].Time.After(l.latest) {
+			candles = append(candles, d.Candles[i])
+		}
+	}
+	if len(candles) == 0 {
+		return nil, errNoNewCandles
+	}
+	item := &gctkline.Item{
+		Exchange: d.Exchange,
+		Pair:     d.Pair,
+		Asset:    d.Asset,
+		Interval: d.Interval,
+		Candles:  candles,
+	}
+	if l.data == nil {
+		l.data = kline.NewDataFromKline()
+		l.data.Item = item
+		if err := l.data.Load(); err != nil {
+			return nil, err
+		}
+		if err := l.data.SetLive(true); err != nil {
+			return nil, err
+		}
+	} else if err := l.data.AppendResults(item); err != nil {
+		return nil, err
+	}
+	l.latest = candles[len(candles)-1].Time
+	for {
+		if _, err := l.data.Next(); err != nil {
+			if errors.Is(err, data.ErrEndOfData) {
+				break
+			}
+			return nil, err
+		}
+	}
+
+	ev, err := l.OnSignal(l.data, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp := &engine.LiveStrategySignal{
+		Side:   order.DoNothing,
+		Amount: ev.GetAmount().InexactFloat64(),
+		Reason: ev.GetConcatReasons(),
+	}
+	switch dir := ev.GetDirection(); {
+	case dir.IsLong():
+		resp.Side = order.Buy
+	case dir.IsShort():
+		resp.Side = order.Sell
+	}
+	return resp, nil
+}
@@ -0,0 +1,108 @@
+package strategies
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/dollarcostaverage"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/rsi"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func TestLoadLiveStrategy(t *testing.T) {
+	t.Parallel()
+	_, err := LoadLiveStrategy("test", nil)
+	if !errors.Is(err, base.ErrStrategyNotFound) {
+		t.Errorf("received: %v, expected: %v", err, base.ErrStrategyNotFound)
+	}
+	_, err = LoadLiveStrategy(rsi.Name, map[string]interface{}{"rsi-low": "low"})
+	if !errors.Is(err, base.ErrInvalidCustomSettings) {
+		t.Errorf("received: %v, expected: %v", err, base.ErrInvalidCustomSettings)
+	}
+	s, err := LoadLiveStrategy(rsi.Name, map[string]interface{}{"rsi-low": 20.0})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if s.Name() != rsi.Name {
+		t.Errorf("received: %v, expected: %v", s.Name(), rsi.Name)
+	}
+}
+
+func TestLiveStrategyOnCandles(t *testing.T) {
+	t.Parallel()
+	s, err := LoadLiveStrategy(dollarcostaverage.Name, nil)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	_, err = s.OnCandles(nil)
+	if !errors.Is(err, common.ErrNilPointer) {
+		t.Errorf("received: %v, expected: %v", err, common.ErrNilPointer)
+	}
+
+	tt := time.Now().Truncate(time.Minute)
+	d := &engine.LiveStrategyData{
+		Exchange: "binance",
+		Pair:     currency.NewPair(currency.BTC, currency.USDT),
+		Asset:    asset.Spot,
+		Interval: gctkline.OneMin,
+		Candles: []gctkline.Candle{
+			{Time: tt.Add(-time.Minute), Open: 1, High: 2, Low: 1, Close: 2},
+			{Time: tt, Open: 2, High: 3, Low: 2, Close: 3},
+		},
+	}
+	sig, err := s.OnCandles(d)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if sig.Side != gctorder.Buy {
+		t.Errorf("received: %v, expected: %v", sig.Side, gctorder.Buy)
+	}
+	if sig.Reason == "" {
+		t.Error("expected the strategy's reasoning")
+	}
+
+	_, err = s.OnCandles(d)
+	if !errors.Is(err, errNoNewCandles) {
+		t.Errorf("received: %v, expected: %v", err, errNoNewCandles)
+	}
+
+	d.Candles = append(d.Candles, gctkline.Candle{Time: tt.Add(time.Minute), Open: 3, High: 4, Low: 3, Close: 4})
+	sig, err = s.OnCandles(d)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if sig.Side != gctorder.Buy {
+		t.Errorf("received: %v, expected: %v", sig.Side, gctorder.Buy)
+	}
+	l, ok := s.(*LiveStrategy)
+	if !ok {
+		t.Fatal("expected a LiveStrategy")
+	}
+	latest, err := l.data.Latest()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if !latest.GetTime().Equal(tt.Add(time.Minute)) {
+		t.Errorf("received: %v, expected: %v", latest.GetTime(), tt.Add(time.Minute))
+	}
+
+	// strategies without enough data do nothing
+	s, err = LoadLiveStrategy(rsi.Name, nil)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	sig, err = s.OnCandles(d)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if sig.Side != gctorder.DoNothing {
+		t.Errorf("received: %v, expected: %v", sig.Side, gctorder.DoNothing)
+	}
+}
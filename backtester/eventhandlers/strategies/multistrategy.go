@@ -0,0 +1,296 @@
+package strategies
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// MultiStrategyName is the name of the strategy which runs several strategies
+// in the same backtest, each trading its own currencies
+const MultiStrategyName = "multi-strategy"
+
+var (
+	errNoStrategies          = errors.New("no strategies to run")
+	errCurrencyAlreadyTraded = errors.New("currency is already traded by another strategy")
+	errNoStrategyForCurrency = errors.New("no strategy trades currency")
+)
+
+// SubStrategy is one of the strategies run by a MultiStrategy
+type SubStrategy struct {
+	Nickname string
+	Strategy Handler
+	// Allocation is the fraction of a quote currency's initial funds the
+	// strategy can hold in spot positions. Zero leaves buys unrestricted
+	Allocation decimal.Decimal
+	Currencies []key.ExchangePairAsset
+}
+
+// MultiStrategy passes the data of each currency to the strategy which trades
+// it, allowing strategies to share the same funding and portfolio
+type MultiStrategy struct {
+	base.Strategy
+	strategies []*SubStrategy
+	owners     map[key.ExchangePairAsset]*SubStrategy
+}
+
+// NewMultiStrategy returns a MultiStrategy for strategies which have already
+// been loaded and had their settings applied
+func NewMultiStrategy(subs []*SubStrategy, useSimultaneousProcessing bool) (*MultiStrategy, error) {
+	if len(subs) == 0 {
+		return nil, errNoStrategies
+	}
+	s := &MultiStrategy{
+		strategies: subs,
+		owners:     make(map[key.ExchangePairAsset]*SubStrategy),
+	}
+	for i := range subs {
+		if subs[i] == nil || subs[i].Strategy == nil {
+			return nil, fmt.Errorf("%w strategy %v", gctcommon.ErrNilPointer, i)
+		}
+		if useSimultaneousProcessing && !subs[i].Strategy.SupportsSimultaneousProcessing() {
+			return nil, fmt.Errorf("%v %w", subs[i].Nickname, base.ErrSimultaneousProcessingNotSupported)
+		}
+		subs[i].Strategy.SetSimultaneousProcessing(useSimultaneousProcessing)
+		for j := range subs[i].Currencies {
+			k := subs[i].Currencies[j]
+			k.Exchange = strings.ToLower(k.Exchange)
+			if owner, ok := s.owners[k]; ok {
+				return nil, fmt.Errorf("%v %v %v %w '%v'", k.Exchange, k.Asset, k.Pair(), errCurrencyAlreadyTraded, owner.Nickname)
+			}
+			s.owners[k] = subs[i]
+		}
+	}
+	s.SetSimultaneousProcessing(useSimultaneousProcessing)
+	return s, nil
+}
+
+// Name returns the name of the strategy
+func (s *MultiStrategy) Name() string {
+	return MultiStrategyName
+}
+
+// Description lists the strategies being run
+func (s *MultiStrategy) Description() string {
+	names := make([]string, len(s.strategies))
+	for i := range s.strategies {
+		names[i] = s.strategies[i].Nickname
+		if !strings.EqualFold(s.strategies[i].Nickname, s.strategies[i].Strategy.Name()) {
+			names[i] += " (" + s.strategies[i].Strategy.Name() + ")"
+		}
+	}
+	return "runs " + strings.Join(names, ", ") + " together against the same funding, with each strategy trading its own currencies"
+}
+
+// Strategies returns the strategies being run
+func (s *MultiStrategy) Strategies() []*SubStrategy {
+	return s.strategies
+}
+
+// OnSignal passes the data to the strategy which trades its currency
+func (s *MultiStrategy) OnSignal(d data.Handler, f funding.IFundingTransferer, p portfolio.Handler) (signal.Event, error) {
+	if d == nil {
+		return nil, common.ErrNilEvent
+	}
+	sub, err := s.getStrategyForData(d)
+	if err != nil {
+		return nil, err
+	}
+	ev, err := sub.Strategy.OnSignal(d, f, p)
+	if err != nil {
+		return nil, fmt.Errorf("%v %w", sub.Nickname, err)
+	}
+	return ev, s.applyAllocation(sub, ev, f, p)
+}
+
+// OnSimultaneousSignals groups the data by the strategy which trades each
+// currency so every strategy only assesses its own currencies. An error from
+// one strategy does not prevent the signals of the others being returned
+func (s *MultiStrategy) OnSimultaneousSignals(d []data.Handler, f funding.IFundingTransferer, p portfolio.Handler) ([]signal.Event, error) {
+	if len(d) == 0 {
+		return nil, base.ErrNoDataToProcess
+	}
+	var errs error
+	grouped := make(map[*SubStrategy][]data.Handler, len(s.strategies))
+	for i := range d {
+		sub, err := s.getStrategyForData(d[i])
+		if err != nil {
+			errs = gctcommon.AppendError(errs, err)
+			continue
+		}
+		grouped[sub] = append(grouped[sub], d[i])
+	}
+	var resp []signal.Event
+	for _, sub := range s.strategies {
+		if len(grouped[sub]) == 0 {
+			continue
+		}
+		signals, err := sub.Strategy.OnSimultaneousSignals(grouped[sub], f, p)
+		if err != nil {
+			if errors.Is(err, base.ErrTooMuchBadData) {
+				return nil, fmt.Errorf("%v %w", sub.Nickname, err)
+			}
+			errs = gctcommon.AppendError(errs, fmt.Errorf("%v %w", sub.Nickname, err))
+		}
+		for i := range signals {
+			if err = s.applyAllocation(sub, signals[i], f, p); err != nil {
+				errs = gctcommon.AppendError(errs, err)
+				continue
+			}
+			resp = append(resp, signals[i])
+		}
+	}
+	return resp, errs
+}
+
+// SupportsSimultaneousProcessing returns whether every strategy can assess
+// its currencies together
+func (s *MultiStrategy) SupportsSimultaneousProcessing() bool {
+	for i := range s.strategies {
+		if !s.strategies[i].Strategy.SupportsSimultaneousProcessing() {
+			return false
+		}
+	}
+	return true
+}
+
+// SetCustomSettings is not supported, custom settings are set per strategy
+func (s *MultiStrategy) SetCustomSettings(map[string]interface{}) error {
+	return base.ErrCustomSettingsUnsupported
+}
+
+// SetDefaults is not required, each strategy has its defaults set when loaded
+func (s *MultiStrategy) SetDefaults() {}
+
+// CloseAllPositions asks each strategy to close the positions of its own
+// currencies. Strategies which do not support closing positions are skipped
+func (s *MultiStrategy) CloseAllPositions(h []holdings.Holding, d []data.Event) ([]signal.Event, error) {
+	groupedHoldings := make(map[*SubStrategy][]holdings.Holding, len(s.strategies))
+	for i := range h {
+		sub, ok := s.owners[createKey(h[i].Exchange, h[i].Asset, h[i].Pair)]
+		if !ok {
+			continue
+		}
+		groupedHoldings[sub] = append(groupedHoldings[sub], h[i])
+	}
+	groupedData := make(map[*SubStrategy][]data.Event, len(s.strategies))
+	for i := range d {
+		sub, ok := s.owners[createKey(d[i].GetExchange(), d[i].GetAssetType(), d[i].Pair())]
+		if !ok {
+			continue
+		}
+		groupedData[sub] = append(groupedData[sub], d[i])
+	}
+	var resp []signal.Event
+	var errs error
+	var supported bool
+	for _, sub := range s.strategies {
+		if len(groupedData[sub]) == 0 {
+			continue
+		}
+		signals, err := sub.Strategy.CloseAllPositions(groupedHoldings[sub], groupedData[sub])
+		if errors.Is(err, gctcommon.ErrFunctionNotSupported) {
+			continue
+		}
+		supported = true
+		if err != nil {
+			errs = gctcommon.AppendError(errs, fmt.Errorf("%v %w", sub.Nickname, err))
+		}
+		resp = append(resp, signals...)
+	}
+	if !supported {
+		return nil, gctcommon.ErrFunctionNotSupported
+	}
+	return resp, errs
+}
+
+// getStrategyForData returns the strategy which trades the data's currency
+func (s *MultiStrategy) getStrategyForData(d data.Handler) (*SubStrategy, error) {
+	exch, a, cp, err := d.GetDetails()
+	if err != nil {
+		return nil, err
+	}
+	sub, ok := s.owners[createKey(exch, a, cp)]
+	if !ok {
+		return nil, fmt.Errorf("%w %v %v %v", errNoStrategyForCurrency, exch, a, cp)
+	}
+	return sub, nil
+}
+
+// applyAllocation limits a spot buy so the strategy's holdings across
+// currencies sharing the same quote funding do not exceed its allocation
+func (s *MultiStrategy) applyAllocation(sub *SubStrategy, ev signal.Event, f funding.IFundingTransferer, p portfolio.Handler) error {
+	if sub.Allocation.IsZero() ||
+		ev == nil ||
+		ev.GetDirection() != order.Buy ||
+		ev.GetAssetType() != asset.Spot ||
+		!ev.GetClosePrice().IsPositive() {
+		return nil
+	}
+	sig, ok := ev.(*signal.Signal)
+	if !ok {
+		return fmt.Errorf("%v %w expected *signal.Signal received %T", sub.Nickname, gctcommon.ErrTypeAssertFailure, ev)
+	}
+	if f == nil || p == nil {
+		return fmt.Errorf("%v %w funding or portfolio", sub.Nickname, gctcommon.ErrNilPointer)
+	}
+	funds, err := f.GetFundingForEvent(ev)
+	if err != nil {
+		return err
+	}
+	pairReader, err := funds.FundReader().GetPairReader()
+	if err != nil {
+		return err
+	}
+	allocated := pairReader.QuoteInitialFunds().Mul(sub.Allocation)
+	var used decimal.Decimal
+	h := p.GetLatestHoldingsForAllCurrencies()
+	for i := range h {
+		if h[i].Asset != asset.Spot ||
+			!strings.EqualFold(h[i].Exchange, ev.GetExchange()) ||
+			!h[i].Pair.Quote.Equal(ev.Pair().Quote) ||
+			s.owners[createKey(h[i].Exchange, h[i].Asset, h[i].Pair)] != sub {
+			continue
+		}
+		if !f.IsUsingExchangeLevelFunding() && !h[i].Pair.Equal(ev.Pair()) {
+			// each currency has its own quote funding
+			continue
+		}
+		used = used.Add(h[i].BaseValue)
+	}
+	remaining := allocated.Sub(used)
+	if !remaining.IsPositive() {
+		sig.SetDirection(order.DoNothing)
+		sig.AppendReasonf("%v holds %v %v of its %v %v allocation", sub.Nickname, used.Round(8), ev.Pair().Quote, allocated.Round(8), ev.Pair().Quote)
+		return nil
+	}
+	buyLimit := remaining.Div(ev.GetClosePrice())
+	if sig.BuyLimit.IsZero() || sig.BuyLimit.GreaterThan(buyLimit) {
+		sig.SetBuyLimit(buyLimit)
+		sig.AppendReasonf("buy limited to the remaining %v %v of the %v allocation", remaining.Round(8), ev.Pair().Quote, sub.Nickname)
+	}
+	return nil
+}
+
+func createKey(exch string, a asset.Item, cp currency.Pair) key.ExchangePairAsset {
+	return key.ExchangePairAsset{
+		Exchange: strings.ToLower(exch),
+		Base:     cp.Base.Item,
+		Quote:    cp.Quote.Item,
+		Asset:    a,
+	}
+}
@@ -0,0 +1,319 @@
+package strategies
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	btcommon "github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	eventkline "github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+const testExchange = "binance"
+
+var (
+	btcPair = currency.NewPair(currency.BTC, currency.USDT)
+	ethPair = currency.NewPair(currency.ETH, currency.USDT)
+	errTest = errors.New("test error")
+)
+
+// testStrategy signals the same direction for every currency
+type testStrategy struct {
+	base.Strategy
+	name      string
+	direction gctorder.Side
+	err       error
+	closeErr  error
+}
+
+func (s *testStrategy) Name() string                         { return s.name }
+func (s *testStrategy) Description() string                  { return s.name }
+func (s *testStrategy) SupportsSimultaneousProcessing() bool { return true }
+func (s *testStrategy) SetCustomSettings(map[string]interface{}) error {
+	return base.ErrCustomSettingsUnsupported
+}
+func (s *testStrategy) SetDefaults() {}
+
+func (s *testStrategy) OnSignal(d data.Handler, _ funding.IFundingTransferer, _ portfolio.Handler) (signal.Event, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	es, err := s.GetBaseData(d)
+	if err != nil {
+		return nil, err
+	}
+	es.SetDirection(s.direction)
+	return &es, nil
+}
+
+func (s *testStrategy) OnSimultaneousSignals(d []data.Handler, f funding.IFundingTransferer, p portfolio.Handler) ([]signal.Event, error) {
+	resp := make([]signal.Event, 0, len(d))
+	for i := range d {
+		ev, err := s.OnSignal(d[i], f, p)
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, ev)
+	}
+	return resp, nil
+}
+
+func (s *testStrategy) CloseAllPositions(h []holdings.Holding, d []data.Event) ([]signal.Event, error) {
+	if s.closeErr != nil {
+		return nil, s.closeErr
+	}
+	resp := make([]signal.Event, len(d))
+	for i := range d {
+		resp[i] = &signal.Signal{Base: d[i].GetBase(), Direction: gctorder.ClosePosition}
+	}
+	return resp, nil
+}
+
+// testPortfolio returns set holdings for every currency
+type testPortfolio struct {
+	portfolio.Handler
+	holdings []holdings.Holding
+}
+
+func (p *testPortfolio) GetLatestHoldingsForAllCurrencies() []holdings.Holding {
+	return p.holdings
+}
+
+func createKeyForPair(cp currency.Pair) key.ExchangePairAsset {
+	return key.ExchangePairAsset{Exchange: testExchange, Base: cp.Base.Item, Quote: cp.Quote.Item, Asset: asset.Spot}
+}
+
+func createTestData(t *testing.T, cp currency.Pair) *kline.DataFromKline {
+	t.Helper()
+	d := &data.Base{}
+	err := d.SetStream([]data.Event{&eventkline.Kline{
+		Base: &event.Base{
+			Offset:       1,
+			Exchange:     testExchange,
+			Time:         time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			Interval:     gctkline.OneDay,
+			CurrencyPair: cp,
+			AssetType:    asset.Spot,
+		},
+		Close: decimal.NewFromInt(100),
+	}})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = d.Next()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	return &kline.DataFromKline{Item: &gctkline.Item{}, Base: d, RangeHolder: &gctkline.IntervalRangeHolder{}}
+}
+
+func createTestFunding(t *testing.T) *funding.FundManager {
+	t.Helper()
+	f, err := funding.SetupFundingManager(&engine.ExchangeManager{}, false, true, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	for _, cp := range []currency.Pair{btcPair, ethPair} {
+		baseItem, err := funding.CreateItem(testExchange, asset.Spot, cp.Base, decimal.Zero, decimal.Zero)
+		if !errors.Is(err, nil) {
+			t.Fatalf("received '%v' expected '%v'", err, nil)
+		}
+		quoteItem, err := funding.CreateItem(testExchange, asset.Spot, cp.Quote, decimal.NewFromInt(1000), decimal.Zero)
+		if !errors.Is(err, nil) {
+			t.Fatalf("received '%v' expected '%v'", err, nil)
+		}
+		pair, err := funding.CreatePair(baseItem, quoteItem)
+		if !errors.Is(err, nil) {
+			t.Fatalf("received '%v' expected '%v'", err, nil)
+		}
+		if err = f.AddPair(pair); !errors.Is(err, nil) {
+			t.Fatalf("received '%v' expected '%v'", err, nil)
+		}
+	}
+	return f
+}
+
+func createTestMultiStrategy(t *testing.T, btc, eth *testStrategy) *MultiStrategy {
+	t.Helper()
+	s, err := NewMultiStrategy([]*SubStrategy{
+		{Nickname: "btc", Strategy: btc, Allocation: decimal.NewFromFloat(0.5), Currencies: []key.ExchangePairAsset{createKeyForPair(btcPair)}},
+		{Nickname: "eth", Strategy: eth, Currencies: []key.ExchangePairAsset{createKeyForPair(ethPair)}},
+	}, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	return s
+}
+
+func TestNewMultiStrategy(t *testing.T) {
+	t.Parallel()
+	_, err := NewMultiStrategy(nil, false)
+	if !errors.Is(err, errNoStrategies) {
+		t.Errorf("received '%v' expected '%v'", err, errNoStrategies)
+	}
+	_, err = NewMultiStrategy([]*SubStrategy{{Nickname: "nil"}}, false)
+	if !errors.Is(err, common.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrNilPointer)
+	}
+	_, err = NewMultiStrategy([]*SubStrategy{
+		{Nickname: "one", Strategy: &testStrategy{}, Currencies: []key.ExchangePairAsset{createKeyForPair(btcPair)}},
+		{Nickname: "two", Strategy: &testStrategy{}, Currencies: []key.ExchangePairAsset{createKeyForPair(btcPair)}},
+	}, false)
+	if !errors.Is(err, errCurrencyAlreadyTraded) {
+		t.Errorf("received '%v' expected '%v'", err, errCurrencyAlreadyTraded)
+	}
+
+	btc := &testStrategy{name: "dca"}
+	s, err := NewMultiStrategy([]*SubStrategy{
+		{Nickname: "btc", Strategy: btc, Currencies: []key.ExchangePairAsset{createKeyForPair(btcPair)}},
+	}, true)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !s.UsingSimultaneousProcessing() || !btc.UsingSimultaneousProcessing() {
+		t.Error("expected simultaneous processing to be set for every strategy")
+	}
+	if s.Name() != MultiStrategyName {
+		t.Errorf("received '%v' expected '%v'", s.Name(), MultiStrategyName)
+	}
+	if s.Description() == "" {
+		t.Error("expected a description")
+	}
+	if err = s.SetCustomSettings(nil); !errors.Is(err, base.ErrCustomSettingsUnsupported) {
+		t.Errorf("received '%v' expected '%v'", err, base.ErrCustomSettingsUnsupported)
+	}
+}
+
+func TestMultiStrategyOnSignal(t *testing.T) {
+	t.Parallel()
+	btc := &testStrategy{name: "dca", direction: gctorder.Buy}
+	eth := &testStrategy{name: "rsi", direction: gctorder.Sell}
+	s := createTestMultiStrategy(t, btc, eth)
+	f := createTestFunding(t)
+	p := &testPortfolio{}
+
+	_, err := s.OnSignal(nil, f, p)
+	if !errors.Is(err, btcommon.ErrNilEvent) {
+		t.Errorf("received '%v' expected '%v'", err, btcommon.ErrNilEvent)
+	}
+	_, err = s.OnSignal(createTestData(t, currency.NewPair(currency.LTC, currency.USDT)), f, p)
+	if !errors.Is(err, errNoStrategyForCurrency) {
+		t.Errorf("received '%v' expected '%v'", err, errNoStrategyForCurrency)
+	}
+
+	ev, err := s.OnSignal(createTestData(t, ethPair), f, p)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if ev.GetDirection() != gctorder.Sell {
+		t.Errorf("received '%v' expected '%v'", ev.GetDirection(), gctorder.Sell)
+	}
+
+	// 400 of the 500 USDT allocation is held, leaving 1 BTC at 100
+	p.holdings = []holdings.Holding{
+		{Exchange: testExchange, Asset: asset.Spot, Pair: btcPair, BaseValue: decimal.NewFromInt(400)},
+		{Exchange: testExchange, Asset: asset.Spot, Pair: ethPair, BaseValue: decimal.NewFromInt(1000)},
+	}
+	ev, err = s.OnSignal(createTestData(t, btcPair), f, p)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if ev.GetDirection() != gctorder.Buy {
+		t.Errorf("received '%v' expected '%v'", ev.GetDirection(), gctorder.Buy)
+	}
+	if !ev.GetBuyLimit().Equal(decimal.NewFromInt(1)) {
+		t.Errorf("received '%v' expected '%v'", ev.GetBuyLimit(), 1)
+	}
+
+	p.holdings[0].BaseValue = decimal.NewFromInt(500)
+	ev, err = s.OnSignal(createTestData(t, btcPair), f, p)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if ev.GetDirection() != gctorder.DoNothing {
+		t.Errorf("received '%v' expected '%v'", ev.GetDirection(), gctorder.DoNothing)
+	}
+
+	btc.err = base.ErrTooMuchBadData
+	_, err = s.OnSignal(createTestData(t, btcPair), f, p)
+	if !errors.Is(err, base.ErrTooMuchBadData) {
+		t.Errorf("received '%v' expected '%v'", err, base.ErrTooMuchBadData)
+	}
+}
+
+func TestMultiStrategyOnSimultaneousSignals(t *testing.T) {
+	t.Parallel()
+	btc := &testStrategy{name: "dca", direction: gctorder.Buy, err: errTest}
+	eth := &testStrategy{name: "rsi", direction: gctorder.Sell}
+	s := createTestMultiStrategy(t, btc, eth)
+	f := createTestFunding(t)
+	p := &testPortfolio{}
+
+	_, err := s.OnSimultaneousSignals(nil, f, p)
+	if !errors.Is(err, base.ErrNoDataToProcess) {
+		t.Errorf("received '%v' expected '%v'", err, base.ErrNoDataToProcess)
+	}
+
+	signals, err := s.OnSimultaneousSignals([]data.Handler{createTestData(t, btcPair), createTestData(t, ethPair)}, f, p)
+	if !errors.Is(err, errTest) {
+		t.Errorf("received '%v' expected '%v'", err, errTest)
+	}
+	if len(signals) != 1 || signals[0].GetDirection() != gctorder.Sell {
+		t.Fatalf("received '%v' expected the eth signal to be returned", signals)
+	}
+
+	btc.err = nil
+	signals, err = s.OnSimultaneousSignals([]data.Handler{createTestData(t, btcPair), createTestData(t, ethPair)}, f, p)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if len(signals) != 2 {
+		t.Errorf("received '%v' expected '%v'", len(signals), 2)
+	}
+
+	btc.err = base.ErrTooMuchBadData
+	_, err = s.OnSimultaneousSignals([]data.Handler{createTestData(t, btcPair), createTestData(t, ethPair)}, f, p)
+	if !errors.Is(err, base.ErrTooMuchBadData) {
+		t.Errorf("received '%v' expected '%v'", err, base.ErrTooMuchBadData)
+	}
+}
+
+func TestMultiStrategyCloseAllPositions(t *testing.T) {
+	t.Parallel()
+	btc := &testStrategy{name: "dca", closeErr: common.ErrFunctionNotSupported}
+	eth := &testStrategy{name: "rsi", closeErr: common.ErrFunctionNotSupported}
+	s := createTestMultiStrategy(t, btc, eth)
+	latest := []data.Event{
+		&eventkline.Kline{Base: &event.Base{Exchange: testExchange, AssetType: asset.Spot, CurrencyPair: btcPair}},
+		&eventkline.Kline{Base: &event.Base{Exchange: testExchange, AssetType: asset.Spot, CurrencyPair: ethPair}},
+	}
+	_, err := s.CloseAllPositions(nil, latest)
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrFunctionNotSupported)
+	}
+
+	eth.closeErr = nil
+	signals, err := s.CloseAllPositions(nil, latest)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if len(signals) != 1 || !signals[0].Pair().Equal(ethPair) {
+		t.Errorf("received '%v' expected only eth to be closed", signals)
+	}
+}
@@ -0,0 +1,52 @@
+package tick
+
+import (
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// GetClosePrice returns the price of the tick
+func (t *Tick) GetClosePrice() decimal.Decimal {
+	return t.Price
+}
+
+// GetHighPrice returns the price of the tick
+func (t *Tick) GetHighPrice() decimal.Decimal {
+	return t.Price
+}
+
+// GetLowPrice returns the price of the tick
+func (t *Tick) GetLowPrice() decimal.Decimal {
+	return t.Price
+}
+
+// GetOpenPrice returns the price of the tick
+func (t *Tick) GetOpenPrice() decimal.Decimal {
+	return t.Price
+}
+
+// GetVolume returns the traded amount of the tick
+func (t *Tick) GetVolume() decimal.Decimal {
+	return t.Amount
+}
+
+// GetUnderlyingPair returns the underlying pair of the tick
+func (t *Tick) GetUnderlyingPair() currency.Pair {
+	return t.UnderlyingPair
+}
+
+// IsKline allows ticks to be processed as data events in place of candles
+func (t *Tick) IsKline() bool {
+	return true
+}
+
+// GetKind returns whether the tick is a trade or orderbook change
+func (t *Tick) GetKind() Kind {
+	return t.Kind
+}
+
+// GetOrderbook returns the orderbook at the time of the tick
+func (t *Tick) GetOrderbook() *orderbook.Base {
+	return t.Orderbook
+}
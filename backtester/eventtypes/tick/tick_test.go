@@ -0,0 +1,32 @@
+package tick
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+func TestTick(t *testing.T) {
+	t.Parallel()
+	ob := &orderbook.Base{}
+	tk := &Tick{
+		Base:      &event.Base{UnderlyingPair: currency.NewPair(currency.BTC, currency.USD)},
+		Kind:      Trade,
+		Price:     decimal.NewFromInt(1337),
+		Amount:    decimal.NewFromInt(2),
+		Orderbook: ob,
+	}
+	var ev Event = tk
+	assert.True(t, ev.IsKline(), "ticks should be processed as data events")
+	assert.Equal(t, Trade, ev.GetKind())
+	assert.Same(t, ob, ev.GetOrderbook())
+	for _, price := range []decimal.Decimal{ev.GetOpenPrice(), ev.GetHighPrice(), ev.GetLowPrice(), ev.GetClosePrice()} {
+		assert.True(t, price.Equal(tk.Price))
+	}
+	assert.True(t, ev.GetVolume().Equal(tk.Amount))
+	assert.Equal(t, tk.UnderlyingPair, ev.GetUnderlyingPair())
+}
@@ -0,0 +1,41 @@
+package tick
+
+import (
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// Tick kinds
+const (
+	// Trade is a public trade
+	Trade Kind = "trade"
+	// Book is a change to the orderbook
+	Book Kind = "book"
+)
+
+// Kind identifies what caused a tick
+type Kind string
+
+// Tick holds a trade or orderbook change along with the orderbook
+// as it was at the time, to be processed as a common.Event type
+type Tick struct {
+	*event.Base
+	Kind Kind
+	// Price is the trade price, or the mid price for orderbook changes
+	Price decimal.Decimal
+	// Amount is the trade amount and is zero for orderbook changes
+	Amount decimal.Decimal
+	Side   gctorder.Side
+	// Orderbook is nil until the first orderbook snapshot is received
+	Orderbook *orderbook.Base
+}
+
+// Event is a tick data event
+type Event interface {
+	kline.Event
+	GetKind() Kind
+	GetOrderbook() *orderbook.Base
+}
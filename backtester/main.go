@@ -174,6 +174,31 @@ func main() {
 			fmt.Printf("Could not read strategy config. Error: %v\n", err)
 			os.Exit(1)
 		}
+		if cfg.OptimisationSettings != nil {
+			var o *backtest.Optimiser
+			o, err = backtest.NewOptimiser(cfg, btCfg, backtest.NewTaskManager())
+			if err != nil {
+				fmt.Printf("Could not setup optimisation. Error: %v\n", err)
+				os.Exit(1)
+			}
+			var result *backtest.OptimisationResult
+			result, err = o.Run()
+			if err != nil {
+				fmt.Printf("Could not optimise strategy. Error: %v\n", err)
+				os.Exit(1)
+			}
+			result.PrintResults(10)
+			if generateReport {
+				var path string
+				path, err = result.Save(btCfg.Report.OutputPath)
+				if err != nil {
+					fmt.Printf("Could not save optimisation results. Error: %v\n", err)
+					os.Exit(1)
+				}
+				log.Infof(common.Optimiser, "Saved optimisation results to %v", path)
+			}
+			return
+		}
 		var bt *backtest.BackTest
 		bt, err = backtest.NewBacktesterFromConfigs(cfg, &config.BacktesterConfig{
 			Report: config.Report{
@@ -2,6 +2,7 @@ package report
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
@@ -129,6 +130,129 @@ func createPNLCharts(items map[key.ExchangePairAsset]*statistics.CurrencyPairSta
 	return response, nil
 }
 
+// createStrategyPNLChart shows the trading PNL of each strategy of a
+// multi-strategy run alongside their combined PNL
+func createStrategyPNLChart(strats []*statistics.StrategyStatistic, combined *statistics.StrategyStatistic) (*Chart, error) {
+	if combined == nil {
+		return nil, fmt.Errorf("%w missing combined strategy statistics", gctcommon.ErrNilPointer)
+	}
+	response := &Chart{
+		AxisType: "linear",
+	}
+	all := make([]*statistics.StrategyStatistic, 0, len(strats)+1)
+	all = append(all, strats...)
+	all = append(all, combined)
+	for _, strat := range all {
+		if strat == nil {
+			continue
+		}
+		for i := range strat.Results {
+			line := ChartLine{
+				Name:      fmt.Sprintf("%v %v PNL", strat.Nickname, strat.Results[i].Currency),
+				LinePlots: make([]LinePlot, len(strat.Results[i].PNLOverTime)),
+			}
+			for j := range strat.Results[i].PNLOverTime {
+				line.LinePlots[j] = LinePlot{
+					Value:     strat.Results[i].PNLOverTime[j].Value.InexactFloat64(),
+					UnixMilli: strat.Results[i].PNLOverTime[j].Time.UnixMilli(),
+				}
+			}
+			response.Data = append(response.Data, line)
+		}
+	}
+	return response, nil
+}
+
+// createBenchmarkChart compares the USD value of all holdings to the benchmark
+// rebased to the initial USD value
+func createBenchmarkChart(items []statistics.ValueAtTime, benchmark *statistics.Benchmark) (*Chart, error) {
+	if benchmark == nil {
+		return nil, fmt.Errorf("%w missing benchmark", gctcommon.ErrNilPointer)
+	}
+	if len(items) == 0 || len(benchmark.Values) == 0 {
+		return nil, fmt.Errorf("%w benchmark chart values", errNoValues)
+	}
+	response := &Chart{
+		AxisType: "linear",
+	}
+	usdTotal := ChartLine{
+		Name:      "Total USD value",
+		LinePlots: make([]LinePlot, len(items)),
+	}
+	for i := range items {
+		usdTotal.LinePlots[i] = LinePlot{
+			Value:     items[i].Value.InexactFloat64(),
+			UnixMilli: items[i].Time.UnixMilli(),
+		}
+	}
+	// the benchmark starts at its last value before the first holding value
+	start := benchmark.Values[0].Value
+	for i := range benchmark.Values {
+		if benchmark.Values[i].Time.After(items[0].Time) {
+			break
+		}
+		start = benchmark.Values[i].Value
+	}
+	if start.IsZero() {
+		return nil, fmt.Errorf("%w benchmark starting value", errNoValues)
+	}
+	rebase := items[0].Value.Div(start)
+	benchmarkLine := ChartLine{
+		Name: benchmark.Name,
+	}
+	for i := range benchmark.Values {
+		if benchmark.Values[i].Time.Before(items[0].Time) || benchmark.Values[i].Time.After(items[len(items)-1].Time) {
+			continue
+		}
+		benchmarkLine.LinePlots = append(benchmarkLine.LinePlots, LinePlot{
+			Value:     benchmark.Values[i].Value.Mul(rebase).InexactFloat64(),
+			UnixMilli: benchmark.Values[i].Time.UnixMilli(),
+		})
+	}
+	response.Data = append(response.Data, usdTotal, benchmarkLine)
+	return response, nil
+}
+
+// createRollingSharpeChart shows the rolling Sharpe ratio of each currency
+// and of the USD value of all holdings
+func createRollingSharpeChart(items map[key.ExchangePairAsset]*statistics.CurrencyPairStatistic, usdTotal *statistics.RiskMetrics) (*Chart, error) {
+	if items == nil {
+		return nil, fmt.Errorf("%w missing currency pair statistics", gctcommon.ErrNilPointer)
+	}
+	response := &Chart{
+		AxisType: "linear",
+	}
+	addLine := func(name string, values []statistics.ValueAtTime) {
+		if len(values) == 0 {
+			return
+		}
+		line := ChartLine{
+			Name:      name,
+			LinePlots: make([]LinePlot, len(values)),
+		}
+		for i := range values {
+			line.LinePlots[i] = LinePlot{
+				Value:     values[i].Value.InexactFloat64(),
+				UnixMilli: values[i].Time.UnixMilli(),
+			}
+		}
+		response.Data = append(response.Data, line)
+	}
+	for mapKey, stats := range items {
+		if stats.RiskMetrics == nil {
+			continue
+		}
+		addLine(fmt.Sprintf("%v %v %v-%v", mapKey.Exchange, mapKey.Asset, mapKey.Base, mapKey.Quote), stats.RiskMetrics.RollingSharpeRatio)
+	}
+	sort.Slice(response.Data, func(i, j int) bool {
+		return response.Data[i].Name < response.Data[j].Name
+	})
+	if usdTotal != nil {
+		addLine("Total USD value", usdTotal.RollingSharpeRatio)
+	}
+	return response, nil
+}
+
 // createFuturesSpotDiffChart highlights the difference in futures and spot prices
 // over time
 func createFuturesSpotDiffChart(items map[key.ExchangePairAsset]*statistics.CurrencyPairStatistic) (*Chart, error) {
@@ -236,3 +236,118 @@ func TestCreateFuturesSpotDiffChart(t *testing.T) {
 		t.Error("expected data")
 	}
 }
+
+func TestCreateStrategyPNLChart(t *testing.T) {
+	t.Parallel()
+	_, err := createStrategyPNLChart(nil, nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	tt := time.Now()
+	pnl := []statistics.StrategyPNL{
+		{
+			Currency: currency.USDT,
+			PNLOverTime: []statistics.ValueAtTime{
+				{Time: tt, Value: decimal.NewFromInt(1337)},
+				{Time: tt.Add(time.Hour), Value: decimal.NewFromInt(-1337)},
+			},
+		},
+	}
+	strats := []*statistics.StrategyStatistic{
+		{Nickname: "dca", Results: pnl},
+		nil,
+	}
+	resp, err := createStrategyPNLChart(strats, &statistics.StrategyStatistic{Nickname: statistics.CombinedStrategiesNickname, Results: pnl})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp.Data) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(resp.Data), 2)
+	}
+	if resp.Data[1].Name != "combined USDT PNL" {
+		t.Errorf("received '%v' expected '%v'", resp.Data[1].Name, "combined USDT PNL")
+	}
+	if len(resp.Data[0].LinePlots) != 2 || resp.Data[0].LinePlots[1].Value != -1337 {
+		t.Errorf("received '%v' expected two plots ending at -1337", resp.Data[0].LinePlots)
+	}
+}
+
+func TestCreateBenchmarkChart(t *testing.T) {
+	t.Parallel()
+	_, err := createBenchmarkChart(nil, nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	_, err = createBenchmarkChart(nil, &statistics.Benchmark{})
+	if !errors.Is(err, errNoValues) {
+		t.Errorf("received '%v' expected '%v'", err, errNoValues)
+	}
+
+	tt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	items := []statistics.ValueAtTime{
+		{Time: tt, Value: decimal.NewFromInt(1000)},
+		{Time: tt.Add(time.Hour), Value: decimal.NewFromInt(1100)},
+	}
+	benchmark := &statistics.Benchmark{
+		Name: "test",
+		Values: []statistics.ValueAtTime{
+			{Time: tt.Add(-time.Hour), Value: decimal.NewFromInt(1)},
+			{Time: tt, Value: decimal.NewFromInt(10)},
+			{Time: tt.Add(time.Hour), Value: decimal.NewFromInt(12)},
+			{Time: tt.Add(time.Hour * 2), Value: decimal.NewFromInt(13)},
+		},
+	}
+	resp, err := createBenchmarkChart(items, benchmark)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp.Data) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(resp.Data), 2)
+	}
+	if resp.Data[1].Name != "test" || len(resp.Data[1].LinePlots) != 2 {
+		t.Fatalf("received '%v' expected two benchmark plots", resp.Data[1])
+	}
+	if resp.Data[1].LinePlots[0].Value != 1000 || resp.Data[1].LinePlots[1].Value != 1200 {
+		t.Errorf("received '%v' expected the benchmark to be rebased to 1000", resp.Data[1].LinePlots)
+	}
+
+	benchmark.Values[1].Value = decimal.Zero
+	_, err = createBenchmarkChart(items, benchmark)
+	if !errors.Is(err, errNoValues) {
+		t.Errorf("received '%v' expected '%v'", err, errNoValues)
+	}
+}
+
+func TestCreateRollingSharpeChart(t *testing.T) {
+	t.Parallel()
+	_, err := createRollingSharpeChart(nil, nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	tt := time.Now()
+	sharpe := []statistics.ValueAtTime{
+		{Time: tt, Value: decimal.NewFromInt(1)},
+		{Time: tt.Add(time.Hour), Value: decimal.NewFromInt(-1)},
+	}
+	items := map[key.ExchangePairAsset]*statistics.CurrencyPairStatistic{
+		{Exchange: testExchange, Base: currency.BTC.Item, Quote: currency.USDT.Item, Asset: asset.Spot}: {
+			RiskMetrics: &statistics.RiskMetrics{RollingSharpeRatio: sharpe},
+		},
+		{Exchange: testExchange, Base: currency.ETH.Item, Quote: currency.USDT.Item, Asset: asset.Spot}: {},
+	}
+	resp, err := createRollingSharpeChart(items, &statistics.RiskMetrics{RollingSharpeRatio: sharpe})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp.Data) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(resp.Data), 2)
+	}
+	if resp.Data[1].Name != "Total USD value" {
+		t.Errorf("received '%v' expected '%v'", resp.Data[1].Name, "Total USD value")
+	}
+	if len(resp.Data[0].LinePlots) != 2 || resp.Data[0].LinePlots[1].Value != -1 {
+		t.Errorf("received '%v' expected two plots ending at -1", resp.Data[0].LinePlots)
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
 	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
@@ -71,6 +72,31 @@ func (d *Data) GenerateReport() error {
 			return err
 		}
 	}
+	if d.Statistics.CombinedStrategyStatistics != nil {
+		d.StrategyPNLChart, err = createStrategyPNLChart(d.Statistics.StrategyStatistics, d.Statistics.CombinedStrategyStatistics)
+		if err != nil {
+			return err
+		}
+	}
+	var usdRiskMetrics *statistics.RiskMetrics
+	if d.Statistics.FundingStatistics != nil &&
+		!d.Statistics.FundingStatistics.Report.DisableUSDTracking &&
+		d.Statistics.FundingStatistics.TotalUSDStatistics != nil {
+		usdRiskMetrics = d.Statistics.FundingStatistics.TotalUSDStatistics.RiskMetrics
+		if d.Statistics.Benchmark != nil {
+			d.BenchmarkChart, err = createBenchmarkChart(d.Statistics.FundingStatistics.TotalUSDStatistics.HoldingValues, d.Statistics.Benchmark)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	d.RollingSharpeChart, err = createRollingSharpeChart(d.Statistics.ExchangeAssetPairStatistics, usdRiskMetrics)
+	if err != nil {
+		return err
+	}
+	if len(d.RollingSharpeChart.Data) == 0 {
+		d.RollingSharpeChart = nil
+	}
 	tmpl := template.Must(
 		template.ParseFiles(d.TemplatePath),
 	)
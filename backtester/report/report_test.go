@@ -29,6 +29,14 @@ func TestGenerateReport(t *testing.T) {
 			StrategySettings: config.StrategySettings{
 				DisableUSDTracking: true,
 			},
+			StatisticSettings: config.StatisticSettings{
+				MonteCarlo: &config.MonteCarloSettings{
+					Method:          config.ShuffleResampling,
+					Simulations:     1000,
+					ConfidenceLevel: decimal.NewFromFloat(0.95),
+					RuinThreshold:   decimal.NewFromInt(50),
+				},
+			},
 		},
 		OutputPath:   t.TempDir(),
 		TemplatePath: "tpl.gohtml",
@@ -251,6 +259,15 @@ func TestGenerateReport(t *testing.T) {
 					SellOrders:               1,
 					ArithmeticRatios:         &statistics.Ratios{},
 					GeometricRatios:          &statistics.Ratios{},
+					RiskMetrics: &statistics.RiskMetrics{
+						Benchmark:          "bitstamp spot BTC-USD buy and hold",
+						Beta:               decimal.NewFromInt(1),
+						ConfidenceLevel:    decimal.NewFromFloat(0.95),
+						ValueAtRisk:        decimal.NewFromInt(5),
+						ExpectedShortfall:  decimal.NewFromInt(7),
+						RollingWindow:      2,
+						RollingSharpeRatio: []statistics.ValueAtTime{{Time: time.Now(), Value: decimal.NewFromFloat(1.5)}},
+					},
 				},
 			},
 			TotalBuyOrders:  1337,
@@ -310,6 +327,52 @@ func TestGenerateReport(t *testing.T) {
 				MarketMovement:   decimal.NewFromInt(1337),
 				StrategyMovement: decimal.NewFromInt(1337),
 			},
+			MonteCarlo: &statistics.MonteCarloResults{
+				Method:          config.ShuffleResampling,
+				Simulations:     1000,
+				Trades:          10,
+				ConfidenceLevel: decimal.NewFromFloat(0.95),
+				RuinThreshold:   decimal.NewFromInt(50),
+				InitialValue:    decimal.NewFromInt(1337),
+				FinalPNL:        statistics.Distribution{Mean: decimal.NewFromInt(100), LowerBound: decimal.NewFromInt(-50), UpperBound: decimal.NewFromInt(250)},
+				MaxDrawdown:     statistics.Distribution{Mean: decimal.NewFromInt(-10), LowerBound: decimal.NewFromInt(-30), UpperBound: decimal.NewFromInt(-2)},
+				RiskOfRuin:      decimal.NewFromFloat(0.1),
+			},
+			StrategyStatistics: []*statistics.StrategyStatistic{
+				{
+					Nickname:      "dca",
+					Strategy:      "dollarcostaverage",
+					Allocation:    decimal.NewFromFloat(0.5),
+					CurrencyNames: []string{"binance spot BTC-USDT"},
+					BuyOrders:     1337,
+					TotalOrders:   1337,
+					Results: []statistics.StrategyPNL{
+						{
+							Currency:    currency.USDT,
+							FinalPNL:    decimal.NewFromInt(1337),
+							HighestPNL:  statistics.ValueAtTime{Time: time.Now(), Value: decimal.NewFromInt(1337)},
+							LowestPNL:   statistics.ValueAtTime{Time: time.Now(), Value: decimal.NewFromInt(-137)},
+							MaxDrawdown: decimal.NewFromInt(-137),
+							PNLOverTime: []statistics.ValueAtTime{{Time: time.Now(), Value: decimal.NewFromInt(1337)}},
+						},
+					},
+				},
+			},
+			CombinedStrategyStatistics: &statistics.StrategyStatistic{
+				Nickname:      statistics.CombinedStrategiesNickname,
+				Allocation:    decimal.NewFromFloat(0.5),
+				CurrencyNames: []string{"binance spot BTC-USDT"},
+				BuyOrders:     1337,
+				TotalOrders:   1337,
+				Results: []statistics.StrategyPNL{
+					{
+						Currency:    currency.USDT,
+						FinalPNL:    decimal.NewFromInt(1337),
+						MaxDrawdown: decimal.NewFromInt(-137),
+						PNLOverTime: []statistics.ValueAtTime{{Time: time.Now(), Value: decimal.NewFromInt(1337)}},
+					},
+				},
+			},
 		},
 	}
 	if err := d.GenerateReport(); err != nil {
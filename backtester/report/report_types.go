@@ -19,6 +19,7 @@ const maxChartLimit = 1100
 var (
 	errNoCandles       = errors.New("no candles to enhance")
 	errStatisticsUnset = errors.New("unable to proceed with unset Statistics property")
+	errNoValues        = errors.New("received no values")
 )
 
 // Handler contains all functions required to generate statistical reporting for backtesting results
@@ -42,6 +43,9 @@ type Data struct {
 	HoldingsOverTimeChart *Chart
 	PNLOverTimeChart      *Chart
 	FuturesSpotDiffChart  *Chart
+	StrategyPNLChart      *Chart
+	BenchmarkChart        *Chart
+	RollingSharpeChart    *Chart
 	Prettify              PrettyNumbers
 }
 
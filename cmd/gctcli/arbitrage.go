@@ -0,0 +1,97 @@
+package main
+
+import (
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var arbitrageFlags = []cli.Flag{
+	&cli.StringFlag{
+		Name:  "exchange",
+		Usage: "only returns opportunities with a trade on the exchange, leave empty for all exchanges",
+	},
+	&cli.StringFlag{
+		Name:  "type",
+		Usage: "the arbitrage type 'cross-exchange' or 'triangular', leave empty for both",
+	},
+	&cli.Float64Flag{
+		Name:  "minprofit",
+		Usage: "the minimum profit percentage of returned opportunities",
+	},
+	&cli.Int64Flag{
+		Name:  "limit",
+		Usage: "the maximum number of opportunities to return, zero returns all",
+	},
+}
+
+var arbitrageCommand = &cli.Command{
+	Name:      "arbitrage",
+	Usage:     "arbitrage manager opportunity commands",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:   "getopportunities",
+			Usage:  "returns the opportunities of the latest arbitrage scan, ranked by profit percentage",
+			Action: getArbitrageOpportunities,
+			Flags:  arbitrageFlags,
+		},
+		{
+			Name:   "getopportunitiesstream",
+			Usage:  "streams the opportunities of every arbitrage scan, ranked by profit percentage",
+			Action: getArbitrageOpportunitiesStream,
+			Flags:  arbitrageFlags,
+		},
+	},
+}
+
+func arbitrageRequest(c *cli.Context) *gctrpc.GetArbitrageOpportunitiesRequest {
+	return &gctrpc.GetArbitrageOpportunitiesRequest{
+		Exchange:                c.String("exchange"),
+		Type:                    c.String("type"),
+		MinimumProfitPercentage: c.Float64("minprofit"),
+		Limit:                   c.Int64("limit"),
+	}
+}
+
+func getArbitrageOpportunities(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetArbitrageOpportunities(c.Context, arbitrageRequest(c))
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func getArbitrageOpportunitiesStream(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetArbitrageOpportunitiesStream(c.Context, arbitrageRequest(c))
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+
+		err = clearScreen()
+		if err != nil {
+			return err
+		}
+		jsonOutput(resp)
+	}
+}
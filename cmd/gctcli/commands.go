@@ -1,6 +1,7 @@
 package main
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -847,6 +848,29 @@ func getPortfolioSummary(c *cli.Context) error {
 	return nil
 }
 
+var getPortfolioPNLCommand = &cli.Command{
+	Name:   "getportfoliopnl",
+	Usage:  "gets the cost basis and realised and unrealised profit and loss of spot holdings",
+	Action: getPortfolioPNL,
+}
+
+func getPortfolioPNL(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetPortfolioPNL(c.Context, &gctrpc.GetPortfolioPNLRequest{})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
 var addPortfolioAddressCommand = &cli.Command{
 	Name:      "addportfolioaddress",
 	Usage:     "adds an address to the portfolio",
@@ -1567,6 +1591,324 @@ func submitOrder(c *cli.Context) error {
 	return nil
 }
 
+var submitSyntheticOrderCommand = &cli.Command{
+	Name:      "submitsyntheticorder",
+	Usage:     "submits a stop, trailing stop, OCO, iceberg, TWAP or VWAP order which is managed by the order manager",
+	ArgsUsage: "<exchange> <pair> <asset> <side> <type> <amount>",
+	Action:    submitSyntheticOrder,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to submit the order for",
+		},
+		&cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair",
+		},
+		&cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the currency pair",
+		},
+		&cli.StringFlag{
+			Name:  "side",
+			Usage: "the order side to use (BUY OR SELL)",
+		},
+		&cli.StringFlag{
+			Name:  "type",
+			Usage: "the order type (STOP, TRAILING_STOP, OCO, ICEBERG, TWAP OR VWAP)",
+		},
+		&cli.Float64Flag{
+			Name:  "amount",
+			Usage: "the total amount for the order",
+		},
+		&cli.Float64Flag{
+			Name:  "price",
+			Usage: "the limit price for child orders, market orders are used if unset; the limit leg price for OCO orders",
+		},
+		&cli.Float64Flag{
+			Name:  "trigger_price",
+			Usage: "the price which triggers a stop order or the stop leg of an OCO order",
+		},
+		&cli.Float64Flag{
+			Name:  "trailing_offset",
+			Usage: "the distance a trailing stop follows the best price",
+		},
+		&cli.Float64Flag{
+			Name:  "slice_amount",
+			Usage: "the visible amount of each iceberg child order",
+		},
+		&cli.Int64Flag{
+			Name:  "duration",
+			Usage: "the duration in seconds over which a TWAP or VWAP order is executed",
+		},
+		&cli.Int64Flag{
+			Name:  "slices",
+			Usage: "the number of child orders a TWAP or VWAP order is split into",
+		},
+		&cli.Float64Flag{
+			Name:  "participation_rate",
+			Usage: "the fraction of observed traded volume a VWAP order executes per slice",
+		},
+		&cli.StringFlag{
+			Name:  "client_id",
+			Usage: "the optional client order ID",
+		},
+	},
+}
+
+func submitSyntheticOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	var orderSide string
+	if c.IsSet("side") {
+		orderSide = c.String("side")
+	} else {
+		orderSide = c.Args().Get(3)
+	}
+	if orderSide == "" {
+		return errors.New("order side must be set")
+	}
+
+	var orderType string
+	if c.IsSet("type") {
+		orderType = c.String("type")
+	} else {
+		orderType = c.Args().Get(4)
+	}
+	if orderType == "" {
+		return errors.New("order type must be set")
+	}
+
+	var amount float64
+	if c.IsSet("amount") {
+		amount = c.Float64("amount")
+	} else if c.Args().Get(5) != "" {
+		var err error
+		amount, err = strconv.ParseFloat(c.Args().Get(5), 64)
+		if err != nil {
+			return err
+		}
+	}
+	if amount == 0 {
+		return errors.New("amount must be set")
+	}
+
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.SubmitSyntheticOrder(c.Context, &gctrpc.SubmitSyntheticOrderRequest{
+		Exchange: exchangeName,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		},
+		AssetType:         assetType,
+		Side:              orderSide,
+		OrderType:         orderType,
+		Amount:            amount,
+		Price:             c.Float64("price"),
+		TriggerPrice:      c.Float64("trigger_price"),
+		TrailingOffset:    c.Float64("trailing_offset"),
+		SliceAmount:       c.Float64("slice_amount"),
+		Duration:          int64(time.Duration(c.Int64("duration")) * time.Second),
+		Slices:            c.Int64("slices"),
+		ParticipationRate: c.Float64("participation_rate"),
+		ClientOrderId:     c.String("client_id"),
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var routeOrderCommand = &cli.Command{
+	Name:      "routeorder",
+	Usage:     "splits an order across exchanges by their available liquidity, previewing the allocation unless execute is set",
+	ArgsUsage: "<pair> <asset> <side> <amount> <max_slippage>",
+	Action:    routeOrder,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair",
+		},
+		&cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the currency pair",
+		},
+		&cli.StringFlag{
+			Name:  "side",
+			Usage: "the order side to use (BUY OR SELL)",
+		},
+		&cli.Float64Flag{
+			Name:  "amount",
+			Usage: "the total base amount to buy or sell",
+		},
+		&cli.Float64Flag{
+			Name:  "max_slippage",
+			Usage: "the maximum percentage the worst fee inclusive price of any exchange may be from the best price",
+		},
+		&cli.StringFlag{
+			Name:  "exchanges",
+			Usage: "comma separated exchanges to route to, leave empty for all exchanges",
+		},
+		&cli.StringFlag{
+			Name:  "client_id",
+			Usage: "the optional client order ID of each order",
+		},
+		&cli.BoolFlag{
+			Name:  "execute",
+			Usage: "places the orders, otherwise the allocation is only previewed",
+		},
+	},
+}
+
+func routeOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().First()
+	}
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(1)
+	}
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	var orderSide string
+	if c.IsSet("side") {
+		orderSide = c.String("side")
+	} else {
+		orderSide = c.Args().Get(2)
+	}
+	if orderSide == "" {
+		return errors.New("order side must be set")
+	}
+
+	var amount float64
+	if c.IsSet("amount") {
+		amount = c.Float64("amount")
+	} else if c.Args().Get(3) != "" {
+		var err error
+		amount, err = strconv.ParseFloat(c.Args().Get(3), 64)
+		if err != nil {
+			return err
+		}
+	}
+	if amount == 0 {
+		return errors.New("amount must be set")
+	}
+
+	var maxSlippage float64
+	if c.IsSet("max_slippage") {
+		maxSlippage = c.Float64("max_slippage")
+	} else if c.Args().Get(4) != "" {
+		var err error
+		maxSlippage, err = strconv.ParseFloat(c.Args().Get(4), 64)
+		if err != nil {
+			return err
+		}
+	}
+	if maxSlippage == 0 {
+		return errors.New("max slippage must be set")
+	}
+
+	var exchanges []string
+	for _, e := range strings.Split(c.String("exchanges"), ",") {
+		if e = strings.TrimSpace(e); e != "" {
+			exchanges = append(exchanges, e)
+		}
+	}
+
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.RouteOrder(c.Context, &gctrpc.RouteOrderRequest{
+		Exchanges: exchanges,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		},
+		AssetType:     assetType,
+		Side:          orderSide,
+		Amount:        amount,
+		MaxSlippage:   maxSlippage,
+		ClientOrderId: c.String("client_id"),
+		Execute:       c.Bool("execute"),
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
 var simulateOrderCommand = &cli.Command{
 	Name:      "simulateorder",
 	Usage:     "simulate order simulates an exchange order",
@@ -1969,6 +2311,29 @@ var cancelAllOrdersCommand = &cli.Command{
 	},
 }
 
+var killSwitchCommand = &cli.Command{
+	Name:      "killswitch",
+	Usage:     "manages the order manager risk kill switch, while engaged all new orders are rejected",
+	ArgsUsage: "<command>",
+	Subcommands: []*cli.Command{
+		{
+			Name:   "status",
+			Usage:  "returns whether the kill switch is engaged",
+			Action: getKillSwitch,
+		},
+		{
+			Name:   "engage",
+			Usage:  "engages the kill switch, rejecting all new orders",
+			Action: setKillSwitch,
+		},
+		{
+			Name:   "release",
+			Usage:  "releases the kill switch, allowing new orders",
+			Action: setKillSwitch,
+		},
+	},
+}
+
 var modifyOrderCommand = &cli.Command{
 	Name:      "modifyorder",
 	Usage:     "modify price and/or amount of a previously submitted order",
@@ -2028,6 +2393,42 @@ func cancelAllOrders(c *cli.Context) error {
 	return nil
 }
 
+func getKillSwitch(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetKillSwitch(c.Context, &gctrpc.GetKillSwitchRequest{})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func setKillSwitch(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.SetKillSwitch(c.Context, &gctrpc.SetKillSwitchRequest{
+		Engaged: c.Command.Name == "engage",
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
 func modifyOrder(c *cli.Context) error {
 	if c.NArg() == 0 && c.NumFlags() == 0 {
 		return cli.ShowSubcommandHelp(c)
@@ -2184,11 +2585,77 @@ var addEventCommand = &cli.Command{
 		},
 		&cli.StringFlag{
 			Name:  "action",
-			Usage: "the action for the event to perform upon trigger",
+			Usage: "the action for the event to perform upon trigger. supported: SMS,<action>, CONSOLE_PRINT, ACTION_TEST, SUBMIT_ORDER, CANCEL_ORDER",
+		},
+		&cli.Float64Flag{
+			Name:  "value",
+			Usage: "the value to trigger SPREAD (percentage), RSI (0 to 100) and IMBALANCE (-1 to 1) items",
+		},
+		&cli.StringFlag{
+			Name:  "secondary_exchange",
+			Usage: "the exchange to compare against for the SPREAD item",
+		},
+		&cli.Int64Flag{
+			Name:  "interval",
+			Usage: "the candle interval for the RSI item. " + klineMessage,
+		},
+		&cli.Int64Flag{
+			Name:  "period",
+			Usage: "the RSI period, defaults to 14",
+		},
+		&cli.StringFlag{
+			Name:  "conditions",
+			Usage: `additional conditions as a JSON array e.g. [{"exchange":"binance","item":"PRICE","condition":">","price":1337,"pair":"btc-usdt","asset":"spot"}]`,
+		},
+		&cli.StringFlag{
+			Name:  "logic",
+			Usage: "how conditions are combined, AND or OR",
+			Value: "AND",
+		},
+		&cli.Int64Flag{
+			Name:  "cooldown",
+			Usage: "the number of seconds before an executed event re-arms. 0 only executes the event once",
+		},
+		&cli.StringFlag{
+			Name:  "order_side",
+			Usage: "the order side for the SUBMIT_ORDER action",
+		},
+		&cli.StringFlag{
+			Name:  "order_type",
+			Usage: "the order type for the SUBMIT_ORDER action",
+		},
+		&cli.Float64Flag{
+			Name:  "order_amount",
+			Usage: "the order amount for the SUBMIT_ORDER action",
+		},
+		&cli.Float64Flag{
+			Name:  "order_price",
+			Usage: "the order price for the SUBMIT_ORDER action",
+		},
+		&cli.StringFlag{
+			Name:  "order_id",
+			Usage: "the order id for the CANCEL_ORDER action",
 		},
 	},
 }
 
+// eventCondition is used to unmarshal additional event conditions
+type eventCondition struct {
+	Exchange          string  `json:"exchange"`
+	Item              string  `json:"item"`
+	Condition         string  `json:"condition"`
+	Price             float64 `json:"price"`
+	CheckBids         bool    `json:"check_bids"`
+	CheckAsks         bool    `json:"check_asks"`
+	OrderbookAmount   float64 `json:"orderbook_amount"`
+	Value             float64 `json:"value"`
+	SecondaryExchange string  `json:"secondary_exchange"`
+	Interval          int64   `json:"interval"`
+	Period            int64   `json:"period"`
+	Pair              string  `json:"pair"`
+	Asset             string  `json:"asset"`
+}
+
 func addEvent(c *cli.Context) error {
 	if c.NArg() == 0 && c.NumFlags() == 0 {
 		return cli.ShowSubcommandHelp(c)
@@ -2269,6 +2736,90 @@ func addEvent(c *cli.Context) error {
 		return err
 	}
 
+	rpcPair := &gctrpc.CurrencyPair{
+		Delimiter: p.Delimiter,
+		Base:      p.Base.String(),
+		Quote:     p.Quote.String(),
+	}
+	req := &gctrpc.AddEventRequest{
+		Exchange: exchangeName,
+		Item:     item,
+		ConditionParams: &gctrpc.ConditionParams{
+			Condition:         condition,
+			Price:             price,
+			CheckBids:         checkBids,
+			CheckAsks:         checkAsks,
+			OrderbookAmount:   orderbookAmount,
+			Value:             c.Float64("value"),
+			SecondaryExchange: c.String("secondary_exchange"),
+			Interval:          int64(time.Duration(c.Int64("interval")) * time.Second),
+			Period:            c.Int64("period"),
+		},
+		Pair:      rpcPair,
+		AssetType: assetType,
+		Action:    action,
+		Logic:     c.String("logic"),
+		Cooldown:  int64(time.Duration(c.Int64("cooldown")) * time.Second),
+	}
+
+	if c.IsSet("conditions") {
+		var conditions []eventCondition
+		err = json.Unmarshal([]byte(c.String("conditions")), &conditions)
+		if err != nil {
+			return fmt.Errorf("unable to parse conditions: %w", err)
+		}
+		req.Conditions = make([]*gctrpc.EventCondition, len(conditions))
+		for i := range conditions {
+			var cp currency.Pair
+			cp, err = currency.NewPairDelimiter(conditions[i].Pair, pairDelimiter)
+			if err != nil {
+				return err
+			}
+			req.Conditions[i] = &gctrpc.EventCondition{
+				Exchange: conditions[i].Exchange,
+				Item:     conditions[i].Item,
+				ConditionParams: &gctrpc.ConditionParams{
+					Condition:         conditions[i].Condition,
+					Price:             conditions[i].Price,
+					CheckBids:         conditions[i].CheckBids,
+					CheckAsks:         conditions[i].CheckAsks,
+					OrderbookAmount:   conditions[i].OrderbookAmount,
+					Value:             conditions[i].Value,
+					SecondaryExchange: conditions[i].SecondaryExchange,
+					Interval:          int64(time.Duration(conditions[i].Interval) * time.Second),
+					Period:            conditions[i].Period,
+				},
+				Pair: &gctrpc.CurrencyPair{
+					Delimiter: cp.Delimiter,
+					Base:      cp.Base.String(),
+					Quote:     cp.Quote.String(),
+				},
+				AssetType: strings.ToLower(conditions[i].Asset),
+			}
+		}
+	}
+
+	switch strings.ToUpper(action) {
+	case "SUBMIT_ORDER":
+		req.SubmitOrder = &gctrpc.SubmitOrderRequest{
+			Exchange:  exchangeName,
+			Pair:      rpcPair,
+			Side:      c.String("order_side"),
+			OrderType: c.String("order_type"),
+			Amount:    c.Float64("order_amount"),
+			Price:     c.Float64("order_price"),
+			AssetType: assetType,
+		}
+	case "CANCEL_ORDER":
+		req.CancelOrder = &gctrpc.CancelOrderRequest{
+			Exchange:  exchangeName,
+			OrderId:   c.String("order_id"),
+			Pair:      rpcPair,
+			AssetType: assetType,
+			Side:      c.String("order_side"),
+		}
+	}
+
 	conn, cancel, err := setupClient(c)
 	if err != nil {
 		return err
@@ -2276,24 +2827,7 @@ func addEvent(c *cli.Context) error {
 	defer closeConn(conn, cancel)
 
 	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
-	result, err := client.AddEvent(c.Context, &gctrpc.AddEventRequest{
-		Exchange: exchangeName,
-		Item:     item,
-		ConditionParams: &gctrpc.ConditionParams{
-			Condition:       condition,
-			Price:           price,
-			CheckBids:       checkBids,
-			CheckAsks:       checkAsks,
-			OrderbookAmount: orderbookAmount,
-		},
-		Pair: &gctrpc.CurrencyPair{
-			Delimiter: p.Delimiter,
-			Base:      p.Base.String(),
-			Quote:     p.Quote.String(),
-		},
-		AssetType: assetType,
-		Action:    action,
-	})
+	result, err := client.AddEvent(c.Context, req)
 	if err != nil {
 		return err
 	}
@@ -2352,6 +2886,56 @@ func removeEvent(c *cli.Context) error {
 	return nil
 }
 
+var getEventExecutionsCommand = &cli.Command{
+	Name:      "geteventexecutions",
+	Usage:     "gets the stored execution history of an event",
+	ArgsUsage: "<event_id>",
+	Action:    getEventExecutions,
+	Flags: []cli.Flag{
+		&cli.Int64Flag{
+			Name:  "event_id",
+			Usage: "the event id to get the execution history for",
+		},
+	},
+}
+
+func getEventExecutions(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var eventID int64
+	if c.IsSet("event_id") {
+		eventID = c.Int64("event_id")
+	} else if c.Args().Get(0) != "" {
+		var err error
+		eventID, err = strconv.ParseInt(c.Args().Get(0), 10, 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	if eventID == 0 {
+		return errors.New("event id must be specified")
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetEventExecutions(c.Context,
+		&gctrpc.GetEventExecutionsRequest{Id: eventID})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
 var getCryptocurrencyDepositAddressesCommand = &cli.Command{
 	Name:      "getcryptocurrencydepositaddresses",
 	Usage:     "gets the cryptocurrency deposit addresses for an exchange",
@@ -0,0 +1,186 @@
+package main
+
+import (
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var liveStrategyIDFlag = &cli.StringFlag{
+	Name:     "id",
+	Usage:    "the id of the live strategy",
+	Required: true,
+}
+
+var liveStrategyCommand = &cli.Command{
+	Name:      "livestrategy",
+	Usage:     "runs backtester strategies against live market data in the strategy manager",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:   "start",
+			Usage:  "starts a strategy on an exchange's pair",
+			Action: startLiveStrategy,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     "strategy",
+					Usage:    "the name of the backtester strategy, eg 'rsi'",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     "exchange",
+					Usage:    "the exchange to trade on",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     "pair",
+					Usage:    "the currency pair to trade",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  "asset",
+					Usage: "the asset type of the pair",
+					Value: "spot",
+				},
+				&cli.Int64Flag{
+					Name:  "interval",
+					Usage: "the candle interval in seconds",
+					Value: 60,
+				},
+				&cli.Float64Flag{
+					Name:     "orderamount",
+					Usage:    "the base amount traded when the strategy does not size its own orders",
+					Required: true,
+				},
+				&cli.Int64Flag{
+					Name:  "warmupcandles",
+					Usage: "the number of historic candles retrieved before the first live candle closes",
+				},
+				&cli.StringFlag{
+					Name:  "customsettings",
+					Usage: "the strategy's custom settings as JSON, eg '{\"rsi-period\":14}'",
+				},
+				&cli.BoolFlag{
+					Name:  "paper",
+					Usage: "prevents trading unless the exchange is paper trading",
+				},
+			},
+		},
+		{
+			Name:   "stop",
+			Usage:  "stops a running strategy, its orders are left in place",
+			Action: stopLiveStrategy,
+			Flags:  []cli.Flag{liveStrategyIDFlag},
+		},
+		{
+			Name:   "list",
+			Usage:  "lists every strategy started along with its PNL",
+			Action: getLiveStrategies,
+		},
+		{
+			Name:   "logs",
+			Usage:  "returns the most recent log entries of a strategy",
+			Action: getLiveStrategyLogs,
+			Flags: []cli.Flag{
+				liveStrategyIDFlag,
+				&cli.Int64Flag{
+					Name:  "limit",
+					Usage: "the maximum number of entries to return, zero returns all",
+				},
+			},
+		},
+	},
+}
+
+func startLiveStrategy(c *cli.Context) error {
+	pair, err := currency.NewPairFromString(c.String("pair"))
+	if err != nil {
+		return err
+	}
+	a := strings.ToLower(c.String("asset"))
+	if !validAsset(a) {
+		return errInvalidAsset
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.StartLiveStrategy(c.Context, &gctrpc.StartLiveStrategyRequest{
+		Strategy: c.String("strategy"),
+		Exchange: c.String("exchange"),
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: pair.Delimiter,
+			Base:      pair.Base.String(),
+			Quote:     pair.Quote.String(),
+		},
+		AssetType:      a,
+		Interval:       c.Int64("interval") * int64(time.Second),
+		CustomSettings: c.String("customsettings"),
+		OrderAmount:    c.Float64("orderamount"),
+		WarmupCandles:  c.Int64("warmupcandles"),
+		Paper:          c.Bool("paper"),
+	})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func stopLiveStrategy(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.StopLiveStrategy(c.Context, &gctrpc.StopLiveStrategyRequest{Id: c.String("id")})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func getLiveStrategies(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetLiveStrategies(c.Context, &gctrpc.GetLiveStrategiesRequest{})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func getLiveStrategyLogs(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetLiveStrategyLogs(c.Context, &gctrpc.GetLiveStrategyLogsRequest{
+		Id:    c.String("id"),
+		Limit: c.Int64("limit"),
+	})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
@@ -177,6 +177,7 @@ func main() {
 		getConfigCommand,
 		getPortfolioCommand,
 		getPortfolioSummaryCommand,
+		getPortfolioPNLCommand,
 		addPortfolioAddressCommand,
 		removePortfolioAddressCommand,
 		getForexProvidersCommand,
@@ -185,14 +186,18 @@ func main() {
 		getManagedOrdersCommand,
 		getOrderCommand,
 		submitOrderCommand,
+		submitSyntheticOrderCommand,
+		routeOrderCommand,
 		simulateOrderCommand,
 		cancelOrderCommand,
 		cancelBatchOrdersCommand,
 		cancelAllOrdersCommand,
+		killSwitchCommand,
 		modifyOrderCommand,
 		getEventsCommand,
 		addEventCommand,
 		removeEventCommand,
+		getEventExecutionsCommand,
 		getCryptocurrencyDepositAddressesCommand,
 		getCryptocurrencyDepositAddressCommand,
 		getAvailableTransferChainsCommand,
@@ -218,6 +223,9 @@ func main() {
 		technicalAnalysisCommand,
 		getMarginRatesHistoryCommand,
 		orderbookCommand,
+		tcaCommand,
+		arbitrageCommand,
+		liveStrategyCommand,
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
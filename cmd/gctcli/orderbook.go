@@ -50,6 +50,8 @@ var orderbookCommand = &cli.Command{
 		getOrderbooksCommand,
 		getOrderbookStreamCommand,
 		getExchangeOrderbookStreamCommand,
+		getConsolidatedOrderbookCommand,
+		getConsolidatedOrderbookStreamCommand,
 		whaleBombCommand,
 	},
 }
@@ -754,6 +756,171 @@ func getExchangeOrderbookStream(c *cli.Context) error {
 	}
 }
 
+var consolidatedOrderbookFlags = []cli.Flag{
+	&cli.StringFlag{
+		Name:  "pair",
+		Usage: "the currency pair to get the consolidated orderbook for",
+	},
+	&cli.StringFlag{
+		Name:  "asset",
+		Usage: "the asset type of the currency pair",
+	},
+	&cli.StringFlag{
+		Name:  "exchanges",
+		Usage: "optional - comma separated exchanges to merge, all loaded exchanges are merged when empty",
+	},
+	&cli.BoolFlag{
+		Name:  "convertquotes",
+		Usage: "optional - merges orderbooks quoted in other currencies by converting their prices with forex rates",
+	},
+	&cli.Int64Flag{
+		Name:  "depth",
+		Usage: "optional - limit the levels returned for each side",
+	},
+}
+
+var getConsolidatedOrderbookCommand = &cli.Command{
+	Name:      "getconsolidatedorderbook",
+	Usage:     "gets the orderbook of a currency pair merged across exchanges",
+	ArgsUsage: "<pair> <asset>",
+	Action:    getConsolidatedOrderbook,
+	Flags:     consolidatedOrderbookFlags,
+}
+
+var getConsolidatedOrderbookStreamCommand = &cli.Command{
+	Name:      "getconsolidatedorderbookstream",
+	Usage:     "streams the orderbook of a currency pair merged across exchanges",
+	ArgsUsage: "<pair> <asset>",
+	Action:    getConsolidatedOrderbookStream,
+	Flags:     consolidatedOrderbookFlags,
+}
+
+func consolidatedOrderbookRequest(c *cli.Context) (*gctrpc.GetConsolidatedOrderbookRequest, error) {
+	var pair string
+	if c.IsSet("pair") {
+		pair = c.String("pair")
+	} else {
+		pair = c.Args().First()
+	}
+	if !validPair(pair) {
+		return nil, errInvalidPair
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(1)
+	}
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return nil, errInvalidAsset
+	}
+
+	p, err := currency.NewPairDelimiter(pair, pairDelimiter)
+	if err != nil {
+		return nil, err
+	}
+
+	var exchanges []string
+	if c.IsSet("exchanges") {
+		exchanges = strings.Split(c.String("exchanges"), ",")
+	}
+
+	return &gctrpc.GetConsolidatedOrderbookRequest{
+		Exchanges: exchanges,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		},
+		AssetType:     assetType,
+		ConvertQuotes: c.Bool("convertquotes"),
+		Depth:         c.Int64("depth"),
+	}, nil
+}
+
+func getConsolidatedOrderbook(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	req, err := consolidatedOrderbookRequest(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetConsolidatedOrderbook(c.Context, req)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getConsolidatedOrderbookStream(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	req, err := consolidatedOrderbookRequest(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetConsolidatedOrderbookStream(c.Context, req)
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+
+		err = clearScreen()
+		if err != nil {
+			return err
+		}
+
+		if resp.Error != "" {
+			fmt.Printf("%s\n", resp.Error)
+			continue
+		}
+
+		fmt.Printf("Consolidated orderbook stream for %s %s - Last updated %v\n\n",
+			resp.Pair, resp.AssetType, time.UnixMicro(resp.LastUpdated).Format(common.SimpleTimeFormatWithTimezone))
+		fmt.Println("\t\tBids\t\t\t\t\t\tAsks")
+		fmt.Println()
+		for i := 0; i < len(resp.Bids) || i < len(resp.Asks); i++ {
+			var bid, ask string
+			if i < len(resp.Bids) {
+				bid = fmt.Sprintf("%.8f @ %.8f %s", resp.Bids[i].Amount, resp.Bids[i].Price, resp.Bids[i].Exchange)
+			}
+			if i < len(resp.Asks) {
+				ask = fmt.Sprintf("%.8f @ %.8f %s", resp.Asks[i].Amount, resp.Asks[i].Price, resp.Asks[i].Exchange)
+			}
+			fmt.Printf("%-48s%s\n", bid, ask)
+		}
+	}
+}
+
 var whaleBombCommand = &cli.Command{
 	Name:      "whalebomb",
 	Usage:     "whale bomb finds the amount required to reach a price target",
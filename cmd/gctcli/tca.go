@@ -0,0 +1,290 @@
+package main
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var errInvalidOutputFormat = errors.New("invalid output format, must be 'json' or 'csv'")
+
+var (
+	tcaStartTime   string
+	tcaEndTime     string
+	tcaGranularity int64
+)
+
+var tcaCommand = &cli.Command{
+	Name:      "tca",
+	Usage:     "returns a trade cost analysis report of completed orders",
+	ArgsUsage: "<exchange> <pair> <asset> <start> <end>",
+	Action:    getTCAReport,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to analyse, leave empty for all exchanges",
+		},
+		&cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair to analyse, leave empty for all pairs",
+		},
+		&cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type to analyse, leave empty for all asset types",
+		},
+		&cli.StringFlag{
+			Name:  "strategy",
+			Usage: "only analyse orders whose client order ID is prefixed with '<strategy>:'",
+		},
+		&cli.StringFlag{
+			Name:        "start",
+			Usage:       "the start date of orders to analyse",
+			Value:       time.Now().AddDate(0, 0, -7).Format(time.DateTime),
+			Destination: &tcaStartTime,
+		},
+		&cli.StringFlag{
+			Name:        "end",
+			Usage:       "the end date of orders to analyse",
+			Value:       time.Now().Format(time.DateTime),
+			Destination: &tcaEndTime,
+		},
+		&cli.Int64Flag{
+			Name:        "granularity",
+			Aliases:     []string{"g"},
+			Usage:       "the interval of stored candles used for market prices, " + klineMessage,
+			Value:       60,
+			Destination: &tcaGranularity,
+		},
+		&cli.StringFlag{
+			Name:  "groupby",
+			Usage: "comma separated list of 'exchange', 'pair' and 'strategy' to aggregate by",
+			Value: "exchange,pair,strategy",
+		},
+		&cli.DurationFlag{
+			Name:  "period",
+			Usage: "also aggregates by time period e.g. '24h', zero disables",
+		},
+		&cli.BoolFlag{
+			Name:  "orders",
+			Usage: "includes the analysis of each order, csv output lists orders instead of summaries",
+		},
+		&cli.StringFlag{
+			Name:  "output",
+			Usage: "the output format 'json' or 'csv'",
+			Value: "json",
+		},
+	},
+}
+
+func getTCAReport(c *cli.Context) error {
+	var exchange string
+	if c.IsSet("exchange") {
+		exchange = c.String("exchange")
+	} else {
+		exchange = c.Args().First()
+	}
+
+	var cpString string
+	if c.IsSet("pair") {
+		cpString = c.String("pair")
+	} else {
+		cpString = c.Args().Get(1)
+	}
+	var pair *gctrpc.CurrencyPair
+	if cpString != "" {
+		p, err := currency.NewPairFromString(cpString)
+		if err != nil {
+			return err
+		}
+		pair = &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		}
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+	assetType = strings.ToLower(assetType)
+	if assetType != "" && !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	if !c.IsSet("start") && c.Args().Get(3) != "" {
+		tcaStartTime = c.Args().Get(3)
+	}
+	if !c.IsSet("end") && c.Args().Get(4) != "" {
+		tcaEndTime = c.Args().Get(4)
+	}
+	s, err := time.ParseInLocation(time.DateTime, tcaStartTime, time.Local)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+	e, err := time.ParseInLocation(time.DateTime, tcaEndTime, time.Local)
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+	err = common.StartEndTimeCheck(s, e)
+	if err != nil {
+		return err
+	}
+
+	output := strings.ToLower(c.String("output"))
+	if output != "json" && output != "csv" {
+		return errInvalidOutputFormat
+	}
+
+	var groupBy []string
+	for _, g := range strings.Split(c.String("groupby"), ",") {
+		if g = strings.TrimSpace(g); g != "" {
+			groupBy = append(groupBy, g)
+		}
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetTCAReport(c.Context, &gctrpc.GetTCAReportRequest{
+		Exchange:      exchange,
+		Pair:          pair,
+		AssetType:     assetType,
+		Strategy:      c.String("strategy"),
+		Start:         timestamppb.New(s),
+		End:           timestamppb.New(e),
+		Interval:      tcaGranularity * int64(time.Second),
+		GroupBy:       groupBy,
+		Period:        int64(c.Duration("period")),
+		IncludeOrders: c.Bool("orders"),
+	})
+	if err != nil {
+		return err
+	}
+
+	if output == "json" {
+		jsonOutput(result)
+		return nil
+	}
+	if c.Bool("orders") {
+		return writeTCAOrdersCSV(result.Orders)
+	}
+	return writeTCASummariesCSV(result.Summaries)
+}
+
+func writeTCASummariesCSV(summaries []*gctrpc.TCASummary) error {
+	w := csv.NewWriter(os.Stdout)
+	err := w.Write([]string{
+		"period_start", "exchange", "pair", "asset", "strategy", "quote", "orders",
+		"amount", "executed_amount", "notional", "fee", "fill_rate",
+		"arrival_slippage_bps", "implementation_shortfall", "implementation_shortfall_bps",
+		"vwap_deviation_bps", "fee_drag_bps",
+	})
+	if err != nil {
+		return err
+	}
+	for _, s := range summaries {
+		var periodStart string
+		if s.PeriodStart != nil {
+			periodStart = s.PeriodStart.AsTime().Format(time.RFC3339)
+		}
+		err = w.Write([]string{
+			periodStart,
+			s.Exchange,
+			tcaPairString(s.Pair),
+			s.AssetType,
+			s.Strategy,
+			s.Quote,
+			strconv.FormatInt(s.Orders, 10),
+			formatTCAFloat(s.Amount),
+			formatTCAFloat(s.ExecutedAmount),
+			formatTCAFloat(s.Notional),
+			formatTCAFloat(s.Fee),
+			formatTCAFloat(s.FillRate),
+			formatTCAFloat(s.ArrivalSlippageBps),
+			formatTCAFloat(s.ImplementationShortfall),
+			formatTCAFloat(s.ImplementationShortfallBps),
+			formatTCAFloat(s.VwapDeviationBps),
+			formatTCAFloat(s.FeeDragBps),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+func writeTCAOrdersCSV(orders []*gctrpc.TCAOrder) error {
+	w := csv.NewWriter(os.Stdout)
+	err := w.Write([]string{
+		"date", "exchange", "pair", "asset", "strategy", "order_id", "side", "type", "status",
+		"amount", "executed_amount", "average_executed_price", "notional", "fee", "fill_rate",
+		"has_market_data", "arrival_price", "market_vwap", "closing_price",
+		"arrival_slippage_bps", "implementation_shortfall", "implementation_shortfall_bps",
+		"vwap_deviation_bps", "fee_drag_bps",
+	})
+	if err != nil {
+		return err
+	}
+	for _, o := range orders {
+		err = w.Write([]string{
+			o.Date.AsTime().Format(time.RFC3339),
+			o.Exchange,
+			tcaPairString(o.Pair),
+			o.AssetType,
+			o.Strategy,
+			o.OrderId,
+			o.Side,
+			o.Type,
+			o.Status,
+			formatTCAFloat(o.Amount),
+			formatTCAFloat(o.ExecutedAmount),
+			formatTCAFloat(o.AverageExecutedPrice),
+			formatTCAFloat(o.Notional),
+			formatTCAFloat(o.Fee),
+			formatTCAFloat(o.FillRate),
+			strconv.FormatBool(o.HasMarketData),
+			formatTCAFloat(o.ArrivalPrice),
+			formatTCAFloat(o.MarketVwap),
+			formatTCAFloat(o.ClosingPrice),
+			formatTCAFloat(o.ArrivalSlippageBps),
+			formatTCAFloat(o.ImplementationShortfall),
+			formatTCAFloat(o.ImplementationShortfallBps),
+			formatTCAFloat(o.VwapDeviationBps),
+			formatTCAFloat(o.FeeDragBps),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+func tcaPairString(p *gctrpc.CurrencyPair) string {
+	if p == nil {
+		return ""
+	}
+	return p.Base + p.Delimiter + p.Quote
+}
+
+func formatTCAFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
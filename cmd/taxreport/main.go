@@ -0,0 +1,200 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/core"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	dbPSQL "github.com/thrasher-corp/gocryptotrader/database/drivers/postgres"
+	dbsqlite3 "github.com/thrasher-corp/gocryptotrader/database/drivers/sqlite3"
+	dbfill "github.com/thrasher-corp/gocryptotrader/database/repository/fill"
+	dborder "github.com/thrasher-corp/gocryptotrader/database/repository/order"
+	dbwithdraw "github.com/thrasher-corp/gocryptotrader/database/repository/withdraw"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/costbasis"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+var (
+	configFile   string
+	startTime    string
+	endTime      string
+	fromTime     string
+	method       string
+	fiatCurrency string
+	exchangeList string
+	interval     time.Duration
+	outputFile   string
+)
+
+func openDBConnection(cfg *database.Config) (err error) {
+	if cfg.Driver == database.DBPostgreSQL {
+		_, err = dbPSQL.Connect(cfg)
+		if err != nil {
+			return fmt.Errorf("database failed to connect: %v", err)
+		}
+		return nil
+	} else if cfg.Driver == database.DBSQLite || cfg.Driver == database.DBSQLite3 {
+		_, err = dbsqlite3.Connect(cfg.Database)
+		if err != nil {
+			return fmt.Errorf("database failed to connect: %v", err)
+		}
+		return nil
+	}
+	return errors.New("no connection established")
+}
+
+func main() {
+	fmt.Fprintln(os.Stderr, "GoCryptoTrader tax lot report tool")
+	fmt.Fprintln(os.Stderr, core.Copyright)
+	fmt.Fprintln(os.Stderr)
+
+	year := time.Now().Year() - 1
+	flag.StringVar(&configFile, "config", config.DefaultFilePath(), "config file to load")
+	flag.StringVar(&startTime, "start", time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC).Format(time.DateOnly), "the start date of disposals to report")
+	flag.StringVar(&endTime, "end", time.Date(year+1, 1, 1, 0, 0, 0, 0, time.UTC).Format(time.DateOnly), "the end date of disposals to report")
+	flag.StringVar(&fromTime, "from", "", "the start date of order history used to match lots, defaults to all history")
+	flag.StringVar(&method, "method", "fifo", "the lot matching method fifo, lifo or average")
+	flag.StringVar(&fiatCurrency, "currency", "", "the reporting currency, defaults to the config's fiat display currency")
+	flag.StringVar(&exchangeList, "exchanges", "", "comma separated list of exchanges to report, defaults to all exchanges in the config")
+	flag.DurationVar(&interval, "interval", time.Hour, "the interval of stored candles used for historical prices")
+	flag.StringVar(&outputFile, "output", "", "the CSV file to write, defaults to stdout")
+	flag.Parse()
+
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	start, err := parseDate(startTime)
+	if err != nil {
+		return fmt.Errorf("invalid start date: %w", err)
+	}
+	end, err := parseDate(endTime)
+	if err != nil {
+		return fmt.Errorf("invalid end date: %w", err)
+	}
+	if err = common.StartEndTimeCheck(start, end); err != nil {
+		return err
+	}
+	var from time.Time
+	if fromTime != "" {
+		if from, err = parseDate(fromTime); err != nil {
+			return fmt.Errorf("invalid from date: %w", err)
+		}
+	}
+	m, err := costbasis.MethodFromString(method)
+	if err != nil {
+		return err
+	}
+
+	var conf config.Config
+	if err = conf.LoadConfig(configFile, true); err != nil {
+		return err
+	}
+	if !conf.Database.Enabled {
+		return database.ErrDatabaseSupportDisabled
+	}
+	if err = openDBConnection(&conf.Database); err != nil {
+		return err
+	}
+	defer func() {
+		if err := database.DB.CloseConnection(); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+	}()
+
+	reporting := conf.Currency.FiatDisplayCurrency
+	if fiatCurrency != "" {
+		reporting = currency.NewCode(fiatCurrency)
+	}
+	tracker, err := costbasis.NewTracker(m, reporting)
+	if err != nil {
+		return err
+	}
+	orders, err := dborder.Setup(database.DB)
+	if err != nil {
+		return err
+	}
+	fills, err := dbfill.Setup(database.DB)
+	if err != nil {
+		return err
+	}
+
+	var exchanges []string
+	if exchangeList != "" {
+		for _, e := range strings.Split(exchangeList, ",") {
+			if e = strings.TrimSpace(e); e != "" {
+				exchanges = append(exchanges, e)
+			}
+		}
+	} else {
+		for i := range conf.Exchanges {
+			exchanges = append(exchanges, conf.Exchanges[i].Name)
+		}
+	}
+
+	r := &reporter{
+		orders:      orders,
+		fills:       fills,
+		withdrawals: storedWithdrawals,
+		candles:     kline.LoadFromDatabase,
+		tracker:     tracker,
+		exchanges:   exchanges,
+		interval:    kline.Interval(interval),
+		from:        from,
+		start:       start,
+		end:         end,
+	}
+	disposals, err := r.run()
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	if outputFile != "" {
+		f, err := os.Create(outputFile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	if err = r.writeCSV(w, disposals); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Wrote %d %s disposal(s) in %s between %s and %s\n", len(disposals), m, reporting, start.Format(time.DateOnly), end.Format(time.DateOnly))
+	return nil
+}
+
+// storedWithdrawals returns withdrawals recorded by the withdraw manager.
+// Exchanges without stored withdrawals return no results
+func storedWithdrawals(exchange string, start, end time.Time) ([]*withdraw.Response, error) {
+	w, err := dbwithdraw.GetEventsByDate(exchange, start, end, math.MaxInt32)
+	if err != nil {
+		if !errors.Is(err, dbwithdraw.ErrNoResults) {
+			fmt.Fprintf(os.Stderr, "%s withdrawals could not be loaded: %v\n", exchange, err)
+		}
+		return nil, nil
+	}
+	return w, nil
+}
+
+func parseDate(s string) (time.Time, error) {
+	if t, err := time.ParseInLocation(time.DateTime, s, time.UTC); err == nil {
+		return t, nil
+	}
+	return time.ParseInLocation(time.DateOnly, s, time.UTC)
+}
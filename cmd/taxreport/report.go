@@ -0,0 +1,389 @@
+package main
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	dbfill "github.com/thrasher-corp/gocryptotrader/database/repository/fill"
+	dborder "github.com/thrasher-corp/gocryptotrader/database/repository/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/costbasis"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+// maxCandleAge is the oldest a candle can be relative to an event to price it
+const maxCandleAge = 24 * time.Hour
+
+const (
+	disposalTypeSpot          = "spot"
+	disposalTypeFutures       = "futures"
+	disposalTypeWithdrawalFee = "withdrawal fee"
+)
+
+var (
+	errNoHistoricalPrice = errors.New("no historical price available, store candles for a pair of the currency and the reporting currency")
+	errNoExecutionPrice  = errors.New("order has no execution price")
+)
+
+// withdrawalFunc returns the withdrawals of an exchange between the start and
+// end times
+type withdrawalFunc func(exchange string, start, end time.Time) ([]*withdraw.Response, error)
+
+// candleFunc returns stored candles between the start and end times
+type candleFunc func(exchange string, pair currency.Pair, a asset.Item, interval kline.Interval, start, end time.Time) (*kline.Item, error)
+
+// reporter builds tax lot disposals from stored order, fill and withdrawal
+// history
+type reporter struct {
+	orders      dborder.IDBService
+	fills       dbfill.IDBService
+	withdrawals withdrawalFunc
+	candles     candleFunc
+	tracker     *costbasis.Tracker
+	exchanges   []string
+	interval    kline.Interval
+	// from is the start of the history used to match lots, start and end
+	// bound the disposals which are reported
+	from, start, end time.Time
+
+	priceStart  time.Time
+	prices      map[priceKey][]kline.Candle
+	contracts   map[*currency.Item]bool
+	withdrawIDs map[string]bool
+}
+
+// event is a trade or fee in the order it occurred
+type event struct {
+	time     time.Time
+	exchange string
+	asset    asset.Item
+	trade    *costbasis.Trade
+	// feeAsset is the currency the trade fee was charged in, the quote
+	// currency is assumed when unset
+	feeAsset currency.Code
+	fee      *costbasis.Fee
+}
+
+// fillKey identifies the fills of an order on an asset
+type fillKey struct {
+	orderID string
+	asset   string
+}
+
+type priceKey struct {
+	exchange string
+	base     *currency.Item
+	quote    *currency.Item
+}
+
+// run matches all trades and withdrawal fees against acquired lots and returns
+// the disposals realised between the start and end times
+func (r *reporter) run() ([]costbasis.Disposal, error) {
+	r.prices = make(map[priceKey][]kline.Candle)
+	r.contracts = make(map[*currency.Item]bool)
+	r.withdrawIDs = make(map[string]bool)
+	var events []event
+	for i := range r.exchanges {
+		e, err := r.exchangeEvents(r.exchanges[i])
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, e...)
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].time.Before(events[j].time)
+	})
+	if len(events) > 0 {
+		r.priceStart = events[0].time.Add(-maxCandleAge)
+	}
+
+	for i := range events {
+		if events[i].fee != nil {
+			if err := r.tracker.AddFee(events[i].fee); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		t := events[i].trade
+		rate, err := r.price(events[i].exchange, t.Pair.Quote, t.Time)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s trade %s: %w", events[i].exchange, t.Pair, t.ID, err)
+		}
+		t.Value = t.Amount * t.Price * rate
+		if t.Fee > 0 {
+			feeRate, err := r.feeRate(&events[i], rate)
+			if err != nil {
+				return nil, fmt.Errorf("%s %s trade %s fee: %w", events[i].exchange, t.Pair, t.ID, err)
+			}
+			t.Fee *= feeRate
+		}
+		if events[i].asset.IsFutures() {
+			contract := currency.NewCode(events[i].exchange + ":" + events[i].asset.String() + ":" + t.Pair.String())
+			r.contracts[contract.Item] = true
+			err = r.tracker.AddContractTrade(contract, t)
+		} else {
+			err = r.tracker.AddTrade(t)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s %s trade %s: %w", events[i].exchange, t.Pair, t.ID, err)
+		}
+	}
+
+	all := r.tracker.Disposals()
+	disposals := make([]costbasis.Disposal, 0, len(all))
+	for i := range all {
+		if all[i].Disposed.Before(r.start) || all[i].Disposed.After(r.end) {
+			continue
+		}
+		disposals = append(disposals, all[i])
+	}
+	return disposals, nil
+}
+
+// feeRate returns the price of a trade's fee currency in the reporting
+// currency, given the price of the trade's quote currency
+func (r *reporter) feeRate(e *event, quoteRate float64) (float64, error) {
+	t := e.trade
+	switch {
+	case e.feeAsset.IsEmpty(), e.feeAsset.Equal(t.Pair.Quote):
+		return quoteRate, nil
+	case e.feeAsset.Equal(t.Pair.Base):
+		return t.Price * quoteRate, nil
+	default:
+		return r.price(e.exchange, e.feeAsset, t.Time)
+	}
+}
+
+// exchangeEvents returns the executed spot and futures trades and withdrawal
+// fees of an exchange. Orders are split into their fills where fills were
+// stored. Each fill keeps its stored fee, unless no fill of the order has a
+// fee stored in which case the order's fee is apportioned by amount
+func (r *reporter) exchangeEvents(exch string) ([]event, error) {
+	orders, err := r.orders.GetInRange(exch, r.from, r.end)
+	if err != nil {
+		return nil, fmt.Errorf("%s orders: %w", exch, err)
+	}
+	fills, err := r.fills.GetInRange(exch, r.from, r.end)
+	if err != nil {
+		return nil, fmt.Errorf("%s fills: %w", exch, err)
+	}
+	fillsByOrder := make(map[fillKey][]dbfill.Fill)
+	for i := range fills {
+		k := fillKey{orderID: fills[i].OrderID, asset: fills[i].AssetType}
+		fillsByOrder[k] = append(fillsByOrder[k], fills[i])
+	}
+
+	var events []event
+	for i := range orders {
+		if orders[i].ExecutedAmount <= 0 {
+			continue
+		}
+		a, err := asset.New(orders[i].AssetType)
+		if err != nil {
+			return nil, fmt.Errorf("%s order %s: %w", exch, orders[i].OrderID, err)
+		}
+		if a != asset.Spot && !a.IsFutures() {
+			continue
+		}
+		side, err := order.StringToOrderSide(orders[i].Side)
+		if err != nil {
+			return nil, fmt.Errorf("%s order %s: %w", exch, orders[i].OrderID, err)
+		}
+		pair := currency.NewPair(currency.NewCode(orders[i].Base), currency.NewCode(orders[i].Quote))
+		orderFills := fillsByOrder[fillKey{orderID: orders[i].OrderID, asset: orders[i].AssetType}]
+		var filled, fillFees float64
+		for j := range orderFills {
+			filled += orderFills[j].Amount
+			fillFees += orderFills[j].Fee
+		}
+		if filled <= 0 {
+			price := orders[i].AverageExecutedPrice
+			if price <= 0 {
+				price = orders[i].Cost / orders[i].ExecutedAmount
+			}
+			if price <= 0 {
+				return nil, fmt.Errorf("%s order %s: %w", exch, orders[i].OrderID, errNoExecutionPrice)
+			}
+			executed := orders[i].LastUpdated
+			if executed.IsZero() {
+				executed = orders[i].Date
+			}
+			events = append(events, event{
+				time:     executed,
+				exchange: exch,
+				asset:    a,
+				trade: &costbasis.Trade{
+					Exchange: exch,
+					ID:       orders[i].OrderID,
+					Pair:     pair,
+					Side:     side,
+					Amount:   orders[i].ExecutedAmount,
+					Price:    price,
+					Fee:      orders[i].Fee,
+					Time:     executed,
+				},
+				feeAsset: currency.NewCode(orders[i].FeeAsset),
+			})
+			continue
+		}
+		for j := range orderFills {
+			id := orderFills[j].TradeID
+			if id == "" {
+				id = orders[i].OrderID
+			}
+			fee, feeAsset := orderFills[j].Fee, orderFills[j].FeeAsset
+			if fillFees == 0 {
+				fee, feeAsset = orders[i].Fee*orderFills[j].Amount/filled, orders[i].FeeAsset
+			}
+			if feeAsset == "" {
+				feeAsset = orders[i].FeeAsset
+			}
+			events = append(events, event{
+				time:     orderFills[j].Timestamp,
+				exchange: exch,
+				asset:    a,
+				trade: &costbasis.Trade{
+					Exchange: exch,
+					ID:       id,
+					Pair:     pair,
+					Side:     side,
+					Amount:   orderFills[j].Amount,
+					Price:    orderFills[j].Price,
+					Fee:      fee,
+					Time:     orderFills[j].Timestamp,
+				},
+				feeAsset: currency.NewCode(feeAsset),
+			})
+		}
+	}
+
+	withdrawals, err := r.withdrawals(exch, r.from, r.end)
+	if err != nil {
+		return nil, fmt.Errorf("%s withdrawals: %w", exch, err)
+	}
+	for i := range withdrawals {
+		req := &withdrawals[i].RequestDetails
+		if req.Type != withdraw.Crypto || req.Crypto.FeeAmount <= 0 {
+			continue
+		}
+		id := withdrawals[i].ID.String()
+		r.withdrawIDs[id] = true
+		events = append(events, event{
+			time:     withdrawals[i].CreatedAt,
+			exchange: exch,
+			fee: &costbasis.Fee{
+				Exchange: exch,
+				ID:       id,
+				Currency: req.Currency,
+				Amount:   req.Crypto.FeeAmount,
+				Time:     withdrawals[i].CreatedAt,
+			},
+		})
+	}
+	return events, nil
+}
+
+// price returns the close of the most recent stored candle of the currency in
+// the reporting currency at the time supplied. Candles from the event's
+// exchange are preferred, inverse pairs are used when no direct pair is stored
+func (r *reporter) price(exch string, c currency.Code, at time.Time) (float64, error) {
+	reporting := r.tracker.Currency()
+	if c.Equal(reporting) {
+		return 1, nil
+	}
+	exchanges := append([]string{exch}, r.exchanges...)
+	for i := range exchanges {
+		if p, ok := r.candleClose(exchanges[i], currency.NewPair(c, reporting), at); ok {
+			return p, nil
+		}
+		if p, ok := r.candleClose(exchanges[i], currency.NewPair(reporting, c), at); ok {
+			return 1 / p, nil
+		}
+	}
+	return 0, fmt.Errorf("%s in %s at %s: %w", c, reporting, at.UTC().Format(time.RFC3339), errNoHistoricalPrice)
+}
+
+func (r *reporter) candleClose(exch string, pair currency.Pair, at time.Time) (float64, bool) {
+	k := priceKey{exchange: strings.ToLower(exch), base: pair.Base.Item, quote: pair.Quote.Item}
+	candles, ok := r.prices[k]
+	if !ok {
+		item, err := r.candles(exch, pair, asset.Spot, r.interval, r.priceStart, r.end)
+		if err == nil {
+			candles = item.Candles
+		}
+		r.prices[k] = candles
+	}
+	i := sort.Search(len(candles), func(i int) bool {
+		return candles[i].Time.After(at)
+	}) - 1
+	if i < 0 || candles[i].Close <= 0 || at.Sub(candles[i].Time) > maxCandleAge {
+		return 0, false
+	}
+	return candles[i].Close, true
+}
+
+// disposalType returns whether a disposal is from a spot trade, a futures
+// trade or a withdrawal fee
+func (r *reporter) disposalType(d *costbasis.Disposal) string {
+	switch {
+	case r.withdrawIDs[d.TradeID]:
+		return disposalTypeWithdrawalFee
+	case r.contracts[d.Currency.Item]:
+		return disposalTypeFutures
+	default:
+		return disposalTypeSpot
+	}
+}
+
+// writeCSV writes one row per disposed lot. Monetary values are in the
+// reporting currency
+func (r *reporter) writeCSV(w io.Writer, disposals []costbasis.Disposal) error {
+	c := csv.NewWriter(w)
+	err := c.Write([]string{
+		"type", "currency", "exchange", "reference", "acquired", "disposed",
+		"amount", "proceeds", "cost_basis", "fees", "gain", "unmatched", "reporting_currency",
+	})
+	if err != nil {
+		return err
+	}
+	reporting := r.tracker.Currency().String()
+	for i := range disposals {
+		var acquired string
+		if !disposals[i].Acquired.IsZero() {
+			acquired = disposals[i].Acquired.UTC().Format(time.RFC3339)
+		}
+		err = c.Write([]string{
+			r.disposalType(&disposals[i]),
+			disposals[i].Currency.String(),
+			disposals[i].Exchange,
+			disposals[i].TradeID,
+			acquired,
+			disposals[i].Disposed.UTC().Format(time.RFC3339),
+			formatFloat(disposals[i].Amount),
+			formatFloat(disposals[i].Proceeds),
+			formatFloat(disposals[i].CostBasis),
+			formatFloat(disposals[i].Fee),
+			formatFloat(disposals[i].Gain),
+			strconv.FormatBool(disposals[i].Unmatched),
+			reporting,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	c.Flush()
+	return c.Error()
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
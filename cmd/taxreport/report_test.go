@@ -0,0 +1,247 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	dbfill "github.com/thrasher-corp/gocryptotrader/database/repository/fill"
+	dborder "github.com/thrasher-corp/gocryptotrader/database/repository/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/costbasis"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+const testExchange = "Bitstamp"
+
+var testStart = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+type fakeOrders struct {
+	dborder.IDBService
+	orders []dborder.Order
+}
+
+func (f *fakeOrders) GetInRange(string, time.Time, time.Time) ([]dborder.Order, error) {
+	return f.orders, nil
+}
+
+type fakeFills struct {
+	dbfill.IDBService
+	fills []dbfill.Fill
+}
+
+func (f *fakeFills) GetInRange(string, time.Time, time.Time) ([]dbfill.Fill, error) {
+	return f.fills, nil
+}
+
+func noWithdrawals(string, time.Time, time.Time) ([]*withdraw.Response, error) {
+	return nil, nil
+}
+
+// hourlyCandles returns hourly BTC and EUR candles in USD from the test start
+func hourlyCandles(_ string, pair currency.Pair, _ asset.Item, _ kline.Interval, _, _ time.Time) (*kline.Item, error) {
+	var closes []float64
+	switch {
+	case pair.Equal(currency.NewPair(currency.BTC, currency.USD)):
+		closes = []float64{100, 150, 200, 250}
+	case pair.Equal(currency.NewPair(currency.USD, currency.EUR)):
+		closes = []float64{0.5, 0.5, 0.5, 0.5}
+	default:
+		return nil, errors.New("no candles")
+	}
+	item := &kline.Item{}
+	for i := range closes {
+		item.Candles = append(item.Candles, kline.Candle{Time: testStart.Add(time.Duration(i) * time.Hour), Close: closes[i]})
+	}
+	return item, nil
+}
+
+func newTestReporter(t *testing.T, method costbasis.Method, reporting currency.Code, orders []dborder.Order, fills []dbfill.Fill) *reporter {
+	t.Helper()
+	tracker, err := costbasis.NewTracker(method, reporting)
+	require.NoError(t, err)
+	return &reporter{
+		orders:      &fakeOrders{orders: orders},
+		fills:       &fakeFills{fills: fills},
+		withdrawals: noWithdrawals,
+		candles:     hourlyCandles,
+		tracker:     tracker,
+		exchanges:   []string{testExchange},
+		interval:    kline.OneHour,
+		start:       testStart,
+		end:         testStart.Add(24 * time.Hour),
+	}
+}
+
+func TestRunSpot(t *testing.T) {
+	t.Parallel()
+	orders := []dborder.Order{
+		{OrderID: "1", Base: "BTC", Quote: "USD", AssetType: "spot", Side: "BUY", ExecutedAmount: 2, AverageExecutedPrice: 100, Fee: 2, Date: testStart},
+		{OrderID: "2", Base: "BTC", Quote: "USD", AssetType: "spot", Side: "SELL", ExecutedAmount: 1.5, Fee: 3},
+		{OrderID: "3", Base: "BTC", Quote: "USD", AssetType: "margin", Side: "SELL", ExecutedAmount: 1, AverageExecutedPrice: 100},
+	}
+	fills := []dbfill.Fill{
+		{OrderID: "2", TradeID: "2a", AssetType: "spot", Price: 200, Amount: 1, Timestamp: testStart.Add(2 * time.Hour)},
+		{OrderID: "2", TradeID: "2b", AssetType: "spot", Price: 250, Amount: 0.5, Timestamp: testStart.Add(3 * time.Hour)},
+		{OrderID: "3", TradeID: "3a", AssetType: "margin", Price: 100, Amount: 1, Timestamp: testStart.Add(2 * time.Hour)},
+	}
+	r := newTestReporter(t, costbasis.FIFO, currency.USD, orders, fills)
+	d, err := r.run()
+	require.NoError(t, err)
+	require.Len(t, d, 2, "the sell order should be split by its fills and margin orders ignored")
+	assert.Equal(t, "2a", d[0].TradeID)
+	assert.Equal(t, testStart, d[0].Acquired)
+	assert.Equal(t, 200.0, d[0].Proceeds)
+	assert.Equal(t, 101.0, d[0].CostBasis)
+	assert.Equal(t, 2.0, d[0].Fee, "the order fee should be apportioned by fill amount")
+	assert.Equal(t, 97.0, d[0].Gain)
+	assert.Equal(t, "2b", d[1].TradeID)
+	assert.Equal(t, 125.0, d[1].Proceeds)
+	assert.Equal(t, 50.5, d[1].CostBasis)
+
+	r = newTestReporter(t, costbasis.FIFO, currency.EUR, orders, fills)
+	d, err = r.run()
+	require.NoError(t, err, "inverse pairs should be used to price the quote currency")
+	require.Len(t, d, 2)
+	assert.Equal(t, 100.0, d[0].Proceeds)
+	assert.Equal(t, 50.5, d[0].CostBasis)
+
+	r = newTestReporter(t, costbasis.FIFO, currency.USD, orders, fills)
+	r.start = testStart.Add(150 * time.Minute)
+	d, err = r.run()
+	require.NoError(t, err)
+	require.Len(t, d, 1, "disposals before the start should not be reported")
+	assert.Equal(t, "2b", d[0].TradeID)
+
+	// Margin fills sharing an order ID should not be treated as spot fills
+	orders[2].OrderID = "2"
+	r = newTestReporter(t, costbasis.FIFO, currency.USD, orders, fills)
+	d, err = r.run()
+	require.NoError(t, err)
+	require.Len(t, d, 2)
+	assert.Equal(t, 200.0, d[0].Proceeds)
+	assert.Equal(t, 125.0, d[1].Proceeds)
+}
+
+func TestRunFees(t *testing.T) {
+	t.Parallel()
+	orders := []dborder.Order{
+		{OrderID: "1", Base: "BTC", Quote: "USD", AssetType: "spot", Side: "BUY", ExecutedAmount: 2, AverageExecutedPrice: 100, Fee: 0.01, FeeAsset: "BTC", Date: testStart},
+		{OrderID: "2", Base: "BTC", Quote: "USD", AssetType: "spot", Side: "SELL", ExecutedAmount: 1.5, Fee: 3, FeeAsset: "USD"},
+	}
+	fills := []dbfill.Fill{
+		{OrderID: "2", TradeID: "2a", AssetType: "spot", Price: 200, Amount: 1, Fee: 0.5, Timestamp: testStart.Add(2 * time.Hour)},
+		{OrderID: "2", TradeID: "2b", AssetType: "spot", Price: 250, Amount: 0.5, Fee: 0.001, FeeAsset: "BTC", Timestamp: testStart.Add(3 * time.Hour)},
+	}
+	r := newTestReporter(t, costbasis.FIFO, currency.USD, orders, fills)
+	d, err := r.run()
+	require.NoError(t, err)
+	require.Len(t, d, 2)
+	assert.InDelta(t, 100.5, d[0].CostBasis, 1e-9, "a base fee should be valued at the trade price")
+	assert.InDelta(t, 0.5, d[0].Fee, 1e-9, "the stored fill fee should be used")
+	assert.InDelta(t, 0.25, d[1].Fee, 1e-9, "a fill fee in base should be valued at the fill price")
+}
+
+func TestRunFutures(t *testing.T) {
+	t.Parallel()
+	orders := []dborder.Order{
+		{OrderID: "1", Base: "BTC", Quote: "USD", AssetType: "futures", Side: "SHORT", ExecutedAmount: 2, AverageExecutedPrice: 100, LastUpdated: testStart},
+		{OrderID: "2", Base: "BTC", Quote: "USD", AssetType: "futures", Side: "LONG", ExecutedAmount: 2, Cost: 160, LastUpdated: testStart.Add(time.Hour)},
+	}
+	r := newTestReporter(t, costbasis.FIFO, currency.USD, orders, nil)
+	d, err := r.run()
+	require.NoError(t, err)
+	require.Len(t, d, 1)
+	assert.Equal(t, "BITSTAMP:FUTURES:BTCUSD", d[0].Currency.String())
+	assert.Equal(t, 200.0, d[0].Proceeds)
+	assert.Equal(t, 160.0, d[0].CostBasis)
+	assert.Equal(t, 40.0, d[0].Gain)
+	assert.Equal(t, disposalTypeFutures, r.disposalType(&d[0]))
+
+	orders[1].Cost = 0
+	r = newTestReporter(t, costbasis.FIFO, currency.USD, orders, nil)
+	_, err = r.run()
+	assert.ErrorIs(t, err, errNoExecutionPrice)
+}
+
+func TestRunWithdrawalFee(t *testing.T) {
+	t.Parallel()
+	orders := []dborder.Order{
+		{OrderID: "1", Base: "BTC", Quote: "USD", AssetType: "spot", Side: "BUY", ExecutedAmount: 1, AverageExecutedPrice: 100, Date: testStart},
+	}
+	id, err := uuid.NewV4()
+	require.NoError(t, err)
+	r := newTestReporter(t, costbasis.AverageCost, currency.USD, orders, nil)
+	r.withdrawals = func(string, time.Time, time.Time) ([]*withdraw.Response, error) {
+		return []*withdraw.Response{
+			{
+				ID:        id,
+				CreatedAt: testStart.Add(time.Hour),
+				RequestDetails: withdraw.Request{
+					Currency: currency.BTC,
+					Type:     withdraw.Crypto,
+					Crypto:   withdraw.CryptoRequest{FeeAmount: 0.1},
+				},
+			},
+			{
+				CreatedAt:      testStart.Add(time.Hour),
+				RequestDetails: withdraw.Request{Currency: currency.USD, Type: withdraw.Fiat},
+			},
+		}, nil
+	}
+	d, err := r.run()
+	require.NoError(t, err)
+	require.Len(t, d, 1)
+	assert.Equal(t, id.String(), d[0].TradeID)
+	assert.Zero(t, d[0].Proceeds)
+	assert.InDelta(t, 10.0, d[0].CostBasis, 1e-9)
+	assert.Equal(t, disposalTypeWithdrawalFee, r.disposalType(&d[0]))
+}
+
+func TestRunNoHistoricalPrice(t *testing.T) {
+	t.Parallel()
+	orders := []dborder.Order{
+		{OrderID: "1", Base: "BTC", Quote: "USD", AssetType: "spot", Side: "BUY", ExecutedAmount: 1, AverageExecutedPrice: 100, Date: testStart},
+	}
+	r := newTestReporter(t, costbasis.FIFO, currency.GBP, orders, nil)
+	_, err := r.run()
+	assert.ErrorIs(t, err, errNoHistoricalPrice)
+
+	r = newTestReporter(t, costbasis.FIFO, currency.USD, nil, nil)
+	p, err := r.price(testExchange, currency.USD, testStart)
+	require.NoError(t, err, "the reporting currency should not require candles")
+	assert.Equal(t, 1.0, p)
+	r.prices = make(map[priceKey][]kline.Candle)
+	_, err = r.price(testExchange, currency.BTC, testStart.Add(-time.Minute))
+	assert.ErrorIs(t, err, errNoHistoricalPrice, "candles after the event should not be used")
+	p, err = r.price(testExchange, currency.BTC, testStart.Add(90*time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, 150.0, p)
+	_, err = r.price(testExchange, currency.BTC, testStart.Add(30*time.Hour))
+	assert.ErrorIs(t, err, errNoHistoricalPrice, "stale candles should not be used")
+}
+
+func TestWriteCSV(t *testing.T) {
+	t.Parallel()
+	r := newTestReporter(t, costbasis.FIFO, currency.USD, nil, nil)
+	r.contracts = map[*currency.Item]bool{}
+	r.withdrawIDs = map[string]bool{}
+	var b bytes.Buffer
+	require.NoError(t, r.writeCSV(&b, []costbasis.Disposal{
+		{Currency: currency.BTC, Exchange: testExchange, TradeID: "1", Acquired: testStart, Disposed: testStart.Add(time.Hour), Amount: 0.5, Proceeds: 100, Fee: 1, CostBasis: 50.25, Gain: 48.75},
+		{Currency: currency.ETH, Exchange: testExchange, TradeID: "2", Disposed: testStart, Amount: 1, Proceeds: 10, Gain: 10, Unmatched: true},
+	}))
+	rows, err := csv.NewReader(&b).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+	assert.Equal(t, []string{"spot", "BTC", testExchange, "1", "2024-01-01T00:00:00Z", "2024-01-01T01:00:00Z", "0.5", "100", "50.25", "1", "48.75", "false", "USD"}, rows[1])
+	assert.Empty(t, rows[2][4], "average cost and unmatched disposals should not have an acquisition date")
+	assert.Equal(t, "true", rows[2][11])
+}
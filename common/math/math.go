@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sort"
 
 	"github.com/shopspring/decimal"
 )
@@ -24,6 +25,7 @@ var (
 	errCAGRNoIntervals         = errors.New("cannot calculate CAGR with no intervals")
 	errCAGRZeroOpenValue       = errors.New("cannot calculate CAGR with an open value of 0")
 	errInformationBadLength    = errors.New("benchmark rates length does not match returns rates")
+	errInvalidConfidenceLevel  = errors.New("confidence level must be between 0 and 1")
 )
 
 // CalculateAmountWithFee returns a calculated fee included amount on fee
@@ -483,3 +485,96 @@ func DecimalSharpeRatio(movementPerCandle []decimal.Decimal, riskFreeRatePerInte
 
 	return average.Sub(riskFreeRatePerInterval).Div(standardDeviation), nil
 }
+
+// DecimalBeta measures how much returns move with the benchmark, where 1 moves
+// in line with the benchmark and 0 is unrelated to it
+func DecimalBeta(returnsRates, benchmarkRates []decimal.Decimal) (decimal.Decimal, error) {
+	if len(benchmarkRates) != len(returnsRates) {
+		return decimal.Zero, errInformationBadLength
+	}
+	if len(returnsRates) == 0 {
+		return decimal.Zero, errZeroValue
+	}
+	returnsAverage, err := DecimalArithmeticMean(returnsRates)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	benchmarkAverage, err := DecimalArithmeticMean(benchmarkRates)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	var covariance, variance decimal.Decimal
+	for i := range returnsRates {
+		benchmarkDiff := benchmarkRates[i].Sub(benchmarkAverage)
+		covariance = covariance.Add(returnsRates[i].Sub(returnsAverage).Mul(benchmarkDiff))
+		variance = variance.Add(benchmarkDiff.Mul(benchmarkDiff))
+	}
+	if variance.IsZero() {
+		return decimal.Zero, nil
+	}
+	return covariance.Div(variance), nil
+}
+
+// DecimalTrackingError is the standard deviation of the difference between
+// returns and the benchmark, measuring how closely returns follow it
+func DecimalTrackingError(returnsRates, benchmarkRates []decimal.Decimal) (decimal.Decimal, error) {
+	if len(benchmarkRates) != len(returnsRates) {
+		return decimal.Zero, errInformationBadLength
+	}
+	diffs := make([]decimal.Decimal, len(returnsRates))
+	for i := range returnsRates {
+		diffs[i] = returnsRates[i].Sub(benchmarkRates[i])
+	}
+	stdDev, err := DecimalPopulationStandardDeviation(diffs)
+	if err != nil && !errors.Is(err, ErrInexactConversion) {
+		return decimal.Zero, err
+	}
+	return stdDev, nil
+}
+
+// DecimalHistoricalValueAtRisk returns the loss per interval which is not
+// exceeded at the confidence level based on the returns which occurred. Losses
+// are positive, eg 0.05 at a 0.95 confidence level means 5% of returns lost
+// at least 5%
+func DecimalHistoricalValueAtRisk(returnsRates []decimal.Decimal, confidenceLevel decimal.Decimal) (decimal.Decimal, error) {
+	tail, err := lossTail(returnsRates, confidenceLevel)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return tail[len(tail)-1].Neg(), nil
+}
+
+// DecimalExpectedShortfall returns the average loss per interval of the returns
+// beyond the value at risk at the confidence level. Losses are positive
+func DecimalExpectedShortfall(returnsRates []decimal.Decimal, confidenceLevel decimal.Decimal) (decimal.Decimal, error) {
+	tail, err := lossTail(returnsRates, confidenceLevel)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	average, err := DecimalArithmeticMean(tail)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return average.Neg(), nil
+}
+
+// lossTail returns the worst returns outside of the confidence level, sorted
+// from worst to best. At least one return is always included
+func lossTail(returnsRates []decimal.Decimal, confidenceLevel decimal.Decimal) ([]decimal.Decimal, error) {
+	if len(returnsRates) == 0 {
+		return nil, errZeroValue
+	}
+	if !confidenceLevel.IsPositive() || confidenceLevel.GreaterThanOrEqual(decimal.NewFromInt(1)) {
+		return nil, fmt.Errorf("%w received %v", errInvalidConfidenceLevel, confidenceLevel)
+	}
+	sorted := make([]decimal.Decimal, len(returnsRates))
+	copy(sorted, returnsRates)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].LessThan(sorted[j])
+	})
+	count := decimal.NewFromInt(1).Sub(confidenceLevel).Mul(decimal.NewFromInt(int64(len(sorted)))).Ceil().IntPart()
+	if count < 1 {
+		count = 1
+	}
+	return sorted[:count], nil
+}
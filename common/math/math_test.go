@@ -897,3 +897,107 @@ func TestDecimalPow(t *testing.T) {
 		t.Errorf("received '%v' expected '%v'", pow, 0)
 	}
 }
+
+func TestDecimalBeta(t *testing.T) {
+	t.Parallel()
+	_, err := DecimalBeta([]decimal.Decimal{decimal.Zero}, nil)
+	if !errors.Is(err, errInformationBadLength) {
+		t.Errorf("received '%v' expected '%v'", err, errInformationBadLength)
+	}
+	_, err = DecimalBeta(nil, nil)
+	if !errors.Is(err, errZeroValue) {
+		t.Errorf("received '%v' expected '%v'", err, errZeroValue)
+	}
+	benchmark := []decimal.Decimal{
+		decimal.NewFromFloat(0.01),
+		decimal.NewFromFloat(-0.02),
+		decimal.NewFromFloat(0.03),
+		decimal.NewFromFloat(0.005),
+	}
+	returns := make([]decimal.Decimal, len(benchmark))
+	for i := range benchmark {
+		returns[i] = benchmark[i].Mul(decimal.NewFromInt(2)).Add(decimal.NewFromFloat(0.001))
+	}
+	beta, err := DecimalBeta(returns, benchmark)
+	if err != nil {
+		t.Error(err)
+	}
+	if !beta.Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received '%v' expected '%v'", beta, 2)
+	}
+	flat := []decimal.Decimal{decimal.NewFromFloat(0.01), decimal.NewFromFloat(0.01), decimal.NewFromFloat(0.01), decimal.NewFromFloat(0.01)}
+	beta, err = DecimalBeta(returns, flat)
+	if err != nil {
+		t.Error(err)
+	}
+	if !beta.IsZero() {
+		t.Errorf("received '%v' expected '%v'", beta, 0)
+	}
+}
+
+func TestDecimalTrackingError(t *testing.T) {
+	t.Parallel()
+	_, err := DecimalTrackingError([]decimal.Decimal{decimal.Zero}, nil)
+	if !errors.Is(err, errInformationBadLength) {
+		t.Errorf("received '%v' expected '%v'", err, errInformationBadLength)
+	}
+	trackingError, err := DecimalTrackingError(
+		[]decimal.Decimal{decimal.NewFromFloat(0.03), decimal.NewFromFloat(0.01)},
+		[]decimal.Decimal{decimal.NewFromFloat(0.01), decimal.NewFromFloat(0.01)})
+	if err != nil {
+		t.Error(err)
+	}
+	if !trackingError.Equal(decimal.NewFromFloat(0.01)) {
+		t.Errorf("received '%v' expected '%v'", trackingError, 0.01)
+	}
+}
+
+func TestDecimalHistoricalValueAtRisk(t *testing.T) {
+	t.Parallel()
+	_, err := DecimalHistoricalValueAtRisk(nil, decimal.NewFromFloat(0.95))
+	if !errors.Is(err, errZeroValue) {
+		t.Errorf("received '%v' expected '%v'", err, errZeroValue)
+	}
+	returns := make([]decimal.Decimal, 20)
+	for i := range returns {
+		returns[i] = decimal.NewFromInt(int64(i - 10)).Div(decimal.NewFromInt(100))
+	}
+	_, err = DecimalHistoricalValueAtRisk(returns, decimal.NewFromInt(1))
+	if !errors.Is(err, errInvalidConfidenceLevel) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidConfidenceLevel)
+	}
+	valueAtRisk, err := DecimalHistoricalValueAtRisk(returns, decimal.NewFromFloat(0.9))
+	if err != nil {
+		t.Error(err)
+	}
+	if !valueAtRisk.Equal(decimal.NewFromFloat(0.09)) {
+		t.Errorf("received '%v' expected '%v'", valueAtRisk, 0.09)
+	}
+	// a single return is always used
+	valueAtRisk, err = DecimalHistoricalValueAtRisk(returns, decimal.NewFromFloat(0.999))
+	if err != nil {
+		t.Error(err)
+	}
+	if !valueAtRisk.Equal(decimal.NewFromFloat(0.1)) {
+		t.Errorf("received '%v' expected '%v'", valueAtRisk, 0.1)
+	}
+}
+
+func TestDecimalExpectedShortfall(t *testing.T) {
+	t.Parallel()
+	_, err := DecimalExpectedShortfall(nil, decimal.NewFromFloat(0.95))
+	if !errors.Is(err, errZeroValue) {
+		t.Errorf("received '%v' expected '%v'", err, errZeroValue)
+	}
+	returns := make([]decimal.Decimal, 20)
+	for i := range returns {
+		returns[i] = decimal.NewFromInt(int64(i - 10)).Div(decimal.NewFromInt(100))
+	}
+	shortfall, err := DecimalExpectedShortfall(returns, decimal.NewFromFloat(0.9))
+	if err != nil {
+		t.Error(err)
+	}
+	if !shortfall.Equal(decimal.NewFromFloat(0.095)) {
+		t.Errorf("received '%v' expected '%v'", shortfall, 0.095)
+	}
+}
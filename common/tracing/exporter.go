@@ -0,0 +1,209 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+)
+
+// FileExporter appends spans to a file as OTLP/JSON, one export request per
+// line, in the format read by the OpenTelemetry collector's file receiver
+type FileExporter struct {
+	m sync.Mutex
+	f *os.File
+}
+
+// HTTPExporter sends spans to an OTLP/HTTP collector endpoint as JSON
+type HTTPExporter struct {
+	endpoint string
+	client   *http.Client
+}
+
+// NewFileExporter opens the file for appending, creating it if needed
+func NewFileExporter(path string) (*FileExporter, error) {
+	if err := os.MkdirAll(filepath.Dir(path), file.DefaultPermissionOctal); err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, file.DefaultPermissionOctal)
+	if err != nil {
+		return nil, err
+	}
+	return &FileExporter{f: f}, nil
+}
+
+// ExportSpans writes the spans as a single line
+func (e *FileExporter) ExportSpans(_ context.Context, serviceName string, spans []SpanData) error {
+	b, err := json.Marshal(newOTLPRequest(serviceName, spans))
+	if err != nil {
+		return err
+	}
+	e.m.Lock()
+	defer e.m.Unlock()
+	_, err = e.f.Write(append(b, '\n'))
+	return err
+}
+
+// Shutdown closes the file
+func (e *FileExporter) Shutdown(context.Context) error {
+	e.m.Lock()
+	defer e.m.Unlock()
+	return e.f.Close()
+}
+
+// NewHTTPExporter returns an exporter for an OTLP/HTTP traces endpoint such
+// as http://localhost:4318/v1/traces
+func NewHTTPExporter(endpoint string, client *http.Client) (*HTTPExporter, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("%w %q: %v", errInvalidExportEndpoint, endpoint, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+		return nil, fmt.Errorf("%w %q", errInvalidExportEndpoint, endpoint)
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &HTTPExporter{endpoint: endpoint, client: client}, nil
+}
+
+// ExportSpans posts the spans to the collector
+func (e *HTTPExporter) ExportSpans(ctx context.Context, serviceName string, spans []SpanData) error {
+	b, err := json.Marshal(newOTLPRequest(serviceName, spans))
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.endpoint, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("%w %s: %s", errUnexpectedStatusCode, resp.Status, body)
+	}
+	_, err = io.Copy(io.Discard, resp.Body)
+	return err
+}
+
+// Shutdown does nothing as spans are sent synchronously
+func (e *HTTPExporter) Shutdown(context.Context) error {
+	return nil
+}
+
+// otlpRequest is the JSON encoding of an OTLP ExportTraceServiceRequest
+type otlpRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              SpanKind       `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Status            otlpStatus     `json:"status"`
+}
+
+type otlpStatus struct {
+	Code    StatusCode `json:"code,omitempty"`
+	Message string     `json:"message,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+// otlpAnyValue holds exactly one value. 64 bit integers are encoded as
+// strings as required by the OTLP/JSON specification
+type otlpAnyValue struct {
+	StringValue *string  `json:"stringValue,omitempty"`
+	BoolValue   *bool    `json:"boolValue,omitempty"`
+	IntValue    string   `json:"intValue,omitempty"`
+	DoubleValue *float64 `json:"doubleValue,omitempty"`
+}
+
+func newOTLPRequest(serviceName string, spans []SpanData) *otlpRequest {
+	s := make([]otlpSpan, len(spans))
+	for i := range spans {
+		s[i] = otlpSpan{
+			TraceID:           spans[i].SpanContext.TraceID.String(),
+			SpanID:            spans[i].SpanContext.SpanID.String(),
+			Name:              spans[i].Name,
+			Kind:              spans[i].Kind,
+			StartTimeUnixNano: strconv.FormatInt(spans[i].Start.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(spans[i].End.UnixNano(), 10),
+			Attributes:        otlpAttributes(spans[i].Attributes),
+			Status:            otlpStatus{Code: spans[i].StatusCode, Message: spans[i].StatusMessage},
+		}
+		if spans[i].ParentSpanID != (SpanID{}) {
+			s[i].ParentSpanID = spans[i].ParentSpanID.String()
+		}
+	}
+	return &otlpRequest{
+		ResourceSpans: []otlpResourceSpans{{
+			Resource:   otlpResource{Attributes: otlpAttributes([]Attribute{String("service.name", serviceName)})},
+			ScopeSpans: []otlpScopeSpans{{Scope: otlpScope{Name: instrumentationScope}, Spans: s}},
+		}},
+	}
+}
+
+func otlpAttributes(attributes []Attribute) []otlpKeyValue {
+	if len(attributes) == 0 {
+		return nil
+	}
+	kv := make([]otlpKeyValue, len(attributes))
+	for i := range attributes {
+		kv[i].Key = attributes[i].Key
+		switch v := attributes[i].Value.(type) {
+		case string:
+			kv[i].Value.StringValue = &v
+		case bool:
+			kv[i].Value.BoolValue = &v
+		case int64:
+			kv[i].Value.IntValue = strconv.FormatInt(v, 10)
+		case float64:
+			kv[i].Value.DoubleValue = &v
+		default:
+			str := fmt.Sprint(v)
+			kv[i].Value.StringValue = &str
+		}
+	}
+	return kv
+}
@@ -0,0 +1,76 @@
+package tracing
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testSpans = []SpanData{{
+	SpanContext:  SpanContext{TraceID: TraceID{1}, SpanID: SpanID{2}, Sampled: true},
+	ParentSpanID: SpanID{3},
+	Name:         "test",
+	Kind:         SpanKindClient,
+	Start:        time.Unix(1, 0),
+	End:          time.Unix(2, 0),
+	Attributes:   []Attribute{String("s", "v"), Int64("i", 1), Float64("f", 1.5), Bool("b", true), {Key: "u", Value: 1}},
+	StatusCode:   StatusError,
+}}
+
+const expectedOTLP = `{"resourceSpans":[{"resource":{"attributes":[{"key":"service.name","value":{"stringValue":"gct"}}]},"scopeSpans":[{"scope":{"name":"github.com/thrasher-corp/gocryptotrader"},"spans":[{"traceId":"01000000000000000000000000000000","spanId":"0200000000000000","parentSpanId":"0300000000000000","name":"test","kind":3,"startTimeUnixNano":"1000000000","endTimeUnixNano":"2000000000","attributes":[{"key":"s","value":{"stringValue":"v"}},{"key":"i","value":{"intValue":"1"}},{"key":"f","value":{"doubleValue":1.5}},{"key":"b","value":{"boolValue":true}},{"key":"u","value":{"stringValue":"1"}}],"status":{"code":2}}]}]}]}`
+
+func TestFileExporter(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "traces", "traces.json")
+	e, err := NewFileExporter(path)
+	require.NoError(t, err)
+	require.NoError(t, e.ExportSpans(context.Background(), "gct", testSpans))
+	require.NoError(t, e.ExportSpans(context.Background(), "gct", testSpans))
+	require.NoError(t, e.Shutdown(context.Background()))
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	s := bufio.NewScanner(f)
+	var lines int
+	for s.Scan() {
+		assert.JSONEq(t, expectedOTLP, s.Text())
+		lines++
+	}
+	assert.Equal(t, 2, lines, "each export should be written as a line")
+}
+
+func TestHTTPExporter(t *testing.T) {
+	t.Parallel()
+	_, err := NewHTTPExporter("localhost:4318", nil)
+	assert.ErrorIs(t, err, errInvalidExportEndpoint)
+	_, err = NewHTTPExporter("http://", nil)
+	assert.ErrorIs(t, err, errInvalidExportEndpoint)
+
+	status := http.StatusOK
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		b, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.True(t, json.Valid(b))
+		assert.JSONEq(t, expectedOTLP, string(b))
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+	e, err := NewHTTPExporter(srv.URL+"/v1/traces", srv.Client())
+	require.NoError(t, err)
+	assert.NoError(t, e.ExportSpans(context.Background(), "gct", testSpans))
+	status = http.StatusBadRequest
+	assert.ErrorIs(t, e.ExportSpans(context.Background(), "gct", testSpans), errUnexpectedStatusCode)
+	assert.NoError(t, e.Shutdown(context.Background()))
+}
@@ -0,0 +1,297 @@
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+var globalTracer atomic.Pointer[Tracer]
+
+// NewTracer returns a tracer which exports spans under the service name
+func NewTracer(serviceName string, exporter Exporter) (*Tracer, error) {
+	if exporter == nil {
+		return nil, errNilExporter
+	}
+	return &Tracer{
+		serviceName:   serviceName,
+		exporter:      exporter,
+		queue:         make(chan SpanData, defaultQueueSize),
+		batchSize:     defaultBatchSize,
+		flushInterval: defaultFlushInterval,
+	}, nil
+}
+
+// SetGlobalTracer sets the tracer used by StartSpan. A nil tracer disables
+// tracing
+func SetGlobalTracer(t *Tracer) {
+	globalTracer.Store(t)
+}
+
+// Start starts exporting ended spans in the background
+func (t *Tracer) Start() error {
+	if !atomic.CompareAndSwapInt32(&t.started, 0, 1) {
+		return errTracerAlreadyStarted
+	}
+	t.shutdown = make(chan struct{})
+	t.wg.Add(1)
+	go t.run()
+	return nil
+}
+
+// Stop exports any queued spans and shuts down the exporter
+func (t *Tracer) Stop(ctx context.Context) error {
+	if !atomic.CompareAndSwapInt32(&t.started, 1, 0) {
+		return errTracerNotStarted
+	}
+	close(t.shutdown)
+	t.wg.Wait()
+	if dropped := atomic.LoadUint64(&t.dropped); dropped > 0 {
+		log.Warnf(log.Global, "Tracer dropped %d spans as the export queue was full", dropped)
+	}
+	return t.exporter.Shutdown(ctx)
+}
+
+func (t *Tracer) run() {
+	defer t.wg.Done()
+	ticker := time.NewTicker(t.flushInterval)
+	defer ticker.Stop()
+	batch := make([]SpanData, 0, t.batchSize)
+	for {
+		select {
+		case s := <-t.queue:
+			batch = append(batch, s)
+			if len(batch) >= t.batchSize {
+				batch = t.export(batch)
+			}
+		case <-ticker.C:
+			batch = t.export(batch)
+		case <-t.shutdown:
+			for {
+				select {
+				case s := <-t.queue:
+					batch = append(batch, s)
+				default:
+					t.export(batch)
+					return
+				}
+			}
+		}
+	}
+}
+
+// export sends the batch to the exporter and returns it emptied for reuse
+func (t *Tracer) export(batch []SpanData) []SpanData {
+	if len(batch) == 0 {
+		return batch
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), exportTimeout)
+	defer cancel()
+	if err := t.exporter.ExportSpans(ctx, t.serviceName, batch); err != nil {
+		log.Errorf(log.Global, "Tracer unable to export %d spans: %v", len(batch), err)
+	}
+	return batch[:0]
+}
+
+func (t *Tracer) enqueue(s *SpanData) {
+	select {
+	case t.queue <- *s:
+	default:
+		atomic.AddUint64(&t.dropped, 1)
+	}
+}
+
+// StartSpan starts a span as a child of any span in the context and returns a
+// context carrying the new span. When tracing is disabled the context is
+// returned unchanged with a nil span
+func StartSpan(ctx context.Context, name string, kind SpanKind, attributes ...Attribute) (context.Context, *Span) {
+	t := globalTracer.Load()
+	if t == nil {
+		return ctx, nil
+	}
+	s := &Span{
+		tracer:     t,
+		name:       name,
+		kind:       kind,
+		start:      time.Now(),
+		attributes: attributes,
+	}
+	parent := SpanContextFromContext(ctx)
+	if parent.IsValid() {
+		s.spanContext.TraceID = parent.TraceID
+		s.spanContext.Sampled = parent.Sampled
+		s.parentSpanID = parent.SpanID
+	} else {
+		s.spanContext.TraceID = newTraceID()
+		s.spanContext.Sampled = true
+	}
+	s.spanContext.SpanID = newSpanID()
+	return context.WithValue(ctx, spanContextKey{}, s.spanContext), s
+}
+
+// ContextWithRemoteSpanContext returns a context carrying a span context
+// received from another process so that new spans continue its trace
+func ContextWithRemoteSpanContext(ctx context.Context, sc SpanContext) context.Context {
+	sc.Remote = true
+	return context.WithValue(ctx, spanContextKey{}, sc)
+}
+
+// SpanContextFromContext returns the span context carried by the context, if
+// any
+func SpanContextFromContext(ctx context.Context) SpanContext {
+	sc, _ := ctx.Value(spanContextKey{}).(SpanContext)
+	return sc
+}
+
+// SpanContext returns the span's identifiers
+func (s *Span) SpanContext() SpanContext {
+	if s == nil {
+		return SpanContext{}
+	}
+	return s.spanContext
+}
+
+// SetAttributes adds attributes to the span
+func (s *Span) SetAttributes(attributes ...Attribute) {
+	if s == nil {
+		return
+	}
+	s.m.Lock()
+	s.attributes = append(s.attributes, attributes...)
+	s.m.Unlock()
+}
+
+// RecordError sets the span's status to an error. A nil error does nothing
+func (s *Span) RecordError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.m.Lock()
+	s.statusCode = StatusError
+	s.statusMessage = err.Error()
+	s.m.Unlock()
+}
+
+// End ends the span and queues it for export if sampled. Subsequent calls do
+// nothing
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+	s.m.Lock()
+	if !s.end.IsZero() {
+		s.m.Unlock()
+		return
+	}
+	s.end = time.Now()
+	d := SpanData{
+		SpanContext:   s.spanContext,
+		ParentSpanID:  s.parentSpanID,
+		Name:          s.name,
+		Kind:          s.kind,
+		Start:         s.start,
+		End:           s.end,
+		Attributes:    s.attributes,
+		StatusCode:    s.statusCode,
+		StatusMessage: s.statusMessage,
+	}
+	s.m.Unlock()
+	if d.SpanContext.Sampled {
+		s.tracer.enqueue(&d)
+	}
+}
+
+// String returns a string attribute
+func String(key, value string) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+// Int64 returns an integer attribute
+func Int64(key string, value int64) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+// Float64 returns a floating point attribute
+func Float64(key string, value float64) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+// Bool returns a boolean attribute
+func Bool(key string, value bool) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+// IsValid returns whether the span context has a trace and span ID
+func (sc SpanContext) IsValid() bool {
+	return sc.TraceID != TraceID{} && sc.SpanID != SpanID{}
+}
+
+// TraceParent returns the span context as a W3C traceparent header value
+func (sc SpanContext) TraceParent() string {
+	var flags byte
+	if sc.Sampled {
+		flags = flagSampled
+	}
+	return fmt.Sprintf("%s-%s-%s-%02x", traceParentVersion, sc.TraceID, sc.SpanID, flags)
+}
+
+// ParseTraceParent parses a W3C traceparent header value
+func ParseTraceParent(v string) (SpanContext, error) {
+	parts := strings.Split(strings.TrimSpace(v), "-")
+	if len(parts) < 4 || len(parts[0]) != 2 || parts[0] == "ff" ||
+		(parts[0] == traceParentVersion && len(parts) != 4) {
+		return SpanContext{}, fmt.Errorf("%w: %q", ErrInvalidTraceParent, v)
+	}
+	var sc SpanContext
+	var flags [1]byte
+	if err := decodeHex(sc.TraceID[:], parts[1]); err != nil {
+		return SpanContext{}, fmt.Errorf("%w: %q trace ID %v", ErrInvalidTraceParent, v, err)
+	}
+	if err := decodeHex(sc.SpanID[:], parts[2]); err != nil {
+		return SpanContext{}, fmt.Errorf("%w: %q span ID %v", ErrInvalidTraceParent, v, err)
+	}
+	if err := decodeHex(flags[:], parts[3]); err != nil {
+		return SpanContext{}, fmt.Errorf("%w: %q flags %v", ErrInvalidTraceParent, v, err)
+	}
+	if !sc.IsValid() {
+		return SpanContext{}, fmt.Errorf("%w: %q all zero ID", ErrInvalidTraceParent, v)
+	}
+	sc.Sampled = flags[0]&flagSampled != 0
+	sc.Remote = true
+	return sc, nil
+}
+
+// decodeHex decodes lowercase hex of exactly the destination's length
+func decodeHex(dst []byte, s string) error {
+	if len(s) != hex.EncodedLen(len(dst)) || strings.ToLower(s) != s {
+		return fmt.Errorf("expected %d lowercase hex characters", hex.EncodedLen(len(dst)))
+	}
+	_, err := hex.Decode(dst, []byte(s))
+	return err
+}
+
+// String returns the trace ID as lowercase hex
+func (t TraceID) String() string {
+	return hex.EncodeToString(t[:])
+}
+
+// String returns the span ID as lowercase hex
+func (s SpanID) String() string {
+	return hex.EncodeToString(s[:])
+}
+
+func newTraceID() (t TraceID) {
+	_, _ = rand.Read(t[:])
+	return t
+}
+
+func newSpanID() (s SpanID) {
+	_, _ = rand.Read(s[:])
+	return s
+}
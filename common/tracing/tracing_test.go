@@ -0,0 +1,128 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testExporter struct {
+	m           sync.Mutex
+	spans       []SpanData
+	serviceName string
+	shutdown    bool
+}
+
+func (e *testExporter) ExportSpans(_ context.Context, serviceName string, spans []SpanData) error {
+	e.m.Lock()
+	defer e.m.Unlock()
+	e.serviceName = serviceName
+	e.spans = append(e.spans, spans...)
+	return nil
+}
+
+func (e *testExporter) Shutdown(context.Context) error {
+	e.shutdown = true
+	return nil
+}
+
+func TestParseTraceParent(t *testing.T) {
+	t.Parallel()
+	sc, err := ParseTraceParent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	require.NoError(t, err)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", sc.TraceID.String())
+	assert.Equal(t, "00f067aa0ba902b7", sc.SpanID.String())
+	assert.True(t, sc.Sampled)
+	assert.True(t, sc.Remote)
+	assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", sc.TraceParent())
+
+	sc, err = ParseTraceParent("01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00-future")
+	require.NoError(t, err, "future versions with additional fields should be accepted")
+	assert.False(t, sc.Sampled)
+
+	for _, v := range []string{
+		"",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra",
+		"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-zz",
+	} {
+		_, err = ParseTraceParent(v)
+		assert.ErrorIs(t, err, ErrInvalidTraceParent, v)
+	}
+}
+
+// TestStartSpan is not parallel as it sets the global tracer
+func TestStartSpan(t *testing.T) {
+	ctx, s := StartSpan(context.Background(), "disabled", SpanKindInternal)
+	assert.Nil(t, s, "StartSpan should not create spans when tracing is disabled")
+	assert.False(t, SpanContextFromContext(ctx).IsValid())
+	s.SetAttributes(String("nil", "safe"))
+	s.RecordError(errors.New("nil safe"))
+	s.End()
+
+	_, err := NewTracer("test", nil)
+	assert.ErrorIs(t, err, errNilExporter)
+	e := &testExporter{}
+	tr, err := NewTracer("test", e)
+	require.NoError(t, err)
+	assert.ErrorIs(t, tr.Stop(context.Background()), errTracerNotStarted)
+	require.NoError(t, tr.Start())
+	assert.ErrorIs(t, tr.Start(), errTracerAlreadyStarted)
+	SetGlobalTracer(tr)
+	defer SetGlobalTracer(nil)
+
+	remote, err := ParseTraceParent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	require.NoError(t, err)
+	ctx, parent := StartSpan(ContextWithRemoteSpanContext(context.Background(), remote), "parent", SpanKindServer, String("rpc.system", "grpc"))
+	require.NotNil(t, parent)
+	assert.Equal(t, parent.SpanContext(), SpanContextFromContext(ctx))
+	_, child := StartSpan(ctx, "child", SpanKindClient)
+	child.SetAttributes(Int64("attempt", 1), Float64("amount", 1.5), Bool("ok", false))
+	child.RecordError(errors.New("test error"))
+	child.RecordError(nil)
+	child.End()
+	child.End()
+	parent.End()
+
+	_, unsampled := StartSpan(ContextWithRemoteSpanContext(context.Background(), SpanContext{TraceID: remote.TraceID, SpanID: remote.SpanID}), "unsampled", SpanKindServer)
+	unsampled.End()
+
+	require.NoError(t, tr.Stop(context.Background()))
+	assert.True(t, e.shutdown)
+	assert.Equal(t, "test", e.serviceName)
+	require.Len(t, e.spans, 2, "spans should be exported once and unsampled spans should not be exported")
+	c, p := e.spans[0], e.spans[1]
+	assert.Equal(t, "child", c.Name)
+	assert.Equal(t, remote.TraceID, c.SpanContext.TraceID)
+	assert.Equal(t, p.SpanContext.SpanID, c.ParentSpanID)
+	assert.Equal(t, remote.SpanID, p.ParentSpanID)
+	assert.Equal(t, StatusError, c.StatusCode)
+	assert.Equal(t, "test error", c.StatusMessage)
+	assert.Len(t, c.Attributes, 3)
+	assert.False(t, c.End.Before(c.Start))
+	assert.Equal(t, SpanKindServer, p.Kind)
+
+	_, root := StartSpan(context.Background(), "root", SpanKindInternal)
+	assert.True(t, root.SpanContext().IsValid())
+	assert.True(t, root.SpanContext().Sampled, "root spans should be sampled")
+	assert.NotEqual(t, remote.TraceID, root.SpanContext().TraceID)
+}
+
+func TestEnqueueFull(t *testing.T) {
+	t.Parallel()
+	tr, err := NewTracer("test", &testExporter{})
+	require.NoError(t, err)
+	tr.queue = make(chan SpanData, 1)
+	tr.enqueue(&SpanData{})
+	tr.enqueue(&SpanData{})
+	assert.Equal(t, uint64(1), tr.dropped, "spans should be dropped rather than block when the queue is full")
+}
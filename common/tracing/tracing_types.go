@@ -0,0 +1,132 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// TraceParentHeader is the W3C trace context header, also used as the gRPC
+// metadata key for propagating trace context
+const TraceParentHeader = "traceparent"
+
+const (
+	traceParentVersion = "00"
+	flagSampled        = 0x01
+
+	defaultQueueSize     = 2048
+	defaultBatchSize     = 512
+	defaultFlushInterval = time.Second * 5
+	exportTimeout        = time.Second * 10
+
+	instrumentationScope = "github.com/thrasher-corp/gocryptotrader"
+)
+
+// Span kinds as defined by OTLP
+const (
+	SpanKindUnspecified SpanKind = iota
+	SpanKindInternal
+	SpanKindServer
+	SpanKindClient
+	SpanKindProducer
+	SpanKindConsumer
+)
+
+// Span status codes as defined by OTLP
+const (
+	StatusUnset StatusCode = iota
+	StatusOK
+	StatusError
+)
+
+var (
+	// ErrInvalidTraceParent is returned when a traceparent header cannot be
+	// parsed
+	ErrInvalidTraceParent = errors.New("invalid traceparent")
+
+	errNilExporter           = errors.New("span exporter is nil")
+	errTracerAlreadyStarted  = errors.New("tracer already started")
+	errTracerNotStarted      = errors.New("tracer not started")
+	errUnexpectedStatusCode  = errors.New("unexpected status code")
+	errInvalidExportEndpoint = errors.New("invalid export endpoint")
+)
+
+// SpanKind describes the relationship of a span to its caller
+type SpanKind int
+
+// StatusCode is the status of a finished span
+type StatusCode int
+
+// TraceID is a W3C trace identifier
+type TraceID [16]byte
+
+// SpanID is a W3C span identifier
+type SpanID [8]byte
+
+// SpanContext identifies a span and is propagated between processes
+type SpanContext struct {
+	TraceID TraceID
+	SpanID  SpanID
+	Sampled bool
+	Remote  bool
+}
+
+// Attribute is a key value pair describing a span. Values are strings,
+// int64s, float64s or bools
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+// Span records the timing and outcome of an operation. A nil Span is valid
+// and records nothing, which is what StartSpan returns when tracing is
+// disabled
+type Span struct {
+	tracer       *Tracer
+	spanContext  SpanContext
+	parentSpanID SpanID
+	name         string
+	kind         SpanKind
+	start        time.Time
+
+	m             sync.Mutex
+	end           time.Time
+	attributes    []Attribute
+	statusCode    StatusCode
+	statusMessage string
+}
+
+// SpanData is a snapshot of an ended span for export
+type SpanData struct {
+	SpanContext   SpanContext
+	ParentSpanID  SpanID
+	Name          string
+	Kind          SpanKind
+	Start         time.Time
+	End           time.Time
+	Attributes    []Attribute
+	StatusCode    StatusCode
+	StatusMessage string
+}
+
+// Exporter sends ended spans to a trace backend
+type Exporter interface {
+	ExportSpans(ctx context.Context, serviceName string, spans []SpanData) error
+	Shutdown(ctx context.Context) error
+}
+
+// Tracer batches ended spans and periodically sends them to its exporter
+type Tracer struct {
+	serviceName   string
+	exporter      Exporter
+	queue         chan SpanData
+	batchSize     int
+	flushInterval time.Duration
+	dropped       uint64
+	started       int32
+	shutdown      chan struct{}
+	wg            sync.WaitGroup
+}
+
+type spanContextKey struct{}
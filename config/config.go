@@ -1410,6 +1410,107 @@ func (c *Config) CheckCurrencyStateManager() {
 	}
 }
 
+// CheckMetricsConfig ensures the metrics config is valid, or sets default
+// values
+func (c *Config) CheckMetricsConfig() {
+	m.Lock()
+	defer m.Unlock()
+	if c.Metrics.ListenAddress == "" {
+		c.Metrics.ListenAddress = defaultMetricsListenAddress
+	}
+}
+
+// CheckTracingConfig ensures the tracing config is valid, or sets default
+// values. The default file path is resolved against the data directory by the
+// engine
+func (c *Config) CheckTracingConfig() {
+	m.Lock()
+	defer m.Unlock()
+	c.Tracing.Exporter = strings.ToLower(c.Tracing.Exporter)
+	if c.Tracing.Exporter != TracingExporterFile && c.Tracing.Exporter != TracingExporterOTLP {
+		if c.Tracing.Exporter != "" {
+			log.Warnf(log.ConfigMgr, "Tracing exporter %q is invalid, defaulting to %s", c.Tracing.Exporter, TracingExporterFile)
+		}
+		c.Tracing.Exporter = TracingExporterFile
+	}
+	if c.Tracing.Endpoint == "" {
+		c.Tracing.Endpoint = defaultTracingEndpoint
+	}
+	if c.Tracing.ServiceName == "" {
+		c.Tracing.ServiceName = defaultTracingServiceName
+	}
+}
+
+// CheckSharedRateLimitsConfig sets the default shared rate limits directory
+func (c *Config) CheckSharedRateLimitsConfig() {
+	m.Lock()
+	defer m.Unlock()
+	if c.SharedRateLimits.Directory == "" {
+		c.SharedRateLimits.Directory = filepath.Join(os.TempDir(), DefaultSharedRateLimitsDirectoryName)
+	}
+}
+
+// CheckMarketDataCaptureConfig sets default market data capture intervals
+func (c *Config) CheckMarketDataCaptureConfig() {
+	m.Lock()
+	defer m.Unlock()
+	if c.MarketDataCapture.SnapshotInterval <= 0 {
+		c.MarketDataCapture.SnapshotInterval = defaultMarketDataSnapshotInterval
+	}
+	if c.MarketDataCapture.FlushInterval <= 0 {
+		c.MarketDataCapture.FlushInterval = defaultMarketDataFlushInterval
+	}
+}
+
+// CheckArbitrageConfig sets default arbitrage scanning settings
+func (c *Config) CheckArbitrageConfig() {
+	m.Lock()
+	defer m.Unlock()
+	if c.Arbitrage.ScanInterval <= 0 {
+		c.Arbitrage.ScanInterval = defaultArbitrageScanInterval
+	}
+	if c.Arbitrage.FallbackFeeRate <= 0 {
+		c.Arbitrage.FallbackFeeRate = defaultArbitrageFallbackFeeRate
+	}
+	if c.Arbitrage.MaximumOpportunities <= 0 {
+		c.Arbitrage.MaximumOpportunities = defaultArbitrageMaxOpportunities
+	}
+	if c.Arbitrage.MaximumQuoteAmount < 0 {
+		c.Arbitrage.MaximumQuoteAmount = 0
+	}
+}
+
+// CheckMarketMakerConfig sets default market making settings
+func (c *Config) CheckMarketMakerConfig() {
+	m.Lock()
+	defer m.Unlock()
+	if c.MarketMaker.QuoteInterval <= 0 {
+		c.MarketMaker.QuoteInterval = defaultMarketMakerQuoteInterval
+	}
+	for i := range c.MarketMaker.Markets {
+		if c.MarketMaker.Markets[i].FairValueModel == "" {
+			c.MarketMaker.Markets[i].FairValueModel = defaultMarketMakerFairValueModel
+		}
+		if c.MarketMaker.Markets[i].SpreadModel == "" {
+			c.MarketMaker.Markets[i].SpreadModel = defaultMarketMakerSpreadModel
+		}
+	}
+}
+
+// CheckStrategyManagerConfig sets default live strategy settings
+func (c *Config) CheckStrategyManagerConfig() {
+	m.Lock()
+	defer m.Unlock()
+	for i := range c.StrategyManager.Strategies {
+		if c.StrategyManager.Strategies[i].Interval <= 0 {
+			c.StrategyManager.Strategies[i].Interval = defaultLiveStrategyInterval
+		}
+		if c.StrategyManager.Strategies[i].WarmupCandles < 0 {
+			c.StrategyManager.Strategies[i].WarmupCandles = 0
+		}
+	}
+}
+
 // CheckOrderManagerConfig ensures the order manager is setup correctly
 func (c *Config) CheckOrderManagerConfig() {
 	m.Lock()
@@ -1426,6 +1527,35 @@ func (c *Config) CheckOrderManagerConfig() {
 		// for longer than a year
 		c.OrderManager.FuturesTrackingSeekDuration = -time.Hour * 24 * 365
 	}
+	limits := &c.OrderManager.RiskLimits
+	if limits.Currency.IsEmpty() {
+		// The currency config is checked afterwards, so fall back to its
+		// default when no display currency has been set yet
+		limits.Currency = c.Currency.FiatDisplayCurrency
+		if limits.Currency.IsEmpty() {
+			limits.Currency = currency.USD
+		}
+	}
+	if limits.MaxOrderNotional < 0 {
+		log.Warnf(log.ConfigMgr, "Order manager risk limit maxOrderNotional cannot be negative, disabling.")
+		limits.MaxOrderNotional = 0
+	}
+	if limits.MaxPositionPerPair < 0 {
+		log.Warnf(log.ConfigMgr, "Order manager risk limit maxPositionPerPair cannot be negative, disabling.")
+		limits.MaxPositionPerPair = 0
+	}
+	if limits.MaxOpenOrdersPerExchange < 0 {
+		log.Warnf(log.ConfigMgr, "Order manager risk limit maxOpenOrdersPerExchange cannot be negative, disabling.")
+		limits.MaxOpenOrdersPerExchange = 0
+	}
+	if limits.MaxDailyLoss < 0 {
+		log.Warnf(log.ConfigMgr, "Order manager risk limit maxDailyLoss cannot be negative, disabling.")
+		limits.MaxDailyLoss = 0
+	}
+	if limits.PriceBandPercentage < 0 {
+		log.Warnf(log.ConfigMgr, "Order manager risk limit priceBandPercentage cannot be negative, disabling.")
+		limits.PriceBandPercentage = 0
+	}
 }
 
 // CheckConnectionMonitorConfig checks and if zero value assigns default values
@@ -1776,6 +1906,13 @@ func (c *Config) CheckConfig() error {
 	c.CheckConnectionMonitorConfig()
 	c.CheckDataHistoryMonitorConfig()
 	c.CheckCurrencyStateManager()
+	c.CheckMetricsConfig()
+	c.CheckTracingConfig()
+	c.CheckSharedRateLimitsConfig()
+	c.CheckMarketDataCaptureConfig()
+	c.CheckArbitrageConfig()
+	c.CheckMarketMakerConfig()
+	c.CheckStrategyManagerConfig()
 	c.CheckOrderManagerConfig()
 	c.CheckCommunicationsConfig()
 	c.CheckClientBankAccounts()
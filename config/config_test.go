@@ -1744,6 +1744,99 @@ func TestCheckConnectionMonitorConfig(t *testing.T) {
 	}
 }
 
+func TestCheckMetricsConfig(t *testing.T) {
+	t.Parallel()
+	var c Config
+	c.CheckMetricsConfig()
+	assert.Equal(t, defaultMetricsListenAddress, c.Metrics.ListenAddress, "CheckMetricsConfig should set the default listen address")
+
+	c.Metrics.ListenAddress = "localhost:1337"
+	c.CheckMetricsConfig()
+	assert.Equal(t, "localhost:1337", c.Metrics.ListenAddress, "CheckMetricsConfig should not override a set listen address")
+}
+
+func TestCheckTracingConfig(t *testing.T) {
+	t.Parallel()
+	var c Config
+	c.CheckTracingConfig()
+	assert.Equal(t, TracingExporterFile, c.Tracing.Exporter, "CheckTracingConfig should set the default exporter")
+	assert.Equal(t, defaultTracingEndpoint, c.Tracing.Endpoint, "CheckTracingConfig should set the default endpoint")
+	assert.Equal(t, defaultTracingServiceName, c.Tracing.ServiceName, "CheckTracingConfig should set the default service name")
+
+	c.Tracing.Exporter = "OTLP"
+	c.CheckTracingConfig()
+	assert.Equal(t, TracingExporterOTLP, c.Tracing.Exporter, "CheckTracingConfig should accept exporters case insensitively")
+
+	c.Tracing.Exporter = "jaeger"
+	c.CheckTracingConfig()
+	assert.Equal(t, TracingExporterFile, c.Tracing.Exporter, "CheckTracingConfig should replace an invalid exporter")
+}
+
+func TestCheckSharedRateLimitsConfig(t *testing.T) {
+	t.Parallel()
+	var c Config
+	c.CheckSharedRateLimitsConfig()
+	assert.Equal(t, filepath.Join(os.TempDir(), DefaultSharedRateLimitsDirectoryName), c.SharedRateLimits.Directory, "CheckSharedRateLimitsConfig should set the default directory")
+
+	c.SharedRateLimits.Directory = "/var/run/gct"
+	c.CheckSharedRateLimitsConfig()
+	assert.Equal(t, "/var/run/gct", c.SharedRateLimits.Directory, "CheckSharedRateLimitsConfig should not override a set directory")
+}
+
+func TestCheckMarketDataCaptureConfig(t *testing.T) {
+	t.Parallel()
+	var c Config
+	c.CheckMarketDataCaptureConfig()
+	assert.Equal(t, defaultMarketDataSnapshotInterval, c.MarketDataCapture.SnapshotInterval, "CheckMarketDataCaptureConfig should set the default snapshot interval")
+	assert.Equal(t, defaultMarketDataFlushInterval, c.MarketDataCapture.FlushInterval, "CheckMarketDataCaptureConfig should set the default flush interval")
+
+	c.MarketDataCapture.SnapshotInterval = time.Hour
+	c.MarketDataCapture.FlushInterval = -time.Second
+	c.CheckMarketDataCaptureConfig()
+	assert.Equal(t, time.Hour, c.MarketDataCapture.SnapshotInterval, "CheckMarketDataCaptureConfig should not override a set interval")
+	assert.Equal(t, defaultMarketDataFlushInterval, c.MarketDataCapture.FlushInterval, "CheckMarketDataCaptureConfig should replace an invalid interval")
+}
+
+func TestCheckArbitrageConfig(t *testing.T) {
+	t.Parallel()
+	var c Config
+	c.Arbitrage.MaximumQuoteAmount = -1
+	c.CheckArbitrageConfig()
+	assert.Equal(t, defaultArbitrageScanInterval, c.Arbitrage.ScanInterval, "CheckArbitrageConfig should set the default scan interval")
+	assert.Equal(t, defaultArbitrageFallbackFeeRate, c.Arbitrage.FallbackFeeRate, "CheckArbitrageConfig should set the default fallback fee rate")
+	assert.Equal(t, defaultArbitrageMaxOpportunities, c.Arbitrage.MaximumOpportunities, "CheckArbitrageConfig should set the default maximum opportunities")
+	assert.Zero(t, c.Arbitrage.MaximumQuoteAmount, "CheckArbitrageConfig should remove a negative maximum quote amount")
+
+	c.Arbitrage.ScanInterval = time.Minute
+	c.Arbitrage.FallbackFeeRate = 0.001
+	c.CheckArbitrageConfig()
+	assert.Equal(t, time.Minute, c.Arbitrage.ScanInterval, "CheckArbitrageConfig should not override a set interval")
+	assert.Equal(t, 0.001, c.Arbitrage.FallbackFeeRate, "CheckArbitrageConfig should not override a set fee rate")
+}
+
+func TestCheckMarketMakerConfig(t *testing.T) {
+	t.Parallel()
+	var c Config
+	c.MarketMaker.Markets = []MarketMakerMarket{{}, {FairValueModel: "microprice", SpreadModel: "book"}}
+	c.CheckMarketMakerConfig()
+	assert.Equal(t, defaultMarketMakerQuoteInterval, c.MarketMaker.QuoteInterval, "CheckMarketMakerConfig should set the default quote interval")
+	assert.Equal(t, defaultMarketMakerFairValueModel, c.MarketMaker.Markets[0].FairValueModel, "CheckMarketMakerConfig should set the default fair value model")
+	assert.Equal(t, defaultMarketMakerSpreadModel, c.MarketMaker.Markets[0].SpreadModel, "CheckMarketMakerConfig should set the default spread model")
+	assert.Equal(t, "microprice", c.MarketMaker.Markets[1].FairValueModel, "CheckMarketMakerConfig should not override a set model")
+	assert.Equal(t, "book", c.MarketMaker.Markets[1].SpreadModel, "CheckMarketMakerConfig should not override a set model")
+}
+
+func TestCheckStrategyManagerConfig(t *testing.T) {
+	t.Parallel()
+	var c Config
+	c.StrategyManager.Strategies = []LiveStrategyConfig{{WarmupCandles: -1}, {Interval: time.Hour, WarmupCandles: 50}}
+	c.CheckStrategyManagerConfig()
+	assert.Equal(t, defaultLiveStrategyInterval, c.StrategyManager.Strategies[0].Interval, "CheckStrategyManagerConfig should set the default interval")
+	assert.Zero(t, c.StrategyManager.Strategies[0].WarmupCandles, "CheckStrategyManagerConfig should not allow negative warmup candles")
+	assert.Equal(t, time.Hour, c.StrategyManager.Strategies[1].Interval, "CheckStrategyManagerConfig should not override a set interval")
+	assert.Equal(t, int64(50), c.StrategyManager.Strategies[1].WarmupCandles, "CheckStrategyManagerConfig should not override set warmup candles")
+}
+
 func TestDefaultFilePath(t *testing.T) {
 	// This is tricky to test because we're dealing with a config file stored
 	// in a persons default directory and to properly test it, it would
@@ -2316,3 +2409,31 @@ func TestCheckSyncManagerConfig(t *testing.T) {
 		t.Errorf("received %v expected %v", c.SyncManagerConfig.NumWorkers, DefaultSyncerWorkers)
 	}
 }
+
+func TestCheckOrderManagerConfig(t *testing.T) {
+	t.Parallel()
+	c := &Config{
+		OrderManager: OrderManager{
+			RiskLimits: RiskLimits{
+				MaxOrderNotional:         -1,
+				MaxPositionPerPair:       -1,
+				MaxOpenOrdersPerExchange: -1,
+				MaxDailyLoss:             -1,
+				PriceBandPercentage:      5,
+			},
+		},
+	}
+	c.CheckOrderManagerConfig()
+	assert.True(t, *c.OrderManager.Enabled)
+	assert.Zero(t, c.OrderManager.RiskLimits.MaxOrderNotional)
+	assert.Zero(t, c.OrderManager.RiskLimits.MaxPositionPerPair)
+	assert.Zero(t, c.OrderManager.RiskLimits.MaxOpenOrdersPerExchange)
+	assert.Zero(t, c.OrderManager.RiskLimits.MaxDailyLoss)
+	assert.Equal(t, 5.0, c.OrderManager.RiskLimits.PriceBandPercentage)
+	assert.Equal(t, currency.USD, c.OrderManager.RiskLimits.Currency)
+
+	c.OrderManager.RiskLimits.Currency = currency.EMPTYCODE
+	c.Currency.FiatDisplayCurrency = currency.AUD
+	c.CheckOrderManagerConfig()
+	assert.Equal(t, currency.AUD, c.OrderManager.RiskLimits.Currency, "risk limits should default to the fiat display currency")
+}
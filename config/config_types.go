@@ -38,6 +38,25 @@ const (
 	defaultDataHistoryMonitorCheckTimer  = time.Minute
 	defaultCurrencyStateManagerDelay     = time.Minute
 	defaultMaxJobsPerCycle               = 5
+	defaultMetricsListenAddress          = "localhost:9054"
+	defaultTracingEndpoint               = "http://localhost:4318/v1/traces"
+	defaultTracingServiceName            = "gocryptotrader"
+	// TracingExporterFile writes spans to a file as OTLP/JSON
+	TracingExporterFile = "file"
+	// TracingExporterOTLP sends spans to an OTLP/HTTP collector
+	TracingExporterOTLP = "otlp"
+	// DefaultSharedRateLimitsDirectoryName is created in the temporary
+	// directory when a shared rate limits directory is not set
+	DefaultSharedRateLimitsDirectoryName = "gocryptotrader-ratelimits"
+	defaultMarketDataSnapshotInterval    = time.Minute * 15
+	defaultMarketDataFlushInterval       = time.Second * 10
+	defaultArbitrageScanInterval         = time.Second * 5
+	defaultArbitrageFallbackFeeRate      = 0.002
+	defaultArbitrageMaxOpportunities     = 50
+	defaultMarketMakerQuoteInterval      = time.Second * 5
+	defaultMarketMakerFairValueModel     = "mid"
+	defaultMarketMakerSpreadModel        = "fixed"
+	defaultLiveStrategyInterval          = time.Minute
 	DefaultOrderbookPublishPeriod        = time.Second * 10
 	// DefaultSyncerWorkers limits the number of sync workers
 	DefaultSyncerWorkers = 15
@@ -102,6 +121,13 @@ type Config struct {
 	DataHistoryManager   DataHistoryManager        `json:"dataHistoryManager"`
 	CurrencyStateManager CurrencyStateManager      `json:"currencyStateManager"`
 	Profiler             Profiler                  `json:"profiler"`
+	Metrics              MetricsConfig             `json:"metrics"`
+	Tracing              TracingConfig             `json:"tracing"`
+	SharedRateLimits     SharedRateLimitsConfig    `json:"sharedRateLimits"`
+	MarketDataCapture    MarketDataCaptureConfig   `json:"marketDataCapture"`
+	Arbitrage            ArbitrageConfig           `json:"arbitrage"`
+	MarketMaker          MarketMakerConfig         `json:"marketMaker"`
+	StrategyManager      StrategyManagerConfig     `json:"strategyManager"`
 	NTPClient            NTPClientConfig           `json:"ntpclient"`
 	GCTScript            gctscript.Config          `json:"gctscript"`
 	Currency             currency.Config           `json:"currencyConfig"`
@@ -130,6 +156,31 @@ type OrderManager struct {
 	FuturesTrackingSeekDuration   time.Duration `json:"futuresTrackingSeekDuration"`
 	RespectOrderHistoryLimits     *bool         `json:"respectOrderHistoryLimits"`
 	CancelOrdersOnShutdown        bool          `json:"cancelOrdersOnShutdown"`
+	RiskLimits                    RiskLimits    `json:"riskLimits"`
+}
+
+// RiskLimits defines the pre-trade risk checks applied to every order
+// submitted via the order manager. A zero value disables an individual limit
+type RiskLimits struct {
+	Enabled bool `json:"enabled"`
+	// KillSwitch rejects all new orders while set, regardless of Enabled
+	KillSwitch bool `json:"killSwitch"`
+	// Currency is the currency MaxOrderNotional and MaxDailyLoss are
+	// denominated in, defaulting to the fiat display currency
+	Currency currency.Code `json:"currency"`
+	// MaxOrderNotional is the maximum value of a single order
+	MaxOrderNotional float64 `json:"maxOrderNotional"`
+	// MaxPositionPerPair is the maximum absolute base amount held or
+	// working per exchange, asset and pair
+	MaxPositionPerPair float64 `json:"maxPositionPerPair"`
+	// MaxOpenOrdersPerExchange is the maximum number of active orders
+	MaxOpenOrdersPerExchange int64 `json:"maxOpenOrdersPerExchange"`
+	// MaxDailyLoss is the maximum loss across all orders traded since
+	// midnight UTC before new orders are rejected
+	MaxDailyLoss float64 `json:"maxDailyLoss"`
+	// PriceBandPercentage is the maximum deviation of an order price from
+	// the last ticker price
+	PriceBandPercentage float64 `json:"priceBandPercentage"`
 }
 
 // DataHistoryManager holds all information required for the data history manager
@@ -195,6 +246,7 @@ type Exchange struct {
 	Features                      *FeaturesConfig        `json:"features"`
 	BankAccounts                  []banking.Account      `json:"bankAccounts,omitempty"`
 	Orderbook                     Orderbook              `json:"orderbook"`
+	PaperTrading                  *PaperTrading          `json:"paperTrading,omitempty"`
 
 	// Deprecated settings which will be removed in a future update
 	AvailablePairs                   *currency.Pairs      `json:"availablePairs,omitempty"`
@@ -217,12 +269,141 @@ type Exchange struct {
 	WebsocketURL                     *string              `json:"websocketUrl,omitempty"`
 }
 
+// PaperTrading defines a simulated account which an exchange's orders are
+// routed to while public market data is still sourced from the exchange
+type PaperTrading struct {
+	Enabled bool `json:"enabled"`
+	// Balances are the starting spot balances keyed by currency code
+	Balances map[string]float64 `json:"balances"`
+	// MakerFee and TakerFee are fee rates charged in the quote currency
+	// e.g. 0.001 for 0.1%
+	MakerFee float64 `json:"makerFee"`
+	TakerFee float64 `json:"takerFee"`
+}
+
 // Profiler defines the profiler configuration to enable pprof
 type Profiler struct {
 	Enabled              bool `json:"enabled"`
 	MutexProfileFraction int  `json:"mutex_profile_fraction"`
 }
 
+// MetricsConfig defines the metrics subsystem configuration which serves
+// Prometheus metrics over HTTP
+type MetricsConfig struct {
+	Enabled       bool   `json:"enabled"`
+	ListenAddress string `json:"listenAddress"`
+}
+
+// TracingConfig defines how trace spans are exported. Exporter is either file,
+// which appends spans to FilePath, or otlp, which sends spans to the Endpoint
+// of an OTLP/HTTP collector
+type TracingConfig struct {
+	Enabled     bool   `json:"enabled"`
+	Exporter    string `json:"exporter"`
+	Endpoint    string `json:"endpoint"`
+	FilePath    string `json:"filePath"`
+	ServiceName string `json:"serviceName"`
+}
+
+// SharedRateLimitsConfig defines a directory in which exchange rate limiter
+// state is shared between GoCryptoTrader instances on the same host
+type SharedRateLimitsConfig struct {
+	Enabled   bool   `json:"enabled"`
+	Directory string `json:"directory"`
+}
+
+// MarketDataCaptureConfig defines the capture of orderbook and trade history
+// to compressed files. An empty directory defaults to the marketdata directory
+// within the data directory and no exchanges captures every enabled exchange
+type MarketDataCaptureConfig struct {
+	Enabled          bool          `json:"enabled"`
+	Directory        string        `json:"directory"`
+	Exchanges        []string      `json:"exchanges"`
+	SnapshotInterval time.Duration `json:"snapshotInterval"`
+	FlushInterval    time.Duration `json:"flushInterval"`
+}
+
+// ArbitrageConfig defines the detection of arbitrage opportunities between
+// exchanges and triangular opportunities within an exchange from orderbook
+// depth. No exchanges scans every enabled exchange and no assets scans spot
+type ArbitrageConfig struct {
+	Enabled                 bool               `json:"enabled"`
+	Exchanges               []string           `json:"exchanges"`
+	Assets                  []string           `json:"assets"`
+	ScanInterval            time.Duration      `json:"scanInterval"`
+	MinimumProfitPercentage float64            `json:"minimumProfitPercentage"`
+	MaximumQuoteAmount      float64            `json:"maximumQuoteAmount"`
+	IncludeWithdrawalFees   bool               `json:"includeWithdrawalFees"`
+	FallbackFeeRate         float64            `json:"fallbackFeeRate"`
+	TriangularStartAmounts  map[string]float64 `json:"triangularStartAmounts"`
+	MaximumOpportunities    int                `json:"maximumOpportunities"`
+}
+
+// MarketMakerConfig defines continuous two-sided quoting of markets around a
+// fair value derived from their orderbook depth
+type MarketMakerConfig struct {
+	Enabled       bool                `json:"enabled"`
+	QuoteInterval time.Duration       `json:"quoteInterval"`
+	Markets       []MarketMakerMarket `json:"markets"`
+}
+
+// MarketMakerMarket defines the quoting of a pair on an exchange. Amounts and
+// inventory are in the base currency
+type MarketMakerMarket struct {
+	Exchange string        `json:"exchange"`
+	Pair     currency.Pair `json:"pair"`
+	Asset    string        `json:"asset"`
+	// FairValueModel and SpreadModel are the names of registered models.
+	// Parameters are passed to custom models
+	FairValueModel string             `json:"fairValueModel"`
+	SpreadModel    string             `json:"spreadModel"`
+	Parameters     map[string]float64 `json:"parameters,omitempty"`
+	// SpreadPercentage is the distance between the bid and ask as a
+	// percentage of the fair value, the book spread model uses it as a minimum
+	SpreadPercentage float64 `json:"spreadPercentage"`
+	OrderAmount      float64 `json:"orderAmount"`
+	// Quotes are skewed by up to InventorySkewPercentage of the fair value as
+	// the inventory deviates from its target. Once the deviation reaches
+	// MaximumInventoryDeviation the side which would increase it is withdrawn
+	TargetInventory           float64 `json:"targetInventory"`
+	MaximumInventoryDeviation float64 `json:"maximumInventoryDeviation"`
+	InventorySkewPercentage   float64 `json:"inventorySkewPercentage"`
+	// RequoteThresholdPercentage is how far the desired price can move from a
+	// resting quote before the quote is amended
+	RequoteThresholdPercentage float64 `json:"requoteThresholdPercentage"`
+	PostOnly                   bool    `json:"postOnly"`
+	// Paper prevents quoting unless the exchange is paper trading
+	Paper bool `json:"paper"`
+}
+
+// StrategyManagerConfig defines strategies run by the engine against live
+// market data
+type StrategyManagerConfig struct {
+	Enabled bool `json:"enabled"`
+	// Strategies are started with the strategy manager, further strategies
+	// can be started over gRPC
+	Strategies []LiveStrategyConfig `json:"strategies"`
+}
+
+// LiveStrategyConfig defines a strategy run on a pair of an exchange
+type LiveStrategyConfig struct {
+	// Strategy is the name of a backtester strategy
+	Strategy       string                 `json:"strategy"`
+	Exchange       string                 `json:"exchange"`
+	Pair           currency.Pair          `json:"pair"`
+	Asset          string                 `json:"asset"`
+	Interval       time.Duration          `json:"interval"`
+	CustomSettings map[string]interface{} `json:"customSettings,omitempty"`
+	// OrderAmount is the base amount traded when the strategy does not size
+	// its own orders
+	OrderAmount float64 `json:"orderAmount"`
+	// WarmupCandles is the number of historic candles retrieved before the
+	// first live candle closes
+	WarmupCandles int64 `json:"warmupCandles"`
+	// Paper prevents trading unless the exchange is paper trading
+	Paper bool `json:"paper"`
+}
+
 // NTPClientConfig defines a network time protocol configuration to allow for
 // positive and negative differences
 type NTPClientConfig struct {
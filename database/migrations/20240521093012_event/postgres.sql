@@ -0,0 +1,26 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS event
+(
+    id bigserial PRIMARY KEY NOT NULL,
+    exchange_name varchar NOT NULL,
+    item varchar NOT NULL,
+    action varchar NOT NULL,
+    definition TEXT NOT NULL,
+    executed boolean NOT NULL,
+    execution_count BIGINT NOT NULL,
+    last_executed_at TIMESTAMPTZ NULL,
+    created TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS event_execution
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id BIGINT NOT NULL REFERENCES event(id) ON DELETE CASCADE,
+    action varchar NOT NULL,
+    success boolean NOT NULL,
+    result TEXT NULL,
+    executed_at TIMESTAMPTZ NOT NULL
+);
+-- +goose Down
+DROP TABLE event_execution;
+DROP TABLE event;
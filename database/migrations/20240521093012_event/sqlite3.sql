@@ -0,0 +1,28 @@
+-- +goose Up
+CREATE TABLE event
+(
+    id integer NOT NULL primary key AUTOINCREMENT,
+    exchange_name text NOT NULL,
+    item text NOT NULL,
+    action text NOT NULL,
+    definition text NOT NULL,
+    executed integer NOT NULL,
+    execution_count integer NOT NULL,
+    last_executed_at timestamp NULL,
+    created timestamp NOT NULL default CURRENT_TIMESTAMP
+);
+
+CREATE TABLE event_execution
+(
+    id text NOT NULL primary key,
+    event_id integer NOT NULL,
+    action text NOT NULL,
+    success integer NOT NULL,
+    result text NULL,
+    executed_at timestamp NOT NULL,
+    FOREIGN KEY(event_id) REFERENCES event(id) ON DELETE CASCADE
+);
+
+-- +goose Down
+DROP TABLE event_execution;
+DROP TABLE event;
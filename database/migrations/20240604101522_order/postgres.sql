@@ -0,0 +1,68 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS orders
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    exchange_name varchar NOT NULL,
+    order_id varchar NOT NULL,
+    client_order_id varchar NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    asset varchar NOT NULL,
+    side varchar NOT NULL,
+    type varchar NOT NULL,
+    status varchar NOT NULL,
+    price DOUBLE PRECISION NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    executed_amount DOUBLE PRECISION NOT NULL,
+    remaining_amount DOUBLE PRECISION NOT NULL,
+    average_executed_price DOUBLE PRECISION NOT NULL,
+    fee DOUBLE PRECISION NOT NULL,
+    cost DOUBLE PRECISION NOT NULL,
+    date TIMESTAMPTZ NOT NULL,
+    last_updated TIMESTAMPTZ NOT NULL,
+    CONSTRAINT uniqueexchangeorder
+        unique(exchange_name, order_id, asset, base, quote)
+);
+
+CREATE TABLE IF NOT EXISTS order_history
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    exchange_name varchar NOT NULL,
+    order_id varchar NOT NULL,
+    status varchar NOT NULL,
+    price DOUBLE PRECISION NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    executed_amount DOUBLE PRECISION NOT NULL,
+    remaining_amount DOUBLE PRECISION NOT NULL,
+    average_executed_price DOUBLE PRECISION NOT NULL,
+    fee DOUBLE PRECISION NOT NULL,
+    cost DOUBLE PRECISION NOT NULL,
+    last_updated TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX order_history_exchange_order ON order_history (exchange_name, order_id);
+
+CREATE TABLE IF NOT EXISTS fill
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    exchange_name varchar NOT NULL,
+    order_id varchar NOT NULL,
+    client_order_id varchar NULL,
+    trade_id varchar NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    asset varchar NOT NULL,
+    side varchar NOT NULL,
+    price DOUBLE PRECISION NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    fee DOUBLE PRECISION NOT NULL,
+    timestamp TIMESTAMPTZ NOT NULL,
+    CONSTRAINT uniquefilltradeid
+        unique(exchange_name, trade_id, asset, base, quote)
+);
+
+CREATE INDEX fill_exchange_order ON fill (exchange_name, order_id);
+-- +goose Down
+DROP TABLE fill;
+DROP TABLE order_history;
+DROP TABLE orders;
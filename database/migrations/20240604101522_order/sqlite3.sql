@@ -0,0 +1,69 @@
+-- +goose Up
+CREATE TABLE orders
+(
+    id text NOT NULL primary key,
+    exchange_name text NOT NULL,
+    order_id text NOT NULL,
+    client_order_id text NULL,
+    base text NOT NULL,
+    quote text NOT NULL,
+    asset text NOT NULL,
+    side text NOT NULL,
+    type text NOT NULL,
+    status text NOT NULL,
+    price real NOT NULL,
+    amount real NOT NULL,
+    executed_amount real NOT NULL,
+    remaining_amount real NOT NULL,
+    average_executed_price real NOT NULL,
+    fee real NOT NULL,
+    cost real NOT NULL,
+    date timestamp NOT NULL,
+    last_updated timestamp NOT NULL,
+    CONSTRAINT uniqueexchangeorder
+        unique(exchange_name, order_id, asset, base, quote)
+);
+
+CREATE TABLE order_history
+(
+    id text NOT NULL primary key,
+    exchange_name text NOT NULL,
+    order_id text NOT NULL,
+    status text NOT NULL,
+    price real NOT NULL,
+    amount real NOT NULL,
+    executed_amount real NOT NULL,
+    remaining_amount real NOT NULL,
+    average_executed_price real NOT NULL,
+    fee real NOT NULL,
+    cost real NOT NULL,
+    last_updated timestamp NOT NULL
+);
+
+CREATE INDEX order_history_exchange_order ON order_history (exchange_name, order_id);
+
+CREATE TABLE fill
+(
+    id text NOT NULL primary key,
+    exchange_name text NOT NULL,
+    order_id text NOT NULL,
+    client_order_id text NULL,
+    trade_id text NULL,
+    base text NOT NULL,
+    quote text NOT NULL,
+    asset text NOT NULL,
+    side text NOT NULL,
+    price real NOT NULL,
+    amount real NOT NULL,
+    fee real NOT NULL,
+    timestamp timestamp NOT NULL,
+    CONSTRAINT uniquefilltradeid
+        unique(exchange_name, trade_id, asset, base, quote) ON CONFLICT IGNORE
+);
+
+CREATE INDEX fill_exchange_order ON fill (exchange_name, order_id);
+
+-- +goose Down
+DROP TABLE fill;
+DROP TABLE order_history;
+DROP TABLE orders;
@@ -0,0 +1,15 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS cost_basis_lot
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    exchange_name varchar NOT NULL,
+    currency varchar(30) NOT NULL,
+    trade_id varchar NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    cost DOUBLE PRECISION NOT NULL,
+    acquired TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX cost_basis_lot_acquired ON cost_basis_lot (acquired);
+-- +goose Down
+DROP TABLE cost_basis_lot;
@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE cost_basis_lot
+(
+    id text NOT NULL primary key,
+    exchange_name text NOT NULL,
+    currency text NOT NULL,
+    trade_id text NOT NULL,
+    amount real NOT NULL,
+    cost real NOT NULL,
+    acquired timestamp NOT NULL
+);
+
+CREATE INDEX cost_basis_lot_acquired ON cost_basis_lot (acquired);
+
+-- +goose Down
+DROP TABLE cost_basis_lot;
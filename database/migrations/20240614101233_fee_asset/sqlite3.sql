@@ -0,0 +1,10 @@
+-- +goose Up
+ALTER TABLE orders
+    ADD fee_asset TEXT;
+ALTER TABLE fill
+    ADD fee_asset TEXT;
+-- +goose Down
+ALTER TABLE fill
+    DROP fee_asset;
+ALTER TABLE orders
+    DROP fee_asset;
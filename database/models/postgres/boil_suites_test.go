@@ -13,82 +13,162 @@ import "testing"
 // Separating the tests thusly grants avoidance of Postgres deadlocks.
 func TestParent(t *testing.T) {
 	t.Run("AuditEvents", testAuditEvents)
+	t.Run("Events", testEvents)
+	t.Run("EventExecutions", testEventExecutions)
 	t.Run("Exchanges", testExchanges)
+	t.Run("Fills", testFills)
+	t.Run("CostBasisLots", testCostBasisLots)
+	t.Run("OrderHistories", testOrderHistories)
+	t.Run("Orders", testOrders)
 	t.Run("Scripts", testScripts)
 }
 
 func TestDelete(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsDelete)
+	t.Run("Events", testEventsDelete)
+	t.Run("EventExecutions", testEventExecutionsDelete)
 	t.Run("Exchanges", testExchangesDelete)
+	t.Run("Fills", testFillsDelete)
+	t.Run("CostBasisLots", testCostBasisLotsDelete)
+	t.Run("OrderHistories", testOrderHistoriesDelete)
+	t.Run("Orders", testOrdersDelete)
 	t.Run("Scripts", testScriptsDelete)
 }
 
 func TestQueryDeleteAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsQueryDeleteAll)
+	t.Run("Events", testEventsQueryDeleteAll)
+	t.Run("EventExecutions", testEventExecutionsQueryDeleteAll)
 	t.Run("Exchanges", testExchangesQueryDeleteAll)
+	t.Run("Fills", testFillsQueryDeleteAll)
+	t.Run("CostBasisLots", testCostBasisLotsQueryDeleteAll)
+	t.Run("OrderHistories", testOrderHistoriesQueryDeleteAll)
+	t.Run("Orders", testOrdersQueryDeleteAll)
 	t.Run("Scripts", testScriptsQueryDeleteAll)
 }
 
 func TestSliceDeleteAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSliceDeleteAll)
+	t.Run("Events", testEventsSliceDeleteAll)
+	t.Run("EventExecutions", testEventExecutionsSliceDeleteAll)
 	t.Run("Exchanges", testExchangesSliceDeleteAll)
+	t.Run("Fills", testFillsSliceDeleteAll)
+	t.Run("CostBasisLots", testCostBasisLotsSliceDeleteAll)
+	t.Run("OrderHistories", testOrderHistoriesSliceDeleteAll)
+	t.Run("Orders", testOrdersSliceDeleteAll)
 	t.Run("Scripts", testScriptsSliceDeleteAll)
 }
 
 func TestExists(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsExists)
+	t.Run("Events", testEventsExists)
+	t.Run("EventExecutions", testEventExecutionsExists)
 	t.Run("Exchanges", testExchangesExists)
+	t.Run("Fills", testFillsExists)
+	t.Run("CostBasisLots", testCostBasisLotsExists)
+	t.Run("OrderHistories", testOrderHistoriesExists)
+	t.Run("Orders", testOrdersExists)
 	t.Run("Scripts", testScriptsExists)
 }
 
 func TestFind(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsFind)
+	t.Run("Events", testEventsFind)
+	t.Run("EventExecutions", testEventExecutionsFind)
 	t.Run("Exchanges", testExchangesFind)
+	t.Run("Fills", testFillsFind)
+	t.Run("CostBasisLots", testCostBasisLotsFind)
+	t.Run("OrderHistories", testOrderHistoriesFind)
+	t.Run("Orders", testOrdersFind)
 	t.Run("Scripts", testScriptsFind)
 }
 
 func TestBind(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsBind)
+	t.Run("Events", testEventsBind)
+	t.Run("EventExecutions", testEventExecutionsBind)
 	t.Run("Exchanges", testExchangesBind)
+	t.Run("Fills", testFillsBind)
+	t.Run("CostBasisLots", testCostBasisLotsBind)
+	t.Run("OrderHistories", testOrderHistoriesBind)
+	t.Run("Orders", testOrdersBind)
 	t.Run("Scripts", testScriptsBind)
 }
 
 func TestOne(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsOne)
+	t.Run("Events", testEventsOne)
+	t.Run("EventExecutions", testEventExecutionsOne)
 	t.Run("Exchanges", testExchangesOne)
+	t.Run("Fills", testFillsOne)
+	t.Run("CostBasisLots", testCostBasisLotsOne)
+	t.Run("OrderHistories", testOrderHistoriesOne)
+	t.Run("Orders", testOrdersOne)
 	t.Run("Scripts", testScriptsOne)
 }
 
 func TestAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsAll)
+	t.Run("Events", testEventsAll)
+	t.Run("EventExecutions", testEventExecutionsAll)
 	t.Run("Exchanges", testExchangesAll)
+	t.Run("Fills", testFillsAll)
+	t.Run("CostBasisLots", testCostBasisLotsAll)
+	t.Run("OrderHistories", testOrderHistoriesAll)
+	t.Run("Orders", testOrdersAll)
 	t.Run("Scripts", testScriptsAll)
 }
 
 func TestCount(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsCount)
+	t.Run("Events", testEventsCount)
+	t.Run("EventExecutions", testEventExecutionsCount)
 	t.Run("Exchanges", testExchangesCount)
+	t.Run("Fills", testFillsCount)
+	t.Run("CostBasisLots", testCostBasisLotsCount)
+	t.Run("OrderHistories", testOrderHistoriesCount)
+	t.Run("Orders", testOrdersCount)
 	t.Run("Scripts", testScriptsCount)
 }
 
 func TestHooks(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsHooks)
+	t.Run("Events", testEventsHooks)
+	t.Run("EventExecutions", testEventExecutionsHooks)
 	t.Run("Exchanges", testExchangesHooks)
+	t.Run("Fills", testFillsHooks)
+	t.Run("CostBasisLots", testCostBasisLotsHooks)
+	t.Run("OrderHistories", testOrderHistoriesHooks)
+	t.Run("Orders", testOrdersHooks)
 	t.Run("Scripts", testScriptsHooks)
 }
 
 func TestInsert(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsInsert)
 	t.Run("AuditEvents", testAuditEventsInsertWhitelist)
+	t.Run("Events", testEventsInsert)
+	t.Run("Events", testEventsInsertWhitelist)
+	t.Run("EventExecutions", testEventExecutionsInsert)
+	t.Run("EventExecutions", testEventExecutionsInsertWhitelist)
 	t.Run("Exchanges", testExchangesInsert)
 	t.Run("Exchanges", testExchangesInsertWhitelist)
+	t.Run("Fills", testFillsInsert)
+	t.Run("CostBasisLots", testCostBasisLotsInsert)
+	t.Run("Fills", testFillsInsertWhitelist)
+	t.Run("CostBasisLots", testCostBasisLotsInsertWhitelist)
+	t.Run("OrderHistories", testOrderHistoriesInsert)
+	t.Run("OrderHistories", testOrderHistoriesInsertWhitelist)
+	t.Run("Orders", testOrdersInsert)
+	t.Run("Orders", testOrdersInsertWhitelist)
 	t.Run("Scripts", testScriptsInsert)
 	t.Run("Scripts", testScriptsInsertWhitelist)
 }
 
 // TestToOne tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToOne(t *testing.T) {}
+func TestToOne(t *testing.T) {
+	t.Run("EventExecutionToEventUsingEvent", testEventExecutionToOneEventUsingEvent)
+}
 
 // TestOneToOne tests cannot be run in parallel
 // or deadlocks can occur.
@@ -96,11 +176,15 @@ func TestOneToOne(t *testing.T) {}
 
 // TestToMany tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToMany(t *testing.T) {}
+func TestToMany(t *testing.T) {
+	t.Run("EventToEventExecutions", testEventToManyEventExecutions)
+}
 
 // TestToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToOneSet(t *testing.T) {}
+func TestToOneSet(t *testing.T) {
+	t.Run("EventExecutionToEventUsingEventExecutions", testEventExecutionToOneSetOpEventUsingEvent)
+}
 
 // TestToOneRemove tests cannot be run in parallel
 // or deadlocks can occur.
@@ -116,7 +200,9 @@ func TestOneToOneRemove(t *testing.T) {}
 
 // TestToManyAdd tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToManyAdd(t *testing.T) {}
+func TestToManyAdd(t *testing.T) {
+	t.Run("EventToEventExecutions", testEventToManyAddOpEventExecutions)
+}
 
 // TestToManySet tests cannot be run in parallel
 // or deadlocks can occur.
@@ -128,29 +214,59 @@ func TestToManyRemove(t *testing.T) {}
 
 func TestReload(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsReload)
+	t.Run("Events", testEventsReload)
+	t.Run("EventExecutions", testEventExecutionsReload)
 	t.Run("Exchanges", testExchangesReload)
+	t.Run("Fills", testFillsReload)
+	t.Run("CostBasisLots", testCostBasisLotsReload)
+	t.Run("OrderHistories", testOrderHistoriesReload)
+	t.Run("Orders", testOrdersReload)
 }
 
 func TestReloadAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsReloadAll)
+	t.Run("Events", testEventsReloadAll)
+	t.Run("EventExecutions", testEventExecutionsReloadAll)
 	t.Run("Exchanges", testExchangesReloadAll)
+	t.Run("Fills", testFillsReloadAll)
+	t.Run("CostBasisLots", testCostBasisLotsReloadAll)
+	t.Run("OrderHistories", testOrderHistoriesReloadAll)
+	t.Run("Orders", testOrdersReloadAll)
 	t.Run("Scripts", testScriptsReloadAll)
 }
 
 func TestSelect(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSelect)
+	t.Run("Events", testEventsSelect)
+	t.Run("EventExecutions", testEventExecutionsSelect)
 	t.Run("Exchanges", testExchangesSelect)
+	t.Run("Fills", testFillsSelect)
+	t.Run("CostBasisLots", testCostBasisLotsSelect)
+	t.Run("OrderHistories", testOrderHistoriesSelect)
+	t.Run("Orders", testOrdersSelect)
 	t.Run("Scripts", testScriptsSelect)
 }
 
 func TestUpdate(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsUpdate)
+	t.Run("Events", testEventsUpdate)
+	t.Run("EventExecutions", testEventExecutionsUpdate)
 	t.Run("Exchanges", testExchangesUpdate)
+	t.Run("Fills", testFillsUpdate)
+	t.Run("CostBasisLots", testCostBasisLotsUpdate)
+	t.Run("OrderHistories", testOrderHistoriesUpdate)
+	t.Run("Orders", testOrdersUpdate)
 	t.Run("Scripts", testScriptsUpdate)
 }
 
 func TestSliceUpdateAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSliceUpdateAll)
+	t.Run("Events", testEventsSliceUpdateAll)
+	t.Run("EventExecutions", testEventExecutionsSliceUpdateAll)
 	t.Run("Exchanges", testExchangesSliceUpdateAll)
+	t.Run("Fills", testFillsSliceUpdateAll)
+	t.Run("CostBasisLots", testCostBasisLotsSliceUpdateAll)
+	t.Run("OrderHistories", testOrderHistoriesSliceUpdateAll)
+	t.Run("Orders", testOrdersSliceUpdateAll)
 	t.Run("Scripts", testScriptsSliceUpdateAll)
 }
@@ -0,0 +1,889 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+// CostBasisLot is an object representing the database table.
+type CostBasisLot struct {
+	ID           string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ExchangeName string    `boil:"exchange_name" json:"exchange_name" toml:"exchange_name" yaml:"exchange_name"`
+	Currency     string    `boil:"currency" json:"currency" toml:"currency" yaml:"currency"`
+	TradeID      string    `boil:"trade_id" json:"trade_id" toml:"trade_id" yaml:"trade_id"`
+	Amount       float64   `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	Cost         float64   `boil:"cost" json:"cost" toml:"cost" yaml:"cost"`
+	Acquired     time.Time `boil:"acquired" json:"acquired" toml:"acquired" yaml:"acquired"`
+
+	R *costBasisLotR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L costBasisLotL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var CostBasisLotColumns = struct {
+	ID           string
+	ExchangeName string
+	Currency     string
+	TradeID      string
+	Amount       string
+	Cost         string
+	Acquired     string
+}{
+	ID:           "id",
+	ExchangeName: "exchange_name",
+	Currency:     "currency",
+	TradeID:      "trade_id",
+	Amount:       "amount",
+	Cost:         "cost",
+	Acquired:     "acquired",
+}
+
+// Generated where
+
+var CostBasisLotWhere = struct {
+	ID           whereHelperstring
+	ExchangeName whereHelperstring
+	Currency     whereHelperstring
+	TradeID      whereHelperstring
+	Amount       whereHelperfloat64
+	Cost         whereHelperfloat64
+	Acquired     whereHelpertime_Time
+}{
+	ID:           whereHelperstring{field: "\"cost_basis_lot\".\"id\""},
+	ExchangeName: whereHelperstring{field: "\"cost_basis_lot\".\"exchange_name\""},
+	Currency:     whereHelperstring{field: "\"cost_basis_lot\".\"currency\""},
+	TradeID:      whereHelperstring{field: "\"cost_basis_lot\".\"trade_id\""},
+	Amount:       whereHelperfloat64{field: "\"cost_basis_lot\".\"amount\""},
+	Cost:         whereHelperfloat64{field: "\"cost_basis_lot\".\"cost\""},
+	Acquired:     whereHelpertime_Time{field: "\"cost_basis_lot\".\"acquired\""},
+}
+
+// CostBasisLotRels is where relationship names are stored.
+var CostBasisLotRels = struct {
+}{}
+
+// costBasisLotR is where relationships are stored.
+type costBasisLotR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*costBasisLotR) NewStruct() *costBasisLotR {
+	return &costBasisLotR{}
+}
+
+// costBasisLotL is where Load methods for each relationship are stored.
+type costBasisLotL struct{}
+
+var (
+	costBasisLotAllColumns            = []string{"id", "exchange_name", "currency", "trade_id", "amount", "cost", "acquired"}
+	costBasisLotColumnsWithoutDefault = []string{"exchange_name", "currency", "trade_id", "amount", "cost", "acquired"}
+	costBasisLotColumnsWithDefault    = []string{"id"}
+	costBasisLotPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// CostBasisLotSlice is an alias for a slice of pointers to CostBasisLot.
+	// This should generally be used opposed to []CostBasisLot.
+	CostBasisLotSlice []*CostBasisLot
+	// CostBasisLotHook is the signature for custom CostBasisLot hook methods
+	CostBasisLotHook func(context.Context, boil.ContextExecutor, *CostBasisLot) error
+
+	costBasisLotQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	costBasisLotType                 = reflect.TypeOf(&CostBasisLot{})
+	costBasisLotMapping              = queries.MakeStructMapping(costBasisLotType)
+	costBasisLotPrimaryKeyMapping, _ = queries.BindMapping(costBasisLotType, costBasisLotMapping, costBasisLotPrimaryKeyColumns)
+	costBasisLotInsertCacheMut       sync.RWMutex
+	costBasisLotInsertCache          = make(map[string]insertCache)
+	costBasisLotUpdateCacheMut       sync.RWMutex
+	costBasisLotUpdateCache          = make(map[string]updateCache)
+	costBasisLotUpsertCacheMut       sync.RWMutex
+	costBasisLotUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var costBasisLotBeforeInsertHooks []CostBasisLotHook
+var costBasisLotBeforeUpdateHooks []CostBasisLotHook
+var costBasisLotBeforeDeleteHooks []CostBasisLotHook
+var costBasisLotBeforeUpsertHooks []CostBasisLotHook
+
+var costBasisLotAfterInsertHooks []CostBasisLotHook
+var costBasisLotAfterSelectHooks []CostBasisLotHook
+var costBasisLotAfterUpdateHooks []CostBasisLotHook
+var costBasisLotAfterDeleteHooks []CostBasisLotHook
+var costBasisLotAfterUpsertHooks []CostBasisLotHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *CostBasisLot) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range costBasisLotBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *CostBasisLot) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range costBasisLotBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *CostBasisLot) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range costBasisLotBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *CostBasisLot) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range costBasisLotBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *CostBasisLot) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range costBasisLotAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *CostBasisLot) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range costBasisLotAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *CostBasisLot) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range costBasisLotAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *CostBasisLot) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range costBasisLotAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *CostBasisLot) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range costBasisLotAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddCostBasisLotHook registers your hook function for all future operations.
+func AddCostBasisLotHook(hookPoint boil.HookPoint, costBasisLotHook CostBasisLotHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		costBasisLotBeforeInsertHooks = append(costBasisLotBeforeInsertHooks, costBasisLotHook)
+	case boil.BeforeUpdateHook:
+		costBasisLotBeforeUpdateHooks = append(costBasisLotBeforeUpdateHooks, costBasisLotHook)
+	case boil.BeforeDeleteHook:
+		costBasisLotBeforeDeleteHooks = append(costBasisLotBeforeDeleteHooks, costBasisLotHook)
+	case boil.BeforeUpsertHook:
+		costBasisLotBeforeUpsertHooks = append(costBasisLotBeforeUpsertHooks, costBasisLotHook)
+	case boil.AfterInsertHook:
+		costBasisLotAfterInsertHooks = append(costBasisLotAfterInsertHooks, costBasisLotHook)
+	case boil.AfterSelectHook:
+		costBasisLotAfterSelectHooks = append(costBasisLotAfterSelectHooks, costBasisLotHook)
+	case boil.AfterUpdateHook:
+		costBasisLotAfterUpdateHooks = append(costBasisLotAfterUpdateHooks, costBasisLotHook)
+	case boil.AfterDeleteHook:
+		costBasisLotAfterDeleteHooks = append(costBasisLotAfterDeleteHooks, costBasisLotHook)
+	case boil.AfterUpsertHook:
+		costBasisLotAfterUpsertHooks = append(costBasisLotAfterUpsertHooks, costBasisLotHook)
+	}
+}
+
+// One returns a single cost_basis_lot record from the query.
+func (q costBasisLotQuery) One(ctx context.Context, exec boil.ContextExecutor) (*CostBasisLot, error) {
+	o := &CostBasisLot{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: failed to execute a one query for cost_basis_lot")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all CostBasisLot records from the query.
+func (q costBasisLotQuery) All(ctx context.Context, exec boil.ContextExecutor) (CostBasisLotSlice, error) {
+	var o []*CostBasisLot
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "postgres: failed to assign all query results to CostBasisLot slice")
+	}
+
+	if len(costBasisLotAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all CostBasisLot records in the query.
+func (q costBasisLotQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to count cost_basis_lot rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q costBasisLotQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: failed to check if cost_basis_lot exists")
+	}
+
+	return count > 0, nil
+}
+
+// CostBasisLots retrieves all the records using an executor.
+func CostBasisLots(mods ...qm.QueryMod) costBasisLotQuery {
+	mods = append(mods, qm.From("\"cost_basis_lot\""))
+	return costBasisLotQuery{NewQuery(mods...)}
+}
+
+// FindCostBasisLot retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindCostBasisLot(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*CostBasisLot, error) {
+	costBasisLotObj := &CostBasisLot{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"cost_basis_lot\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, costBasisLotObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: unable to select from cost_basis_lot")
+	}
+
+	return costBasisLotObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *CostBasisLot) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no cost_basis_lot provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(costBasisLotColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	costBasisLotInsertCacheMut.RLock()
+	cache, cached := costBasisLotInsertCache[key]
+	costBasisLotInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			costBasisLotAllColumns,
+			costBasisLotColumnsWithDefault,
+			costBasisLotColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(costBasisLotType, costBasisLotMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(costBasisLotType, costBasisLotMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"cost_basis_lot\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"cost_basis_lot\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to insert into cost_basis_lot")
+	}
+
+	if !cached {
+		costBasisLotInsertCacheMut.Lock()
+		costBasisLotInsertCache[key] = cache
+		costBasisLotInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the CostBasisLot.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *CostBasisLot) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	costBasisLotUpdateCacheMut.RLock()
+	cache, cached := costBasisLotUpdateCache[key]
+	costBasisLotUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			costBasisLotAllColumns,
+			costBasisLotPrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("postgres: unable to update cost_basis_lot, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"cost_basis_lot\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, costBasisLotPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(costBasisLotType, costBasisLotMapping, append(wl, costBasisLotPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update cost_basis_lot row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by update for cost_basis_lot")
+	}
+
+	if !cached {
+		costBasisLotUpdateCacheMut.Lock()
+		costBasisLotUpdateCache[key] = cache
+		costBasisLotUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q costBasisLotQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all for cost_basis_lot")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected for cost_basis_lot")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o CostBasisLotSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("postgres: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), costBasisLotPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"cost_basis_lot\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, costBasisLotPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all in cost_basis_lot slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected all in update all cost_basis_lot")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *CostBasisLot) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no cost_basis_lot provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(costBasisLotColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	costBasisLotUpsertCacheMut.RLock()
+	cache, cached := costBasisLotUpsertCache[key]
+	costBasisLotUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			costBasisLotAllColumns,
+			costBasisLotColumnsWithDefault,
+			costBasisLotColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			costBasisLotAllColumns,
+			costBasisLotPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("postgres: unable to upsert cost_basis_lot, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(costBasisLotPrimaryKeyColumns))
+			copy(conflict, costBasisLotPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"cost_basis_lot\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(costBasisLotType, costBasisLotMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(costBasisLotType, costBasisLotMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to upsert cost_basis_lot")
+	}
+
+	if !cached {
+		costBasisLotUpsertCacheMut.Lock()
+		costBasisLotUpsertCache[key] = cache
+		costBasisLotUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single CostBasisLot record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *CostBasisLot) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("postgres: no CostBasisLot provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), costBasisLotPrimaryKeyMapping)
+	sql := "DELETE FROM \"cost_basis_lot\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete from cost_basis_lot")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by delete for cost_basis_lot")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q costBasisLotQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("postgres: no costBasisLotQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from cost_basis_lot")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for cost_basis_lot")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o CostBasisLotSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(costBasisLotBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), costBasisLotPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"cost_basis_lot\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, costBasisLotPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from cost_basis_lot slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for cost_basis_lot")
+	}
+
+	if len(costBasisLotAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *CostBasisLot) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindCostBasisLot(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *CostBasisLotSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := CostBasisLotSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), costBasisLotPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"cost_basis_lot\".* FROM \"cost_basis_lot\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, costBasisLotPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to reload all in CostBasisLotSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// CostBasisLotExists checks if the CostBasisLot row exists.
+func CostBasisLotExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"cost_basis_lot\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: unable to check if cost_basis_lot exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,1051 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+	"github.com/volatiletech/null"
+)
+
+// EventExecution is an object representing the database table.
+type EventExecution struct {
+	ID         string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	EventID    int64       `boil:"event_id" json:"event_id" toml:"event_id" yaml:"event_id"`
+	Action     string      `boil:"action" json:"action" toml:"action" yaml:"action"`
+	Success    bool        `boil:"success" json:"success" toml:"success" yaml:"success"`
+	Result     null.String `boil:"result" json:"result,omitempty" toml:"result" yaml:"result,omitempty"`
+	ExecutedAt time.Time   `boil:"executed_at" json:"executed_at" toml:"executed_at" yaml:"executed_at"`
+
+	R *eventExecutionR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L eventExecutionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var EventExecutionColumns = struct {
+	ID         string
+	EventID    string
+	Action     string
+	Success    string
+	Result     string
+	ExecutedAt string
+}{
+	ID:         "id",
+	EventID:    "event_id",
+	Action:     "action",
+	Success:    "success",
+	Result:     "result",
+	ExecutedAt: "executed_at",
+}
+
+// Generated where
+
+var EventExecutionWhere = struct {
+	ID         whereHelperstring
+	EventID    whereHelperint64
+	Action     whereHelperstring
+	Success    whereHelperbool
+	Result     whereHelpernull_String
+	ExecutedAt whereHelpertime_Time
+}{
+	ID:         whereHelperstring{field: "\"event_execution\".\"id\""},
+	EventID:    whereHelperint64{field: "\"event_execution\".\"event_id\""},
+	Action:     whereHelperstring{field: "\"event_execution\".\"action\""},
+	Success:    whereHelperbool{field: "\"event_execution\".\"success\""},
+	Result:     whereHelpernull_String{field: "\"event_execution\".\"result\""},
+	ExecutedAt: whereHelpertime_Time{field: "\"event_execution\".\"executed_at\""},
+}
+
+// EventExecutionRels is where relationship names are stored.
+var EventExecutionRels = struct {
+	Event string
+}{
+	Event: "Event",
+}
+
+// eventExecutionR is where relationships are stored.
+type eventExecutionR struct {
+	Event *Event
+}
+
+// NewStruct creates a new relationship struct
+func (*eventExecutionR) NewStruct() *eventExecutionR {
+	return &eventExecutionR{}
+}
+
+// eventExecutionL is where Load methods for each relationship are stored.
+type eventExecutionL struct{}
+
+var (
+	eventExecutionAllColumns            = []string{"id", "event_id", "action", "success", "result", "executed_at"}
+	eventExecutionColumnsWithoutDefault = []string{"event_id", "action", "success", "result", "executed_at"}
+	eventExecutionColumnsWithDefault    = []string{"id"}
+	eventExecutionPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// EventExecutionSlice is an alias for a slice of pointers to EventExecution.
+	// This should generally be used opposed to []EventExecution.
+	EventExecutionSlice []*EventExecution
+	// EventExecutionHook is the signature for custom EventExecution hook methods
+	EventExecutionHook func(context.Context, boil.ContextExecutor, *EventExecution) error
+
+	eventExecutionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	eventExecutionType                 = reflect.TypeOf(&EventExecution{})
+	eventExecutionMapping              = queries.MakeStructMapping(eventExecutionType)
+	eventExecutionPrimaryKeyMapping, _ = queries.BindMapping(eventExecutionType, eventExecutionMapping, eventExecutionPrimaryKeyColumns)
+	eventExecutionInsertCacheMut       sync.RWMutex
+	eventExecutionInsertCache          = make(map[string]insertCache)
+	eventExecutionUpdateCacheMut       sync.RWMutex
+	eventExecutionUpdateCache          = make(map[string]updateCache)
+	eventExecutionUpsertCacheMut       sync.RWMutex
+	eventExecutionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var eventExecutionBeforeInsertHooks []EventExecutionHook
+var eventExecutionBeforeUpdateHooks []EventExecutionHook
+var eventExecutionBeforeDeleteHooks []EventExecutionHook
+var eventExecutionBeforeUpsertHooks []EventExecutionHook
+
+var eventExecutionAfterInsertHooks []EventExecutionHook
+var eventExecutionAfterSelectHooks []EventExecutionHook
+var eventExecutionAfterUpdateHooks []EventExecutionHook
+var eventExecutionAfterDeleteHooks []EventExecutionHook
+var eventExecutionAfterUpsertHooks []EventExecutionHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *EventExecution) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range eventExecutionBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *EventExecution) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range eventExecutionBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *EventExecution) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range eventExecutionBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *EventExecution) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range eventExecutionBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *EventExecution) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range eventExecutionAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *EventExecution) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range eventExecutionAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *EventExecution) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range eventExecutionAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *EventExecution) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range eventExecutionAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *EventExecution) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range eventExecutionAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddEventExecutionHook registers your hook function for all future operations.
+func AddEventExecutionHook(hookPoint boil.HookPoint, eventExecutionHook EventExecutionHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		eventExecutionBeforeInsertHooks = append(eventExecutionBeforeInsertHooks, eventExecutionHook)
+	case boil.BeforeUpdateHook:
+		eventExecutionBeforeUpdateHooks = append(eventExecutionBeforeUpdateHooks, eventExecutionHook)
+	case boil.BeforeDeleteHook:
+		eventExecutionBeforeDeleteHooks = append(eventExecutionBeforeDeleteHooks, eventExecutionHook)
+	case boil.BeforeUpsertHook:
+		eventExecutionBeforeUpsertHooks = append(eventExecutionBeforeUpsertHooks, eventExecutionHook)
+	case boil.AfterInsertHook:
+		eventExecutionAfterInsertHooks = append(eventExecutionAfterInsertHooks, eventExecutionHook)
+	case boil.AfterSelectHook:
+		eventExecutionAfterSelectHooks = append(eventExecutionAfterSelectHooks, eventExecutionHook)
+	case boil.AfterUpdateHook:
+		eventExecutionAfterUpdateHooks = append(eventExecutionAfterUpdateHooks, eventExecutionHook)
+	case boil.AfterDeleteHook:
+		eventExecutionAfterDeleteHooks = append(eventExecutionAfterDeleteHooks, eventExecutionHook)
+	case boil.AfterUpsertHook:
+		eventExecutionAfterUpsertHooks = append(eventExecutionAfterUpsertHooks, eventExecutionHook)
+	}
+}
+
+// One returns a single eventExecution record from the query.
+func (q eventExecutionQuery) One(ctx context.Context, exec boil.ContextExecutor) (*EventExecution, error) {
+	o := &EventExecution{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: failed to execute a one query for event_execution")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all EventExecution records from the query.
+func (q eventExecutionQuery) All(ctx context.Context, exec boil.ContextExecutor) (EventExecutionSlice, error) {
+	var o []*EventExecution
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "postgres: failed to assign all query results to EventExecution slice")
+	}
+
+	if len(eventExecutionAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all EventExecution records in the query.
+func (q eventExecutionQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to count event_execution rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q eventExecutionQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: failed to check if event_execution exists")
+	}
+
+	return count > 0, nil
+}
+
+// Event pointed to by the foreign key.
+func (o *EventExecution) Event(mods ...qm.QueryMod) eventQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.EventID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Events(queryMods...)
+	queries.SetFrom(query.Query, "\"event\"")
+
+	return query
+}
+
+// LoadEvent allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (eventExecutionL) LoadEvent(ctx context.Context, e boil.ContextExecutor, singular bool, maybeEventExecution interface{}, mods queries.Applicator) error {
+	var slice []*EventExecution
+	var object *EventExecution
+
+	if singular {
+		object = maybeEventExecution.(*EventExecution)
+	} else {
+		slice = *maybeEventExecution.(*[]*EventExecution)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &eventExecutionR{}
+		}
+		args = append(args, object.EventID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &eventExecutionR{}
+			}
+
+			for _, a := range args {
+				if a == obj.EventID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.EventID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`event`), qm.WhereIn(`event.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Event")
+	}
+
+	var resultSlice []*Event
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Event")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for event")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for event")
+	}
+
+	if len(eventExecutionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Event = foreign
+		if foreign.R == nil {
+			foreign.R = &eventR{}
+		}
+		foreign.R.EventExecutions = append(foreign.R.EventExecutions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.EventID == foreign.ID {
+				local.R.Event = foreign
+				if foreign.R == nil {
+					foreign.R = &eventR{}
+				}
+				foreign.R.EventExecutions = append(foreign.R.EventExecutions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetEvent of the eventExecution to the related item.
+// Sets o.R.Event to related.
+// Adds o to related.R.EventExecutions.
+func (o *EventExecution) SetEvent(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Event) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"event_execution\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"event_id"}),
+		strmangle.WhereClause("\"", "\"", 2, eventExecutionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.EventID = related.ID
+	if o.R == nil {
+		o.R = &eventExecutionR{
+			Event: related,
+		}
+	} else {
+		o.R.Event = related
+	}
+
+	if related.R == nil {
+		related.R = &eventR{
+			EventExecutions: EventExecutionSlice{o},
+		}
+	} else {
+		related.R.EventExecutions = append(related.R.EventExecutions, o)
+	}
+
+	return nil
+}
+
+// EventExecutions retrieves all the records using an executor.
+func EventExecutions(mods ...qm.QueryMod) eventExecutionQuery {
+	mods = append(mods, qm.From("\"event_execution\""))
+	return eventExecutionQuery{NewQuery(mods...)}
+}
+
+// FindEventExecution retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindEventExecution(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*EventExecution, error) {
+	eventExecutionObj := &EventExecution{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"event_execution\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, eventExecutionObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: unable to select from event_execution")
+	}
+
+	return eventExecutionObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *EventExecution) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no event_execution provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(eventExecutionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	eventExecutionInsertCacheMut.RLock()
+	cache, cached := eventExecutionInsertCache[key]
+	eventExecutionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			eventExecutionAllColumns,
+			eventExecutionColumnsWithDefault,
+			eventExecutionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(eventExecutionType, eventExecutionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(eventExecutionType, eventExecutionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"event_execution\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"event_execution\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to insert into event_execution")
+	}
+
+	if !cached {
+		eventExecutionInsertCacheMut.Lock()
+		eventExecutionInsertCache[key] = cache
+		eventExecutionInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the EventExecution.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *EventExecution) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	eventExecutionUpdateCacheMut.RLock()
+	cache, cached := eventExecutionUpdateCache[key]
+	eventExecutionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			eventExecutionAllColumns,
+			eventExecutionPrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("postgres: unable to update event_execution, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"event_execution\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, eventExecutionPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(eventExecutionType, eventExecutionMapping, append(wl, eventExecutionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update event_execution row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by update for event_execution")
+	}
+
+	if !cached {
+		eventExecutionUpdateCacheMut.Lock()
+		eventExecutionUpdateCache[key] = cache
+		eventExecutionUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q eventExecutionQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all for event_execution")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected for event_execution")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o EventExecutionSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("postgres: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), eventExecutionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"event_execution\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, eventExecutionPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all in eventExecution slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected all in update all eventExecution")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *EventExecution) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no event_execution provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(eventExecutionColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	eventExecutionUpsertCacheMut.RLock()
+	cache, cached := eventExecutionUpsertCache[key]
+	eventExecutionUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			eventExecutionAllColumns,
+			eventExecutionColumnsWithDefault,
+			eventExecutionColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			eventExecutionAllColumns,
+			eventExecutionPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("postgres: unable to upsert event_execution, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(eventExecutionPrimaryKeyColumns))
+			copy(conflict, eventExecutionPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"event_execution\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(eventExecutionType, eventExecutionMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(eventExecutionType, eventExecutionMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to upsert event_execution")
+	}
+
+	if !cached {
+		eventExecutionUpsertCacheMut.Lock()
+		eventExecutionUpsertCache[key] = cache
+		eventExecutionUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single EventExecution record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *EventExecution) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("postgres: no EventExecution provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), eventExecutionPrimaryKeyMapping)
+	sql := "DELETE FROM \"event_execution\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete from event_execution")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by delete for event_execution")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q eventExecutionQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("postgres: no eventExecutionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from event_execution")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for event_execution")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o EventExecutionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(eventExecutionBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), eventExecutionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"event_execution\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, eventExecutionPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from eventExecution slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for event_execution")
+	}
+
+	if len(eventExecutionAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *EventExecution) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindEventExecution(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *EventExecutionSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := EventExecutionSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), eventExecutionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"event_execution\".* FROM \"event_execution\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, eventExecutionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to reload all in EventExecutionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// EventExecutionExists checks if the EventExecution row exists.
+func EventExecutionExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"event_execution\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: unable to check if event_execution exists")
+	}
+
+	return exists, nil
+}
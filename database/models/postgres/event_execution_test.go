@@ -0,0 +1,841 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testEventExecutions(t *testing.T) {
+	t.Parallel()
+
+	query := EventExecutions()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testEventExecutionsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventExecution{}
+	if err = randomize.Struct(seed, o, eventExecutionDBTypes, true, eventExecutionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventExecution struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := EventExecutions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testEventExecutionsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventExecution{}
+	if err = randomize.Struct(seed, o, eventExecutionDBTypes, true, eventExecutionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventExecution struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := EventExecutions().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := EventExecutions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testEventExecutionsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventExecution{}
+	if err = randomize.Struct(seed, o, eventExecutionDBTypes, true, eventExecutionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventExecution struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := EventExecutionSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := EventExecutions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testEventExecutionsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventExecution{}
+	if err = randomize.Struct(seed, o, eventExecutionDBTypes, true, eventExecutionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventExecution struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := EventExecutionExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if EventExecution exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected EventExecutionExists to return true, but got false.")
+	}
+}
+
+func testEventExecutionsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventExecution{}
+	if err = randomize.Struct(seed, o, eventExecutionDBTypes, true, eventExecutionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventExecution struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	eventExecutionFound, err := FindEventExecution(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if eventExecutionFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testEventExecutionsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventExecution{}
+	if err = randomize.Struct(seed, o, eventExecutionDBTypes, true, eventExecutionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventExecution struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = EventExecutions().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testEventExecutionsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventExecution{}
+	if err = randomize.Struct(seed, o, eventExecutionDBTypes, true, eventExecutionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventExecution struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := EventExecutions().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testEventExecutionsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	eventExecutionOne := &EventExecution{}
+	eventExecutionTwo := &EventExecution{}
+	if err = randomize.Struct(seed, eventExecutionOne, eventExecutionDBTypes, false, eventExecutionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventExecution struct: %s", err)
+	}
+	if err = randomize.Struct(seed, eventExecutionTwo, eventExecutionDBTypes, false, eventExecutionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventExecution struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = eventExecutionOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = eventExecutionTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := EventExecutions().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testEventExecutionsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	eventExecutionOne := &EventExecution{}
+	eventExecutionTwo := &EventExecution{}
+	if err = randomize.Struct(seed, eventExecutionOne, eventExecutionDBTypes, false, eventExecutionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventExecution struct: %s", err)
+	}
+	if err = randomize.Struct(seed, eventExecutionTwo, eventExecutionDBTypes, false, eventExecutionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventExecution struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = eventExecutionOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = eventExecutionTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := EventExecutions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func eventExecutionBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *EventExecution) error {
+	*o = EventExecution{}
+	return nil
+}
+
+func eventExecutionAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *EventExecution) error {
+	*o = EventExecution{}
+	return nil
+}
+
+func eventExecutionAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *EventExecution) error {
+	*o = EventExecution{}
+	return nil
+}
+
+func eventExecutionBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *EventExecution) error {
+	*o = EventExecution{}
+	return nil
+}
+
+func eventExecutionAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *EventExecution) error {
+	*o = EventExecution{}
+	return nil
+}
+
+func eventExecutionBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *EventExecution) error {
+	*o = EventExecution{}
+	return nil
+}
+
+func eventExecutionAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *EventExecution) error {
+	*o = EventExecution{}
+	return nil
+}
+
+func eventExecutionBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *EventExecution) error {
+	*o = EventExecution{}
+	return nil
+}
+
+func eventExecutionAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *EventExecution) error {
+	*o = EventExecution{}
+	return nil
+}
+
+func testEventExecutionsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &EventExecution{}
+	o := &EventExecution{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, eventExecutionDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize EventExecution object: %s", err)
+	}
+
+	AddEventExecutionHook(boil.BeforeInsertHook, eventExecutionBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	eventExecutionBeforeInsertHooks = []EventExecutionHook{}
+
+	AddEventExecutionHook(boil.AfterInsertHook, eventExecutionAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	eventExecutionAfterInsertHooks = []EventExecutionHook{}
+
+	AddEventExecutionHook(boil.AfterSelectHook, eventExecutionAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	eventExecutionAfterSelectHooks = []EventExecutionHook{}
+
+	AddEventExecutionHook(boil.BeforeUpdateHook, eventExecutionBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	eventExecutionBeforeUpdateHooks = []EventExecutionHook{}
+
+	AddEventExecutionHook(boil.AfterUpdateHook, eventExecutionAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	eventExecutionAfterUpdateHooks = []EventExecutionHook{}
+
+	AddEventExecutionHook(boil.BeforeDeleteHook, eventExecutionBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	eventExecutionBeforeDeleteHooks = []EventExecutionHook{}
+
+	AddEventExecutionHook(boil.AfterDeleteHook, eventExecutionAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	eventExecutionAfterDeleteHooks = []EventExecutionHook{}
+
+	AddEventExecutionHook(boil.BeforeUpsertHook, eventExecutionBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	eventExecutionBeforeUpsertHooks = []EventExecutionHook{}
+
+	AddEventExecutionHook(boil.AfterUpsertHook, eventExecutionAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	eventExecutionAfterUpsertHooks = []EventExecutionHook{}
+}
+
+func testEventExecutionsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventExecution{}
+	if err = randomize.Struct(seed, o, eventExecutionDBTypes, true, eventExecutionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventExecution struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := EventExecutions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testEventExecutionsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventExecution{}
+	if err = randomize.Struct(seed, o, eventExecutionDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize EventExecution struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(eventExecutionColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := EventExecutions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testEventExecutionToOneEventUsingEvent(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local EventExecution
+	var foreign Event
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, eventExecutionDBTypes, false, eventExecutionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventExecution struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, eventDBTypes, false, eventColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Event struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.EventID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Event().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := EventExecutionSlice{&local}
+	if err = local.L.LoadEvent(ctx, tx, false, (*[]*EventExecution)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Event == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Event = nil
+	if err = local.L.LoadEvent(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Event == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testEventExecutionToOneSetOpEventUsingEvent(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a EventExecution
+	var b, c Event
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, eventExecutionDBTypes, false, strmangle.SetComplement(eventExecutionPrimaryKeyColumns, eventExecutionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, eventDBTypes, false, strmangle.SetComplement(eventPrimaryKeyColumns, eventColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, eventDBTypes, false, strmangle.SetComplement(eventPrimaryKeyColumns, eventColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Event{&b, &c} {
+		err = a.SetEvent(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Event != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.EventExecutions[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.EventID != x.ID {
+			t.Error("foreign key was wrong value", a.EventID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.EventID))
+		reflect.Indirect(reflect.ValueOf(&a.EventID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.EventID != x.ID {
+			t.Error("foreign key was wrong value", a.EventID, x.ID)
+		}
+	}
+}
+
+func testEventExecutionsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventExecution{}
+	if err = randomize.Struct(seed, o, eventExecutionDBTypes, true, eventExecutionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventExecution struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testEventExecutionsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventExecution{}
+	if err = randomize.Struct(seed, o, eventExecutionDBTypes, true, eventExecutionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventExecution struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := EventExecutionSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testEventExecutionsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventExecution{}
+	if err = randomize.Struct(seed, o, eventExecutionDBTypes, true, eventExecutionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventExecution struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := EventExecutions().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	eventExecutionDBTypes = map[string]string{`ID`: `uuid`, `EventID`: `bigint`, `Action`: `character varying`, `Success`: `boolean`, `Result`: `text`, `ExecutedAt`: `timestamp with time zone`}
+	_                     = bytes.MinRead
+)
+
+func testEventExecutionsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(eventExecutionPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(eventExecutionAllColumns) == len(eventExecutionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventExecution{}
+	if err = randomize.Struct(seed, o, eventExecutionDBTypes, true, eventExecutionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventExecution struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := EventExecutions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, eventExecutionDBTypes, true, eventExecutionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize EventExecution struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testEventExecutionsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(eventExecutionAllColumns) == len(eventExecutionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EventExecution{}
+	if err = randomize.Struct(seed, o, eventExecutionDBTypes, true, eventExecutionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EventExecution struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := EventExecutions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, eventExecutionDBTypes, true, eventExecutionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize EventExecution struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(eventExecutionAllColumns, eventExecutionPrimaryKeyColumns) {
+		fields = eventExecutionAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			eventExecutionAllColumns,
+			eventExecutionPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := EventExecutionSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testEventExecutionsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(eventExecutionAllColumns) == len(eventExecutionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := EventExecution{}
+	if err = randomize.Struct(seed, &o, eventExecutionDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize EventExecution struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert EventExecution: %s", err)
+	}
+
+	count, err := EventExecutions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, eventExecutionDBTypes, false, eventExecutionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize EventExecution struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert EventExecution: %s", err)
+	}
+
+	count, err = EventExecutions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,925 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+	"github.com/volatiletech/null"
+)
+
+// Fill is an object representing the database table.
+type Fill struct {
+	ID            string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ExchangeName  string      `boil:"exchange_name" json:"exchange_name" toml:"exchange_name" yaml:"exchange_name"`
+	OrderID       string      `boil:"order_id" json:"order_id" toml:"order_id" yaml:"order_id"`
+	ClientOrderID null.String `boil:"client_order_id" json:"client_order_id,omitempty" toml:"client_order_id" yaml:"client_order_id,omitempty"`
+	TradeID       null.String `boil:"trade_id" json:"trade_id,omitempty" toml:"trade_id" yaml:"trade_id,omitempty"`
+	Base          string      `boil:"base" json:"base" toml:"base" yaml:"base"`
+	Quote         string      `boil:"quote" json:"quote" toml:"quote" yaml:"quote"`
+	Asset         string      `boil:"asset" json:"asset" toml:"asset" yaml:"asset"`
+	Side          string      `boil:"side" json:"side" toml:"side" yaml:"side"`
+	Price         float64     `boil:"price" json:"price" toml:"price" yaml:"price"`
+	Amount        float64     `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	Fee           float64     `boil:"fee" json:"fee" toml:"fee" yaml:"fee"`
+	Timestamp     time.Time   `boil:"timestamp" json:"timestamp" toml:"timestamp" yaml:"timestamp"`
+	FeeAsset      null.String `boil:"fee_asset" json:"fee_asset,omitempty" toml:"fee_asset" yaml:"fee_asset,omitempty"`
+
+	R *fillR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L fillL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var FillColumns = struct {
+	ID            string
+	ExchangeName  string
+	OrderID       string
+	ClientOrderID string
+	TradeID       string
+	Base          string
+	Quote         string
+	Asset         string
+	Side          string
+	Price         string
+	Amount        string
+	Fee           string
+	Timestamp     string
+	FeeAsset      string
+}{
+	ID:            "id",
+	ExchangeName:  "exchange_name",
+	OrderID:       "order_id",
+	ClientOrderID: "client_order_id",
+	TradeID:       "trade_id",
+	Base:          "base",
+	Quote:         "quote",
+	Asset:         "asset",
+	Side:          "side",
+	Price:         "price",
+	Amount:        "amount",
+	Fee:           "fee",
+	Timestamp:     "timestamp",
+	FeeAsset:      "fee_asset",
+}
+
+// Generated where
+
+var FillWhere = struct {
+	ID            whereHelperstring
+	ExchangeName  whereHelperstring
+	OrderID       whereHelperstring
+	ClientOrderID whereHelpernull_String
+	TradeID       whereHelpernull_String
+	Base          whereHelperstring
+	Quote         whereHelperstring
+	Asset         whereHelperstring
+	Side          whereHelperstring
+	Price         whereHelperfloat64
+	Amount        whereHelperfloat64
+	Fee           whereHelperfloat64
+	Timestamp     whereHelpertime_Time
+	FeeAsset      whereHelpernull_String
+}{
+	ID:            whereHelperstring{field: "\"fill\".\"id\""},
+	ExchangeName:  whereHelperstring{field: "\"fill\".\"exchange_name\""},
+	OrderID:       whereHelperstring{field: "\"fill\".\"order_id\""},
+	ClientOrderID: whereHelpernull_String{field: "\"fill\".\"client_order_id\""},
+	TradeID:       whereHelpernull_String{field: "\"fill\".\"trade_id\""},
+	Base:          whereHelperstring{field: "\"fill\".\"base\""},
+	Quote:         whereHelperstring{field: "\"fill\".\"quote\""},
+	Asset:         whereHelperstring{field: "\"fill\".\"asset\""},
+	Side:          whereHelperstring{field: "\"fill\".\"side\""},
+	Price:         whereHelperfloat64{field: "\"fill\".\"price\""},
+	Amount:        whereHelperfloat64{field: "\"fill\".\"amount\""},
+	Fee:           whereHelperfloat64{field: "\"fill\".\"fee\""},
+	Timestamp:     whereHelpertime_Time{field: "\"fill\".\"timestamp\""},
+	FeeAsset:      whereHelpernull_String{field: "\"fill\".\"fee_asset\""},
+}
+
+// FillRels is where relationship names are stored.
+var FillRels = struct {
+}{}
+
+// fillR is where relationships are stored.
+type fillR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*fillR) NewStruct() *fillR {
+	return &fillR{}
+}
+
+// fillL is where Load methods for each relationship are stored.
+type fillL struct{}
+
+var (
+	fillAllColumns            = []string{"id", "exchange_name", "order_id", "client_order_id", "trade_id", "base", "quote", "asset", "side", "price", "amount", "fee", "timestamp", "fee_asset"}
+	fillColumnsWithoutDefault = []string{"exchange_name", "order_id", "client_order_id", "trade_id", "base", "quote", "asset", "side", "price", "amount", "fee", "timestamp", "fee_asset"}
+	fillColumnsWithDefault    = []string{"id"}
+	fillPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// FillSlice is an alias for a slice of pointers to Fill.
+	// This should generally be used opposed to []Fill.
+	FillSlice []*Fill
+	// FillHook is the signature for custom Fill hook methods
+	FillHook func(context.Context, boil.ContextExecutor, *Fill) error
+
+	fillQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	fillType                 = reflect.TypeOf(&Fill{})
+	fillMapping              = queries.MakeStructMapping(fillType)
+	fillPrimaryKeyMapping, _ = queries.BindMapping(fillType, fillMapping, fillPrimaryKeyColumns)
+	fillInsertCacheMut       sync.RWMutex
+	fillInsertCache          = make(map[string]insertCache)
+	fillUpdateCacheMut       sync.RWMutex
+	fillUpdateCache          = make(map[string]updateCache)
+	fillUpsertCacheMut       sync.RWMutex
+	fillUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var fillBeforeInsertHooks []FillHook
+var fillBeforeUpdateHooks []FillHook
+var fillBeforeDeleteHooks []FillHook
+var fillBeforeUpsertHooks []FillHook
+
+var fillAfterInsertHooks []FillHook
+var fillAfterSelectHooks []FillHook
+var fillAfterUpdateHooks []FillHook
+var fillAfterDeleteHooks []FillHook
+var fillAfterUpsertHooks []FillHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Fill) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fillBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Fill) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fillBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Fill) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fillBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Fill) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fillBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Fill) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fillAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Fill) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fillAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Fill) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fillAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Fill) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fillAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Fill) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fillAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddFillHook registers your hook function for all future operations.
+func AddFillHook(hookPoint boil.HookPoint, fillHook FillHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		fillBeforeInsertHooks = append(fillBeforeInsertHooks, fillHook)
+	case boil.BeforeUpdateHook:
+		fillBeforeUpdateHooks = append(fillBeforeUpdateHooks, fillHook)
+	case boil.BeforeDeleteHook:
+		fillBeforeDeleteHooks = append(fillBeforeDeleteHooks, fillHook)
+	case boil.BeforeUpsertHook:
+		fillBeforeUpsertHooks = append(fillBeforeUpsertHooks, fillHook)
+	case boil.AfterInsertHook:
+		fillAfterInsertHooks = append(fillAfterInsertHooks, fillHook)
+	case boil.AfterSelectHook:
+		fillAfterSelectHooks = append(fillAfterSelectHooks, fillHook)
+	case boil.AfterUpdateHook:
+		fillAfterUpdateHooks = append(fillAfterUpdateHooks, fillHook)
+	case boil.AfterDeleteHook:
+		fillAfterDeleteHooks = append(fillAfterDeleteHooks, fillHook)
+	case boil.AfterUpsertHook:
+		fillAfterUpsertHooks = append(fillAfterUpsertHooks, fillHook)
+	}
+}
+
+// One returns a single fill record from the query.
+func (q fillQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Fill, error) {
+	o := &Fill{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: failed to execute a one query for fill")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Fill records from the query.
+func (q fillQuery) All(ctx context.Context, exec boil.ContextExecutor) (FillSlice, error) {
+	var o []*Fill
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "postgres: failed to assign all query results to Fill slice")
+	}
+
+	if len(fillAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Fill records in the query.
+func (q fillQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to count fill rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q fillQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: failed to check if fill exists")
+	}
+
+	return count > 0, nil
+}
+
+// Fills retrieves all the records using an executor.
+func Fills(mods ...qm.QueryMod) fillQuery {
+	mods = append(mods, qm.From("\"fill\""))
+	return fillQuery{NewQuery(mods...)}
+}
+
+// FindFill retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindFill(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*Fill, error) {
+	fillObj := &Fill{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"fill\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, fillObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: unable to select from fill")
+	}
+
+	return fillObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Fill) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no fill provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(fillColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	fillInsertCacheMut.RLock()
+	cache, cached := fillInsertCache[key]
+	fillInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			fillAllColumns,
+			fillColumnsWithDefault,
+			fillColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(fillType, fillMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(fillType, fillMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"fill\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"fill\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to insert into fill")
+	}
+
+	if !cached {
+		fillInsertCacheMut.Lock()
+		fillInsertCache[key] = cache
+		fillInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Fill.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Fill) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	fillUpdateCacheMut.RLock()
+	cache, cached := fillUpdateCache[key]
+	fillUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			fillAllColumns,
+			fillPrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("postgres: unable to update fill, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"fill\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, fillPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(fillType, fillMapping, append(wl, fillPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update fill row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by update for fill")
+	}
+
+	if !cached {
+		fillUpdateCacheMut.Lock()
+		fillUpdateCache[key] = cache
+		fillUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q fillQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all for fill")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected for fill")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o FillSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("postgres: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), fillPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"fill\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, fillPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all in fill slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected all in update all fill")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Fill) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no fill provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(fillColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	fillUpsertCacheMut.RLock()
+	cache, cached := fillUpsertCache[key]
+	fillUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			fillAllColumns,
+			fillColumnsWithDefault,
+			fillColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			fillAllColumns,
+			fillPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("postgres: unable to upsert fill, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(fillPrimaryKeyColumns))
+			copy(conflict, fillPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"fill\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(fillType, fillMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(fillType, fillMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to upsert fill")
+	}
+
+	if !cached {
+		fillUpsertCacheMut.Lock()
+		fillUpsertCache[key] = cache
+		fillUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Fill record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Fill) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("postgres: no Fill provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), fillPrimaryKeyMapping)
+	sql := "DELETE FROM \"fill\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete from fill")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by delete for fill")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q fillQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("postgres: no fillQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from fill")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for fill")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o FillSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(fillBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), fillPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"fill\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, fillPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from fill slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for fill")
+	}
+
+	if len(fillAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Fill) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindFill(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *FillSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := FillSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), fillPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"fill\".* FROM \"fill\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, fillPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to reload all in FillSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// FillExists checks if the Fill row exists.
+func FillExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"fill\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: unable to check if fill exists")
+	}
+
+	return exists, nil
+}
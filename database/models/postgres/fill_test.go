@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testFills(t *testing.T) {
+	t.Parallel()
+
+	query := Fills()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testFillsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Fill{}
+	if err = randomize.Struct(seed, o, fillDBTypes, true, fillColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Fill struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Fills().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFillsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Fill{}
+	if err = randomize.Struct(seed, o, fillDBTypes, true, fillColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Fill struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Fills().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Fills().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFillsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Fill{}
+	if err = randomize.Struct(seed, o, fillDBTypes, true, fillColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Fill struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := FillSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Fills().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFillsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Fill{}
+	if err = randomize.Struct(seed, o, fillDBTypes, true, fillColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Fill struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := FillExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if Fill exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected FillExists to return true, but got false.")
+	}
+}
+
+func testFillsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Fill{}
+	if err = randomize.Struct(seed, o, fillDBTypes, true, fillColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Fill struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	fillFound, err := FindFill(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if fillFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testFillsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Fill{}
+	if err = randomize.Struct(seed, o, fillDBTypes, true, fillColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Fill struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = Fills().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFillsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Fill{}
+	if err = randomize.Struct(seed, o, fillDBTypes, true, fillColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Fill struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := Fills().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testFillsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	fillOne := &Fill{}
+	fillTwo := &Fill{}
+	if err = randomize.Struct(seed, fillOne, fillDBTypes, false, fillColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Fill struct: %s", err)
+	}
+	if err = randomize.Struct(seed, fillTwo, fillDBTypes, false, fillColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Fill struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = fillOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = fillTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Fills().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testFillsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	fillOne := &Fill{}
+	fillTwo := &Fill{}
+	if err = randomize.Struct(seed, fillOne, fillDBTypes, false, fillColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Fill struct: %s", err)
+	}
+	if err = randomize.Struct(seed, fillTwo, fillDBTypes, false, fillColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Fill struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = fillOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = fillTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Fills().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func fillBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *Fill) error {
+	*o = Fill{}
+	return nil
+}
+
+func fillAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *Fill) error {
+	*o = Fill{}
+	return nil
+}
+
+func fillAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *Fill) error {
+	*o = Fill{}
+	return nil
+}
+
+func fillBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Fill) error {
+	*o = Fill{}
+	return nil
+}
+
+func fillAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Fill) error {
+	*o = Fill{}
+	return nil
+}
+
+func fillBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Fill) error {
+	*o = Fill{}
+	return nil
+}
+
+func fillAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Fill) error {
+	*o = Fill{}
+	return nil
+}
+
+func fillBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Fill) error {
+	*o = Fill{}
+	return nil
+}
+
+func fillAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Fill) error {
+	*o = Fill{}
+	return nil
+}
+
+func testFillsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &Fill{}
+	o := &Fill{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, fillDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize Fill object: %s", err)
+	}
+
+	AddFillHook(boil.BeforeInsertHook, fillBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	fillBeforeInsertHooks = []FillHook{}
+
+	AddFillHook(boil.AfterInsertHook, fillAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	fillAfterInsertHooks = []FillHook{}
+
+	AddFillHook(boil.AfterSelectHook, fillAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	fillAfterSelectHooks = []FillHook{}
+
+	AddFillHook(boil.BeforeUpdateHook, fillBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	fillBeforeUpdateHooks = []FillHook{}
+
+	AddFillHook(boil.AfterUpdateHook, fillAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	fillAfterUpdateHooks = []FillHook{}
+
+	AddFillHook(boil.BeforeDeleteHook, fillBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	fillBeforeDeleteHooks = []FillHook{}
+
+	AddFillHook(boil.AfterDeleteHook, fillAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	fillAfterDeleteHooks = []FillHook{}
+
+	AddFillHook(boil.BeforeUpsertHook, fillBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	fillBeforeUpsertHooks = []FillHook{}
+
+	AddFillHook(boil.AfterUpsertHook, fillAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	fillAfterUpsertHooks = []FillHook{}
+}
+
+func testFillsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Fill{}
+	if err = randomize.Struct(seed, o, fillDBTypes, true, fillColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Fill struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Fills().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testFillsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Fill{}
+	if err = randomize.Struct(seed, o, fillDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Fill struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(fillColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Fills().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testFillsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Fill{}
+	if err = randomize.Struct(seed, o, fillDBTypes, true, fillColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Fill struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFillsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Fill{}
+	if err = randomize.Struct(seed, o, fillDBTypes, true, fillColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Fill struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := FillSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFillsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Fill{}
+	if err = randomize.Struct(seed, o, fillDBTypes, true, fillColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Fill struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Fills().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	fillDBTypes = map[string]string{`ID`: `uuid`, `ExchangeName`: `character varying`, `OrderID`: `character varying`, `ClientOrderID`: `character varying`, `TradeID`: `character varying`, `Base`: `character varying`, `Quote`: `character varying`, `Asset`: `character varying`, `Side`: `character varying`, `Price`: `double precision`, `Amount`: `double precision`, `Timestamp`: `timestamp with time zone`, `FeeAsset`: `character varying`}
+	_           = bytes.MinRead
+)
+
+func testFillsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(fillPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(fillAllColumns) == len(fillPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Fill{}
+	if err = randomize.Struct(seed, o, fillDBTypes, true, fillColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Fill struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Fills().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, fillDBTypes, true, fillPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Fill struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testFillsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(fillAllColumns) == len(fillPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Fill{}
+	if err = randomize.Struct(seed, o, fillDBTypes, true, fillColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Fill struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Fills().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, fillDBTypes, true, fillPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Fill struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(fillAllColumns, fillPrimaryKeyColumns) {
+		fields = fillAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			fillAllColumns,
+			fillPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := FillSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testFillsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(fillAllColumns) == len(fillPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := Fill{}
+	if err = randomize.Struct(seed, &o, fillDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Fill struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Fill: %s", err)
+	}
+
+	count, err := Fills().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, fillDBTypes, false, fillPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Fill struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Fill: %s", err)
+	}
+
+	count, err = Fills().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,914 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+// OrderHistory is an object representing the database table.
+type OrderHistory struct {
+	ID                   string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ExchangeName         string    `boil:"exchange_name" json:"exchange_name" toml:"exchange_name" yaml:"exchange_name"`
+	OrderID              string    `boil:"order_id" json:"order_id" toml:"order_id" yaml:"order_id"`
+	Status               string    `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Price                float64   `boil:"price" json:"price" toml:"price" yaml:"price"`
+	Amount               float64   `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	ExecutedAmount       float64   `boil:"executed_amount" json:"executed_amount" toml:"executed_amount" yaml:"executed_amount"`
+	RemainingAmount      float64   `boil:"remaining_amount" json:"remaining_amount" toml:"remaining_amount" yaml:"remaining_amount"`
+	AverageExecutedPrice float64   `boil:"average_executed_price" json:"average_executed_price" toml:"average_executed_price" yaml:"average_executed_price"`
+	Fee                  float64   `boil:"fee" json:"fee" toml:"fee" yaml:"fee"`
+	Cost                 float64   `boil:"cost" json:"cost" toml:"cost" yaml:"cost"`
+	LastUpdated          time.Time `boil:"last_updated" json:"last_updated" toml:"last_updated" yaml:"last_updated"`
+
+	R *orderHistoryR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L orderHistoryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var OrderHistoryColumns = struct {
+	ID                   string
+	ExchangeName         string
+	OrderID              string
+	Status               string
+	Price                string
+	Amount               string
+	ExecutedAmount       string
+	RemainingAmount      string
+	AverageExecutedPrice string
+	Fee                  string
+	Cost                 string
+	LastUpdated          string
+}{
+	ID:                   "id",
+	ExchangeName:         "exchange_name",
+	OrderID:              "order_id",
+	Status:               "status",
+	Price:                "price",
+	Amount:               "amount",
+	ExecutedAmount:       "executed_amount",
+	RemainingAmount:      "remaining_amount",
+	AverageExecutedPrice: "average_executed_price",
+	Fee:                  "fee",
+	Cost:                 "cost",
+	LastUpdated:          "last_updated",
+}
+
+// Generated where
+
+var OrderHistoryWhere = struct {
+	ID                   whereHelperstring
+	ExchangeName         whereHelperstring
+	OrderID              whereHelperstring
+	Status               whereHelperstring
+	Price                whereHelperfloat64
+	Amount               whereHelperfloat64
+	ExecutedAmount       whereHelperfloat64
+	RemainingAmount      whereHelperfloat64
+	AverageExecutedPrice whereHelperfloat64
+	Fee                  whereHelperfloat64
+	Cost                 whereHelperfloat64
+	LastUpdated          whereHelpertime_Time
+}{
+	ID:                   whereHelperstring{field: "\"order_history\".\"id\""},
+	ExchangeName:         whereHelperstring{field: "\"order_history\".\"exchange_name\""},
+	OrderID:              whereHelperstring{field: "\"order_history\".\"order_id\""},
+	Status:               whereHelperstring{field: "\"order_history\".\"status\""},
+	Price:                whereHelperfloat64{field: "\"order_history\".\"price\""},
+	Amount:               whereHelperfloat64{field: "\"order_history\".\"amount\""},
+	ExecutedAmount:       whereHelperfloat64{field: "\"order_history\".\"executed_amount\""},
+	RemainingAmount:      whereHelperfloat64{field: "\"order_history\".\"remaining_amount\""},
+	AverageExecutedPrice: whereHelperfloat64{field: "\"order_history\".\"average_executed_price\""},
+	Fee:                  whereHelperfloat64{field: "\"order_history\".\"fee\""},
+	Cost:                 whereHelperfloat64{field: "\"order_history\".\"cost\""},
+	LastUpdated:          whereHelpertime_Time{field: "\"order_history\".\"last_updated\""},
+}
+
+// OrderHistoryRels is where relationship names are stored.
+var OrderHistoryRels = struct {
+}{}
+
+// orderHistoryR is where relationships are stored.
+type orderHistoryR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*orderHistoryR) NewStruct() *orderHistoryR {
+	return &orderHistoryR{}
+}
+
+// orderHistoryL is where Load methods for each relationship are stored.
+type orderHistoryL struct{}
+
+var (
+	orderHistoryAllColumns            = []string{"id", "exchange_name", "order_id", "status", "price", "amount", "executed_amount", "remaining_amount", "average_executed_price", "fee", "cost", "last_updated"}
+	orderHistoryColumnsWithoutDefault = []string{"exchange_name", "order_id", "status", "price", "amount", "executed_amount", "remaining_amount", "average_executed_price", "fee", "cost", "last_updated"}
+	orderHistoryColumnsWithDefault    = []string{"id"}
+	orderHistoryPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// OrderHistorySlice is an alias for a slice of pointers to OrderHistory.
+	// This should generally be used opposed to []OrderHistory.
+	OrderHistorySlice []*OrderHistory
+	// OrderHistoryHook is the signature for custom OrderHistory hook methods
+	OrderHistoryHook func(context.Context, boil.ContextExecutor, *OrderHistory) error
+
+	orderHistoryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	orderHistoryType                 = reflect.TypeOf(&OrderHistory{})
+	orderHistoryMapping              = queries.MakeStructMapping(orderHistoryType)
+	orderHistoryPrimaryKeyMapping, _ = queries.BindMapping(orderHistoryType, orderHistoryMapping, orderHistoryPrimaryKeyColumns)
+	orderHistoryInsertCacheMut       sync.RWMutex
+	orderHistoryInsertCache          = make(map[string]insertCache)
+	orderHistoryUpdateCacheMut       sync.RWMutex
+	orderHistoryUpdateCache          = make(map[string]updateCache)
+	orderHistoryUpsertCacheMut       sync.RWMutex
+	orderHistoryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var orderHistoryBeforeInsertHooks []OrderHistoryHook
+var orderHistoryBeforeUpdateHooks []OrderHistoryHook
+var orderHistoryBeforeDeleteHooks []OrderHistoryHook
+var orderHistoryBeforeUpsertHooks []OrderHistoryHook
+
+var orderHistoryAfterInsertHooks []OrderHistoryHook
+var orderHistoryAfterSelectHooks []OrderHistoryHook
+var orderHistoryAfterUpdateHooks []OrderHistoryHook
+var orderHistoryAfterDeleteHooks []OrderHistoryHook
+var orderHistoryAfterUpsertHooks []OrderHistoryHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *OrderHistory) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderHistoryBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *OrderHistory) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderHistoryBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *OrderHistory) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderHistoryBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *OrderHistory) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderHistoryBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *OrderHistory) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderHistoryAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *OrderHistory) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderHistoryAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *OrderHistory) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderHistoryAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *OrderHistory) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderHistoryAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *OrderHistory) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderHistoryAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddOrderHistoryHook registers your hook function for all future operations.
+func AddOrderHistoryHook(hookPoint boil.HookPoint, orderHistoryHook OrderHistoryHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		orderHistoryBeforeInsertHooks = append(orderHistoryBeforeInsertHooks, orderHistoryHook)
+	case boil.BeforeUpdateHook:
+		orderHistoryBeforeUpdateHooks = append(orderHistoryBeforeUpdateHooks, orderHistoryHook)
+	case boil.BeforeDeleteHook:
+		orderHistoryBeforeDeleteHooks = append(orderHistoryBeforeDeleteHooks, orderHistoryHook)
+	case boil.BeforeUpsertHook:
+		orderHistoryBeforeUpsertHooks = append(orderHistoryBeforeUpsertHooks, orderHistoryHook)
+	case boil.AfterInsertHook:
+		orderHistoryAfterInsertHooks = append(orderHistoryAfterInsertHooks, orderHistoryHook)
+	case boil.AfterSelectHook:
+		orderHistoryAfterSelectHooks = append(orderHistoryAfterSelectHooks, orderHistoryHook)
+	case boil.AfterUpdateHook:
+		orderHistoryAfterUpdateHooks = append(orderHistoryAfterUpdateHooks, orderHistoryHook)
+	case boil.AfterDeleteHook:
+		orderHistoryAfterDeleteHooks = append(orderHistoryAfterDeleteHooks, orderHistoryHook)
+	case boil.AfterUpsertHook:
+		orderHistoryAfterUpsertHooks = append(orderHistoryAfterUpsertHooks, orderHistoryHook)
+	}
+}
+
+// One returns a single orderHistory record from the query.
+func (q orderHistoryQuery) One(ctx context.Context, exec boil.ContextExecutor) (*OrderHistory, error) {
+	o := &OrderHistory{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: failed to execute a one query for order_history")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all OrderHistory records from the query.
+func (q orderHistoryQuery) All(ctx context.Context, exec boil.ContextExecutor) (OrderHistorySlice, error) {
+	var o []*OrderHistory
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "postgres: failed to assign all query results to OrderHistory slice")
+	}
+
+	if len(orderHistoryAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all OrderHistory records in the query.
+func (q orderHistoryQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to count order_history rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q orderHistoryQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: failed to check if order_history exists")
+	}
+
+	return count > 0, nil
+}
+
+// OrderHistories retrieves all the records using an executor.
+func OrderHistories(mods ...qm.QueryMod) orderHistoryQuery {
+	mods = append(mods, qm.From("\"order_history\""))
+	return orderHistoryQuery{NewQuery(mods...)}
+}
+
+// FindOrderHistory retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindOrderHistory(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*OrderHistory, error) {
+	orderHistoryObj := &OrderHistory{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"order_history\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, orderHistoryObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: unable to select from order_history")
+	}
+
+	return orderHistoryObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *OrderHistory) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no order_history provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(orderHistoryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	orderHistoryInsertCacheMut.RLock()
+	cache, cached := orderHistoryInsertCache[key]
+	orderHistoryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			orderHistoryAllColumns,
+			orderHistoryColumnsWithDefault,
+			orderHistoryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(orderHistoryType, orderHistoryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(orderHistoryType, orderHistoryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"order_history\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"order_history\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to insert into order_history")
+	}
+
+	if !cached {
+		orderHistoryInsertCacheMut.Lock()
+		orderHistoryInsertCache[key] = cache
+		orderHistoryInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the OrderHistory.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *OrderHistory) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	orderHistoryUpdateCacheMut.RLock()
+	cache, cached := orderHistoryUpdateCache[key]
+	orderHistoryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			orderHistoryAllColumns,
+			orderHistoryPrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("postgres: unable to update order_history, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"order_history\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, orderHistoryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(orderHistoryType, orderHistoryMapping, append(wl, orderHistoryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update order_history row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by update for order_history")
+	}
+
+	if !cached {
+		orderHistoryUpdateCacheMut.Lock()
+		orderHistoryUpdateCache[key] = cache
+		orderHistoryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q orderHistoryQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all for order_history")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected for order_history")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o OrderHistorySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("postgres: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), orderHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"order_history\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, orderHistoryPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all in orderHistory slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected all in update all orderHistory")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *OrderHistory) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no order_history provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(orderHistoryColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	orderHistoryUpsertCacheMut.RLock()
+	cache, cached := orderHistoryUpsertCache[key]
+	orderHistoryUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			orderHistoryAllColumns,
+			orderHistoryColumnsWithDefault,
+			orderHistoryColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			orderHistoryAllColumns,
+			orderHistoryPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("postgres: unable to upsert order_history, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(orderHistoryPrimaryKeyColumns))
+			copy(conflict, orderHistoryPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"order_history\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(orderHistoryType, orderHistoryMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(orderHistoryType, orderHistoryMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to upsert order_history")
+	}
+
+	if !cached {
+		orderHistoryUpsertCacheMut.Lock()
+		orderHistoryUpsertCache[key] = cache
+		orderHistoryUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single OrderHistory record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *OrderHistory) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("postgres: no OrderHistory provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), orderHistoryPrimaryKeyMapping)
+	sql := "DELETE FROM \"order_history\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete from order_history")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by delete for order_history")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q orderHistoryQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("postgres: no orderHistoryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from order_history")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for order_history")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o OrderHistorySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(orderHistoryBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), orderHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"order_history\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, orderHistoryPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from orderHistory slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for order_history")
+	}
+
+	if len(orderHistoryAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *OrderHistory) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindOrderHistory(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *OrderHistorySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := OrderHistorySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), orderHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"order_history\".* FROM \"order_history\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, orderHistoryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to reload all in OrderHistorySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// OrderHistoryExists checks if the OrderHistory row exists.
+func OrderHistoryExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"order_history\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: unable to check if order_history exists")
+	}
+
+	return exists, nil
+}
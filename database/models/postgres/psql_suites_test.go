@@ -7,6 +7,11 @@ import "testing"
 
 func TestUpsert(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsUpsert)
+	t.Run("Events", testEventsUpsert)
+	t.Run("EventExecutions", testEventExecutionsUpsert)
 	t.Run("Exchanges", testExchangesUpsert)
+	t.Run("Fills", testFillsUpsert)
+	t.Run("OrderHistories", testOrderHistoriesUpsert)
+	t.Run("Orders", testOrdersUpsert)
 	t.Run("Scripts", testScriptsUpsert)
 }
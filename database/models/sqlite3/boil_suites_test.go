@@ -14,9 +14,15 @@ import "testing"
 func TestParent(t *testing.T) {
 	t.Run("AuditEvents", testAuditEvents)
 	t.Run("Candles", testCandles)
+	t.Run("CostBasisLots", testCostBasisLots)
 	t.Run("Datahistoryjobs", testDatahistoryjobs)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresults)
+	t.Run("Events", testEvents)
+	t.Run("EventExecutions", testEventExecutions)
 	t.Run("Exchanges", testExchanges)
+	t.Run("Fills", testFills)
+	t.Run("OrderHistories", testOrderHistories)
+	t.Run("Orders", testOrders)
 	t.Run("Scripts", testScripts)
 	t.Run("ScriptExecutions", testScriptExecutions)
 	t.Run("Trades", testTrades)
@@ -28,9 +34,15 @@ func TestParent(t *testing.T) {
 func TestDelete(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsDelete)
 	t.Run("Candles", testCandlesDelete)
+	t.Run("CostBasisLots", testCostBasisLotsDelete)
 	t.Run("Datahistoryjobs", testDatahistoryjobsDelete)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsDelete)
+	t.Run("Events", testEventsDelete)
+	t.Run("EventExecutions", testEventExecutionsDelete)
 	t.Run("Exchanges", testExchangesDelete)
+	t.Run("Fills", testFillsDelete)
+	t.Run("OrderHistories", testOrderHistoriesDelete)
+	t.Run("Orders", testOrdersDelete)
 	t.Run("Scripts", testScriptsDelete)
 	t.Run("ScriptExecutions", testScriptExecutionsDelete)
 	t.Run("Trades", testTradesDelete)
@@ -42,9 +54,15 @@ func TestDelete(t *testing.T) {
 func TestQueryDeleteAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsQueryDeleteAll)
 	t.Run("Candles", testCandlesQueryDeleteAll)
+	t.Run("CostBasisLots", testCostBasisLotsQueryDeleteAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsQueryDeleteAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsQueryDeleteAll)
+	t.Run("Events", testEventsQueryDeleteAll)
+	t.Run("EventExecutions", testEventExecutionsQueryDeleteAll)
 	t.Run("Exchanges", testExchangesQueryDeleteAll)
+	t.Run("Fills", testFillsQueryDeleteAll)
+	t.Run("OrderHistories", testOrderHistoriesQueryDeleteAll)
+	t.Run("Orders", testOrdersQueryDeleteAll)
 	t.Run("Scripts", testScriptsQueryDeleteAll)
 	t.Run("ScriptExecutions", testScriptExecutionsQueryDeleteAll)
 	t.Run("Trades", testTradesQueryDeleteAll)
@@ -56,9 +74,15 @@ func TestQueryDeleteAll(t *testing.T) {
 func TestSliceDeleteAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSliceDeleteAll)
 	t.Run("Candles", testCandlesSliceDeleteAll)
+	t.Run("CostBasisLots", testCostBasisLotsSliceDeleteAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsSliceDeleteAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSliceDeleteAll)
+	t.Run("Events", testEventsSliceDeleteAll)
+	t.Run("EventExecutions", testEventExecutionsSliceDeleteAll)
 	t.Run("Exchanges", testExchangesSliceDeleteAll)
+	t.Run("Fills", testFillsSliceDeleteAll)
+	t.Run("OrderHistories", testOrderHistoriesSliceDeleteAll)
+	t.Run("Orders", testOrdersSliceDeleteAll)
 	t.Run("Scripts", testScriptsSliceDeleteAll)
 	t.Run("ScriptExecutions", testScriptExecutionsSliceDeleteAll)
 	t.Run("Trades", testTradesSliceDeleteAll)
@@ -70,9 +94,15 @@ func TestSliceDeleteAll(t *testing.T) {
 func TestExists(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsExists)
 	t.Run("Candles", testCandlesExists)
+	t.Run("CostBasisLots", testCostBasisLotsExists)
 	t.Run("Datahistoryjobs", testDatahistoryjobsExists)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsExists)
+	t.Run("Events", testEventsExists)
+	t.Run("EventExecutions", testEventExecutionsExists)
 	t.Run("Exchanges", testExchangesExists)
+	t.Run("Fills", testFillsExists)
+	t.Run("OrderHistories", testOrderHistoriesExists)
+	t.Run("Orders", testOrdersExists)
 	t.Run("Scripts", testScriptsExists)
 	t.Run("ScriptExecutions", testScriptExecutionsExists)
 	t.Run("Trades", testTradesExists)
@@ -84,9 +114,15 @@ func TestExists(t *testing.T) {
 func TestFind(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsFind)
 	t.Run("Candles", testCandlesFind)
+	t.Run("CostBasisLots", testCostBasisLotsFind)
 	t.Run("Datahistoryjobs", testDatahistoryjobsFind)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsFind)
+	t.Run("Events", testEventsFind)
+	t.Run("EventExecutions", testEventExecutionsFind)
 	t.Run("Exchanges", testExchangesFind)
+	t.Run("Fills", testFillsFind)
+	t.Run("OrderHistories", testOrderHistoriesFind)
+	t.Run("Orders", testOrdersFind)
 	t.Run("Scripts", testScriptsFind)
 	t.Run("ScriptExecutions", testScriptExecutionsFind)
 	t.Run("Trades", testTradesFind)
@@ -98,9 +134,15 @@ func TestFind(t *testing.T) {
 func TestBind(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsBind)
 	t.Run("Candles", testCandlesBind)
+	t.Run("CostBasisLots", testCostBasisLotsBind)
 	t.Run("Datahistoryjobs", testDatahistoryjobsBind)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsBind)
+	t.Run("Events", testEventsBind)
+	t.Run("EventExecutions", testEventExecutionsBind)
 	t.Run("Exchanges", testExchangesBind)
+	t.Run("Fills", testFillsBind)
+	t.Run("OrderHistories", testOrderHistoriesBind)
+	t.Run("Orders", testOrdersBind)
 	t.Run("Scripts", testScriptsBind)
 	t.Run("ScriptExecutions", testScriptExecutionsBind)
 	t.Run("Trades", testTradesBind)
@@ -112,9 +154,15 @@ func TestBind(t *testing.T) {
 func TestOne(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsOne)
 	t.Run("Candles", testCandlesOne)
+	t.Run("CostBasisLots", testCostBasisLotsOne)
 	t.Run("Datahistoryjobs", testDatahistoryjobsOne)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsOne)
+	t.Run("Events", testEventsOne)
+	t.Run("EventExecutions", testEventExecutionsOne)
 	t.Run("Exchanges", testExchangesOne)
+	t.Run("Fills", testFillsOne)
+	t.Run("OrderHistories", testOrderHistoriesOne)
+	t.Run("Orders", testOrdersOne)
 	t.Run("Scripts", testScriptsOne)
 	t.Run("ScriptExecutions", testScriptExecutionsOne)
 	t.Run("Trades", testTradesOne)
@@ -126,9 +174,15 @@ func TestOne(t *testing.T) {
 func TestAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsAll)
 	t.Run("Candles", testCandlesAll)
+	t.Run("CostBasisLots", testCostBasisLotsAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsAll)
+	t.Run("Events", testEventsAll)
+	t.Run("EventExecutions", testEventExecutionsAll)
 	t.Run("Exchanges", testExchangesAll)
+	t.Run("Fills", testFillsAll)
+	t.Run("OrderHistories", testOrderHistoriesAll)
+	t.Run("Orders", testOrdersAll)
 	t.Run("Scripts", testScriptsAll)
 	t.Run("ScriptExecutions", testScriptExecutionsAll)
 	t.Run("Trades", testTradesAll)
@@ -140,9 +194,15 @@ func TestAll(t *testing.T) {
 func TestCount(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsCount)
 	t.Run("Candles", testCandlesCount)
+	t.Run("CostBasisLots", testCostBasisLotsCount)
 	t.Run("Datahistoryjobs", testDatahistoryjobsCount)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsCount)
+	t.Run("Events", testEventsCount)
+	t.Run("EventExecutions", testEventExecutionsCount)
 	t.Run("Exchanges", testExchangesCount)
+	t.Run("Fills", testFillsCount)
+	t.Run("OrderHistories", testOrderHistoriesCount)
+	t.Run("Orders", testOrdersCount)
 	t.Run("Scripts", testScriptsCount)
 	t.Run("ScriptExecutions", testScriptExecutionsCount)
 	t.Run("Trades", testTradesCount)
@@ -154,9 +214,15 @@ func TestCount(t *testing.T) {
 func TestHooks(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsHooks)
 	t.Run("Candles", testCandlesHooks)
+	t.Run("CostBasisLots", testCostBasisLotsHooks)
 	t.Run("Datahistoryjobs", testDatahistoryjobsHooks)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsHooks)
+	t.Run("Events", testEventsHooks)
+	t.Run("EventExecutions", testEventExecutionsHooks)
 	t.Run("Exchanges", testExchangesHooks)
+	t.Run("Fills", testFillsHooks)
+	t.Run("OrderHistories", testOrderHistoriesHooks)
+	t.Run("Orders", testOrdersHooks)
 	t.Run("Scripts", testScriptsHooks)
 	t.Run("ScriptExecutions", testScriptExecutionsHooks)
 	t.Run("Trades", testTradesHooks)
@@ -169,13 +235,25 @@ func TestInsert(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsInsert)
 	t.Run("AuditEvents", testAuditEventsInsertWhitelist)
 	t.Run("Candles", testCandlesInsert)
+	t.Run("CostBasisLots", testCostBasisLotsInsert)
 	t.Run("Candles", testCandlesInsertWhitelist)
+	t.Run("CostBasisLots", testCostBasisLotsInsertWhitelist)
 	t.Run("Datahistoryjobs", testDatahistoryjobsInsert)
 	t.Run("Datahistoryjobs", testDatahistoryjobsInsertWhitelist)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsInsert)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsInsertWhitelist)
+	t.Run("Events", testEventsInsert)
+	t.Run("Events", testEventsInsertWhitelist)
+	t.Run("EventExecutions", testEventExecutionsInsert)
+	t.Run("EventExecutions", testEventExecutionsInsertWhitelist)
 	t.Run("Exchanges", testExchangesInsert)
 	t.Run("Exchanges", testExchangesInsertWhitelist)
+	t.Run("Fills", testFillsInsert)
+	t.Run("Fills", testFillsInsertWhitelist)
+	t.Run("OrderHistories", testOrderHistoriesInsert)
+	t.Run("OrderHistories", testOrderHistoriesInsertWhitelist)
+	t.Run("Orders", testOrdersInsert)
+	t.Run("Orders", testOrdersInsertWhitelist)
 	t.Run("Scripts", testScriptsInsert)
 	t.Run("Scripts", testScriptsInsertWhitelist)
 	t.Run("ScriptExecutions", testScriptExecutionsInsert)
@@ -199,6 +277,7 @@ func TestToOne(t *testing.T) {
 	t.Run("DatahistoryjobToExchangeUsingExchangeName", testDatahistoryjobToOneExchangeUsingExchangeName)
 	t.Run("DatahistoryjobToExchangeUsingSecondaryExchange", testDatahistoryjobToOneExchangeUsingSecondaryExchange)
 	t.Run("DatahistoryjobresultToDatahistoryjobUsingJob", testDatahistoryjobresultToOneDatahistoryjobUsingJob)
+	t.Run("EventExecutionToEventUsingEvent", testEventExecutionToOneEventUsingEvent)
 	t.Run("ScriptExecutionToScriptUsingScript", testScriptExecutionToOneScriptUsingScript)
 	t.Run("TradeToExchangeUsingExchangeName", testTradeToOneExchangeUsingExchangeName)
 	t.Run("WithdrawalCryptoToWithdrawalHistoryUsingWithdrawalHistory", testWithdrawalCryptoToOneWithdrawalHistoryUsingWithdrawalHistory)
@@ -221,6 +300,7 @@ func TestToMany(t *testing.T) {
 	t.Run("DatahistoryjobToPrerequisiteJobDatahistoryjobs", testDatahistoryjobToManyPrerequisiteJobDatahistoryjobs)
 	t.Run("DatahistoryjobToJobDatahistoryjobs", testDatahistoryjobToManyJobDatahistoryjobs)
 	t.Run("DatahistoryjobToJobDatahistoryjobresults", testDatahistoryjobToManyJobDatahistoryjobresults)
+	t.Run("EventToEventExecutions", testEventToManyEventExecutions)
 	t.Run("ExchangeToExchangeNameDatahistoryjobs", testExchangeToManyExchangeNameDatahistoryjobs)
 	t.Run("ExchangeToSecondaryExchangeDatahistoryjobs", testExchangeToManySecondaryExchangeDatahistoryjobs)
 	t.Run("ExchangeToExchangeNameWithdrawalHistories", testExchangeToManyExchangeNameWithdrawalHistories)
@@ -238,6 +318,7 @@ func TestToOneSet(t *testing.T) {
 	t.Run("DatahistoryjobToExchangeUsingExchangeNameDatahistoryjobs", testDatahistoryjobToOneSetOpExchangeUsingExchangeName)
 	t.Run("DatahistoryjobToExchangeUsingSecondaryExchangeDatahistoryjobs", testDatahistoryjobToOneSetOpExchangeUsingSecondaryExchange)
 	t.Run("DatahistoryjobresultToDatahistoryjobUsingJobDatahistoryjobresults", testDatahistoryjobresultToOneSetOpDatahistoryjobUsingJob)
+	t.Run("EventExecutionToEventUsingEventExecutions", testEventExecutionToOneSetOpEventUsingEvent)
 	t.Run("ScriptExecutionToScriptUsingScriptExecutions", testScriptExecutionToOneSetOpScriptUsingScript)
 	t.Run("TradeToExchangeUsingExchangeNameTrade", testTradeToOneSetOpExchangeUsingExchangeName)
 	t.Run("WithdrawalCryptoToWithdrawalHistoryUsingWithdrawalCryptos", testWithdrawalCryptoToOneSetOpWithdrawalHistoryUsingWithdrawalHistory)
@@ -272,6 +353,7 @@ func TestToManyAdd(t *testing.T) {
 	t.Run("DatahistoryjobToPrerequisiteJobDatahistoryjobs", testDatahistoryjobToManyAddOpPrerequisiteJobDatahistoryjobs)
 	t.Run("DatahistoryjobToJobDatahistoryjobs", testDatahistoryjobToManyAddOpJobDatahistoryjobs)
 	t.Run("DatahistoryjobToJobDatahistoryjobresults", testDatahistoryjobToManyAddOpJobDatahistoryjobresults)
+	t.Run("EventToEventExecutions", testEventToManyAddOpEventExecutions)
 	t.Run("ExchangeToExchangeNameDatahistoryjobs", testExchangeToManyAddOpExchangeNameDatahistoryjobs)
 	t.Run("ExchangeToSecondaryExchangeDatahistoryjobs", testExchangeToManyAddOpSecondaryExchangeDatahistoryjobs)
 	t.Run("ExchangeToExchangeNameWithdrawalHistories", testExchangeToManyAddOpExchangeNameWithdrawalHistories)
@@ -303,9 +385,15 @@ func TestToManyRemove(t *testing.T) {
 func TestReload(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsReload)
 	t.Run("Candles", testCandlesReload)
+	t.Run("CostBasisLots", testCostBasisLotsReload)
 	t.Run("Datahistoryjobs", testDatahistoryjobsReload)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsReload)
+	t.Run("Events", testEventsReload)
+	t.Run("EventExecutions", testEventExecutionsReload)
 	t.Run("Exchanges", testExchangesReload)
+	t.Run("Fills", testFillsReload)
+	t.Run("OrderHistories", testOrderHistoriesReload)
+	t.Run("Orders", testOrdersReload)
 	t.Run("Scripts", testScriptsReload)
 	t.Run("ScriptExecutions", testScriptExecutionsReload)
 	t.Run("Trades", testTradesReload)
@@ -317,9 +405,15 @@ func TestReload(t *testing.T) {
 func TestReloadAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsReloadAll)
 	t.Run("Candles", testCandlesReloadAll)
+	t.Run("CostBasisLots", testCostBasisLotsReloadAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsReloadAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsReloadAll)
+	t.Run("Events", testEventsReloadAll)
+	t.Run("EventExecutions", testEventExecutionsReloadAll)
 	t.Run("Exchanges", testExchangesReloadAll)
+	t.Run("Fills", testFillsReloadAll)
+	t.Run("OrderHistories", testOrderHistoriesReloadAll)
+	t.Run("Orders", testOrdersReloadAll)
 	t.Run("Scripts", testScriptsReloadAll)
 	t.Run("ScriptExecutions", testScriptExecutionsReloadAll)
 	t.Run("Trades", testTradesReloadAll)
@@ -331,9 +425,15 @@ func TestReloadAll(t *testing.T) {
 func TestSelect(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSelect)
 	t.Run("Candles", testCandlesSelect)
+	t.Run("CostBasisLots", testCostBasisLotsSelect)
 	t.Run("Datahistoryjobs", testDatahistoryjobsSelect)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSelect)
+	t.Run("Events", testEventsSelect)
+	t.Run("EventExecutions", testEventExecutionsSelect)
 	t.Run("Exchanges", testExchangesSelect)
+	t.Run("Fills", testFillsSelect)
+	t.Run("OrderHistories", testOrderHistoriesSelect)
+	t.Run("Orders", testOrdersSelect)
 	t.Run("Scripts", testScriptsSelect)
 	t.Run("ScriptExecutions", testScriptExecutionsSelect)
 	t.Run("Trades", testTradesSelect)
@@ -345,9 +445,15 @@ func TestSelect(t *testing.T) {
 func TestUpdate(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsUpdate)
 	t.Run("Candles", testCandlesUpdate)
+	t.Run("CostBasisLots", testCostBasisLotsUpdate)
 	t.Run("Datahistoryjobs", testDatahistoryjobsUpdate)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsUpdate)
+	t.Run("Events", testEventsUpdate)
+	t.Run("EventExecutions", testEventExecutionsUpdate)
 	t.Run("Exchanges", testExchangesUpdate)
+	t.Run("Fills", testFillsUpdate)
+	t.Run("OrderHistories", testOrderHistoriesUpdate)
+	t.Run("Orders", testOrdersUpdate)
 	t.Run("Scripts", testScriptsUpdate)
 	t.Run("ScriptExecutions", testScriptExecutionsUpdate)
 	t.Run("Trades", testTradesUpdate)
@@ -359,9 +465,15 @@ func TestUpdate(t *testing.T) {
 func TestSliceUpdateAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSliceUpdateAll)
 	t.Run("Candles", testCandlesSliceUpdateAll)
+	t.Run("CostBasisLots", testCostBasisLotsSliceUpdateAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsSliceUpdateAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSliceUpdateAll)
+	t.Run("Events", testEventsSliceUpdateAll)
+	t.Run("EventExecutions", testEventExecutionsSliceUpdateAll)
 	t.Run("Exchanges", testExchangesSliceUpdateAll)
+	t.Run("Fills", testFillsSliceUpdateAll)
+	t.Run("OrderHistories", testOrderHistoriesSliceUpdateAll)
+	t.Run("Orders", testOrdersSliceUpdateAll)
 	t.Run("Scripts", testScriptsSliceUpdateAll)
 	t.Run("ScriptExecutions", testScriptExecutionsSliceUpdateAll)
 	t.Run("Trades", testTradesSliceUpdateAll)
@@ -6,10 +6,16 @@ package sqlite3
 var TableNames = struct {
 	AuditEvent              string
 	Candle                  string
+	CostBasisLot            string
 	Datahistoryjob          string
 	Datahistoryjobrelations string
 	Datahistoryjobresult    string
+	Event                   string
+	EventExecution          string
 	Exchange                string
+	Fill                    string
+	OrderHistory            string
+	Orders                  string
 	Script                  string
 	ScriptExecution         string
 	Trade                   string
@@ -19,10 +25,16 @@ var TableNames = struct {
 }{
 	AuditEvent:              "audit_event",
 	Candle:                  "candle",
+	CostBasisLot:            "cost_basis_lot",
 	Datahistoryjob:          "datahistoryjob",
 	Datahistoryjobrelations: "datahistoryjobrelations",
 	Datahistoryjobresult:    "datahistoryjobresult",
+	Event:                   "event",
+	EventExecution:          "event_execution",
 	Exchange:                "exchange",
+	Fill:                    "fill",
+	OrderHistory:            "order_history",
+	Orders:                  "orders",
 	Script:                  "script",
 	ScriptExecution:         "script_execution",
 	Trade:                   "trade",
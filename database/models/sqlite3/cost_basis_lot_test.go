@@ -0,0 +1,684 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testCostBasisLots(t *testing.T) {
+	t.Parallel()
+
+	query := CostBasisLots()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testCostBasisLotsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CostBasisLot{}
+	if err = randomize.Struct(seed, o, costBasisLotDBTypes, true, costBasisLotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CostBasisLot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := CostBasisLots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCostBasisLotsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CostBasisLot{}
+	if err = randomize.Struct(seed, o, costBasisLotDBTypes, true, costBasisLotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CostBasisLot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := CostBasisLots().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := CostBasisLots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCostBasisLotsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CostBasisLot{}
+	if err = randomize.Struct(seed, o, costBasisLotDBTypes, true, costBasisLotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CostBasisLot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := CostBasisLotSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := CostBasisLots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCostBasisLotsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CostBasisLot{}
+	if err = randomize.Struct(seed, o, costBasisLotDBTypes, true, costBasisLotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CostBasisLot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := CostBasisLotExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if CostBasisLot exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected CostBasisLotExists to return true, but got false.")
+	}
+}
+
+func testCostBasisLotsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CostBasisLot{}
+	if err = randomize.Struct(seed, o, costBasisLotDBTypes, true, costBasisLotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CostBasisLot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	costBasisLotFound, err := FindCostBasisLot(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if costBasisLotFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testCostBasisLotsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CostBasisLot{}
+	if err = randomize.Struct(seed, o, costBasisLotDBTypes, true, costBasisLotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CostBasisLot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = CostBasisLots().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCostBasisLotsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CostBasisLot{}
+	if err = randomize.Struct(seed, o, costBasisLotDBTypes, true, costBasisLotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CostBasisLot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := CostBasisLots().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testCostBasisLotsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	costBasisLotOne := &CostBasisLot{}
+	costBasisLotTwo := &CostBasisLot{}
+	if err = randomize.Struct(seed, costBasisLotOne, costBasisLotDBTypes, false, costBasisLotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CostBasisLot struct: %s", err)
+	}
+	if err = randomize.Struct(seed, costBasisLotTwo, costBasisLotDBTypes, false, costBasisLotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CostBasisLot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = costBasisLotOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = costBasisLotTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := CostBasisLots().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testCostBasisLotsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	costBasisLotOne := &CostBasisLot{}
+	costBasisLotTwo := &CostBasisLot{}
+	if err = randomize.Struct(seed, costBasisLotOne, costBasisLotDBTypes, false, costBasisLotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CostBasisLot struct: %s", err)
+	}
+	if err = randomize.Struct(seed, costBasisLotTwo, costBasisLotDBTypes, false, costBasisLotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CostBasisLot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = costBasisLotOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = costBasisLotTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CostBasisLots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func costBasisLotBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *CostBasisLot) error {
+	*o = CostBasisLot{}
+	return nil
+}
+
+func costBasisLotAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *CostBasisLot) error {
+	*o = CostBasisLot{}
+	return nil
+}
+
+func costBasisLotAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *CostBasisLot) error {
+	*o = CostBasisLot{}
+	return nil
+}
+
+func costBasisLotBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *CostBasisLot) error {
+	*o = CostBasisLot{}
+	return nil
+}
+
+func costBasisLotAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *CostBasisLot) error {
+	*o = CostBasisLot{}
+	return nil
+}
+
+func costBasisLotBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *CostBasisLot) error {
+	*o = CostBasisLot{}
+	return nil
+}
+
+func costBasisLotAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *CostBasisLot) error {
+	*o = CostBasisLot{}
+	return nil
+}
+
+func costBasisLotBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *CostBasisLot) error {
+	*o = CostBasisLot{}
+	return nil
+}
+
+func costBasisLotAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *CostBasisLot) error {
+	*o = CostBasisLot{}
+	return nil
+}
+
+func testCostBasisLotsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &CostBasisLot{}
+	o := &CostBasisLot{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, costBasisLotDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize CostBasisLot object: %s", err)
+	}
+
+	AddCostBasisLotHook(boil.BeforeInsertHook, costBasisLotBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	costBasisLotBeforeInsertHooks = []CostBasisLotHook{}
+
+	AddCostBasisLotHook(boil.AfterInsertHook, costBasisLotAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	costBasisLotAfterInsertHooks = []CostBasisLotHook{}
+
+	AddCostBasisLotHook(boil.AfterSelectHook, costBasisLotAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	costBasisLotAfterSelectHooks = []CostBasisLotHook{}
+
+	AddCostBasisLotHook(boil.BeforeUpdateHook, costBasisLotBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	costBasisLotBeforeUpdateHooks = []CostBasisLotHook{}
+
+	AddCostBasisLotHook(boil.AfterUpdateHook, costBasisLotAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	costBasisLotAfterUpdateHooks = []CostBasisLotHook{}
+
+	AddCostBasisLotHook(boil.BeforeDeleteHook, costBasisLotBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	costBasisLotBeforeDeleteHooks = []CostBasisLotHook{}
+
+	AddCostBasisLotHook(boil.AfterDeleteHook, costBasisLotAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	costBasisLotAfterDeleteHooks = []CostBasisLotHook{}
+
+	AddCostBasisLotHook(boil.BeforeUpsertHook, costBasisLotBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	costBasisLotBeforeUpsertHooks = []CostBasisLotHook{}
+
+	AddCostBasisLotHook(boil.AfterUpsertHook, costBasisLotAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	costBasisLotAfterUpsertHooks = []CostBasisLotHook{}
+}
+
+func testCostBasisLotsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CostBasisLot{}
+	if err = randomize.Struct(seed, o, costBasisLotDBTypes, true, costBasisLotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CostBasisLot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CostBasisLots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testCostBasisLotsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CostBasisLot{}
+	if err = randomize.Struct(seed, o, costBasisLotDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize CostBasisLot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(costBasisLotColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CostBasisLots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testCostBasisLotsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CostBasisLot{}
+	if err = randomize.Struct(seed, o, costBasisLotDBTypes, true, costBasisLotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CostBasisLot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCostBasisLotsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CostBasisLot{}
+	if err = randomize.Struct(seed, o, costBasisLotDBTypes, true, costBasisLotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CostBasisLot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := CostBasisLotSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCostBasisLotsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CostBasisLot{}
+	if err = randomize.Struct(seed, o, costBasisLotDBTypes, true, costBasisLotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CostBasisLot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := CostBasisLots().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	costBasisLotDBTypes = map[string]string{`ID`: `TEXT`, `ExchangeName`: `TEXT`, `Currency`: `TEXT`, `TradeID`: `TEXT`, `Amount`: `REAL`, `Cost`: `REAL`, `Acquired`: `TIMESTAMP`}
+	_                   = bytes.MinRead
+)
+
+func testCostBasisLotsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(costBasisLotPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(costBasisLotAllColumns) == len(costBasisLotPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CostBasisLot{}
+	if err = randomize.Struct(seed, o, costBasisLotDBTypes, true, costBasisLotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CostBasisLot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CostBasisLots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, costBasisLotDBTypes, true, costBasisLotPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize CostBasisLot struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testCostBasisLotsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(costBasisLotAllColumns) == len(costBasisLotPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CostBasisLot{}
+	if err = randomize.Struct(seed, o, costBasisLotDBTypes, true, costBasisLotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CostBasisLot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CostBasisLots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, costBasisLotDBTypes, true, costBasisLotPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize CostBasisLot struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(costBasisLotAllColumns, costBasisLotPrimaryKeyColumns) {
+		fields = costBasisLotAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			costBasisLotAllColumns,
+			costBasisLotPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := CostBasisLotSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
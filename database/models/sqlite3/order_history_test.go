@@ -0,0 +1,684 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testOrderHistories(t *testing.T) {
+	t.Parallel()
+
+	query := OrderHistories()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testOrderHistoriesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderHistory{}
+	if err = randomize.Struct(seed, o, orderHistoryDBTypes, true, orderHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OrderHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOrderHistoriesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderHistory{}
+	if err = randomize.Struct(seed, o, orderHistoryDBTypes, true, orderHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := OrderHistories().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OrderHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOrderHistoriesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderHistory{}
+	if err = randomize.Struct(seed, o, orderHistoryDBTypes, true, orderHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OrderHistorySlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OrderHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOrderHistoriesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderHistory{}
+	if err = randomize.Struct(seed, o, orderHistoryDBTypes, true, orderHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := OrderHistoryExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if OrderHistory exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected OrderHistoryExists to return true, but got false.")
+	}
+}
+
+func testOrderHistoriesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderHistory{}
+	if err = randomize.Struct(seed, o, orderHistoryDBTypes, true, orderHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	orderHistoryFound, err := FindOrderHistory(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if orderHistoryFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testOrderHistoriesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderHistory{}
+	if err = randomize.Struct(seed, o, orderHistoryDBTypes, true, orderHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = OrderHistories().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOrderHistoriesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderHistory{}
+	if err = randomize.Struct(seed, o, orderHistoryDBTypes, true, orderHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := OrderHistories().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testOrderHistoriesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	orderHistoryOne := &OrderHistory{}
+	orderHistoryTwo := &OrderHistory{}
+	if err = randomize.Struct(seed, orderHistoryOne, orderHistoryDBTypes, false, orderHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderHistory struct: %s", err)
+	}
+	if err = randomize.Struct(seed, orderHistoryTwo, orderHistoryDBTypes, false, orderHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = orderHistoryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = orderHistoryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := OrderHistories().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testOrderHistoriesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	orderHistoryOne := &OrderHistory{}
+	orderHistoryTwo := &OrderHistory{}
+	if err = randomize.Struct(seed, orderHistoryOne, orderHistoryDBTypes, false, orderHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderHistory struct: %s", err)
+	}
+	if err = randomize.Struct(seed, orderHistoryTwo, orderHistoryDBTypes, false, orderHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = orderHistoryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = orderHistoryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OrderHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func orderHistoryBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *OrderHistory) error {
+	*o = OrderHistory{}
+	return nil
+}
+
+func orderHistoryAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *OrderHistory) error {
+	*o = OrderHistory{}
+	return nil
+}
+
+func orderHistoryAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *OrderHistory) error {
+	*o = OrderHistory{}
+	return nil
+}
+
+func orderHistoryBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *OrderHistory) error {
+	*o = OrderHistory{}
+	return nil
+}
+
+func orderHistoryAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *OrderHistory) error {
+	*o = OrderHistory{}
+	return nil
+}
+
+func orderHistoryBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *OrderHistory) error {
+	*o = OrderHistory{}
+	return nil
+}
+
+func orderHistoryAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *OrderHistory) error {
+	*o = OrderHistory{}
+	return nil
+}
+
+func orderHistoryBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *OrderHistory) error {
+	*o = OrderHistory{}
+	return nil
+}
+
+func orderHistoryAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *OrderHistory) error {
+	*o = OrderHistory{}
+	return nil
+}
+
+func testOrderHistoriesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &OrderHistory{}
+	o := &OrderHistory{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, orderHistoryDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize OrderHistory object: %s", err)
+	}
+
+	AddOrderHistoryHook(boil.BeforeInsertHook, orderHistoryBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	orderHistoryBeforeInsertHooks = []OrderHistoryHook{}
+
+	AddOrderHistoryHook(boil.AfterInsertHook, orderHistoryAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	orderHistoryAfterInsertHooks = []OrderHistoryHook{}
+
+	AddOrderHistoryHook(boil.AfterSelectHook, orderHistoryAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	orderHistoryAfterSelectHooks = []OrderHistoryHook{}
+
+	AddOrderHistoryHook(boil.BeforeUpdateHook, orderHistoryBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	orderHistoryBeforeUpdateHooks = []OrderHistoryHook{}
+
+	AddOrderHistoryHook(boil.AfterUpdateHook, orderHistoryAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	orderHistoryAfterUpdateHooks = []OrderHistoryHook{}
+
+	AddOrderHistoryHook(boil.BeforeDeleteHook, orderHistoryBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	orderHistoryBeforeDeleteHooks = []OrderHistoryHook{}
+
+	AddOrderHistoryHook(boil.AfterDeleteHook, orderHistoryAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	orderHistoryAfterDeleteHooks = []OrderHistoryHook{}
+
+	AddOrderHistoryHook(boil.BeforeUpsertHook, orderHistoryBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	orderHistoryBeforeUpsertHooks = []OrderHistoryHook{}
+
+	AddOrderHistoryHook(boil.AfterUpsertHook, orderHistoryAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	orderHistoryAfterUpsertHooks = []OrderHistoryHook{}
+}
+
+func testOrderHistoriesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderHistory{}
+	if err = randomize.Struct(seed, o, orderHistoryDBTypes, true, orderHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OrderHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOrderHistoriesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderHistory{}
+	if err = randomize.Struct(seed, o, orderHistoryDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize OrderHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(orderHistoryColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OrderHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOrderHistoriesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderHistory{}
+	if err = randomize.Struct(seed, o, orderHistoryDBTypes, true, orderHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOrderHistoriesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderHistory{}
+	if err = randomize.Struct(seed, o, orderHistoryDBTypes, true, orderHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OrderHistorySlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOrderHistoriesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderHistory{}
+	if err = randomize.Struct(seed, o, orderHistoryDBTypes, true, orderHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := OrderHistories().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	orderHistoryDBTypes = map[string]string{`ID`: `TEXT`, `ExchangeName`: `TEXT`, `OrderID`: `TEXT`, `Status`: `TEXT`, `Price`: `REAL`, `Amount`: `REAL`, `ExecutedAmount`: `REAL`, `RemainingAmount`: `REAL`, `AverageExecutedPrice`: `REAL`, `Fee`: `REAL`, `Cost`: `REAL`, `LastUpdated`: `TIMESTAMP`}
+	_                   = bytes.MinRead
+)
+
+func testOrderHistoriesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(orderHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(orderHistoryAllColumns) == len(orderHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderHistory{}
+	if err = randomize.Struct(seed, o, orderHistoryDBTypes, true, orderHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OrderHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, orderHistoryDBTypes, true, orderHistoryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OrderHistory struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testOrderHistoriesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(orderHistoryAllColumns) == len(orderHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderHistory{}
+	if err = randomize.Struct(seed, o, orderHistoryDBTypes, true, orderHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OrderHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, orderHistoryDBTypes, true, orderHistoryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OrderHistory struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(orderHistoryAllColumns, orderHistoryPrimaryKeyColumns) {
+		fields = orderHistoryAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			orderHistoryAllColumns,
+			orderHistoryPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := OrderHistorySlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
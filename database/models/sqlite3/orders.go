@@ -0,0 +1,856 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+	"github.com/volatiletech/null"
+)
+
+// Order is an object representing the database table.
+type Order struct {
+	ID                   string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ExchangeName         string      `boil:"exchange_name" json:"exchange_name" toml:"exchange_name" yaml:"exchange_name"`
+	OrderID              string      `boil:"order_id" json:"order_id" toml:"order_id" yaml:"order_id"`
+	ClientOrderID        null.String `boil:"client_order_id" json:"client_order_id,omitempty" toml:"client_order_id" yaml:"client_order_id,omitempty"`
+	Base                 string      `boil:"base" json:"base" toml:"base" yaml:"base"`
+	Quote                string      `boil:"quote" json:"quote" toml:"quote" yaml:"quote"`
+	Asset                string      `boil:"asset" json:"asset" toml:"asset" yaml:"asset"`
+	Side                 string      `boil:"side" json:"side" toml:"side" yaml:"side"`
+	Type                 string      `boil:"type" json:"type" toml:"type" yaml:"type"`
+	Status               string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Price                float64     `boil:"price" json:"price" toml:"price" yaml:"price"`
+	Amount               float64     `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	ExecutedAmount       float64     `boil:"executed_amount" json:"executed_amount" toml:"executed_amount" yaml:"executed_amount"`
+	RemainingAmount      float64     `boil:"remaining_amount" json:"remaining_amount" toml:"remaining_amount" yaml:"remaining_amount"`
+	AverageExecutedPrice float64     `boil:"average_executed_price" json:"average_executed_price" toml:"average_executed_price" yaml:"average_executed_price"`
+	Fee                  float64     `boil:"fee" json:"fee" toml:"fee" yaml:"fee"`
+	Cost                 float64     `boil:"cost" json:"cost" toml:"cost" yaml:"cost"`
+	Date                 string      `boil:"date" json:"date" toml:"date" yaml:"date"`
+	LastUpdated          string      `boil:"last_updated" json:"last_updated" toml:"last_updated" yaml:"last_updated"`
+	FeeAsset             null.String `boil:"fee_asset" json:"fee_asset,omitempty" toml:"fee_asset" yaml:"fee_asset,omitempty"`
+
+	R *orderR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L orderL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var OrderColumns = struct {
+	ID                   string
+	ExchangeName         string
+	OrderID              string
+	ClientOrderID        string
+	Base                 string
+	Quote                string
+	Asset                string
+	Side                 string
+	Type                 string
+	Status               string
+	Price                string
+	Amount               string
+	ExecutedAmount       string
+	RemainingAmount      string
+	AverageExecutedPrice string
+	Fee                  string
+	Cost                 string
+	Date                 string
+	LastUpdated          string
+	FeeAsset             string
+}{
+	ID:                   "id",
+	ExchangeName:         "exchange_name",
+	OrderID:              "order_id",
+	ClientOrderID:        "client_order_id",
+	Base:                 "base",
+	Quote:                "quote",
+	Asset:                "asset",
+	Side:                 "side",
+	Type:                 "type",
+	Status:               "status",
+	Price:                "price",
+	Amount:               "amount",
+	ExecutedAmount:       "executed_amount",
+	RemainingAmount:      "remaining_amount",
+	AverageExecutedPrice: "average_executed_price",
+	Fee:                  "fee",
+	Cost:                 "cost",
+	Date:                 "date",
+	LastUpdated:          "last_updated",
+	FeeAsset:             "fee_asset",
+}
+
+// Generated where
+
+var OrderWhere = struct {
+	ID                   whereHelperstring
+	ExchangeName         whereHelperstring
+	OrderID              whereHelperstring
+	ClientOrderID        whereHelpernull_String
+	Base                 whereHelperstring
+	Quote                whereHelperstring
+	Asset                whereHelperstring
+	Side                 whereHelperstring
+	Type                 whereHelperstring
+	Status               whereHelperstring
+	Price                whereHelperfloat64
+	Amount               whereHelperfloat64
+	ExecutedAmount       whereHelperfloat64
+	RemainingAmount      whereHelperfloat64
+	AverageExecutedPrice whereHelperfloat64
+	Fee                  whereHelperfloat64
+	Cost                 whereHelperfloat64
+	Date                 whereHelperstring
+	LastUpdated          whereHelperstring
+	FeeAsset             whereHelpernull_String
+}{
+	ID:                   whereHelperstring{field: "\"orders\".\"id\""},
+	ExchangeName:         whereHelperstring{field: "\"orders\".\"exchange_name\""},
+	OrderID:              whereHelperstring{field: "\"orders\".\"order_id\""},
+	ClientOrderID:        whereHelpernull_String{field: "\"orders\".\"client_order_id\""},
+	Base:                 whereHelperstring{field: "\"orders\".\"base\""},
+	Quote:                whereHelperstring{field: "\"orders\".\"quote\""},
+	Asset:                whereHelperstring{field: "\"orders\".\"asset\""},
+	Side:                 whereHelperstring{field: "\"orders\".\"side\""},
+	Type:                 whereHelperstring{field: "\"orders\".\"type\""},
+	Status:               whereHelperstring{field: "\"orders\".\"status\""},
+	Price:                whereHelperfloat64{field: "\"orders\".\"price\""},
+	Amount:               whereHelperfloat64{field: "\"orders\".\"amount\""},
+	ExecutedAmount:       whereHelperfloat64{field: "\"orders\".\"executed_amount\""},
+	RemainingAmount:      whereHelperfloat64{field: "\"orders\".\"remaining_amount\""},
+	AverageExecutedPrice: whereHelperfloat64{field: "\"orders\".\"average_executed_price\""},
+	Fee:                  whereHelperfloat64{field: "\"orders\".\"fee\""},
+	Cost:                 whereHelperfloat64{field: "\"orders\".\"cost\""},
+	Date:                 whereHelperstring{field: "\"orders\".\"date\""},
+	LastUpdated:          whereHelperstring{field: "\"orders\".\"last_updated\""},
+	FeeAsset:             whereHelpernull_String{field: "\"orders\".\"fee_asset\""},
+}
+
+// OrderRels is where relationship names are stored.
+var OrderRels = struct {
+}{}
+
+// orderR is where relationships are stored.
+type orderR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*orderR) NewStruct() *orderR {
+	return &orderR{}
+}
+
+// orderL is where Load methods for each relationship are stored.
+type orderL struct{}
+
+var (
+	orderAllColumns            = []string{"id", "exchange_name", "order_id", "client_order_id", "base", "quote", "asset", "side", "type", "status", "price", "amount", "executed_amount", "remaining_amount", "average_executed_price", "fee", "cost", "date", "last_updated", "fee_asset"}
+	orderColumnsWithoutDefault = []string{"id", "exchange_name", "order_id", "client_order_id", "base", "quote", "asset", "side", "type", "status", "price", "amount", "executed_amount", "remaining_amount", "average_executed_price", "fee", "cost", "date", "last_updated", "fee_asset"}
+	orderColumnsWithDefault    = []string{}
+	orderPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// OrderSlice is an alias for a slice of pointers to Order.
+	// This should generally be used opposed to []Order.
+	OrderSlice []*Order
+	// OrderHook is the signature for custom Order hook methods
+	OrderHook func(context.Context, boil.ContextExecutor, *Order) error
+
+	orderQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	orderType                 = reflect.TypeOf(&Order{})
+	orderMapping              = queries.MakeStructMapping(orderType)
+	orderPrimaryKeyMapping, _ = queries.BindMapping(orderType, orderMapping, orderPrimaryKeyColumns)
+	orderInsertCacheMut       sync.RWMutex
+	orderInsertCache          = make(map[string]insertCache)
+	orderUpdateCacheMut       sync.RWMutex
+	orderUpdateCache          = make(map[string]updateCache)
+	orderUpsertCacheMut       sync.RWMutex
+	orderUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var orderBeforeInsertHooks []OrderHook
+var orderBeforeUpdateHooks []OrderHook
+var orderBeforeDeleteHooks []OrderHook
+var orderBeforeUpsertHooks []OrderHook
+
+var orderAfterInsertHooks []OrderHook
+var orderAfterSelectHooks []OrderHook
+var orderAfterUpdateHooks []OrderHook
+var orderAfterDeleteHooks []OrderHook
+var orderAfterUpsertHooks []OrderHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Order) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Order) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Order) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Order) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Order) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Order) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Order) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Order) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Order) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddOrderHook registers your hook function for all future operations.
+func AddOrderHook(hookPoint boil.HookPoint, orderHook OrderHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		orderBeforeInsertHooks = append(orderBeforeInsertHooks, orderHook)
+	case boil.BeforeUpdateHook:
+		orderBeforeUpdateHooks = append(orderBeforeUpdateHooks, orderHook)
+	case boil.BeforeDeleteHook:
+		orderBeforeDeleteHooks = append(orderBeforeDeleteHooks, orderHook)
+	case boil.BeforeUpsertHook:
+		orderBeforeUpsertHooks = append(orderBeforeUpsertHooks, orderHook)
+	case boil.AfterInsertHook:
+		orderAfterInsertHooks = append(orderAfterInsertHooks, orderHook)
+	case boil.AfterSelectHook:
+		orderAfterSelectHooks = append(orderAfterSelectHooks, orderHook)
+	case boil.AfterUpdateHook:
+		orderAfterUpdateHooks = append(orderAfterUpdateHooks, orderHook)
+	case boil.AfterDeleteHook:
+		orderAfterDeleteHooks = append(orderAfterDeleteHooks, orderHook)
+	case boil.AfterUpsertHook:
+		orderAfterUpsertHooks = append(orderAfterUpsertHooks, orderHook)
+	}
+}
+
+// One returns a single order record from the query.
+func (q orderQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Order, error) {
+	o := &Order{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: failed to execute a one query for orders")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Order records from the query.
+func (q orderQuery) All(ctx context.Context, exec boil.ContextExecutor) (OrderSlice, error) {
+	var o []*Order
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "sqlite3: failed to assign all query results to Order slice")
+	}
+
+	if len(orderAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Order records in the query.
+func (q orderQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to count orders rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q orderQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: failed to check if orders exists")
+	}
+
+	return count > 0, nil
+}
+
+// Orders retrieves all the records using an executor.
+func Orders(mods ...qm.QueryMod) orderQuery {
+	mods = append(mods, qm.From("\"orders\""))
+	return orderQuery{NewQuery(mods...)}
+}
+
+// FindOrder retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindOrder(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*Order, error) {
+	orderObj := &Order{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"orders\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, orderObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: unable to select from orders")
+	}
+
+	return orderObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Order) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("sqlite3: no orders provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(orderColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	orderInsertCacheMut.RLock()
+	cache, cached := orderInsertCache[key]
+	orderInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			orderAllColumns,
+			orderColumnsWithDefault,
+			orderColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(orderType, orderMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(orderType, orderMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"orders\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"orders\" () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT \"%s\" FROM \"orders\" WHERE %s", strings.Join(returnColumns, "\",\""), strmangle.WhereClause("\"", "\"", 0, orderPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to insert into orders")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to populate default values for orders")
+	}
+
+CacheNoHooks:
+	if !cached {
+		orderInsertCacheMut.Lock()
+		orderInsertCache[key] = cache
+		orderInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Order.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Order) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	orderUpdateCacheMut.RLock()
+	cache, cached := orderUpdateCache[key]
+	orderUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			orderAllColumns,
+			orderPrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("sqlite3: unable to update orders, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"orders\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, orderPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(orderType, orderMapping, append(wl, orderPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update orders row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by update for orders")
+	}
+
+	if !cached {
+		orderUpdateCacheMut.Lock()
+		orderUpdateCache[key] = cache
+		orderUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q orderQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all for orders")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected for orders")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o OrderSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("sqlite3: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), orderPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"orders\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, orderPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all in order slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected all in update all order")
+	}
+	return rowsAff, nil
+}
+
+// Delete deletes a single Order record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Order) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("sqlite3: no Order provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), orderPrimaryKeyMapping)
+	sql := "DELETE FROM \"orders\" WHERE \"id\"=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete from orders")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by delete for orders")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q orderQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("sqlite3: no orderQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from orders")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for orders")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o OrderSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(orderBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), orderPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"orders\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, orderPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from order slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for orders")
+	}
+
+	if len(orderAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Order) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindOrder(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *OrderSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := OrderSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), orderPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"orders\".* FROM \"orders\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, orderPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to reload all in OrderSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// OrderExists checks if the Order row exists.
+func OrderExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"orders\" where \"id\"=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: unable to check if orders exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,191 @@
+package costbasislot
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// Setup returns a DBService
+func Setup(db database.IDatabase) (*DBService, error) {
+	if db == nil {
+		return nil, nil
+	}
+	if !db.IsConnected() {
+		return nil, nil
+	}
+	cfg := db.GetConfig()
+	dbCon, err := db.GetSQL()
+	if err != nil {
+		return nil, err
+	}
+	return &DBService{
+		sql:    dbCon,
+		driver: cfg.Driver,
+	}, nil
+}
+
+// Insert stores lots in the database
+func (db *DBService) Insert(lots ...*Lot) error {
+	if len(lots) == 0 {
+		return nil
+	}
+	for i := range lots {
+		if lots[i].Currency == "" {
+			return errCurrencyUnset
+		}
+		if lots[i].Amount <= 0 {
+			return fmt.Errorf("%w: %v", errInvalidAmount, lots[i].Amount)
+		}
+	}
+	ctx := context.TODO()
+
+	tx, err := db.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Insert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		err = insertSqlite(ctx, tx, lots...)
+	case database.DBPostgreSQL:
+		err = insertPostgres(ctx, tx, lots...)
+	default:
+		return database.ErrNoDatabaseProvided
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetAll returns all lots ordered by the time they were acquired
+func (db *DBService) GetAll() ([]Lot, error) {
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		return db.getSQLite(qm.OrderBy("acquired"))
+	case database.DBPostgreSQL:
+		return db.getPostgres(qm.OrderBy("acquired"))
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+func insertSqlite(ctx context.Context, tx *sql.Tx, lots ...*Lot) error {
+	for i := range lots {
+		if lots[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			lots[i].ID = freshUUID.String()
+		}
+		var tempLot = sqlite3.CostBasisLot{
+			ID:           lots[i].ID,
+			ExchangeName: lots[i].Exchange,
+			Currency:     lots[i].Currency,
+			TradeID:      lots[i].TradeID,
+			Amount:       lots[i].Amount,
+			Cost:         lots[i].Cost,
+			Acquired:     lots[i].Acquired.UTC().Format(time.RFC3339),
+		}
+		err := tempLot.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func insertPostgres(ctx context.Context, tx *sql.Tx, lots ...*Lot) error {
+	for i := range lots {
+		if lots[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			lots[i].ID = freshUUID.String()
+		}
+		var tempLot = postgres.CostBasisLot{
+			ID:           lots[i].ID,
+			ExchangeName: lots[i].Exchange,
+			Currency:     lots[i].Currency,
+			TradeID:      lots[i].TradeID,
+			Amount:       lots[i].Amount,
+			Cost:         lots[i].Cost,
+			Acquired:     lots[i].Acquired.UTC(),
+		}
+		err := tempLot.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (db *DBService) getSQLite(mods ...qm.QueryMod) ([]Lot, error) {
+	results, err := sqlite3.CostBasisLots(mods...).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Lot, len(results))
+	for i := range results {
+		var acquired time.Time
+		acquired, err = time.Parse(time.RFC3339, results[i].Acquired)
+		if err != nil {
+			return nil, err
+		}
+		resp[i] = Lot{
+			ID:       results[i].ID,
+			Exchange: results[i].ExchangeName,
+			Currency: results[i].Currency,
+			TradeID:  results[i].TradeID,
+			Amount:   results[i].Amount,
+			Cost:     results[i].Cost,
+			Acquired: acquired,
+		}
+	}
+
+	return resp, nil
+}
+
+func (db *DBService) getPostgres(mods ...qm.QueryMod) ([]Lot, error) {
+	results, err := postgres.CostBasisLots(mods...).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Lot, len(results))
+	for i := range results {
+		resp[i] = Lot{
+			ID:       results[i].ID,
+			Exchange: results[i].ExchangeName,
+			Currency: results[i].Currency,
+			TradeID:  results[i].TradeID,
+			Amount:   results[i].Amount,
+			Cost:     results[i].Cost,
+			Acquired: results[i].Acquired,
+		}
+	}
+
+	return resp, nil
+}
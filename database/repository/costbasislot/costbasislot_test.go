@@ -0,0 +1,124 @@
+package costbasislot
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+var verbose = false
+
+func TestMain(m *testing.M) {
+	if verbose {
+		err := testhelpers.EnableVerboseTestOutput()
+		if err != nil {
+			fmt.Printf("failed to enable verbose test output: %v", err)
+			os.Exit(1)
+		}
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = os.MkdirTemp("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	t := m.Run()
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func TestCostBasisLot(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			db, err := Setup(dbConn)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = db.Insert(&Lot{Exchange: "binance", Amount: 1})
+			if !errors.Is(err, errCurrencyUnset) {
+				t.Errorf("received %v, expected %v", err, errCurrencyUnset)
+			}
+			err = db.Insert(&Lot{Exchange: "binance", Currency: "BTC"})
+			if !errors.Is(err, errInvalidAmount) {
+				t.Errorf("received %v, expected %v", err, errInvalidAmount)
+			}
+
+			ts := time.Now().Add(-time.Hour).Truncate(time.Second)
+			err = db.Insert(&Lot{
+				Exchange: "binance",
+				Currency: "ETH",
+				TradeID:  "opening-balance",
+				Amount:   2,
+				Cost:     4000,
+				Acquired: ts.Add(time.Minute),
+			}, &Lot{
+				Currency: "BTC",
+				TradeID:  "opening-balance",
+				Amount:   0.5,
+				Cost:     30000,
+				Acquired: ts,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := db.GetAll()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(resp) != 2 {
+				t.Fatalf("received %v lots, expected %v", len(resp), 2)
+			}
+			if resp[0].Currency != "BTC" || resp[0].Amount != 0.5 || resp[0].Cost != 30000 || !resp[0].Acquired.Equal(ts) {
+				t.Errorf("unexpected lot %+v", resp[0])
+			}
+			if resp[1].Exchange != "binance" || resp[1].TradeID != "opening-balance" {
+				t.Errorf("unexpected lot %+v", resp[1])
+			}
+
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package costbasislot
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+)
+
+var (
+	errCurrencyUnset = errors.New("currency not set")
+	errInvalidAmount = errors.New("lot amount must be greater than zero")
+)
+
+// Lot is a DTO for a cost basis lot acquired outside of a recorded order,
+// such as a holding which predates the order history
+type Lot struct {
+	ID       string
+	Exchange string
+	Currency string
+	TradeID  string
+	Amount   float64
+	Cost     float64
+	Acquired time.Time
+}
+
+// DBService is a service which allows the interaction with
+// the database without a direct reference to a global
+type DBService struct {
+	sql    database.ISQL
+	driver string
+}
+
+// IDBService allows using the cost basis lot database service
+// without needing to care about implementation
+type IDBService interface {
+	Insert(...*Lot) error
+	GetAll() ([]Lot, error)
+}
@@ -0,0 +1,417 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/volatiletech/null"
+)
+
+// Setup returns a DBService
+func Setup(db database.IDatabase) (*DBService, error) {
+	if db == nil {
+		return nil, nil
+	}
+	if !db.IsConnected() {
+		return nil, nil
+	}
+	cfg := db.GetConfig()
+	dbCon, err := db.GetSQL()
+	if err != nil {
+		return nil, err
+	}
+	return &DBService{
+		sql:    dbCon,
+		driver: cfg.Driver,
+	}, nil
+}
+
+// Upsert inserts or updates events in the database. Events without an ID are
+// inserted and assigned the ID generated by the database so IDs are never
+// reused
+func (db *DBService) Upsert(events ...*Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+	ctx := context.TODO()
+
+	tx, err := db.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Upsert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		err = upsertSqlite(ctx, tx, events...)
+	case database.DBPostgreSQL:
+		err = upsertPostgres(ctx, tx, events...)
+	default:
+		return database.ErrNoDatabaseProvided
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetAll returns all stored events ordered by their ID
+func (db *DBService) GetAll() ([]Event, error) {
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		return db.getAllSQLite()
+	case database.DBPostgreSQL:
+		return db.getAllPostgres()
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+// Delete removes an event and its execution history from the database
+func (db *DBService) Delete(eventID int64) error {
+	if eventID <= 0 {
+		return fmt.Errorf("%w %v", errInvalidEventID, eventID)
+	}
+	ctx := context.TODO()
+
+	tx, err := db.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Delete tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		_, err = sqlite3.EventExecutions(qm.Where("event_id = ?", eventID)).DeleteAll(ctx, tx)
+		if err != nil {
+			return err
+		}
+		_, err = sqlite3.Events(qm.Where("id = ?", eventID)).DeleteAll(ctx, tx)
+	case database.DBPostgreSQL:
+		_, err = postgres.EventExecutions(qm.Where("event_id = ?", eventID)).DeleteAll(ctx, tx)
+		if err != nil {
+			return err
+		}
+		_, err = postgres.Events(qm.Where("id = ?", eventID)).DeleteAll(ctx, tx)
+	default:
+		return database.ErrNoDatabaseProvided
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// InsertExecutions stores the execution history of events
+func (db *DBService) InsertExecutions(executions ...*Execution) error {
+	if len(executions) == 0 {
+		return nil
+	}
+	ctx := context.TODO()
+
+	tx, err := db.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "InsertExecutions tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		err = insertExecutionsSqlite(ctx, tx, executions...)
+	case database.DBPostgreSQL:
+		err = insertExecutionsPostgres(ctx, tx, executions...)
+	default:
+		return database.ErrNoDatabaseProvided
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetExecutionsByEventID returns the execution history of an event ordered
+// by execution time
+func (db *DBService) GetExecutionsByEventID(eventID int64) ([]Execution, error) {
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		return db.getExecutionsByEventIDSQLite(eventID)
+	case database.DBPostgreSQL:
+		return db.getExecutionsByEventIDPostgres(eventID)
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+func upsertSqlite(ctx context.Context, tx *sql.Tx, events ...*Event) error {
+	for i := range events {
+		if events[i].ID < 0 {
+			return fmt.Errorf("%w %v", errInvalidEventID, events[i].ID)
+		}
+		if events[i].CreatedDate.IsZero() {
+			events[i].CreatedDate = time.Now()
+		}
+		var executed int64
+		if events[i].Executed {
+			executed = 1
+		}
+		var tempEvent = sqlite3.Event{
+			ID:             events[i].ID,
+			ExchangeName:   events[i].ExchangeName,
+			Item:           events[i].Item,
+			Action:         events[i].Action,
+			Definition:     events[i].Definition,
+			Executed:       executed,
+			ExecutionCount: events[i].ExecutionCount,
+			LastExecutedAt: null.NewString(events[i].LastExecuted.UTC().Format(time.RFC3339), !events[i].LastExecuted.IsZero()),
+			Created:        events[i].CreatedDate.UTC().Format(time.RFC3339),
+		}
+		var exists bool
+		if events[i].ID > 0 {
+			var err error
+			exists, err = sqlite3.EventExists(ctx, tx, events[i].ID)
+			if err != nil {
+				return err
+			}
+		}
+		if exists {
+			if _, err := tempEvent.Update(ctx, tx, boil.Blacklist(sqlite3.EventColumns.Created)); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := tempEvent.Insert(ctx, tx, boil.Infer()); err != nil {
+			return err
+		}
+		events[i].ID = tempEvent.ID
+	}
+
+	return nil
+}
+
+func upsertPostgres(ctx context.Context, tx *sql.Tx, events ...*Event) error {
+	for i := range events {
+		if events[i].ID < 0 {
+			return fmt.Errorf("%w %v", errInvalidEventID, events[i].ID)
+		}
+		if events[i].CreatedDate.IsZero() {
+			events[i].CreatedDate = time.Now()
+		}
+		var tempEvent = postgres.Event{
+			ID:             events[i].ID,
+			ExchangeName:   events[i].ExchangeName,
+			Item:           events[i].Item,
+			Action:         events[i].Action,
+			Definition:     events[i].Definition,
+			Executed:       events[i].Executed,
+			ExecutionCount: events[i].ExecutionCount,
+			LastExecutedAt: null.NewTime(events[i].LastExecuted.UTC(), !events[i].LastExecuted.IsZero()),
+			Created:        events[i].CreatedDate.UTC(),
+		}
+		if events[i].ID == 0 {
+			if err := tempEvent.Insert(ctx, tx, boil.Infer()); err != nil {
+				return err
+			}
+			events[i].ID = tempEvent.ID
+			continue
+		}
+		err := tempEvent.Upsert(ctx, tx, true, []string{"id"}, boil.Blacklist(postgres.EventColumns.Created), boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func insertExecutionsSqlite(ctx context.Context, tx *sql.Tx, executions ...*Execution) error {
+	for i := range executions {
+		if executions[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			executions[i].ID = freshUUID.String()
+		}
+		var success int64
+		if executions[i].Success {
+			success = 1
+		}
+		var tempExecution = sqlite3.EventExecution{
+			ID:         executions[i].ID,
+			EventID:    executions[i].EventID,
+			Action:     executions[i].Action,
+			Success:    success,
+			Result:     null.NewString(executions[i].Result, executions[i].Result != ""),
+			ExecutedAt: executions[i].ExecutedAt.UTC().Format(time.RFC3339),
+		}
+		err := tempExecution.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func insertExecutionsPostgres(ctx context.Context, tx *sql.Tx, executions ...*Execution) error {
+	for i := range executions {
+		if executions[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			executions[i].ID = freshUUID.String()
+		}
+		var tempExecution = postgres.EventExecution{
+			ID:         executions[i].ID,
+			EventID:    executions[i].EventID,
+			Action:     executions[i].Action,
+			Success:    executions[i].Success,
+			Result:     null.NewString(executions[i].Result, executions[i].Result != ""),
+			ExecutedAt: executions[i].ExecutedAt.UTC(),
+		}
+		err := tempExecution.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (db *DBService) getAllSQLite() ([]Event, error) {
+	results, err := sqlite3.Events(qm.OrderBy("id")).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Event, len(results))
+	for i := range results {
+		var created, lastExecuted time.Time
+		created, err = time.Parse(time.RFC3339, results[i].Created)
+		if err != nil {
+			return nil, err
+		}
+		if results[i].LastExecutedAt.Valid {
+			lastExecuted, err = time.Parse(time.RFC3339, results[i].LastExecutedAt.String)
+			if err != nil {
+				return nil, err
+			}
+		}
+		resp[i] = Event{
+			ID:             results[i].ID,
+			ExchangeName:   results[i].ExchangeName,
+			Item:           results[i].Item,
+			Action:         results[i].Action,
+			Definition:     results[i].Definition,
+			Executed:       results[i].Executed == 1,
+			ExecutionCount: results[i].ExecutionCount,
+			LastExecuted:   lastExecuted,
+			CreatedDate:    created,
+		}
+	}
+
+	return resp, nil
+}
+
+func (db *DBService) getAllPostgres() ([]Event, error) {
+	results, err := postgres.Events(qm.OrderBy("id")).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Event, len(results))
+	for i := range results {
+		resp[i] = Event{
+			ID:             results[i].ID,
+			ExchangeName:   results[i].ExchangeName,
+			Item:           results[i].Item,
+			Action:         results[i].Action,
+			Definition:     results[i].Definition,
+			Executed:       results[i].Executed,
+			ExecutionCount: results[i].ExecutionCount,
+			LastExecuted:   results[i].LastExecutedAt.Time,
+			CreatedDate:    results[i].Created,
+		}
+	}
+
+	return resp, nil
+}
+
+func (db *DBService) getExecutionsByEventIDSQLite(eventID int64) ([]Execution, error) {
+	query := sqlite3.EventExecutions(qm.Where("event_id = ?", eventID), qm.OrderBy("executed_at"))
+	results, err := query.All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Execution, len(results))
+	for i := range results {
+		var executedAt time.Time
+		executedAt, err = time.Parse(time.RFC3339, results[i].ExecutedAt)
+		if err != nil {
+			return nil, err
+		}
+		resp[i] = Execution{
+			ID:         results[i].ID,
+			EventID:    results[i].EventID,
+			Action:     results[i].Action,
+			Success:    results[i].Success == 1,
+			Result:     results[i].Result.String,
+			ExecutedAt: executedAt,
+		}
+	}
+
+	return resp, nil
+}
+
+func (db *DBService) getExecutionsByEventIDPostgres(eventID int64) ([]Execution, error) {
+	query := postgres.EventExecutions(qm.Where("event_id = ?", eventID), qm.OrderBy("executed_at"))
+	results, err := query.All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Execution, len(results))
+	for i := range results {
+		resp[i] = Execution{
+			ID:         results[i].ID,
+			EventID:    results[i].EventID,
+			Action:     results[i].Action,
+			Success:    results[i].Success,
+			Result:     results[i].Result.String,
+			ExecutedAt: results[i].ExecutedAt,
+		}
+	}
+
+	return resp, nil
+}
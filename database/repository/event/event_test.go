@@ -0,0 +1,199 @@
+package event
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+var verbose = false
+
+func TestMain(m *testing.M) {
+	if verbose {
+		err := testhelpers.EnableVerboseTestOutput()
+		if err != nil {
+			fmt.Printf("failed to enable verbose test output: %v", err)
+			os.Exit(1)
+		}
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = os.MkdirTemp("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	t := m.Run()
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func TestEvent(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			db, err := Setup(dbConn)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = db.Upsert(&Event{ID: -1})
+			if !errors.Is(err, errInvalidEventID) {
+				t.Errorf("received %v, expected %v", err, errInvalidEventID)
+			}
+
+			var events []*Event
+			for i := 0; i < 5; i++ {
+				events = append(events, &Event{
+					ExchangeName: "binance",
+					Item:         "PRICE",
+					Action:       "ACTION_TEST",
+					Definition:   "{}",
+				})
+			}
+			err = db.Upsert(events...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for i := range events {
+				if events[i].ID != int64(i+1) {
+					t.Errorf("received ID %v, expected %v", events[i].ID, i+1)
+				}
+			}
+
+			// update an existing event to test conflict resolution
+			lastExecuted := time.Now().Truncate(time.Second)
+			events[0].Executed = true
+			events[0].ExecutionCount = 1
+			events[0].LastExecuted = lastExecuted
+			err = db.Upsert(events[0])
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := db.GetAll()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(resp) != 5 {
+				t.Fatalf("received %v events, expected %v", len(resp), 5)
+			}
+			if !resp[0].Executed || resp[0].ExecutionCount != 1 || !resp[0].LastExecuted.Equal(lastExecuted) {
+				t.Errorf("expected event to be updated, received %+v", resp[0])
+			}
+			if resp[1].Executed || !resp[1].LastExecuted.IsZero() {
+				t.Errorf("expected event to be unexecuted, received %+v", resp[1])
+			}
+
+			err = db.InsertExecutions(&Execution{
+				EventID:    1,
+				Action:     "ACTION_TEST",
+				Success:    true,
+				ExecutedAt: lastExecuted,
+			}, &Execution{
+				EventID:    1,
+				Action:     "ACTION_TEST",
+				Result:     "failed to do the thing",
+				ExecutedAt: lastExecuted.Add(time.Minute),
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			executions, err := db.GetExecutionsByEventID(1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(executions) != 2 {
+				t.Fatalf("received %v executions, expected %v", len(executions), 2)
+			}
+			if !executions[0].Success || executions[1].Success || executions[1].Result == "" {
+				t.Errorf("unexpected execution results %+v", executions)
+			}
+
+			err = db.Delete(0)
+			if !errors.Is(err, errInvalidEventID) {
+				t.Errorf("received %v, expected %v", err, errInvalidEventID)
+			}
+
+			err = db.Delete(1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			executions, err = db.GetExecutionsByEventID(1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(executions) != 0 {
+				t.Errorf("received %v executions, expected %v", len(executions), 0)
+			}
+			resp, err = db.GetAll()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(resp) != 4 {
+				t.Errorf("received %v events, expected %v", len(resp), 4)
+			}
+
+			// IDs of deleted events are not reused
+			err = db.Delete(5)
+			if err != nil {
+				t.Fatal(err)
+			}
+			e := &Event{
+				ExchangeName: "binance",
+				Item:         "PRICE",
+				Action:       "ACTION_TEST",
+				Definition:   "{}",
+			}
+			err = db.Upsert(e)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if e.ID != 6 {
+				t.Errorf("received ID %v, expected %v", e.ID, 6)
+			}
+
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package event
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+)
+
+var errInvalidEventID = errors.New("invalid event ID")
+
+// Event is a DTO for database data
+type Event struct {
+	ID             int64
+	ExchangeName   string
+	Item           string
+	Action         string
+	Definition     string
+	Executed       bool
+	ExecutionCount int64
+	LastExecuted   time.Time
+	CreatedDate    time.Time
+}
+
+// Execution is a DTO for a single event execution
+type Execution struct {
+	ID         string
+	EventID    int64
+	Action     string
+	Success    bool
+	Result     string
+	ExecutedAt time.Time
+}
+
+// DBService is a service which allows the interaction with
+// the database without a direct reference to a global
+type DBService struct {
+	sql    database.ISQL
+	driver string
+}
+
+// IDBService allows using the event database service
+// without needing to care about implementation
+type IDBService interface {
+	Upsert(...*Event) error
+	GetAll() ([]Event, error)
+	Delete(int64) error
+	InsertExecutions(...*Execution) error
+	GetExecutionsByEventID(int64) ([]Execution, error)
+}
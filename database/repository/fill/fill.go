@@ -0,0 +1,245 @@
+package fill
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/volatiletech/null"
+)
+
+// Setup returns a DBService
+func Setup(db database.IDatabase) (*DBService, error) {
+	if db == nil {
+		return nil, nil
+	}
+	if !db.IsConnected() {
+		return nil, nil
+	}
+	cfg := db.GetConfig()
+	dbCon, err := db.GetSQL()
+	if err != nil {
+		return nil, err
+	}
+	return &DBService{
+		sql:    dbCon,
+		driver: cfg.Driver,
+	}, nil
+}
+
+// Insert stores fills in the database, fills which have already been stored
+// for an exchange trade ID are ignored
+func (db *DBService) Insert(fills ...*Fill) error {
+	if len(fills) == 0 {
+		return nil
+	}
+	for i := range fills {
+		if fills[i].Exchange == "" {
+			return errExchangeNameUnset
+		}
+	}
+	ctx := context.TODO()
+
+	tx, err := db.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Insert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		err = insertSqlite(ctx, tx, fills...)
+	case database.DBPostgreSQL:
+		err = insertPostgres(ctx, tx, fills...)
+	default:
+		err = database.ErrNoDatabaseProvided
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetByOrderID returns the fills of an order ordered by timestamp
+func (db *DBService) GetByOrderID(exchange, orderID string) ([]Fill, error) {
+	if exchange == "" {
+		return nil, errExchangeNameUnset
+	}
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		return db.getSQLite(qm.Where("exchange_name = ? AND order_id = ?", exchange, orderID), qm.OrderBy("timestamp"))
+	case database.DBPostgreSQL:
+		return db.getPostgres(qm.Where("exchange_name = ? AND order_id = ?", exchange, orderID), qm.OrderBy("timestamp"))
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+// GetInRange returns all fills for an exchange between the start and end
+// times ordered by timestamp
+func (db *DBService) GetInRange(exchange string, start, end time.Time) ([]Fill, error) {
+	if exchange == "" {
+		return nil, errExchangeNameUnset
+	}
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		return db.getSQLite(qm.Where("exchange_name = ? AND timestamp BETWEEN ? AND ?",
+			exchange,
+			start.UTC().Format(time.RFC3339),
+			end.UTC().Format(time.RFC3339)),
+			qm.OrderBy("timestamp"))
+	case database.DBPostgreSQL:
+		return db.getPostgres(qm.Where("exchange_name = ? AND timestamp BETWEEN ? AND ?",
+			exchange,
+			start.UTC(),
+			end.UTC()),
+			qm.OrderBy("timestamp"))
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+func insertSqlite(ctx context.Context, tx *sql.Tx, fills ...*Fill) error {
+	for i := range fills {
+		if fills[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			fills[i].ID = freshUUID.String()
+		}
+		var tempFill = sqlite3.Fill{
+			ID:            fills[i].ID,
+			ExchangeName:  fills[i].Exchange,
+			OrderID:       fills[i].OrderID,
+			ClientOrderID: null.NewString(fills[i].ClientOrderID, fills[i].ClientOrderID != ""),
+			TradeID:       null.NewString(fills[i].TradeID, fills[i].TradeID != ""),
+			Base:          fills[i].Base,
+			Quote:         fills[i].Quote,
+			Asset:         fills[i].AssetType,
+			Side:          fills[i].Side,
+			Price:         fills[i].Price,
+			Amount:        fills[i].Amount,
+			Fee:           fills[i].Fee,
+			FeeAsset:      null.NewString(fills[i].FeeAsset, fills[i].FeeAsset != ""),
+			Timestamp:     fills[i].Timestamp.UTC().Format(time.RFC3339),
+		}
+		err := tempFill.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func insertPostgres(ctx context.Context, tx *sql.Tx, fills ...*Fill) error {
+	for i := range fills {
+		if fills[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			fills[i].ID = freshUUID.String()
+		}
+		var tempFill = postgres.Fill{
+			ID:            fills[i].ID,
+			ExchangeName:  fills[i].Exchange,
+			OrderID:       fills[i].OrderID,
+			ClientOrderID: null.NewString(fills[i].ClientOrderID, fills[i].ClientOrderID != ""),
+			TradeID:       null.NewString(fills[i].TradeID, fills[i].TradeID != ""),
+			Base:          fills[i].Base,
+			Quote:         fills[i].Quote,
+			Asset:         fills[i].AssetType,
+			Side:          fills[i].Side,
+			Price:         fills[i].Price,
+			Amount:        fills[i].Amount,
+			Fee:           fills[i].Fee,
+			FeeAsset:      null.NewString(fills[i].FeeAsset, fills[i].FeeAsset != ""),
+			Timestamp:     fills[i].Timestamp.UTC(),
+		}
+		err := tempFill.Upsert(ctx, tx, false, []string{"exchange_name", "trade_id", "asset", "base", "quote"}, boil.Infer(), boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (db *DBService) getSQLite(mods ...qm.QueryMod) ([]Fill, error) {
+	results, err := sqlite3.Fills(mods...).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Fill, len(results))
+	for i := range results {
+		var ts time.Time
+		ts, err = time.Parse(time.RFC3339, results[i].Timestamp)
+		if err != nil {
+			return nil, err
+		}
+		resp[i] = Fill{
+			ID:            results[i].ID,
+			Exchange:      results[i].ExchangeName,
+			OrderID:       results[i].OrderID,
+			ClientOrderID: results[i].ClientOrderID.String,
+			TradeID:       results[i].TradeID.String,
+			Base:          results[i].Base,
+			Quote:         results[i].Quote,
+			AssetType:     results[i].Asset,
+			Side:          results[i].Side,
+			Price:         results[i].Price,
+			Amount:        results[i].Amount,
+			Fee:           results[i].Fee,
+			FeeAsset:      results[i].FeeAsset.String,
+			Timestamp:     ts,
+		}
+	}
+
+	return resp, nil
+}
+
+func (db *DBService) getPostgres(mods ...qm.QueryMod) ([]Fill, error) {
+	results, err := postgres.Fills(mods...).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Fill, len(results))
+	for i := range results {
+		resp[i] = Fill{
+			ID:            results[i].ID,
+			Exchange:      results[i].ExchangeName,
+			OrderID:       results[i].OrderID,
+			ClientOrderID: results[i].ClientOrderID.String,
+			TradeID:       results[i].TradeID.String,
+			Base:          results[i].Base,
+			Quote:         results[i].Quote,
+			AssetType:     results[i].Asset,
+			Side:          results[i].Side,
+			Price:         results[i].Price,
+			Amount:        results[i].Amount,
+			Fee:           results[i].Fee,
+			FeeAsset:      results[i].FeeAsset.String,
+			Timestamp:     results[i].Timestamp,
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,179 @@
+package fill
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+var verbose = false
+
+func TestMain(m *testing.M) {
+	if verbose {
+		err := testhelpers.EnableVerboseTestOutput()
+		if err != nil {
+			fmt.Printf("failed to enable verbose test output: %v", err)
+			os.Exit(1)
+		}
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = os.MkdirTemp("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	t := m.Run()
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func TestFill(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			db, err := Setup(dbConn)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = db.Insert(&Fill{OrderID: "1"})
+			if !errors.Is(err, errExchangeNameUnset) {
+				t.Errorf("received %v, expected %v", err, errExchangeNameUnset)
+			}
+
+			ts := time.Now().Add(-time.Hour).Truncate(time.Second)
+			var fills []*Fill
+			for i := 1; i <= 4; i++ {
+				fills = append(fills, &Fill{
+					Exchange:  "binance",
+					OrderID:   fmt.Sprintf("order-%d", i%2),
+					TradeID:   fmt.Sprintf("trade-%d", i),
+					Base:      "BTC",
+					Quote:     "USDT",
+					AssetType: "spot",
+					Side:      "BUY",
+					Price:     1337,
+					Amount:    0.5,
+					Fee:       float64(i) / 100,
+					Timestamp: ts.Add(time.Duration(i) * time.Minute),
+				})
+			}
+			err = db.Insert(fills...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// fills already stored for a trade ID are ignored
+			err = db.Insert(&Fill{
+				Exchange:  "binance",
+				OrderID:   "order-1",
+				TradeID:   "trade-1",
+				Base:      "BTC",
+				Quote:     "USDT",
+				AssetType: "spot",
+				Side:      "BUY",
+				Price:     1337,
+				Amount:    0.5,
+				Timestamp: ts,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// the same trade ID on another asset is a different fill
+			err = db.Insert(&Fill{
+				Exchange:  "binance",
+				OrderID:   "order-3",
+				TradeID:   "trade-1",
+				Base:      "BTC",
+				Quote:     "USDT",
+				AssetType: "usdtmarginedfutures",
+				Side:      "SELL",
+				Price:     1337,
+				Amount:    0.5,
+				Timestamp: ts,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := db.GetByOrderID("binance", "order-3")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(resp) != 1 {
+				t.Errorf("received %v fills, expected %v", len(resp), 1)
+			}
+
+			err = (&DBService{sql: db.sql, driver: "unsupported"}).Insert(fills[0])
+			if !errors.Is(err, database.ErrNoDatabaseProvided) {
+				t.Errorf("received %v, expected %v", err, database.ErrNoDatabaseProvided)
+			}
+
+			resp, err = db.GetByOrderID("binance", "order-1")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(resp) != 2 {
+				t.Fatalf("received %v fills, expected %v", len(resp), 2)
+			}
+			if resp[0].TradeID != "trade-1" || !resp[0].Timestamp.Equal(fills[0].Timestamp) || resp[0].Fee != 0.01 {
+				t.Errorf("unexpected fill %+v", resp[0])
+			}
+
+			resp, err = db.GetInRange("binance", ts.Add(time.Minute*2), ts.Add(time.Hour))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(resp) != 3 {
+				t.Errorf("received %v fills, expected %v", len(resp), 3)
+			}
+
+			_, err = db.GetByOrderID("", "order-1")
+			if !errors.Is(err, errExchangeNameUnset) {
+				t.Errorf("received %v, expected %v", err, errExchangeNameUnset)
+			}
+
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package fill
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+)
+
+var errExchangeNameUnset = errors.New("exchange name not set")
+
+// Fill is a DTO for an order fill
+type Fill struct {
+	ID            string
+	Exchange      string
+	OrderID       string
+	ClientOrderID string
+	TradeID       string
+	Base          string
+	Quote         string
+	AssetType     string
+	Side          string
+	Price         float64
+	Amount        float64
+	Fee           float64
+	FeeAsset      string
+	Timestamp     time.Time
+}
+
+// DBService is a service which allows the interaction with
+// the database without a direct reference to a global
+type DBService struct {
+	sql    database.ISQL
+	driver string
+}
+
+// IDBService allows using the fill database service
+// without needing to care about implementation
+type IDBService interface {
+	Insert(...*Fill) error
+	GetByOrderID(exchange, orderID string) ([]Fill, error)
+	GetInRange(exchange string, start, end time.Time) ([]Fill, error)
+}
@@ -0,0 +1,416 @@
+package order
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/volatiletech/null"
+)
+
+// Setup returns a DBService
+func Setup(db database.IDatabase) (*DBService, error) {
+	if db == nil {
+		return nil, nil
+	}
+	if !db.IsConnected() {
+		return nil, nil
+	}
+	cfg := db.GetConfig()
+	dbCon, err := db.GetSQL()
+	if err != nil {
+		return nil, err
+	}
+	return &DBService{
+		sql:    dbCon,
+		driver: cfg.Driver,
+	}, nil
+}
+
+// Upsert inserts or updates the latest state of orders and records each
+// state as a transition in the order's history
+func (db *DBService) Upsert(orders ...*Order) error {
+	if len(orders) == 0 {
+		return nil
+	}
+	for i := range orders {
+		if orders[i].Exchange == "" {
+			return errExchangeNameUnset
+		}
+		if orders[i].OrderID == "" {
+			return errOrderIDUnset
+		}
+	}
+	ctx := context.TODO()
+
+	tx, err := db.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Upsert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		err = upsertSqlite(ctx, tx, orders...)
+	case database.DBPostgreSQL:
+		err = upsertPostgres(ctx, tx, orders...)
+	default:
+		err = database.ErrNoDatabaseProvided
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetByStatus returns all orders whose latest state matches one of the
+// supplied statuses, ordered by date
+func (db *DBService) GetByStatus(statuses ...string) ([]Order, error) {
+	if len(statuses) == 0 {
+		return nil, nil
+	}
+	values := make([]interface{}, len(statuses))
+	for i := range statuses {
+		values[i] = statuses[i]
+	}
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		return db.getSQLite(qm.WhereIn("status IN ?", values...), qm.OrderBy("date"))
+	case database.DBPostgreSQL:
+		return db.getPostgres(qm.WhereIn("status IN ?", values...), qm.OrderBy("date"))
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+// GetInRange returns all orders for an exchange which were created between
+// the start and end times, ordered by date
+func (db *DBService) GetInRange(exchange string, start, end time.Time) ([]Order, error) {
+	if exchange == "" {
+		return nil, errExchangeNameUnset
+	}
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		return db.getSQLite(qm.Where("exchange_name = ? AND date BETWEEN ? AND ?",
+			exchange,
+			start.UTC().Format(time.RFC3339),
+			end.UTC().Format(time.RFC3339)),
+			qm.OrderBy("date"))
+	case database.DBPostgreSQL:
+		return db.getPostgres(qm.Where("exchange_name = ? AND date BETWEEN ? AND ?",
+			exchange,
+			start.UTC(),
+			end.UTC()),
+			qm.OrderBy("date"))
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+// GetTransitions returns the recorded state transitions of an order ordered by
+// when they occurred
+func (db *DBService) GetTransitions(exchange, orderID string) ([]Transition, error) {
+	if exchange == "" {
+		return nil, errExchangeNameUnset
+	}
+	if orderID == "" {
+		return nil, errOrderIDUnset
+	}
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		return db.getTransitionsSQLite(exchange, orderID)
+	case database.DBPostgreSQL:
+		return db.getTransitionsPostgres(exchange, orderID)
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+func upsertSqlite(ctx context.Context, tx *sql.Tx, orders ...*Order) error {
+	for i := range orders {
+		var tempOrder = sqlite3.Order{
+			ID:                   orders[i].ID,
+			ExchangeName:         orders[i].Exchange,
+			OrderID:              orders[i].OrderID,
+			ClientOrderID:        null.NewString(orders[i].ClientOrderID, orders[i].ClientOrderID != ""),
+			Base:                 orders[i].Base,
+			Quote:                orders[i].Quote,
+			Asset:                orders[i].AssetType,
+			Side:                 orders[i].Side,
+			Type:                 orders[i].Type,
+			Status:               orders[i].Status,
+			Price:                orders[i].Price,
+			Amount:               orders[i].Amount,
+			ExecutedAmount:       orders[i].ExecutedAmount,
+			RemainingAmount:      orders[i].RemainingAmount,
+			AverageExecutedPrice: orders[i].AverageExecutedPrice,
+			Fee:                  orders[i].Fee,
+			FeeAsset:             null.NewString(orders[i].FeeAsset, orders[i].FeeAsset != ""),
+			Cost:                 orders[i].Cost,
+			Date:                 orders[i].Date.UTC().Format(time.RFC3339),
+			LastUpdated:          orders[i].LastUpdated.UTC().Format(time.RFC3339),
+		}
+		existing, err := sqlite3.Orders(qm.Where("exchange_name = ? AND order_id = ? AND asset = ? AND base = ? AND quote = ?",
+			orders[i].Exchange,
+			orders[i].OrderID,
+			orders[i].AssetType,
+			orders[i].Base,
+			orders[i].Quote)).One(ctx, tx)
+		switch {
+		case err == nil:
+			tempOrder.ID = existing.ID
+			_, err = tempOrder.Update(ctx, tx, boil.Infer())
+		case errors.Is(err, sql.ErrNoRows):
+			if tempOrder.ID == "" {
+				var freshUUID uuid.UUID
+				freshUUID, err = uuid.NewV4()
+				if err != nil {
+					return err
+				}
+				tempOrder.ID = freshUUID.String()
+			}
+			err = tempOrder.Insert(ctx, tx, boil.Infer())
+		}
+		if err != nil {
+			return err
+		}
+		orders[i].ID = tempOrder.ID
+
+		freshUUID, err := uuid.NewV4()
+		if err != nil {
+			return err
+		}
+		var tempTransition = sqlite3.OrderHistory{
+			ID:                   freshUUID.String(),
+			ExchangeName:         orders[i].Exchange,
+			OrderID:              orders[i].OrderID,
+			Status:               orders[i].Status,
+			Price:                orders[i].Price,
+			Amount:               orders[i].Amount,
+			ExecutedAmount:       orders[i].ExecutedAmount,
+			RemainingAmount:      orders[i].RemainingAmount,
+			AverageExecutedPrice: orders[i].AverageExecutedPrice,
+			Fee:                  orders[i].Fee,
+			Cost:                 orders[i].Cost,
+			LastUpdated:          tempOrder.LastUpdated,
+		}
+		err = tempTransition.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func upsertPostgres(ctx context.Context, tx *sql.Tx, orders ...*Order) error {
+	for i := range orders {
+		if orders[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			orders[i].ID = freshUUID.String()
+		}
+		var tempOrder = postgres.Order{
+			ID:                   orders[i].ID,
+			ExchangeName:         orders[i].Exchange,
+			OrderID:              orders[i].OrderID,
+			ClientOrderID:        null.NewString(orders[i].ClientOrderID, orders[i].ClientOrderID != ""),
+			Base:                 orders[i].Base,
+			Quote:                orders[i].Quote,
+			Asset:                orders[i].AssetType,
+			Side:                 orders[i].Side,
+			Type:                 orders[i].Type,
+			Status:               orders[i].Status,
+			Price:                orders[i].Price,
+			Amount:               orders[i].Amount,
+			ExecutedAmount:       orders[i].ExecutedAmount,
+			RemainingAmount:      orders[i].RemainingAmount,
+			AverageExecutedPrice: orders[i].AverageExecutedPrice,
+			Fee:                  orders[i].Fee,
+			FeeAsset:             null.NewString(orders[i].FeeAsset, orders[i].FeeAsset != ""),
+			Cost:                 orders[i].Cost,
+			Date:                 orders[i].Date.UTC(),
+			LastUpdated:          orders[i].LastUpdated.UTC(),
+		}
+		err := tempOrder.Upsert(ctx, tx, true, []string{"exchange_name", "order_id", "asset", "base", "quote"}, boil.Blacklist(postgres.OrderColumns.ID), boil.Infer())
+		if err != nil {
+			return err
+		}
+		orders[i].ID = tempOrder.ID
+
+		var tempTransition = postgres.OrderHistory{
+			ExchangeName:         orders[i].Exchange,
+			OrderID:              orders[i].OrderID,
+			Status:               orders[i].Status,
+			Price:                orders[i].Price,
+			Amount:               orders[i].Amount,
+			ExecutedAmount:       orders[i].ExecutedAmount,
+			RemainingAmount:      orders[i].RemainingAmount,
+			AverageExecutedPrice: orders[i].AverageExecutedPrice,
+			Fee:                  orders[i].Fee,
+			Cost:                 orders[i].Cost,
+			LastUpdated:          tempOrder.LastUpdated,
+		}
+		err = tempTransition.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (db *DBService) getSQLite(mods ...qm.QueryMod) ([]Order, error) {
+	results, err := sqlite3.Orders(mods...).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Order, len(results))
+	for i := range results {
+		var date, lastUpdated time.Time
+		date, err = time.Parse(time.RFC3339, results[i].Date)
+		if err != nil {
+			return nil, err
+		}
+		lastUpdated, err = time.Parse(time.RFC3339, results[i].LastUpdated)
+		if err != nil {
+			return nil, err
+		}
+		resp[i] = Order{
+			ID:                   results[i].ID,
+			Exchange:             results[i].ExchangeName,
+			OrderID:              results[i].OrderID,
+			ClientOrderID:        results[i].ClientOrderID.String,
+			Base:                 results[i].Base,
+			Quote:                results[i].Quote,
+			AssetType:            results[i].Asset,
+			Side:                 results[i].Side,
+			Type:                 results[i].Type,
+			Status:               results[i].Status,
+			Price:                results[i].Price,
+			Amount:               results[i].Amount,
+			ExecutedAmount:       results[i].ExecutedAmount,
+			RemainingAmount:      results[i].RemainingAmount,
+			AverageExecutedPrice: results[i].AverageExecutedPrice,
+			Fee:                  results[i].Fee,
+			FeeAsset:             results[i].FeeAsset.String,
+			Cost:                 results[i].Cost,
+			Date:                 date,
+			LastUpdated:          lastUpdated,
+		}
+	}
+
+	return resp, nil
+}
+
+func (db *DBService) getPostgres(mods ...qm.QueryMod) ([]Order, error) {
+	results, err := postgres.Orders(mods...).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Order, len(results))
+	for i := range results {
+		resp[i] = Order{
+			ID:                   results[i].ID,
+			Exchange:             results[i].ExchangeName,
+			OrderID:              results[i].OrderID,
+			ClientOrderID:        results[i].ClientOrderID.String,
+			Base:                 results[i].Base,
+			Quote:                results[i].Quote,
+			AssetType:            results[i].Asset,
+			Side:                 results[i].Side,
+			Type:                 results[i].Type,
+			Status:               results[i].Status,
+			Price:                results[i].Price,
+			Amount:               results[i].Amount,
+			ExecutedAmount:       results[i].ExecutedAmount,
+			RemainingAmount:      results[i].RemainingAmount,
+			AverageExecutedPrice: results[i].AverageExecutedPrice,
+			Fee:                  results[i].Fee,
+			FeeAsset:             results[i].FeeAsset.String,
+			Cost:                 results[i].Cost,
+			Date:                 results[i].Date,
+			LastUpdated:          results[i].LastUpdated,
+		}
+	}
+
+	return resp, nil
+}
+
+func (db *DBService) getTransitionsSQLite(exchange, orderID string) ([]Transition, error) {
+	query := sqlite3.OrderHistories(qm.Where("exchange_name = ? AND order_id = ?", exchange, orderID), qm.OrderBy("last_updated, rowid"))
+	results, err := query.All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Transition, len(results))
+	for i := range results {
+		var lastUpdated time.Time
+		lastUpdated, err = time.Parse(time.RFC3339, results[i].LastUpdated)
+		if err != nil {
+			return nil, err
+		}
+		resp[i] = Transition{
+			ID:                   results[i].ID,
+			Exchange:             results[i].ExchangeName,
+			OrderID:              results[i].OrderID,
+			Status:               results[i].Status,
+			Price:                results[i].Price,
+			Amount:               results[i].Amount,
+			ExecutedAmount:       results[i].ExecutedAmount,
+			RemainingAmount:      results[i].RemainingAmount,
+			AverageExecutedPrice: results[i].AverageExecutedPrice,
+			Fee:                  results[i].Fee,
+			Cost:                 results[i].Cost,
+			LastUpdated:          lastUpdated,
+		}
+	}
+
+	return resp, nil
+}
+
+func (db *DBService) getTransitionsPostgres(exchange, orderID string) ([]Transition, error) {
+	query := postgres.OrderHistories(qm.Where("exchange_name = ? AND order_id = ?", exchange, orderID), qm.OrderBy("last_updated"))
+	results, err := query.All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Transition, len(results))
+	for i := range results {
+		resp[i] = Transition{
+			ID:                   results[i].ID,
+			Exchange:             results[i].ExchangeName,
+			OrderID:              results[i].OrderID,
+			Status:               results[i].Status,
+			Price:                results[i].Price,
+			Amount:               results[i].Amount,
+			ExecutedAmount:       results[i].ExecutedAmount,
+			RemainingAmount:      results[i].RemainingAmount,
+			AverageExecutedPrice: results[i].AverageExecutedPrice,
+			Fee:                  results[i].Fee,
+			Cost:                 results[i].Cost,
+			LastUpdated:          results[i].LastUpdated,
+		}
+	}
+
+	return resp, nil
+}
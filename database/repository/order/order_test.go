@@ -0,0 +1,200 @@
+package order
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+var verbose = false
+
+func TestMain(m *testing.M) {
+	if verbose {
+		err := testhelpers.EnableVerboseTestOutput()
+		if err != nil {
+			fmt.Printf("failed to enable verbose test output: %v", err)
+			os.Exit(1)
+		}
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = os.MkdirTemp("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	t := m.Run()
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func TestOrder(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			db, err := Setup(dbConn)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = db.Upsert(&Order{OrderID: "1"})
+			if !errors.Is(err, errExchangeNameUnset) {
+				t.Errorf("received %v, expected %v", err, errExchangeNameUnset)
+			}
+			err = db.Upsert(&Order{Exchange: "binance"})
+			if !errors.Is(err, errOrderIDUnset) {
+				t.Errorf("received %v, expected %v", err, errOrderIDUnset)
+			}
+
+			date := time.Now().Add(-time.Hour).Truncate(time.Second)
+			var orders []*Order
+			for i := 1; i <= 3; i++ {
+				orders = append(orders, &Order{
+					Exchange:    "binance",
+					OrderID:     fmt.Sprintf("order-%d", i),
+					Base:        "BTC",
+					Quote:       "USDT",
+					AssetType:   "spot",
+					Side:        "BUY",
+					Type:        "LIMIT",
+					Status:      "NEW",
+					Price:       1337,
+					Amount:      float64(i),
+					Date:        date.Add(time.Duration(i) * time.Minute),
+					LastUpdated: date.Add(time.Duration(i) * time.Minute),
+				})
+			}
+			err = db.Upsert(orders...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// transition the first order through a partial fill and a fill
+			orders[0].Status = "PARTIALLY_FILLED"
+			orders[0].ExecutedAmount = 0.5
+			orders[0].RemainingAmount = 0.5
+			orders[0].LastUpdated = orders[0].LastUpdated.Add(time.Minute)
+			err = db.Upsert(orders[0])
+			if err != nil {
+				t.Fatal(err)
+			}
+			orders[0].Status = "FILLED"
+			orders[0].ExecutedAmount = 1
+			orders[0].RemainingAmount = 0
+			orders[0].AverageExecutedPrice = 1337
+			orders[0].LastUpdated = orders[0].LastUpdated.Add(time.Minute)
+			err = db.Upsert(orders[0])
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			open, err := db.GetByStatus("NEW", "PARTIALLY_FILLED")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(open) != 2 {
+				t.Fatalf("received %v open orders, expected %v", len(open), 2)
+			}
+			if open[0].OrderID != "order-2" || !open[0].Date.Equal(orders[1].Date) {
+				t.Errorf("unexpected open order %+v", open[0])
+			}
+
+			resp, err := db.GetInRange("binance", date, date.Add(time.Hour))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(resp) != 3 {
+				t.Fatalf("received %v orders, expected %v", len(resp), 3)
+			}
+			if resp[0].Status != "FILLED" || resp[0].ExecutedAmount != 1 || resp[0].ID != orders[0].ID {
+				t.Errorf("expected order to be updated, received %+v", resp[0])
+			}
+
+			_, err = db.GetInRange("", date, date)
+			if !errors.Is(err, errExchangeNameUnset) {
+				t.Errorf("received %v, expected %v", err, errExchangeNameUnset)
+			}
+
+			transitions, err := db.GetTransitions("binance", "order-1")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(transitions) != 3 {
+				t.Fatalf("received %v transitions, expected %v", len(transitions), 3)
+			}
+			if transitions[0].Status != "NEW" || transitions[1].Status != "PARTIALLY_FILLED" || transitions[2].Status != "FILLED" {
+				t.Errorf("unexpected transitions %+v", transitions)
+			}
+
+			_, err = db.GetTransitions("binance", "")
+			if !errors.Is(err, errOrderIDUnset) {
+				t.Errorf("received %v, expected %v", err, errOrderIDUnset)
+			}
+
+			// the same order ID on another asset is a different order
+			futures := *orders[0]
+			futures.ID = ""
+			futures.AssetType = "usdtmarginedfutures"
+			err = db.Upsert(&futures)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if futures.ID == orders[0].ID {
+				t.Error("expected order on another asset to be stored separately")
+			}
+			resp, err = db.GetInRange("binance", date, date.Add(time.Hour))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(resp) != 4 {
+				t.Errorf("received %v orders, expected %v", len(resp), 4)
+			}
+
+			err = (&DBService{sql: db.sql, driver: "unsupported"}).Upsert(orders[1])
+			if !errors.Is(err, database.ErrNoDatabaseProvided) {
+				t.Errorf("received %v, expected %v", err, database.ErrNoDatabaseProvided)
+			}
+
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
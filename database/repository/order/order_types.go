@@ -0,0 +1,69 @@
+package order
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+)
+
+var (
+	errExchangeNameUnset = errors.New("exchange name not set")
+	errOrderIDUnset      = errors.New("order ID not set")
+)
+
+// Order is a DTO for the latest state of an order
+type Order struct {
+	ID                   string
+	Exchange             string
+	OrderID              string
+	ClientOrderID        string
+	Base                 string
+	Quote                string
+	AssetType            string
+	Side                 string
+	Type                 string
+	Status               string
+	Price                float64
+	Amount               float64
+	ExecutedAmount       float64
+	RemainingAmount      float64
+	AverageExecutedPrice float64
+	Fee                  float64
+	FeeAsset             string
+	Cost                 float64
+	Date                 time.Time
+	LastUpdated          time.Time
+}
+
+// Transition is a DTO for a recorded change in the state of an order
+type Transition struct {
+	ID                   string
+	Exchange             string
+	OrderID              string
+	Status               string
+	Price                float64
+	Amount               float64
+	ExecutedAmount       float64
+	RemainingAmount      float64
+	AverageExecutedPrice float64
+	Fee                  float64
+	Cost                 float64
+	LastUpdated          time.Time
+}
+
+// DBService is a service which allows the interaction with
+// the database without a direct reference to a global
+type DBService struct {
+	sql    database.ISQL
+	driver string
+}
+
+// IDBService allows using the order database service
+// without needing to care about implementation
+type IDBService interface {
+	Upsert(...*Order) error
+	GetByStatus(...string) ([]Order, error)
+	GetInRange(exchange string, start, end time.Time) ([]Order, error)
+	GetTransitions(exchange, orderID string) ([]Transition, error)
+}
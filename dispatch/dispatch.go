@@ -68,6 +68,11 @@ func IsRunning() bool {
 	return dispatcher.isRunning()
 }
 
+// GetStatistics returns a snapshot of the dispatch job queue
+func GetStatistics() Statistics {
+	return dispatcher.getStatistics()
+}
+
 // start compares atomic running value, sets defaults, overrides with
 // configuration, then spawns workers
 func (d *Dispatcher) start(workers, channelCapacity int) error {
@@ -166,6 +171,27 @@ func (d *Dispatcher) isRunning() bool {
 	return d.running
 }
 
+// getStatistics returns a snapshot of the job queue
+func (d *Dispatcher) getStatistics() Statistics {
+	if d == nil {
+		return Statistics{}
+	}
+
+	d.m.RLock()
+	defer d.m.RUnlock()
+	s := Statistics{
+		Running:     d.running,
+		Subscribers: atomic.LoadInt32(&d.subscriberCount),
+		DroppedJobs: atomic.LoadUint64(&d.droppedJobs),
+	}
+	if d.running {
+		s.Workers = d.maxWorkers
+		s.QueueDepth = len(d.jobs)
+		s.QueueCapacity = cap(d.jobs)
+	}
+	return s
+}
+
 // relayer routine relays communications across the defined routes
 func (d *Dispatcher) relayer() {
 	for {
@@ -226,6 +252,7 @@ func (d *Dispatcher) publish(id uuid.UUID, data interface{}) error {
 	case d.jobs <- job{data, id}: // Push job into job channel.
 		return nil
 	default:
+		atomic.AddUint64(&d.droppedJobs, 1)
 		return fmt.Errorf(limitMessage,
 			errDispatcherJobsAtLimit,
 			len(d.jobs),
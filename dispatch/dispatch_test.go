@@ -180,6 +180,35 @@ func TestPublish(t *testing.T) {
 	assert.ErrorIs(t, err, errDispatcherJobsAtLimit, "publish should eventually error at limit")
 }
 
+func TestGetStatistics(t *testing.T) {
+	t.Parallel()
+	var d *Dispatcher
+	assert.Equal(t, Statistics{}, d.getStatistics(), "getStatistics should return empty on a nil dispatcher")
+
+	d = NewDispatcher()
+	assert.False(t, d.getStatistics().Running, "getStatistics should return not running")
+
+	err := d.start(1, 10)
+	require.NoError(t, err, "start should not error")
+	d.routes[nonEmptyUUID] = []chan interface{}{
+		make(chan interface{}),
+	}
+	for x := 0; x < 200; x++ {
+		if err = d.publish(nonEmptyUUID, "test"); err != nil {
+			break
+		}
+	}
+	require.ErrorIs(t, err, errDispatcherJobsAtLimit, "publish must eventually error at limit")
+
+	s := d.getStatistics()
+	assert.True(t, s.Running, "getStatistics should return running")
+	assert.Equal(t, 1, s.Workers, "getStatistics should return the worker count")
+	assert.Equal(t, 10, s.QueueCapacity, "getStatistics should return the jobs limit")
+	assert.LessOrEqual(t, s.QueueDepth, 10, "getStatistics should return the queue depth")
+	assert.Equal(t, uint64(1), s.DroppedJobs, "getStatistics should return the dropped job")
+	assert.NoError(t, d.stop(), "stop should not error")
+}
+
 func TestPublishReceive(t *testing.T) {
 	t.Parallel()
 	d := NewDispatcher()
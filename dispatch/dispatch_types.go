@@ -56,6 +56,21 @@ type Dispatcher struct {
 	// subscriberCount atomically stores the amount of subscription endpoints
 	// to verify whether to send out any jobs
 	subscriberCount int32
+	// droppedJobs atomically counts jobs which could not be queued because
+	// the job channel was at capacity
+	droppedJobs uint64
+}
+
+// Statistics defines a snapshot of the dispatcher's job queue
+type Statistics struct {
+	Running       bool
+	Workers       int
+	QueueDepth    int
+	QueueCapacity int
+	Subscribers   int32
+	// DroppedJobs is the total number of jobs dropped since the dispatcher
+	// was created
+	DroppedJobs uint64
 }
 
 // job defines a relaying job associated with a ticket which allows routing to
@@ -0,0 +1,552 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// setupArbitrageManager creates an arbitrage manager. New opportunities are
+// pushed to the communications manager
+func setupArbitrageManager(em iExchangeManager, comms iCommsManager, cfg *config.ArbitrageConfig) (*arbitrageManager, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if comms == nil {
+		return nil, errNilCommunicationsManager
+	}
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	if cfg.ScanInterval <= 0 {
+		return nil, errInvalidScanInterval
+	}
+	m := &arbitrageManager{
+		exchangeManager: em,
+		comms:           comms,
+		cfg:             *cfg,
+		exchanges:       make(map[string]bool, len(cfg.Exchanges)),
+		startAmounts:    make(map[*currency.Item]float64, len(cfg.TriangularStartAmounts)),
+		mux:             dispatch.GetNewMux(nil),
+	}
+	for i := range cfg.Exchanges {
+		m.exchanges[strings.ToLower(cfg.Exchanges[i])] = true
+	}
+	for i := range cfg.Assets {
+		a, err := asset.New(cfg.Assets[i])
+		if err != nil {
+			return nil, err
+		}
+		m.assets = append(m.assets, a)
+	}
+	if len(m.assets) == 0 {
+		m.assets = []asset.Item{asset.Spot}
+	}
+	for code, amount := range cfg.TriangularStartAmounts {
+		if amount <= 0 {
+			return nil, fmt.Errorf("%w %s", errInvalidStartAmount, code)
+		}
+		m.startAmounts[currency.NewCode(code).Item] = amount
+	}
+	var err error
+	m.id, err = m.mux.GetID()
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *arbitrageManager) IsRunning() bool {
+	if m == nil {
+		return false
+	}
+	return atomic.LoadInt32(&m.started) == 1
+}
+
+// Start begins scanning for arbitrage opportunities
+func (m *arbitrageManager) Start() error {
+	if m == nil {
+		return fmt.Errorf("arbitrage manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return fmt.Errorf("arbitrage manager %w", ErrSubSystemAlreadyStarted)
+	}
+	m.shutdown = make(chan struct{})
+	m.fees = make(map[arbitrageFeeKey]arbitrageFee)
+	m.wg.Add(1)
+	go m.run()
+	log.Debugf(log.Global, "Arbitrage manager %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops scanning for arbitrage opportunities
+func (m *arbitrageManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("arbitrage manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 1, 0) {
+		return fmt.Errorf("arbitrage manager %w", ErrSubSystemNotStarted)
+	}
+	close(m.shutdown)
+	m.wg.Wait()
+	log.Debugf(log.Global, "Arbitrage manager %s", MsgSubSystemShutdown)
+	return nil
+}
+
+func (m *arbitrageManager) run() {
+	defer m.wg.Done()
+	t := time.NewTicker(m.cfg.ScanInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-t.C:
+			m.scan()
+		}
+	}
+}
+
+// scan finds the opportunities of every scanned exchange and asset, publishes
+// them ranked by profit percentage and alerts the communications manager of
+// opportunities which were not found by the previous scan
+func (m *arbitrageManager) scan() {
+	exchanges, err := m.exchangeManager.GetExchanges()
+	if err != nil {
+		log.Errorf(log.Global, "Arbitrage manager unable to get exchanges: %v", err)
+		return
+	}
+	var opportunities []ArbitrageOpportunity
+	for _, a := range m.assets {
+		pairMarkets := make(map[key.PairAsset][]*arbitrageMarket)
+		for i := range exchanges {
+			if !m.scans(exchanges[i].GetName()) {
+				continue
+			}
+			markets := m.getMarkets(exchanges[i], a)
+			for j := range markets {
+				k := key.PairAsset{Base: markets[j].pair.Base.Item, Quote: markets[j].pair.Quote.Item, Asset: a}
+				pairMarkets[k] = append(pairMarkets[k], markets[j])
+			}
+			opportunities = append(opportunities, m.findTriangular(markets, a)...)
+		}
+		for _, markets := range pairMarkets {
+			for buy := range markets {
+				for sell := range markets {
+					if buy == sell {
+						continue
+					}
+					opp, err := m.crossExchange(markets[buy], markets[sell], a)
+					if err != nil {
+						log.Debugf(log.Global, "Arbitrage manager unable to size %s %s %s to %s: %v", a, markets[buy].pair, markets[buy].exchange, markets[sell].exchange, err)
+						continue
+					}
+					if opp != nil && m.isProfitable(opp) {
+						opportunities = append(opportunities, *opp)
+					}
+				}
+			}
+		}
+	}
+	sort.Slice(opportunities, func(i, j int) bool {
+		return opportunities[i].ProfitPercentage > opportunities[j].ProfitPercentage
+	})
+	if m.cfg.MaximumOpportunities > 0 && len(opportunities) > m.cfg.MaximumOpportunities {
+		opportunities = opportunities[:m.cfg.MaximumOpportunities]
+	}
+	m.setScan(&ArbitrageScan{Time: time.Now(), Opportunities: opportunities})
+}
+
+// setScan stores and publishes the scan and alerts new opportunities
+func (m *arbitrageManager) setScan(scan *ArbitrageScan) {
+	m.m.Lock()
+	found := make(map[string]bool, len(scan.Opportunities))
+	for i := range scan.Opportunities {
+		id := scan.Opportunities[i].identifier()
+		found[id] = true
+		if !m.previous[id] {
+			m.comms.PushEvent(base.Event{Type: "arbitrage", Message: scan.Opportunities[i].String()})
+		}
+	}
+	m.previous = found
+	m.latest = scan
+	m.m.Unlock()
+	if err := m.mux.Publish(scan, m.id); err != nil {
+		log.Errorf(log.Global, "Arbitrage manager unable to publish scan: %v", err)
+	}
+}
+
+// scans returns whether the exchange is scanned
+func (m *arbitrageManager) scans(exch string) bool {
+	return len(m.exchanges) == 0 || m.exchanges[strings.ToLower(exch)]
+}
+
+// isProfitable returns whether the opportunity makes at least the minimum
+// profit percentage
+func (m *arbitrageManager) isProfitable(opp *ArbitrageOpportunity) bool {
+	return opp.Profit > 0 && opp.ProfitPercentage >= m.cfg.MinimumProfitPercentage
+}
+
+// getMarkets returns the enabled pairs of an exchange which have orderbook
+// depth
+func (m *arbitrageManager) getMarkets(exch exchange.IBotExchange, a asset.Item) []*arbitrageMarket {
+	pairs, err := exch.GetEnabledPairs(a)
+	if err != nil {
+		return nil
+	}
+	name := exch.GetName()
+	markets := make([]*arbitrageMarket, 0, len(pairs))
+	for i := range pairs {
+		depth, err := orderbook.GetDepth(name, pairs[i], a)
+		if err != nil {
+			continue
+		}
+		markets = append(markets, &arbitrageMarket{
+			exch:     exch,
+			exchange: name,
+			pair:     pairs[i],
+			depth:    depth,
+			feeRate:  m.tradeFeeRate(exch, a, pairs[i]),
+		})
+	}
+	return markets
+}
+
+// tradeFeeRate returns the taker fee rate of a pair, derived from the fee of
+// trading one unit at a price of one. The fallback fee rate is used when the
+// exchange cannot provide it
+func (m *arbitrageManager) tradeFeeRate(exch exchange.IBotExchange, a asset.Item, p currency.Pair) float64 {
+	k := arbitrageFeeKey{
+		exchange: exch.GetName(),
+		feeType:  exchange.CryptocurrencyTradeFee,
+		asset:    a,
+		base:     p.Base.Item,
+		quote:    p.Quote.Item,
+	}
+	return m.getFee(k, m.cfg.FallbackFeeRate, func() (float64, error) {
+		return exch.GetFeeByType(context.TODO(), &exchange.FeeBuilder{
+			FeeType:       exchange.CryptocurrencyTradeFee,
+			Pair:          p,
+			PurchasePrice: 1,
+			Amount:        1,
+		})
+	})
+}
+
+// withdrawalFee returns the fee to withdraw a currency from an exchange, which
+// is treated as fixed per withdrawal. Unknown fees are zero
+func (m *arbitrageManager) withdrawalFee(exch exchange.IBotExchange, c currency.Code) float64 {
+	k := arbitrageFeeKey{
+		exchange: exch.GetName(),
+		feeType:  exchange.CryptocurrencyWithdrawalFee,
+		base:     c.Item,
+	}
+	return m.getFee(k, 0, func() (float64, error) {
+		return exch.GetFeeByType(context.TODO(), &exchange.FeeBuilder{
+			FeeType: exchange.CryptocurrencyWithdrawalFee,
+			Pair:    currency.Pair{Base: c},
+			Amount:  1,
+		})
+	})
+}
+
+// getFee returns a cached fee, retrieving it again once it is older than the
+// refresh interval
+func (m *arbitrageManager) getFee(k arbitrageFeeKey, fallback float64, retrieve func() (float64, error)) float64 {
+	m.feeMtx.Lock()
+	defer m.feeMtx.Unlock()
+	if f, ok := m.fees[k]; ok && time.Since(f.retrieved) < arbitrageFeeRefreshInterval {
+		return f.value
+	}
+	value, err := retrieve()
+	if err != nil || value < 0 {
+		log.Debugf(log.Global, "Arbitrage manager using fallback fee for %s %v %s %s: %v", k.exchange, k.asset, k.base, k.quote, err)
+		value = fallback
+	}
+	m.fees[k] = arbitrageFee{value: value, retrieved: time.Now()}
+	return value
+}
+
+// crossExchange sizes buying a pair on one exchange, withdrawing it and
+// selling it on another. A nil opportunity is returned when no amount is
+// profitable
+func (m *arbitrageManager) crossExchange(buy, sell *arbitrageMarket, a asset.Item) (*ArbitrageOpportunity, error) {
+	asks, err := buy.depth.Retrieve()
+	if err != nil {
+		return nil, err
+	}
+	bids, err := sell.depth.Retrieve()
+	if err != nil {
+		return nil, err
+	}
+	spend := executableQuoteAmount(asks.Asks, bids.Bids, buy.feeRate, sell.feeRate, m.cfg.MaximumQuoteAmount)
+	if spend <= 0 {
+		return nil, nil
+	}
+	opp := &ArbitrageOpportunity{
+		Type:     CrossExchangeArbitrage,
+		Asset:    a,
+		Currency: buy.pair.Quote,
+		Amount:   spend,
+		Legs:     make([]ArbitrageLeg, 2),
+		Time:     time.Now(),
+	}
+	var bought float64
+	opp.Legs[0], bought, err = buy.trade(buy.pair.Quote.Item, spend)
+	if err != nil {
+		return nil, err
+	}
+	if m.cfg.IncludeWithdrawalFees {
+		opp.WithdrawalFee = m.withdrawalFee(buy.exch, buy.pair.Base)
+		bought -= opp.WithdrawalFee
+		if bought <= 0 {
+			return nil, nil
+		}
+	}
+	opp.Legs[1], opp.Return, err = sell.trade(sell.pair.Base.Item, bought)
+	if err != nil {
+		return nil, err
+	}
+	opp.setProfit()
+	return opp, nil
+}
+
+// executableQuoteAmount walks the asks of the buying exchange and the bids of
+// the selling exchange while buying is cheaper than selling after fees,
+// returning the quote amount which can be spent at a profit. The last level of
+// each side is not used, as books may be truncated beyond it. A maximum quote
+// amount of zero is unlimited
+func executableQuoteAmount(asks, bids orderbook.Items, buyFeeRate, sellFeeRate, maxQuote float64) float64 {
+	var spent, askUsed, bidUsed float64
+	for i, j := 0, 0; i < len(asks)-1 && j < len(bids)-1; {
+		if asks[i].Price*(1+buyFeeRate) >= bids[j].Price*(1-sellFeeRate) {
+			break
+		}
+		take := math.Min(asks[i].Amount-askUsed, bids[j].Amount-bidUsed)
+		if maxQuote > 0 && spent+take*asks[i].Price >= maxQuote {
+			return maxQuote
+		}
+		spent += take * asks[i].Price
+		askUsed += take
+		bidUsed += take
+		if askUsed >= asks[i].Amount {
+			i++
+			askUsed = 0
+		}
+		if bidUsed >= bids[j].Amount {
+			j++
+			bidUsed = 0
+		}
+	}
+	return spent
+}
+
+// findTriangular finds the most profitable size of each cycle through three
+// pairs on an exchange which starts and ends in a configured currency
+func (m *arbitrageManager) findTriangular(markets []*arbitrageMarket, a asset.Item) []ArbitrageOpportunity {
+	if len(m.startAmounts) == 0 || len(markets) < 3 {
+		return nil
+	}
+	graph := make(map[*currency.Item]map[*currency.Item]*arbitrageMarket)
+	link := func(from, to *currency.Item, market *arbitrageMarket) {
+		if graph[from] == nil {
+			graph[from] = make(map[*currency.Item]*arbitrageMarket)
+		}
+		graph[from][to] = market
+	}
+	for i := range markets {
+		link(markets[i].pair.Base.Item, markets[i].pair.Quote.Item, markets[i])
+		link(markets[i].pair.Quote.Item, markets[i].pair.Base.Item, markets[i])
+	}
+	var resp []ArbitrageOpportunity
+	for start, amount := range m.startAmounts {
+		for second, first := range graph[start] {
+			for third, middle := range graph[second] {
+				if third == start {
+					continue
+				}
+				last, ok := graph[third][start]
+				if !ok {
+					continue
+				}
+				path := []*arbitrageMarket{first, middle, last}
+				var best *ArbitrageOpportunity
+				size := amount
+				for i := 0; i < triangularSizeSearchSteps; i++ {
+					opp, err := simulateTriangular(start, size, path)
+					if err == nil && (best == nil || opp.Profit > best.Profit) {
+						best = opp
+					}
+					size /= 2
+				}
+				if best != nil && m.isProfitable(best) {
+					best.Asset = a
+					resp = append(resp, *best)
+				}
+			}
+		}
+	}
+	return resp
+}
+
+// simulateTriangular trades an amount of the start currency through each
+// market in turn
+func simulateTriangular(start *currency.Item, amount float64, path []*arbitrageMarket) (*ArbitrageOpportunity, error) {
+	opp := &ArbitrageOpportunity{
+		Type:     TriangularArbitrage,
+		Currency: start.Currency(),
+		Amount:   amount,
+		Legs:     make([]ArbitrageLeg, len(path)),
+		Time:     time.Now(),
+	}
+	held, holding := amount, start
+	for i := range path {
+		var err error
+		opp.Legs[i], held, err = path[i].trade(holding, held)
+		if err != nil {
+			return nil, err
+		}
+		if opp.Legs[i].Side == order.Buy {
+			holding = path[i].pair.Base.Item
+		} else {
+			holding = path[i].pair.Quote.Item
+		}
+	}
+	opp.Return = held
+	opp.setProfit()
+	return opp, nil
+}
+
+// trade simulates exchanging an amount of a currency for the other currency
+// of the pair against the orderbook depth. It returns the amount received
+// after fees
+func (a *arbitrageMarket) trade(from *currency.Item, amount float64) (ArbitrageLeg, float64, error) {
+	leg := ArbitrageLeg{
+		Exchange: a.exchange,
+		Pair:     a.pair,
+		FeeRate:  a.feeRate,
+	}
+	var movement *orderbook.Movement
+	var err error
+	if from == a.pair.Quote.Item {
+		leg.Side = order.Buy
+		movement, err = a.depth.LiftTheAsksFromBest(amount, false)
+	} else {
+		leg.Side = order.Sell
+		movement, err = a.depth.HitTheBidsFromBest(amount, false)
+	}
+	if err != nil {
+		return leg, 0, err
+	}
+	if movement.FullBookSideConsumed {
+		return leg, 0, fmt.Errorf("%w %s %s %s", errNotExecutable, a.exchange, a.pair, leg.Side)
+	}
+	leg.AveragePrice = movement.AverageOrderCost
+	if leg.Side == order.Buy {
+		leg.Amount = movement.Purchased
+	} else {
+		leg.Amount = movement.Sold
+	}
+	leg.Fee = movement.Purchased * a.feeRate
+	return leg, movement.Purchased - leg.Fee, nil
+}
+
+// setProfit sets the profit of the opportunity from its amount and return
+func (o *ArbitrageOpportunity) setProfit() {
+	o.Profit = o.Return - o.Amount
+	if o.Amount > 0 {
+		o.ProfitPercentage = o.Profit / o.Amount * 100
+	}
+}
+
+// identifier returns a key of the opportunity's trades, used to alert only
+// new opportunities
+func (o *ArbitrageOpportunity) identifier() string {
+	var sb strings.Builder
+	sb.WriteString(string(o.Type))
+	for i := range o.Legs {
+		sb.WriteString("|" + o.Legs[i].Exchange + " " + o.Legs[i].Pair.String() + " " + o.Legs[i].Side.String())
+	}
+	return sb.String()
+}
+
+// String returns a summary of the opportunity
+func (o *ArbitrageOpportunity) String() string {
+	legs := make([]string, len(o.Legs))
+	for i := range o.Legs {
+		legs[i] = fmt.Sprintf("%s %v %s at %v on %s", o.Legs[i].Side, o.Legs[i].Amount, o.Legs[i].Pair, o.Legs[i].AveragePrice, o.Legs[i].Exchange)
+	}
+	return fmt.Sprintf("%s %s arbitrage of %v %s returns %v %s (%.4f%%): %s",
+		o.Type, o.Asset, o.Amount, o.Currency, o.Profit, o.Currency, o.ProfitPercentage, strings.Join(legs, ", "))
+}
+
+// getLatestScan returns the last completed scan
+func (m *arbitrageManager) getLatestScan() (*ArbitrageScan, error) {
+	if !m.IsRunning() {
+		return nil, fmt.Errorf("arbitrage manager %w", ErrSubSystemNotStarted)
+	}
+	m.m.RLock()
+	defer m.m.RUnlock()
+	if m.latest == nil {
+		return nil, errNoArbitrageScan
+	}
+	return m.latest, nil
+}
+
+// subscribe returns a pipe which receives every completed scan
+func (m *arbitrageManager) subscribe() (dispatch.Pipe, error) {
+	if !m.IsRunning() {
+		return dispatch.Pipe{}, fmt.Errorf("arbitrage manager %w", ErrSubSystemNotStarted)
+	}
+	return m.mux.Subscribe(m.id)
+}
+
+// filter returns up to limit opportunities of the type with a leg on the
+// exchange which make at least the minimum profit percentage. Empty and zero
+// arguments do not filter
+func (s *ArbitrageScan) filter(exch string, t ArbitrageType, minimumProfitPercentage float64, limit int) ([]ArbitrageOpportunity, error) {
+	switch t {
+	case "", CrossExchangeArbitrage, TriangularArbitrage:
+	default:
+		return nil, fmt.Errorf("%w '%s'", errArbitrageTypeUnsupported, t)
+	}
+	resp := make([]ArbitrageOpportunity, 0, len(s.Opportunities))
+	for i := range s.Opportunities {
+		if limit > 0 && len(resp) == limit {
+			break
+		}
+		if (t != "" && s.Opportunities[i].Type != t) || s.Opportunities[i].ProfitPercentage < minimumProfitPercentage {
+			continue
+		}
+		if exch != "" {
+			found := false
+			for j := range s.Opportunities[i].Legs {
+				if strings.EqualFold(s.Opportunities[i].Legs[j].Exchange, exch) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				continue
+			}
+		}
+		resp = append(resp, s.Opportunities[i])
+	}
+	return resp, nil
+}
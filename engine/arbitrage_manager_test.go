@@ -0,0 +1,287 @@
+package engine
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// arbitrageTestExchange provides enabled pairs and fees without any API calls
+type arbitrageTestExchange struct {
+	exchange.IBotExchange
+	name          string
+	pairs         currency.Pairs
+	feeRate       float64
+	withdrawalFee float64
+}
+
+func (a *arbitrageTestExchange) GetName() string {
+	return a.name
+}
+
+func (a *arbitrageTestExchange) GetEnabledPairs(asset.Item) (currency.Pairs, error) {
+	return a.pairs, nil
+}
+
+func (a *arbitrageTestExchange) GetFeeByType(_ context.Context, f *exchange.FeeBuilder) (float64, error) {
+	if f.FeeType == exchange.CryptocurrencyWithdrawalFee {
+		return a.withdrawalFee, nil
+	}
+	return a.feeRate * f.PurchasePrice * f.Amount, nil
+}
+
+func arbitrageTestBook(t *testing.T, exch string, p currency.Pair, bids, asks orderbook.Items) {
+	t.Helper()
+	require.NoError(t, (&orderbook.Base{
+		Exchange: exch,
+		Pair:     p,
+		Asset:    asset.Spot,
+		Bids:     bids,
+		Asks:     asks,
+	}).Process())
+}
+
+func arbitrageSetup(t *testing.T, cfg *config.ArbitrageConfig, exchs ...*arbitrageTestExchange) (*arbitrageManager, *riskTestComms) {
+	t.Helper()
+	em := NewExchangeManager()
+	for i := range exchs {
+		require.NoError(t, em.Add(exchs[i]))
+	}
+	if cfg.ScanInterval == 0 {
+		cfg.ScanInterval = time.Hour
+	}
+	comms := &riskTestComms{}
+	m, err := setupArbitrageManager(em, comms, cfg)
+	require.NoError(t, err)
+	m.fees = make(map[arbitrageFeeKey]arbitrageFee)
+	return m, comms
+}
+
+func TestSetupArbitrageManager(t *testing.T) {
+	t.Parallel()
+	_, err := setupArbitrageManager(nil, nil, nil)
+	assert.ErrorIs(t, err, errNilExchangeManager)
+	em := NewExchangeManager()
+	_, err = setupArbitrageManager(em, nil, nil)
+	assert.ErrorIs(t, err, errNilCommunicationsManager)
+	comms := &riskTestComms{}
+	_, err = setupArbitrageManager(em, comms, nil)
+	assert.ErrorIs(t, err, errNilConfig)
+	_, err = setupArbitrageManager(em, comms, &config.ArbitrageConfig{})
+	assert.ErrorIs(t, err, errInvalidScanInterval)
+	_, err = setupArbitrageManager(em, comms, &config.ArbitrageConfig{ScanInterval: time.Second, Assets: []string{"bad"}})
+	assert.ErrorIs(t, err, asset.ErrNotSupported)
+	_, err = setupArbitrageManager(em, comms, &config.ArbitrageConfig{ScanInterval: time.Second, TriangularStartAmounts: map[string]float64{"usdt": 0}})
+	assert.ErrorIs(t, err, errInvalidStartAmount)
+
+	m, err := setupArbitrageManager(em, comms, &config.ArbitrageConfig{
+		ScanInterval:           time.Second,
+		Exchanges:              []string{"Bitstamp"},
+		TriangularStartAmounts: map[string]float64{"usdt": 1000},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []asset.Item{asset.Spot}, m.assets)
+	assert.Equal(t, 1000.0, m.startAmounts[currency.USDT.Item])
+	assert.True(t, m.scans("bitstamp"))
+	assert.False(t, m.scans("binance"))
+}
+
+func TestArbitrageManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *arbitrageManager
+	assert.ErrorIs(t, m.Start(), ErrNilSubsystem)
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem)
+	assert.False(t, m.IsRunning())
+
+	m, _ = arbitrageSetup(t, &config.ArbitrageConfig{})
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+	require.NoError(t, m.Start())
+	assert.True(t, m.IsRunning())
+	assert.ErrorIs(t, m.Start(), ErrSubSystemAlreadyStarted)
+	require.NoError(t, m.Stop())
+	assert.False(t, m.IsRunning())
+}
+
+func TestExecutableQuoteAmount(t *testing.T) {
+	t.Parallel()
+	asks := orderbook.Items{{Price: 100, Amount: 1}, {Price: 101, Amount: 1}, {Price: 110, Amount: 5}}
+	bids := orderbook.Items{{Price: 105, Amount: 0.5}, {Price: 103, Amount: 1}, {Price: 90, Amount: 5}}
+	assert.InDelta(t, 150.5, executableQuoteAmount(asks, bids, 0.001, 0.001, 0), 1e-9)
+	assert.Equal(t, 75.0, executableQuoteAmount(asks, bids, 0.001, 0.001, 75))
+	// fees remove the spread of every level
+	assert.Zero(t, executableQuoteAmount(asks, bids, 0.05, 0.05, 0))
+	assert.Zero(t, executableQuoteAmount(bids, asks, 0, 0, 0))
+}
+
+func TestArbitrageCrossExchange(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.NewCode("ARBCROSS"), currency.USDT)
+	buy := &arbitrageTestExchange{name: "arbcrossbuy", pairs: currency.Pairs{p}, feeRate: 0.001, withdrawalFee: 0.0985}
+	sell := &arbitrageTestExchange{name: "arbcrosssell", pairs: currency.Pairs{p}, feeRate: 0.001}
+	arbitrageTestBook(t, buy.name, p,
+		orderbook.Items{{Price: 99, Amount: 1}, {Price: 98, Amount: 5}},
+		orderbook.Items{{Price: 100, Amount: 1}, {Price: 101, Amount: 1}, {Price: 110, Amount: 5}})
+	arbitrageTestBook(t, sell.name, p,
+		orderbook.Items{{Price: 105, Amount: 0.5}, {Price: 103, Amount: 1}, {Price: 90, Amount: 5}},
+		orderbook.Items{{Price: 106, Amount: 1}, {Price: 107, Amount: 5}})
+
+	m, comms := arbitrageSetup(t, &config.ArbitrageConfig{MinimumProfitPercentage: 1}, buy, sell)
+	m.scan()
+	require.Len(t, m.latest.Opportunities, 1)
+	opp := m.latest.Opportunities[0]
+	assert.Equal(t, CrossExchangeArbitrage, opp.Type)
+	assert.Equal(t, asset.Spot, opp.Asset)
+	assert.True(t, opp.Currency.Equal(currency.USDT))
+	assert.InDelta(t, 150.5, opp.Amount, 1e-9)
+	// 1.5 bought less fees is sold into the first two bids
+	assert.InDelta(t, 155.1901545, opp.Return, 1e-9)
+	assert.InDelta(t, 4.6901545, opp.Profit, 1e-9)
+	require.Len(t, opp.Legs, 2)
+	assert.Equal(t, buy.name, opp.Legs[0].Exchange)
+	assert.Equal(t, order.Buy, opp.Legs[0].Side)
+	assert.InDelta(t, 1.5, opp.Legs[0].Amount, 1e-9)
+	assert.Equal(t, sell.name, opp.Legs[1].Exchange)
+	assert.Equal(t, order.Sell, opp.Legs[1].Side)
+	assert.InDelta(t, 1.4985, opp.Legs[1].Amount, 1e-9)
+
+	// the same opportunity is only alerted once
+	m.scan()
+	assert.Len(t, comms.events, 1)
+	assert.Equal(t, "arbitrage", comms.events[0].Type)
+
+	m.cfg.MinimumProfitPercentage = 10
+	m.scan()
+	assert.Empty(t, m.latest.Opportunities)
+
+	// the withdrawal fee removes the profit
+	m.cfg.IncludeWithdrawalFees = true
+	markets := m.getMarkets(buy, asset.Spot)
+	require.Len(t, markets, 1)
+	sellMarkets := m.getMarkets(sell, asset.Spot)
+	require.Len(t, sellMarkets, 1)
+	withFee, err := m.crossExchange(markets[0], sellMarkets[0], asset.Spot)
+	require.NoError(t, err)
+	assert.Equal(t, 0.0985, withFee.WithdrawalFee)
+	assert.InDelta(t, 1.4, withFee.Legs[1].Amount, 1e-9)
+	assert.Negative(t, withFee.Profit)
+	assert.False(t, m.isProfitable(withFee))
+}
+
+func TestArbitrageTriangular(t *testing.T) {
+	t.Parallel()
+	base := currency.NewCode("ARBTRIBASE")
+	mid := currency.NewCode("ARBTRIMID")
+	baseQuote := currency.NewPair(base, currency.USDT)
+	midBase := currency.NewPair(mid, base)
+	midQuote := currency.NewPair(mid, currency.USDT)
+	exch := &arbitrageTestExchange{name: "arbtriangular", pairs: currency.Pairs{baseQuote, midBase, midQuote}}
+	arbitrageTestBook(t, exch.name, baseQuote,
+		orderbook.Items{{Price: 99, Amount: 100}, {Price: 50, Amount: 100}},
+		orderbook.Items{{Price: 100, Amount: 100}, {Price: 200, Amount: 100}})
+	arbitrageTestBook(t, exch.name, midBase,
+		orderbook.Items{{Price: 0.1, Amount: 1000}, {Price: 0.01, Amount: 1000}},
+		orderbook.Items{{Price: 0.11, Amount: 1000}, {Price: 0.5, Amount: 1000}})
+	arbitrageTestBook(t, exch.name, midQuote,
+		orderbook.Items{{Price: 12, Amount: 1000}, {Price: 1, Amount: 1000}},
+		orderbook.Items{{Price: 13, Amount: 1000}, {Price: 100, Amount: 1000}})
+
+	m, _ := arbitrageSetup(t, &config.ArbitrageConfig{TriangularStartAmounts: map[string]float64{"usdt": 1000}}, exch)
+	m.scan()
+	// only buying through the mispriced cross rate is profitable
+	require.Len(t, m.latest.Opportunities, 1)
+	opp := m.latest.Opportunities[0]
+	assert.Equal(t, TriangularArbitrage, opp.Type)
+	assert.True(t, opp.Currency.Equal(currency.USDT))
+	assert.Equal(t, 1000.0, opp.Amount)
+	assert.InDelta(t, 1000/0.11*12/100, opp.Return, 1e-9)
+	assert.InDelta(t, 9.0909090909, opp.ProfitPercentage, 1e-9)
+	require.Len(t, opp.Legs, 3)
+	assert.True(t, opp.Legs[0].Pair.Equal(baseQuote))
+	assert.Equal(t, order.Buy, opp.Legs[0].Side)
+	assert.True(t, opp.Legs[1].Pair.Equal(midBase))
+	assert.Equal(t, order.Buy, opp.Legs[1].Side)
+	assert.True(t, opp.Legs[2].Pair.Equal(midQuote))
+	assert.Equal(t, order.Sell, opp.Legs[2].Side)
+
+	// sizes beyond the depth cannot be executed
+	_, err := simulateTriangular(currency.USDT.Item, 100000, []*arbitrageMarket{m.getMarkets(exch, asset.Spot)[0]})
+	assert.ErrorIs(t, err, errNotExecutable)
+}
+
+func TestArbitrageScanFilter(t *testing.T) {
+	t.Parallel()
+	s := &ArbitrageScan{Opportunities: []ArbitrageOpportunity{
+		{Type: CrossExchangeArbitrage, ProfitPercentage: 3, Legs: []ArbitrageLeg{{Exchange: "Bitstamp"}, {Exchange: "Kraken"}}},
+		{Type: TriangularArbitrage, ProfitPercentage: 2, Legs: []ArbitrageLeg{{Exchange: "Binance"}}},
+		{Type: CrossExchangeArbitrage, ProfitPercentage: 1, Legs: []ArbitrageLeg{{Exchange: "Binance"}, {Exchange: "Kraken"}}},
+	}}
+	_, err := s.filter("", "statistical", 0, 0)
+	assert.ErrorIs(t, err, errArbitrageTypeUnsupported)
+
+	resp, err := s.filter("", "", 0, 0)
+	require.NoError(t, err)
+	assert.Len(t, resp, 3)
+	resp, err = s.filter("kraken", "", 0, 0)
+	require.NoError(t, err)
+	assert.Len(t, resp, 2)
+	resp, err = s.filter("", TriangularArbitrage, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, resp, 1)
+	assert.Equal(t, "Binance", resp[0].Legs[0].Exchange)
+	resp, err = s.filter("", CrossExchangeArbitrage, 2, 0)
+	require.NoError(t, err)
+	assert.Len(t, resp, 1)
+	resp, err = s.filter("", "", 0, 2)
+	require.NoError(t, err)
+	assert.Len(t, resp, 2)
+}
+
+func TestArbitrageLatestScan(t *testing.T) {
+	t.Parallel()
+	m, _ := arbitrageSetup(t, &config.ArbitrageConfig{})
+	_, err := m.getLatestScan()
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+	_, err = m.subscribe()
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+
+	require.NoError(t, m.Start())
+	defer func() { assert.NoError(t, m.Stop()) }()
+	_, err = m.getLatestScan()
+	assert.ErrorIs(t, err, errNoArbitrageScan)
+	m.scan()
+	s, err := m.getLatestScan()
+	require.NoError(t, err)
+	assert.Empty(t, s.Opportunities)
+}
+
+func TestArbitrageSubscribe(t *testing.T) {
+	m, _ := arbitrageSetup(t, &config.ArbitrageConfig{})
+	if !dispatch.IsRunning() {
+		require.NoError(t, dispatch.Start(dispatch.DefaultMaxWorkers, dispatch.DefaultJobsLimit))
+		defer func() { assert.NoError(t, dispatch.Stop()) }()
+	}
+	require.NoError(t, m.Start())
+	defer func() { assert.NoError(t, m.Stop()) }()
+	pipe, err := m.subscribe()
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, pipe.Release()) }()
+	m.scan()
+	select {
+	case data := <-pipe.Channel():
+		s, ok := data.(*ArbitrageScan)
+		require.True(t, ok)
+		assert.Empty(t, s.Opportunities)
+	case <-time.After(time.Second):
+		t.Fatal("expected a published scan")
+	}
+}
@@ -0,0 +1,131 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+const (
+	// ArbitrageManagerName is an exported subsystem name
+	ArbitrageManagerName = "arbitrage_manager"
+
+	// CrossExchangeArbitrage buys a pair on one exchange and sells it on
+	// another
+	CrossExchangeArbitrage ArbitrageType = "cross-exchange"
+	// TriangularArbitrage trades through three pairs on one exchange to end
+	// with more of the starting currency
+	TriangularArbitrage ArbitrageType = "triangular"
+
+	// arbitrageFeeRefreshInterval is how often cached trading and withdrawal
+	// fees are retrieved from exchanges again
+	arbitrageFeeRefreshInterval = time.Hour
+	// triangularSizeSearchSteps is how many times the triangular starting
+	// amount is halved when searching for the most profitable size
+	triangularSizeSearchSteps = 8
+)
+
+var (
+	errArbitrageTypeUnsupported = errors.New("arbitrage type unsupported")
+	errNoArbitrageScan          = errors.New("no arbitrage scan has completed")
+	errNotExecutable            = errors.New("orderbook depth cannot fill the amount")
+	errInvalidScanInterval      = errors.New("scan interval must be greater than zero")
+	errInvalidStartAmount       = errors.New("triangular start amount must be greater than zero")
+)
+
+// ArbitrageType is the type of an arbitrage opportunity
+type ArbitrageType string
+
+// arbitrageManager scans orderbook depth for arbitrage opportunities and
+// publishes them ranked by profit
+type arbitrageManager struct {
+	started         int32
+	shutdown        chan struct{}
+	wg              sync.WaitGroup
+	exchangeManager iExchangeManager
+	comms           iCommsManager
+	cfg             config.ArbitrageConfig
+	exchanges       map[string]bool
+	assets          []asset.Item
+	startAmounts    map[*currency.Item]float64
+	mux             *dispatch.Mux
+	id              uuid.UUID
+
+	feeMtx sync.Mutex
+	fees   map[arbitrageFeeKey]arbitrageFee
+
+	m        sync.RWMutex
+	latest   *ArbitrageScan
+	previous map[string]bool
+}
+
+// arbitrageFeeKey identifies a cached fee. Trading fees are per pair and
+// withdrawal fees are per currency
+type arbitrageFeeKey struct {
+	exchange string
+	feeType  exchange.FeeType
+	asset    asset.Item
+	base     *currency.Item
+	quote    *currency.Item
+}
+
+// arbitrageFee is a fee retrieved from an exchange
+type arbitrageFee struct {
+	value     float64
+	retrieved time.Time
+}
+
+// arbitrageMarket is a pair's orderbook depth on an exchange
+type arbitrageMarket struct {
+	exch     exchange.IBotExchange
+	exchange string
+	pair     currency.Pair
+	feeRate  float64
+	depth    *orderbook.Depth
+}
+
+// ArbitrageScan holds the opportunities found by a scan, ranked by profit
+// percentage
+type ArbitrageScan struct {
+	Time          time.Time
+	Opportunities []ArbitrageOpportunity
+}
+
+// ArbitrageOpportunity is an executable arbitrage sized against orderbook
+// depth. Amount, Return and Profit are in Currency, which the opportunity
+// starts and ends with
+type ArbitrageOpportunity struct {
+	Type             ArbitrageType
+	Asset            asset.Item
+	Currency         currency.Code
+	Amount           float64
+	Return           float64
+	Profit           float64
+	ProfitPercentage float64
+	// WithdrawalFee is the fee to move the bought currency between exchanges
+	// in cross-exchange opportunities, in the bought currency
+	WithdrawalFee float64
+	Legs          []ArbitrageLeg
+	Time          time.Time
+}
+
+// ArbitrageLeg is a trade of an opportunity. Amount is the base traded and
+// the fee is charged on the currency received
+type ArbitrageLeg struct {
+	Exchange     string
+	Pair         currency.Pair
+	Side         order.Side
+	Amount       float64
+	AveragePrice float64
+	FeeRate      float64
+	Fee          float64
+}
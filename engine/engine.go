@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/tracing"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
@@ -21,7 +22,9 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/alert"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/paper"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 	gctlog "github.com/thrasher-corp/gocryptotrader/log"
@@ -49,6 +52,12 @@ type Engine struct {
 	WithdrawManager         *WithdrawManager
 	dataHistoryManager      *DataHistoryManager
 	currencyStateManager    *CurrencyStateManager
+	metricsManager          *metricsManager
+	marketDataCapture       *marketDataCaptureManager
+	arbitrageManager        *arbitrageManager
+	marketMaker             *marketMaker
+	strategyManager         *strategyManager
+	tracer                  *tracing.Tracer
 	Settings                Settings
 	uptime                  time.Time
 	GRPCShutdownSignal      chan struct{}
@@ -177,6 +186,13 @@ func validateSettings(b *Engine, s *Settings, flagSet FlagSet) {
 	flagSet.WithBool("datahistorymanager", &b.Settings.EnableDataHistoryManager, b.Config.DataHistoryManager.Enabled)
 	flagSet.WithBool("currencystatemanager", &b.Settings.EnableCurrencyStateManager, b.Config.CurrencyStateManager.Enabled != nil && *b.Config.CurrencyStateManager.Enabled)
 	flagSet.WithBool("gctscriptmanager", &b.Settings.EnableGCTScriptManager, b.Config.GCTScript.Enabled)
+	flagSet.WithBool("metrics", &b.Settings.EnableMetricsManager, b.Config.Metrics.Enabled)
+	flagSet.WithBool("tracing", &b.Settings.EnableTracing, b.Config.Tracing.Enabled)
+	flagSet.WithBool("sharedratelimits", &b.Settings.EnableSharedRateLimits, b.Config.SharedRateLimits.Enabled)
+	flagSet.WithBool("marketdatacapture", &b.Settings.EnableMarketDataCapture, b.Config.MarketDataCapture.Enabled)
+	flagSet.WithBool("arbitrage", &b.Settings.EnableArbitrageManager, b.Config.Arbitrage.Enabled)
+	flagSet.WithBool("marketmaker", &b.Settings.EnableMarketMaker, b.Config.MarketMaker.Enabled)
+	flagSet.WithBool("strategymanager", &b.Settings.EnableStrategyManager, b.Config.StrategyManager.Enabled)
 
 	flagSet.WithBool("tickersync", &b.Settings.EnableTickerSyncing, b.Config.SyncManagerConfig.SynchronizeTicker)
 	flagSet.WithBool("orderbooksync", &b.Settings.EnableOrderbookSyncing, b.Config.SyncManagerConfig.SynchronizeOrderbook)
@@ -372,6 +388,44 @@ func (bot *Engine) Start() error {
 		bot.Config.PurgeExchangeAPICredentials()
 	}
 
+	if bot.Settings.EnableTracing {
+		if t, err := setupTracer(&bot.Config.Tracing, bot.Settings.DataDir); err != nil {
+			gctlog.Errorf(gctlog.Global, "Tracer unable to setup: %s", err)
+		} else if err := t.Start(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Tracer unable to start: %s", err)
+		} else {
+			bot.tracer = t
+			tracing.SetGlobalTracer(t)
+			gctlog.Debugf(gctlog.Global, "Tracing enabled, exporting spans via %s", bot.Config.Tracing.Exporter)
+		}
+	}
+
+	// Rate limiters are created as exchanges are set up so the shared backend
+	// must be set up first
+	if bot.Settings.EnableSharedRateLimits {
+		if b, err := request.NewFileBackend(bot.Config.SharedRateLimits.Directory); err != nil {
+			gctlog.Errorf(gctlog.Global, "Shared rate limits unable to setup: %s", err)
+		} else {
+			request.SetupSharedRateLimitBackend(b)
+			gctlog.Debugf(gctlog.Global, "Sharing exchange rate limits via %s", bot.Config.SharedRateLimits.Directory)
+		}
+	}
+
+	// Reporters are attached to exchanges as they are set up so the metrics
+	// manager must be set up first
+	if bot.Settings.EnableMetricsManager {
+		if m, err := setupMetricsManager(&bot.Config.Metrics); err != nil {
+			gctlog.Errorf(gctlog.Global, "Metrics manager unable to setup: %s", err)
+		} else {
+			bot.metricsManager = m
+			request.SetupGlobalReporter(m.requestReporter())
+			stream.SetupGlobalReporter(m.websocketReporter())
+			if err := bot.metricsManager.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Metrics manager unable to start: %s", err)
+			}
+		}
+	}
+
 	gctlog.Debugln(gctlog.Global, "Setting up exchanges..")
 	if err := bot.SetupExchanges(); err != nil {
 		return err
@@ -407,12 +461,40 @@ func (bot *Engine) Start() error {
 		go StartRPCServer(bot)
 	}
 
+	if bot.Settings.EnableOrderManager {
+		if o, err := SetupOrderManager(
+			bot.ExchangeManager,
+			bot.CommunicationsManager,
+			&bot.ServicesWG,
+			&bot.Config.OrderManager); err != nil {
+			gctlog.Errorf(gctlog.Global, "Order manager unable to setup: %s", err)
+		} else {
+			bot.OrderManager = o
+			if bot.metricsManager != nil {
+				bot.OrderManager.submissionReporter = bot.metricsManager
+			}
+			if bot.DatabaseManager != nil {
+				if err = bot.OrderManager.SetDatabaseManager(bot.DatabaseManager); err != nil {
+					gctlog.Errorf(gctlog.Global, "Order manager unable to set database manager: %s", err)
+				}
+			}
+			if err = bot.OrderManager.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Order manager unable to start: %s", err)
+			}
+		}
+	}
+
 	if bot.Settings.EnablePortfolioManager {
 		if bot.portfolioManager == nil {
-			if p, err := setupPortfolioManager(bot.ExchangeManager, bot.Settings.PortfolioManagerDelay, &bot.Config.Portfolio); err != nil {
+			if p, err := setupPortfolioManager(bot.ExchangeManager, bot.OrderManager, bot.Settings.PortfolioManagerDelay, &bot.Config.Portfolio, bot.Config.Currency.FiatDisplayCurrency); err != nil {
 				gctlog.Errorf(gctlog.Global, "portfolio manager unable to setup: %s", err)
 			} else {
 				bot.portfolioManager = p
+				if bot.DatabaseManager != nil {
+					if err := bot.portfolioManager.SetDatabaseManager(bot.DatabaseManager); err != nil {
+						gctlog.Errorf(gctlog.Global, "portfolio manager unable to set database manager: %s", err)
+					}
+				}
 				if err := bot.portfolioManager.Start(&bot.ServicesWG); err != nil {
 					gctlog.Errorf(gctlog.Global, "portfolio manager unable to start: %s", err)
 				}
@@ -470,21 +552,6 @@ func (bot *Engine) Start() error {
 		}()
 	}
 
-	if bot.Settings.EnableOrderManager {
-		if o, err := SetupOrderManager(
-			bot.ExchangeManager,
-			bot.CommunicationsManager,
-			&bot.ServicesWG,
-			&bot.Config.OrderManager); err != nil {
-			gctlog.Errorf(gctlog.Global, "Order manager unable to setup: %s", err)
-		} else {
-			bot.OrderManager = o
-			if err = bot.OrderManager.Start(); err != nil {
-				gctlog.Errorf(gctlog.Global, "Order manager unable to start: %s", err)
-			}
-		}
-	}
-
 	if bot.Settings.EnableExchangeSyncManager {
 		cfg := bot.Config.SyncManagerConfig
 		cfg.SynchronizeTicker = bot.Settings.EnableTickerSyncing
@@ -523,7 +590,7 @@ func (bot *Engine) Start() error {
 	}
 
 	if bot.Settings.EnableEventManager {
-		if e, err := setupEventManager(bot.CommunicationsManager, bot.ExchangeManager, bot.Settings.EventManagerDelay, bot.Settings.EnableDryRun); err != nil {
+		if e, err := setupEventManager(bot.CommunicationsManager, bot.ExchangeManager, bot.OrderManager, bot.DatabaseManager, bot.Settings.EventManagerDelay, bot.Settings.EnableDryRun); err != nil {
 			gctlog.Errorf(gctlog.Global, "Unable to initialise event manager. Err: %s", err)
 		} else {
 			bot.eventManager = e
@@ -533,11 +600,65 @@ func (bot *Engine) Start() error {
 		}
 	}
 
+	if bot.Settings.EnableMarketDataCapture {
+		if c, err := setupMarketDataCaptureManager(bot.ExchangeManager, &bot.Config.MarketDataCapture, bot.Settings.DataDir); err != nil {
+			gctlog.Errorf(gctlog.Global, "Market data capture manager unable to setup: %s", err)
+		} else {
+			bot.marketDataCapture = c
+			if err = bot.marketDataCapture.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Market data capture manager unable to start: %s", err)
+			}
+		}
+	}
+
+	if bot.Settings.EnableArbitrageManager {
+		if a, err := setupArbitrageManager(bot.ExchangeManager, bot.CommunicationsManager, &bot.Config.Arbitrage); err != nil {
+			gctlog.Errorf(gctlog.Global, "Arbitrage manager unable to setup: %s", err)
+		} else {
+			bot.arbitrageManager = a
+			if err = bot.arbitrageManager.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Arbitrage manager unable to start: %s", err)
+			}
+		}
+	}
+
+	if bot.Settings.EnableMarketMaker {
+		if mm, err := setupMarketMaker(bot.ExchangeManager, bot.OrderManager, &bot.Config.MarketMaker); err != nil {
+			gctlog.Errorf(gctlog.Global, "Market maker unable to setup: %s", err)
+		} else {
+			bot.marketMaker = mm
+			if err = bot.marketMaker.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Market maker unable to start: %s", err)
+			}
+		}
+	}
+
+	if bot.Settings.EnableStrategyManager {
+		if sm, err := setupStrategyManager(bot.ExchangeManager, bot.OrderManager, getLiveStrategyLoader(), &bot.Config.StrategyManager); err != nil {
+			gctlog.Errorf(gctlog.Global, "Strategy manager unable to setup: %s", err)
+		} else {
+			bot.strategyManager = sm
+			if err = bot.strategyManager.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Strategy manager unable to start: %s", err)
+			}
+		}
+	}
+
 	if bot.Settings.EnableWebsocketRoutine {
 		if w, err := setupWebsocketRoutineManager(bot.ExchangeManager, bot.OrderManager, bot.currencyPairSyncer, &bot.Config.Currency, bot.Settings.Verbose); err != nil {
 			gctlog.Errorf(gctlog.Global, "Unable to initialise websocket routine manager. Err: %s", err)
 		} else {
 			bot.WebsocketRoutineManager = w
+			if bot.metricsManager != nil {
+				if err = bot.WebsocketRoutineManager.registerWebsocketDataHandler(bot.metricsManager.websocketDataHandler, false); err != nil {
+					gctlog.Errorf(gctlog.Global, "Metrics manager unable to register websocket data handler. Err: %s", err)
+				}
+			}
+			if bot.marketDataCapture != nil {
+				if err = bot.WebsocketRoutineManager.registerWebsocketDataHandler(bot.marketDataCapture.websocketDataHandler, false); err != nil {
+					gctlog.Errorf(gctlog.Global, "Market data capture manager unable to register websocket data handler. Err: %s", err)
+				}
+			}
 			if err = bot.WebsocketRoutineManager.Start(); err != nil {
 				gctlog.Errorf(gctlog.Global, "failed to start websocket routine manager. Err: %s", err)
 			}
@@ -594,6 +715,16 @@ func (bot *Engine) Stop() {
 			gctlog.Errorf(gctlog.Global, "GCTScript manager unable to stop. Error: %v", err)
 		}
 	}
+	if bot.strategyManager.IsRunning() {
+		if err := bot.strategyManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Strategy manager unable to stop. Error: %v", err)
+		}
+	}
+	if bot.marketMaker.IsRunning() {
+		if err := bot.marketMaker.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Market maker unable to stop. Error: %v", err)
+		}
+	}
 	if bot.OrderManager.IsRunning() {
 		if err := bot.OrderManager.Stop(); err != nil {
 			gctlog.Errorf(gctlog.Global, "Order manager unable to stop. Error: %v", err)
@@ -661,6 +792,21 @@ func (bot *Engine) Stop() {
 				err)
 		}
 	}
+	if bot.arbitrageManager.IsRunning() {
+		if err := bot.arbitrageManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Arbitrage manager unable to stop. Error: %v", err)
+		}
+	}
+	if bot.marketDataCapture.IsRunning() {
+		if err := bot.marketDataCapture.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Market data capture manager unable to stop. Error: %v", err)
+		}
+	}
+	if bot.metricsManager.IsRunning() {
+		if err := bot.metricsManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Metrics manager unable to stop. Error: %v", err)
+		}
+	}
 
 	err := bot.ExchangeManager.Shutdown(bot.Settings.ExchangeShutdownTimeout)
 	if err != nil {
@@ -683,6 +829,14 @@ func (bot *Engine) Stop() {
 
 	// Wait for services to gracefully shutdown
 	bot.ServicesWG.Wait()
+	if bot.tracer != nil {
+		tracing.SetGlobalTracer(nil)
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+		if err := bot.tracer.Stop(ctx); err != nil {
+			gctlog.Errorf(gctlog.Global, "Tracer unable to stop. Error: %v", err)
+		}
+		cancel()
+	}
 	gctlog.Infoln(gctlog.Global, "Exiting.")
 	if err := gctlog.CloseLogger(); err != nil {
 		log.Printf("Failed to close logger. Error: %v\n", err)
@@ -815,6 +969,15 @@ func (bot *Engine) LoadExchange(name string) error {
 		return err
 	}
 
+	if exchCfg.PaperTrading != nil && exchCfg.PaperTrading.Enabled {
+		exch, err = paper.New(exch, exchCfg.PaperTrading)
+		if err != nil {
+			exchCfg.Enabled = false
+			return err
+		}
+		gctlog.Warnf(gctlog.ExchangeSys, "%s: Paper trading enabled, orders will be simulated against live market data\n", exch.GetName())
+	}
+
 	err = bot.ExchangeManager.Add(exch)
 	if err != nil {
 		return err
@@ -54,6 +54,13 @@ type CoreSettings struct {
 	EnableNTPClient             bool
 	EnableWebsocketRoutine      bool
 	EnableCurrencyStateManager  bool
+	EnableMetricsManager        bool
+	EnableTracing               bool
+	EnableSharedRateLimits      bool
+	EnableMarketDataCapture     bool
+	EnableArbitrageManager      bool
+	EnableMarketMaker           bool
+	EnableStrategyManager       bool
 	EventManagerDelay           time.Duration
 	EnableFuturesTracking       bool
 	Verbose                     bool
@@ -1,22 +1,27 @@
 package engine
 
 import (
-	"errors"
+	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"sync/atomic"
 	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	dbevent "github.com/thrasher-corp/gocryptotrader/database/repository/event"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
 // setupEventManager loads and validates the communications manager config
-func setupEventManager(comManager iCommsManager, exchangeManager iExchangeManager, sleepDelay time.Duration, verbose bool) (*eventManager, error) {
+func setupEventManager(comManager iCommsManager, exchangeManager iExchangeManager, orderManager iOrderManager, dcm iDatabaseConnectionManager, sleepDelay time.Duration, verbose bool) (*eventManager, error) {
 	if comManager == nil {
 		return nil, errNilComManager
 	}
@@ -26,13 +31,26 @@ func setupEventManager(comManager iCommsManager, exchangeManager iExchangeManage
 	if sleepDelay <= 0 {
 		sleepDelay = EventSleepDelay
 	}
-	return &eventManager{
+	m := &eventManager{
 		comms:           comManager,
 		exchangeManager: exchangeManager,
+		orderManager:    orderManager,
 		verbose:         verbose,
 		sleepDelay:      sleepDelay,
 		shutdown:        make(chan struct{}),
-	}, nil
+		rsi:             make(map[rsiKey]*rsiValue),
+		rsiFetches:      make(map[rsiKey]chan struct{}),
+	}
+	if dcm != nil {
+		db, err := dbevent.Setup(dcm.GetInstance())
+		if err != nil {
+			return nil, err
+		}
+		if db != nil {
+			m.db = db
+		}
+	}
+	return m, nil
 }
 
 // Start runs the subsystem
@@ -43,12 +61,43 @@ func (m *eventManager) Start() error {
 	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
 		return fmt.Errorf("event manager %w", ErrSubSystemAlreadyStarted)
 	}
+	if err := m.loadEvents(); err != nil {
+		atomic.StoreInt32(&m.started, 0)
+		return err
+	}
 	log.Debugf(log.EventMgr, "Event Manager started. SleepDelay: %v\n", m.sleepDelay.String())
 	m.shutdown = make(chan struct{})
 	go m.run()
 	return nil
 }
 
+// loadEvents restores the events stored in the database so they survive a
+// restart
+func (m *eventManager) loadEvents() error {
+	if m.db == nil {
+		return nil
+	}
+	stored, err := m.db.GetAll()
+	if err != nil {
+		return fmt.Errorf("event manager could not load events: %w", err)
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	m.events = make([]Event, 0, len(stored))
+	for i := range stored {
+		e, err := eventFromDB(&stored[i])
+		if err != nil {
+			log.Errorf(log.EventMgr, "Events: ID: %d could not be restored: %v\n", stored[i].ID, err)
+			continue
+		}
+		m.events = append(m.events, *e)
+	}
+	if len(m.events) > 0 {
+		log.Infof(log.EventMgr, "Events: restored %d event(s) from the database\n", len(m.events))
+	}
+	return nil
+}
+
 // IsRunning safely checks whether the subsystem is running
 func (m *eventManager) IsRunning() bool {
 	if m == nil {
@@ -71,69 +120,239 @@ func (m *eventManager) Stop() error {
 
 func (m *eventManager) run() {
 	t := time.NewTicker(m.sleepDelay)
-	select {
-	case <-m.shutdown:
-		return
-	case <-t.C:
-		total, executed := m.getEventCounter()
-		if total > 0 && executed != total {
-			m.m.Lock()
-			for i := range m.events {
-				m.executeEvent(i)
+	defer t.Stop()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-t.C:
+			m.processEvents()
+		}
+	}
+}
+
+// processEvents checks the conditions of every armed event. Market data is
+// retrieved and actions are performed without holding the event lock so a
+// slow exchange does not stall the other events
+func (m *eventManager) processEvents() {
+	m.m.Lock()
+	armed := make([]Event, 0, len(m.events))
+	for i := range m.events {
+		e := &m.events[i]
+		if e.Executed {
+			if e.Cooldown <= 0 || time.Since(e.LastExecuted) < e.Cooldown {
+				continue
 			}
-			m.m.Unlock()
+			e.Executed = false
 		}
+		armed = append(armed, *e)
+		armed[len(armed)-1].Conditions = append([]EventCondition(nil), e.Conditions...)
+	}
+	m.m.Unlock()
+	for i := range armed {
+		m.executeEvent(&armed[i])
 	}
 }
 
-func (m *eventManager) executeEvent(i int) {
-	if !m.events[i].Executed {
+// executeEvent checks the conditions of an armed event and performs the event
+// action if they are met
+func (m *eventManager) executeEvent(e *Event) {
+	if m.verbose {
+		log.Debugf(log.EventMgr, "Events: Processing event %s.\n", e.String())
+	}
+	err := m.checkEventCondition(e)
+	if err != nil {
 		if m.verbose {
-			log.Debugf(log.EventMgr, "Events: Processing event %s.\n", m.events[i].String())
+			log.Debugf(log.EventMgr, "Events: ID: %d %v", e.ID, err)
 		}
-		err := m.checkEventCondition(&m.events[i])
+		return
+	}
+	triggered, ok := m.setExecuted(e.ID)
+	if !ok {
+		// The event was removed or executed while its conditions were checked
+		return
+	}
+
+	msg := fmt.Sprintf(
+		"Events: ID: %d triggered on %s successfully [%v]\n", triggered.ID,
+		triggered.Exchange, triggered.String(),
+	)
+	log.Infoln(log.EventMgr, msg)
+	m.comms.PushEvent(base.Event{Type: "event", Message: msg})
+
+	execution := &dbevent.Execution{
+		EventID:    triggered.ID,
+		Action:     triggered.Action,
+		Success:    true,
+		ExecutedAt: triggered.LastExecuted,
+	}
+	execution.Result, err = m.performAction(triggered)
+	if err != nil {
+		msg = fmt.Sprintf("Events: ID: %d action %s failed: %v", triggered.ID, triggered.Action, err)
+		log.Errorln(log.EventMgr, msg)
+		m.comms.PushEvent(base.Event{Type: "event", Message: msg})
+		execution.Success = false
+		execution.Result = err.Error()
+	}
+	m.storeExecution(triggered, execution)
+}
+
+// setExecuted marks an armed event as executed and returns a copy of it
+func (m *eventManager) setExecuted(id int64) (*Event, bool) {
+	m.m.Lock()
+	defer m.m.Unlock()
+	for i := range m.events {
+		e := &m.events[i]
+		if e.ID != id {
+			continue
+		}
+		if e.Executed {
+			return nil, false
+		}
+		e.Executed = true
+		e.LastExecuted = time.Now()
+		e.ExecutionCount++
+		triggered := *e
+		triggered.Conditions = append([]EventCondition(nil), e.Conditions...)
+		return &triggered, true
+	}
+	return nil, false
+}
+
+// performAction executes any trade action attached to the event and returns
+// a description of the outcome
+func (m *eventManager) performAction(e *Event) (string, error) {
+	switch strings.ToUpper(e.Action) {
+	case ActionSubmitOrder:
+		if e.SubmitOrder == nil {
+			return "", fmt.Errorf("%s %w", e.Action, errOrderActionNotSet)
+		}
+		// Copy the submission as the event can be executed multiple times
+		submit := *e.SubmitOrder
+		ctx, cancel := context.WithTimeout(context.Background(), eventRequestTimeout)
+		defer cancel()
+		resp, err := m.orderManager.Submit(ctx, &submit)
 		if err != nil {
-			msg := fmt.Sprintf(
-				"Events: ID: %d triggered on %s successfully [%v]\n", m.events[i].ID,
-				m.events[i].Exchange, m.events[i].String(),
-			)
-			log.Infoln(log.EventMgr, msg)
-			m.comms.PushEvent(base.Event{Type: "event", Message: msg})
-			m.events[i].Executed = true
-		} else if m.verbose {
-			log.Debugf(log.EventMgr, "%v", err)
+			return "", err
+		}
+		log.Infof(log.EventMgr, "Events: ID: %d submitted order %s on %s\n", e.ID, resp.OrderID, resp.Exchange)
+		return fmt.Sprintf("submitted order %s on %s", resp.OrderID, resp.Exchange), nil
+	case ActionCancelOrder:
+		if e.CancelOrder == nil {
+			return "", fmt.Errorf("%s %w", e.Action, errOrderActionNotSet)
+		}
+		c := *e.CancelOrder
+		ctx, cancel := context.WithTimeout(context.Background(), eventRequestTimeout)
+		defer cancel()
+		err := m.orderManager.Cancel(ctx, &c)
+		if err != nil {
+			return "", err
+		}
+		log.Infof(log.EventMgr, "Events: ID: %d cancelled order %s on %s\n", e.ID, c.OrderID, c.Exchange)
+		return fmt.Sprintf("cancelled order %s on %s", c.OrderID, c.Exchange), nil
+	}
+	return "", nil
+}
+
+// storeExecution persists the execution result and the updated execution
+// state of an event when database storage is enabled. Events removed while
+// their action was performed are not stored again
+func (m *eventManager) storeExecution(e *Event, execution *dbevent.Execution) {
+	if m.db == nil {
+		return
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	found := false
+	for i := range m.events {
+		if m.events[i].ID == e.ID {
+			found = true
+			break
 		}
 	}
+	if !found {
+		return
+	}
+	dbEvent, err := e.toDB()
+	if err != nil {
+		log.Errorf(log.EventMgr, "Events: ID: %d could not be stored: %v\n", e.ID, err)
+		return
+	}
+	err = m.db.Upsert(dbEvent)
+	if err != nil {
+		log.Errorf(log.EventMgr, "Events: ID: %d could not be stored: %v\n", e.ID, err)
+		return
+	}
+	err = m.db.InsertExecutions(execution)
+	if err != nil {
+		log.Errorf(log.EventMgr, "Events: ID: %d execution could not be stored: %v\n", e.ID, err)
+	}
 }
 
 // Add adds an event to the Events chain and returns an index/eventID
 // and an error
 func (m *eventManager) Add(exchange, item string, condition EventConditionParams, p currency.Pair, a asset.Item, action string) (int64, error) {
+	return m.AddEvent(&Event{
+		EventCondition: EventCondition{
+			Exchange:  exchange,
+			Item:      item,
+			Condition: condition,
+			Pair:      p,
+			Asset:     a,
+		},
+		Action: action,
+	})
+}
+
+// AddEvent validates and adds an event which can contain multiple conditions,
+// a cooldown and a trade action to the Events chain and returns its eventID
+func (m *eventManager) AddEvent(e *Event) (int64, error) {
 	if m == nil {
 		return 0, fmt.Errorf("event manager %w", ErrNilSubsystem)
 	}
 	if atomic.LoadInt32(&m.started) == 0 {
 		return 0, fmt.Errorf("event manager %w", ErrSubSystemNotStarted)
 	}
-	err := m.isValidEvent(exchange, item, condition, action)
+	if e == nil {
+		return 0, errNilEvent
+	}
+	err := m.validateEvent(e)
 	if err != nil {
 		return 0, err
 	}
-	evt := Event{
-		Exchange:  exchange,
-		Item:      item,
-		Condition: condition,
-		Pair:      p,
-		Asset:     a,
-		Action:    action,
-		Executed:  false,
+	evt := *e
+	evt.Item = strings.ToUpper(evt.Item)
+	evt.Conditions = append([]EventCondition(nil), e.Conditions...)
+	for i := range evt.Conditions {
+		evt.Conditions[i].Item = strings.ToUpper(evt.Conditions[i].Item)
+	}
+	evt.Logic = strings.ToUpper(evt.Logic)
+	if evt.Logic == "" {
+		evt.Logic = LogicAnd
 	}
+	evt.Executed = false
+	evt.LastExecuted = time.Time{}
+	evt.ExecutionCount = 0
+
 	m.m.Lock()
-	if len(m.events) > 0 {
-		evt.ID = int64(len(m.events) + 1)
+	defer m.m.Unlock()
+	if m.db != nil {
+		// The database assigns the ID so IDs of removed events are not reused
+		// after a restart
+		dbEvent, err := evt.toDB()
+		if err != nil {
+			return 0, err
+		}
+		err = m.db.Upsert(dbEvent)
+		if err != nil {
+			return 0, err
+		}
+		evt.ID = dbEvent.ID
+	} else {
+		m.nextID++
+		evt.ID = m.nextID
 	}
 	m.events = append(m.events, evt)
-	m.m.Unlock()
 
 	return evt.ID, nil
 }
@@ -147,6 +366,12 @@ func (m *eventManager) Remove(eventID int64) bool {
 	defer m.m.Unlock()
 	for i := range m.events {
 		if m.events[i].ID == eventID {
+			if m.db != nil {
+				if err := m.db.Delete(eventID); err != nil {
+					log.Errorf(log.EventMgr, "Events: ID: %d could not be removed from the database: %v\n", eventID, err)
+					return false
+				}
+			}
 			m.events = append(m.events[:i], m.events[i+1:]...)
 			return true
 		}
@@ -154,6 +379,50 @@ func (m *eventManager) Remove(eventID int64) bool {
 	return false
 }
 
+// GetEventExecutions returns the stored execution history of an event
+func (m *eventManager) GetEventExecutions(eventID int64) ([]dbevent.Execution, error) {
+	if m == nil {
+		return nil, fmt.Errorf("event manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("event manager %w", ErrSubSystemNotStarted)
+	}
+	if m.db == nil {
+		return nil, errEventDatabaseNotSet
+	}
+	m.m.Lock()
+	found := false
+	for i := range m.events {
+		if m.events[i].ID == eventID {
+			found = true
+			break
+		}
+	}
+	m.m.Unlock()
+	if !found {
+		return nil, fmt.Errorf("%w ID: %d", errEventNotFound, eventID)
+	}
+	return m.db.GetExecutionsByEventID(eventID)
+}
+
+// GetEvents returns a copy of all events on the chain
+func (m *eventManager) GetEvents() ([]Event, error) {
+	if m == nil {
+		return nil, fmt.Errorf("event manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("event manager %w", ErrSubSystemNotStarted)
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	events := make([]Event, len(m.events))
+	for i := range m.events {
+		events[i] = m.events[i]
+		events[i].Conditions = append([]EventCondition(nil), m.events[i].Conditions...)
+	}
+	return events, nil
+}
+
 // getEventCounter displays the amount of total events on the chain and the
 // events that have been executed.
 func (m *eventManager) getEventCounter() (total, executed int) {
@@ -183,10 +452,88 @@ func (m *eventManager) checkEventCondition(e *Event) error {
 	if e == nil {
 		return errNilEvent
 	}
-	if e.Item == ItemPrice {
-		return e.processTicker()
+	conditions := e.GetConditions()
+	var errs error
+	for i := range conditions {
+		err := m.checkCondition(&conditions[i])
+		if err != nil {
+			if e.Logic != LogicOr {
+				return err
+			}
+			errs = common.AppendError(errs, err)
+			continue
+		}
+		if e.Logic == LogicOr {
+			return nil
+		}
+	}
+	return errs
+}
+
+// checkCondition checks a single condition against the current market data
+func (m *eventManager) checkCondition(c *EventCondition) error {
+	switch c.Item {
+	case ItemPrice:
+		return c.processTicker()
+	case ItemSpread:
+		return c.processSpread()
+	case ItemImbalance:
+		return c.processImbalance()
+	case ItemRSI:
+		return m.processRSI(c)
+	}
+	return c.processOrderbook()
+}
+
+// validateEvent checks the conditions and actions of an event and returns an
+// error if incorrect
+func (m *eventManager) validateEvent(e *Event) error {
+	conditions := e.GetConditions()
+	for i := range conditions {
+		err := m.isValidEvent(conditions[i].Exchange, conditions[i].Item, conditions[i].Condition, e.Action)
+		if err != nil {
+			return err
+		}
+	}
+
+	switch strings.ToUpper(e.Logic) {
+	case "", LogicAnd, LogicOr:
+	default:
+		return fmt.Errorf("%w %q", errInvalidLogic, e.Logic)
+	}
+
+	if e.Cooldown < 0 {
+		return fmt.Errorf("%w cooldown cannot be negative", errInvalidCondition)
+	}
+
+	switch strings.ToUpper(e.Action) {
+	case ActionSubmitOrder:
+		if e.SubmitOrder == nil {
+			return fmt.Errorf("%s %w", ActionSubmitOrder, errOrderActionNotSet)
+		}
+		if err := e.SubmitOrder.Validate(); err != nil {
+			return err
+		}
+		if !m.isValidExchange(e.SubmitOrder.Exchange) {
+			return errExchangeDisabled
+		}
+	case ActionCancelOrder:
+		if e.CancelOrder == nil {
+			return fmt.Errorf("%s %w", ActionCancelOrder, errOrderActionNotSet)
+		}
+		if err := e.CancelOrder.Validate(e.CancelOrder.StandardCancel()); err != nil {
+			return err
+		}
+		if !m.isValidExchange(e.CancelOrder.Exchange) {
+			return errExchangeDisabled
+		}
+	default:
+		return nil
 	}
-	return e.processOrderbook()
+	if m.orderManager == nil || !m.orderManager.IsRunning() {
+		return fmt.Errorf("%s %w", e.Action, errNilOrderManager)
+	}
+	return nil
 }
 
 // isValidEvent checks the actions to be taken and returns an error if incorrect
@@ -207,16 +554,36 @@ func (m *eventManager) isValidEvent(exchange, item string, condition EventCondit
 		return errInvalidCondition
 	}
 
-	if item == ItemPrice {
+	switch item {
+	case ItemPrice:
 		if condition.Price <= 0 {
 			return errInvalidCondition
 		}
-	}
-
-	if item == ItemOrderbook {
+	case ItemOrderbook:
 		if condition.OrderbookAmount <= 0 {
 			return errInvalidCondition
 		}
+	case ItemSpread:
+		if condition.SecondaryExchange == "" || strings.EqualFold(condition.SecondaryExchange, exchange) {
+			return fmt.Errorf("%w spread requires a different secondary exchange", errInvalidCondition)
+		}
+		if !m.isValidExchange(condition.SecondaryExchange) {
+			return errExchangeDisabled
+		}
+	case ItemRSI:
+		if condition.Interval <= 0 {
+			return fmt.Errorf("%w RSI requires a candle interval", errInvalidCondition)
+		}
+		if condition.Period < 0 || condition.Period == 1 {
+			return fmt.Errorf("%w RSI period must be greater than 1", errInvalidCondition)
+		}
+		if condition.Value < 0 || condition.Value > 100 {
+			return fmt.Errorf("%w RSI value must be between 0 and 100", errInvalidCondition)
+		}
+	case ItemImbalance:
+		if condition.Value < -1 || condition.Value > 1 {
+			return fmt.Errorf("%w imbalance value must be between -1 and 1", errInvalidCondition)
+		}
 	}
 
 	if strings.Contains(action, ",") {
@@ -225,7 +592,10 @@ func (m *eventManager) isValidEvent(exchange, item string, condition EventCondit
 		if a[0] != ActionSMSNotify {
 			return errInvalidAction
 		}
-	} else if action != ActionConsolePrint && action != ActionTest {
+	} else if action != ActionConsolePrint &&
+		action != ActionTest &&
+		action != ActionSubmitOrder &&
+		action != ActionCancelOrder {
 		return errInvalidAction
 	}
 
@@ -251,22 +621,271 @@ func isValidCondition(condition string) bool {
 func isValidItem(item string) bool {
 	item = strings.ToUpper(item)
 	switch item {
-	case ItemPrice, ItemOrderbook:
+	case ItemPrice, ItemOrderbook, ItemSpread, ItemRSI, ItemImbalance:
 		return true
 	}
 	return false
 }
 
+// GetConditions returns the primary condition along with any additional
+// conditions of the event
+func (e *Event) GetConditions() []EventCondition {
+	conditions := make([]EventCondition, 0, len(e.Conditions)+1)
+	conditions = append(conditions, e.EventCondition)
+	return append(conditions, e.Conditions...)
+}
+
 // String turns the structure event into a string
 func (e *Event) String() string {
+	if len(e.Conditions) == 0 {
+		return fmt.Sprintf("%s then %s.", e.EventCondition.String(), e.Action)
+	}
+	conditions := e.GetConditions()
+	s := make([]string, len(conditions))
+	for i := range conditions {
+		s[i] = conditions[i].String()
+	}
+	return fmt.Sprintf("%s then %s.", strings.Join(s, " "+e.Logic+" "), e.Action)
+}
+
+// String turns the event condition into a string
+func (c *EventCondition) String() string {
 	return fmt.Sprintf(
-		"If the %s [%s] %s on %s meets the following %v then %s.", e.Pair.String(),
-		strings.ToUpper(e.Asset.String()), e.Item, e.Exchange, e.Condition, e.Action,
+		"If the %s [%s] %s on %s meets the following %v", c.Pair.String(),
+		strings.ToUpper(c.Asset.String()), c.Item, c.Exchange, c.Condition,
 	)
 }
 
-func (e *Event) processTicker() error {
-	t, err := ticker.GetTicker(e.Exchange, e.Pair, e.Asset)
+// toDB converts the event to its database representation
+func (e *Event) toDB() (*dbevent.Event, error) {
+	def := eventDefinition{
+		Conditions: e.GetConditions(),
+		Logic:      e.Logic,
+		Cooldown:   e.Cooldown,
+	}
+	// Pairs are stored with a delimiter so they can be unmarshalled without
+	// relying on the exchange's available pairs
+	for i := range def.Conditions {
+		def.Conditions[i].Pair = withDelimiter(def.Conditions[i].Pair)
+	}
+	if e.SubmitOrder != nil {
+		def.SubmitOrder = submitOrderToDB(e.SubmitOrder)
+	}
+	if e.CancelOrder != nil {
+		def.CancelOrder = cancelOrderToDB(e.CancelOrder)
+	}
+	definition, err := json.Marshal(def)
+	if err != nil {
+		return nil, err
+	}
+	return &dbevent.Event{
+		ID:             e.ID,
+		ExchangeName:   e.Exchange,
+		Item:           e.Item,
+		Action:         e.Action,
+		Definition:     string(definition),
+		Executed:       e.Executed,
+		ExecutionCount: e.ExecutionCount,
+		LastExecuted:   e.LastExecuted,
+	}, nil
+}
+
+func withDelimiter(p currency.Pair) currency.Pair {
+	if p.Delimiter == "" && !p.IsEmpty() {
+		p.Delimiter = currency.DashDelimiter
+	}
+	return p
+}
+
+// eventFromDB restores an event from its database representation
+func eventFromDB(d *dbevent.Event) (*Event, error) {
+	var definition eventDefinition
+	err := json.Unmarshal([]byte(d.Definition), &definition)
+	if err != nil {
+		return nil, err
+	}
+	if len(definition.Conditions) == 0 {
+		return nil, fmt.Errorf("%w no conditions stored", errInvalidCondition)
+	}
+	e := &Event{
+		ID:             d.ID,
+		EventCondition: definition.Conditions[0],
+		Conditions:     definition.Conditions[1:],
+		Logic:          definition.Logic,
+		Action:         d.Action,
+		Cooldown:       definition.Cooldown,
+		Executed:       d.Executed,
+		LastExecuted:   d.LastExecuted,
+		ExecutionCount: d.ExecutionCount,
+	}
+	if definition.SubmitOrder != nil {
+		e.SubmitOrder, err = definition.SubmitOrder.toSubmit()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if definition.CancelOrder != nil {
+		e.CancelOrder, err = definition.CancelOrder.toCancel()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return e, nil
+}
+
+// submitOrderToDB converts a submit order action to its stored form
+func submitOrderToDB(s *order.Submit) *eventSubmitOrder {
+	return &eventSubmitOrder{
+		Exchange:          s.Exchange,
+		Type:              typeToDB(s.Type),
+		Side:              sideToDB(s.Side),
+		Pair:              withDelimiter(s.Pair),
+		AssetType:         s.AssetType,
+		ImmediateOrCancel: s.ImmediateOrCancel,
+		FillOrKill:        s.FillOrKill,
+		PostOnly:          s.PostOnly,
+		ReduceOnly:        s.ReduceOnly,
+		Leverage:          s.Leverage,
+		Price:             s.Price,
+		Amount:            s.Amount,
+		QuoteAmount:       s.QuoteAmount,
+		TriggerPrice:      s.TriggerPrice,
+		TriggerPriceType:  s.TriggerPriceType,
+		ClientID:          s.ClientID,
+		ClientOrderID:     s.ClientOrderID,
+		AutoBorrow:        s.AutoBorrow,
+		MarginType:        marginTypeToDB(s.MarginType),
+		RetrieveFees:      s.RetrieveFees,
+		RetrieveFeeDelay:  s.RetrieveFeeDelay,
+		Hidden:            s.Hidden,
+		TradeMode:         s.TradeMode,
+	}
+}
+
+// toSubmit restores a submit order action from its stored form
+func (s *eventSubmitOrder) toSubmit() (*order.Submit, error) {
+	orderType, err := typeFromDB(s.Type)
+	if err != nil {
+		return nil, err
+	}
+	side, err := sideFromDB(s.Side)
+	if err != nil {
+		return nil, err
+	}
+	marginType, err := margin.StringToMarginType(s.MarginType)
+	if err != nil {
+		return nil, err
+	}
+	return &order.Submit{
+		Exchange:          s.Exchange,
+		Type:              orderType,
+		Side:              side,
+		Pair:              s.Pair,
+		AssetType:         s.AssetType,
+		ImmediateOrCancel: s.ImmediateOrCancel,
+		FillOrKill:        s.FillOrKill,
+		PostOnly:          s.PostOnly,
+		ReduceOnly:        s.ReduceOnly,
+		Leverage:          s.Leverage,
+		Price:             s.Price,
+		Amount:            s.Amount,
+		QuoteAmount:       s.QuoteAmount,
+		TriggerPrice:      s.TriggerPrice,
+		TriggerPriceType:  s.TriggerPriceType,
+		ClientID:          s.ClientID,
+		ClientOrderID:     s.ClientOrderID,
+		AutoBorrow:        s.AutoBorrow,
+		MarginType:        marginType,
+		RetrieveFees:      s.RetrieveFees,
+		RetrieveFeeDelay:  s.RetrieveFeeDelay,
+		Hidden:            s.Hidden,
+		TradeMode:         s.TradeMode,
+	}, nil
+}
+
+// cancelOrderToDB converts a cancel order action to its stored form
+func cancelOrderToDB(c *order.Cancel) *eventCancelOrder {
+	return &eventCancelOrder{
+		Exchange:      c.Exchange,
+		OrderID:       c.OrderID,
+		ClientOrderID: c.ClientOrderID,
+		AccountID:     c.AccountID,
+		ClientID:      c.ClientID,
+		WalletAddress: c.WalletAddress,
+		Type:          typeToDB(c.Type),
+		Side:          sideToDB(c.Side),
+		AssetType:     c.AssetType,
+		Pair:          withDelimiter(c.Pair),
+		MarginType:    marginTypeToDB(c.MarginType),
+	}
+}
+
+// toCancel restores a cancel order action from its stored form
+func (c *eventCancelOrder) toCancel() (*order.Cancel, error) {
+	orderType, err := typeFromDB(c.Type)
+	if err != nil {
+		return nil, err
+	}
+	side, err := sideFromDB(c.Side)
+	if err != nil {
+		return nil, err
+	}
+	marginType, err := margin.StringToMarginType(c.MarginType)
+	if err != nil {
+		return nil, err
+	}
+	return &order.Cancel{
+		Exchange:      c.Exchange,
+		OrderID:       c.OrderID,
+		ClientOrderID: c.ClientOrderID,
+		AccountID:     c.AccountID,
+		ClientID:      c.ClientID,
+		WalletAddress: c.WalletAddress,
+		Type:          orderType,
+		Side:          side,
+		AssetType:     c.AssetType,
+		Pair:          c.Pair,
+		MarginType:    marginType,
+	}, nil
+}
+
+func typeToDB(t order.Type) string {
+	if t == order.UnknownType {
+		return ""
+	}
+	return t.String()
+}
+
+func typeFromDB(t string) (order.Type, error) {
+	if t == "" {
+		return order.UnknownType, nil
+	}
+	return order.StringToOrderType(t)
+}
+
+func sideToDB(s order.Side) string {
+	if s == order.UnknownSide {
+		return ""
+	}
+	return s.String()
+}
+
+func sideFromDB(s string) (order.Side, error) {
+	if s == "" {
+		return order.UnknownSide, nil
+	}
+	return order.StringToOrderSide(s)
+}
+
+func marginTypeToDB(t margin.Type) string {
+	if t == margin.Unset {
+		return ""
+	}
+	return t.String()
+}
+
+func (c *EventCondition) processTicker() error {
+	t, err := ticker.GetTicker(c.Exchange, c.Pair, c.Asset)
 	if err != nil {
 		return fmt.Errorf("failed to get ticker. Err: %w", err)
 	}
@@ -274,11 +893,123 @@ func (e *Event) processTicker() error {
 	if t.Last == 0 {
 		return errTickerLastPriceZero
 	}
-	return e.shouldProcessEvent(t.Last, e.Condition.Price)
+	return c.shouldProcessEvent(t.Last, c.Condition.Price)
+}
+
+// processSpread compares the percentage difference between the last price on
+// the primary exchange and the secondary exchange
+func (c *EventCondition) processSpread() error {
+	primary, err := ticker.GetTicker(c.Exchange, c.Pair, c.Asset)
+	if err != nil {
+		return fmt.Errorf("failed to get ticker. Err: %w", err)
+	}
+	secondary, err := ticker.GetTicker(c.Condition.SecondaryExchange, c.Pair, c.Asset)
+	if err != nil {
+		return fmt.Errorf("failed to get ticker. Err: %w", err)
+	}
+	if primary.Last == 0 || secondary.Last == 0 {
+		return errTickerLastPriceZero
+	}
+	spread := (primary.Last - secondary.Last) / secondary.Last * 100
+	return c.shouldProcessEvent(spread, c.Condition.Value)
+}
+
+// processImbalance compares the top of book imbalance of the orderbook depth
+func (c *EventCondition) processImbalance() error {
+	depth, err := orderbook.GetDepth(c.Exchange, c.Pair, c.Asset)
+	if err != nil {
+		return fmt.Errorf("events: Failed to get orderbook. Err: %w", err)
+	}
+	imbalance, err := depth.GetImbalance()
+	if err != nil {
+		return err
+	}
+	return c.shouldProcessEvent(imbalance, c.Condition.Value)
+}
+
+// processRSI compares the relative strength index of the latest closed candle.
+// Candles are only retrieved from the exchange once a new candle has closed,
+// failed requests are retried after eventRetryDelay
+func (m *eventManager) processRSI(c *EventCondition) error {
+	period := c.Condition.Period
+	if period == 0 {
+		period = defaultRSIPeriod
+	}
+	interval := c.Condition.Interval.Duration()
+	end := time.Now().Truncate(interval)
+	key := rsiKey{
+		exchange: strings.ToLower(c.Exchange),
+		pair:     c.Pair.Format(currency.EMPTYFORMAT).String(),
+		asset:    c.Asset,
+		interval: c.Condition.Interval,
+		period:   period,
+	}
+
+	v := m.latestRSI(c, key, period, end)
+	if v.err != nil {
+		return v.err
+	}
+	return c.shouldProcessEvent(v.value, c.Condition.Value)
+}
+
+// latestRSI returns the cached RSI for the key, retrieving candles when a new
+// candle has closed. The lock is not held while candles are retrieved and
+// concurrent callers wait on the request already in flight for the key
+func (m *eventManager) latestRSI(c *EventCondition, key rsiKey, period int64, end time.Time) *rsiValue {
+	for {
+		m.rsiM.Lock()
+		v, ok := m.rsi[key]
+		if ok && (v.closed.Equal(end) || (v.err != nil && time.Since(v.attempted) < eventRetryDelay)) {
+			m.rsiM.Unlock()
+			return v
+		}
+		if wait, fetching := m.rsiFetches[key]; fetching {
+			m.rsiM.Unlock()
+			<-wait
+			continue
+		}
+		done := make(chan struct{})
+		m.rsiFetches[key] = done
+		m.rsiM.Unlock()
+
+		value, err := m.fetchRSI(c, period, end)
+		v = &rsiValue{attempted: time.Now(), value: value, err: err}
+		if err == nil {
+			v.closed = end
+		}
+		m.rsiM.Lock()
+		m.rsi[key] = v
+		delete(m.rsiFetches, key)
+		m.rsiM.Unlock()
+		close(done)
+		return v
+	}
+}
+
+// fetchRSI calculates the relative strength index up to the candle closing at
+// end from candles retrieved from the exchange
+func (m *eventManager) fetchRSI(c *EventCondition, period int64, end time.Time) (float64, error) {
+	exch, err := m.exchangeManager.GetExchangeByName(c.Exchange)
+	if err != nil {
+		return 0, err
+	}
+	// Retrieve additional candles so that the RSI smoothing has settled
+	start := end.Add(-c.Condition.Interval.Duration() * time.Duration(period*3))
+	ctx, cancel := context.WithTimeout(context.Background(), eventRequestTimeout)
+	defer cancel()
+	candles, err := exch.GetHistoricCandles(ctx, c.Pair, c.Asset, c.Condition.Interval, start, end)
+	if err != nil {
+		return 0, err
+	}
+	rsi, err := candles.GetRelativeStrengthIndexOnClose(period)
+	if err != nil {
+		return 0, err
+	}
+	return rsi[len(rsi)-1], nil
 }
 
-func (e *Event) shouldProcessEvent(actual, threshold float64) error {
-	switch e.Condition.Condition {
+func (c *EventCondition) shouldProcessEvent(actual, threshold float64) error {
+	switch c.Condition.Condition {
 	case ConditionGreaterThan:
 		if actual > threshold {
 			return nil
@@ -300,32 +1031,32 @@ func (e *Event) shouldProcessEvent(actual, threshold float64) error {
 			return nil
 		}
 	}
-	return errors.New("does not meet conditions")
+	return errConditionNotMet
 }
 
-func (e *Event) processOrderbook() error {
-	ob, err := orderbook.Get(e.Exchange, e.Pair, e.Asset)
+func (c *EventCondition) processOrderbook() error {
+	ob, err := orderbook.Get(c.Exchange, c.Pair, c.Asset)
 	if err != nil {
 		return fmt.Errorf("events: Failed to get orderbook. Err: %w", err)
 	}
-	if !e.Condition.CheckBids && !e.Condition.CheckAsks {
+	if !c.Condition.CheckBids && !c.Condition.CheckAsks {
 		return nil
 	}
 
-	if e.Condition.CheckBids {
+	if c.Condition.CheckBids {
 		for x := range ob.Bids {
 			subtotal := ob.Bids[x].Amount * ob.Bids[x].Price
-			err = e.shouldProcessEvent(subtotal, e.Condition.OrderbookAmount)
+			err = c.shouldProcessEvent(subtotal, c.Condition.OrderbookAmount)
 			if err == nil {
 				log.Debugf(log.EventMgr, "Events: Bid Amount: %f Price: %v Subtotal: %v\n", ob.Bids[x].Amount, ob.Bids[x].Price, subtotal)
 			}
 		}
 	}
 
-	if e.Condition.CheckAsks {
+	if c.Condition.CheckAsks {
 		for x := range ob.Asks {
 			subtotal := ob.Asks[x].Amount * ob.Asks[x].Price
-			err = e.shouldProcessEvent(subtotal, e.Condition.OrderbookAmount)
+			err = c.shouldProcessEvent(subtotal, c.Condition.OrderbookAmount)
 			if err == nil {
 				log.Debugf(log.EventMgr, "Events: Ask Amount: %f Price: %v Subtotal: %v\n", ob.Asks[x].Amount, ob.Asks[x].Price, subtotal)
 			}
@@ -4,28 +4,38 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	dbevent "github.com/thrasher-corp/gocryptotrader/database/repository/event"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 )
 
 func TestSetupEventManager(t *testing.T) {
 	t.Parallel()
-	_, err := setupEventManager(nil, nil, 0, false)
+	_, err := setupEventManager(nil, nil, nil, nil, 0, false)
 	if !errors.Is(err, errNilComManager) {
 		t.Errorf("error '%v', expected '%v'", err, errNilComManager)
 	}
 
-	_, err = setupEventManager(&CommunicationManager{}, nil, 0, false)
+	_, err = setupEventManager(&CommunicationManager{}, nil, nil, nil, 0, false)
 	if !errors.Is(err, errNilExchangeManager) {
 		t.Errorf("error '%v', expected '%v'", err, errNilExchangeManager)
 	}
 
-	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, 0, false)
+	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, nil, nil, 0, false)
 	if !errors.Is(err, nil) {
 		t.Fatalf("error '%v', expected '%v'", err, nil)
 	}
@@ -38,7 +48,7 @@ func TestSetupEventManager(t *testing.T) {
 }
 
 func TestEventManagerStart(t *testing.T) {
-	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, 0, false)
+	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, nil, nil, 0, false)
 	if !errors.Is(err, nil) {
 		t.Errorf("error '%v', expected '%v'", err, nil)
 	}
@@ -61,7 +71,7 @@ func TestEventManagerStart(t *testing.T) {
 
 func TestEventManagerIsRunning(t *testing.T) {
 	t.Parallel()
-	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, 0, false)
+	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, nil, nil, 0, false)
 	if !errors.Is(err, nil) {
 		t.Errorf("error '%v', expected '%v'", err, nil)
 	}
@@ -84,7 +94,7 @@ func TestEventManagerIsRunning(t *testing.T) {
 
 func TestEventManagerStop(t *testing.T) {
 	t.Parallel()
-	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, 0, false)
+	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, nil, nil, 0, false)
 	if !errors.Is(err, nil) {
 		t.Errorf("error '%v', expected '%v'", err, nil)
 	}
@@ -110,7 +120,7 @@ func TestEventManagerStop(t *testing.T) {
 func TestEventManagerAdd(t *testing.T) {
 	t.Parallel()
 	em := NewExchangeManager()
-	m, err := setupEventManager(&CommunicationManager{}, em, 0, false)
+	m, err := setupEventManager(&CommunicationManager{}, em, nil, nil, 0, false)
 	if !errors.Is(err, nil) {
 		t.Errorf("error '%v', expected '%v'", err, nil)
 	}
@@ -165,7 +175,7 @@ func TestEventManagerAdd(t *testing.T) {
 func TestEventManagerRemove(t *testing.T) {
 	t.Parallel()
 	em := NewExchangeManager()
-	m, err := setupEventManager(&CommunicationManager{}, em, 0, false)
+	m, err := setupEventManager(&CommunicationManager{}, em, nil, nil, 0, false)
 	if !errors.Is(err, nil) {
 		t.Errorf("error '%v', expected '%v'", err, nil)
 	}
@@ -207,7 +217,7 @@ func TestEventManagerRemove(t *testing.T) {
 func TestGetEventCounter(t *testing.T) {
 	t.Parallel()
 	em := NewExchangeManager()
-	m, err := setupEventManager(&CommunicationManager{}, em, 0, false)
+	m, err := setupEventManager(&CommunicationManager{}, em, nil, nil, 0, false)
 	if !errors.Is(err, nil) {
 		t.Errorf("error '%v', expected '%v'", err, nil)
 	}
@@ -251,7 +261,7 @@ func TestGetEventCounter(t *testing.T) {
 
 func TestCheckEventCondition(t *testing.T) {
 	em := NewExchangeManager()
-	m, err := setupEventManager(&CommunicationManager{}, em, 0, false)
+	m, err := setupEventManager(&CommunicationManager{}, em, nil, nil, 0, false)
 	if !errors.Is(err, nil) {
 		t.Errorf("error '%v', expected '%v'", err, nil)
 	}
@@ -343,3 +353,485 @@ func TestCheckEventCondition(t *testing.T) {
 	}
 	m.m.Unlock()
 }
+
+func TestEventManagerAddEvent(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	m, err := setupEventManager(&CommunicationManager{}, em, nil, nil, 0, false)
+	require.NoError(t, err)
+
+	_, err = m.AddEvent(&Event{})
+	require.ErrorIs(t, err, ErrSubSystemNotStarted)
+
+	require.NoError(t, m.Start())
+
+	_, err = m.AddEvent(nil)
+	require.ErrorIs(t, err, errNilEvent)
+
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err)
+	exch.SetDefaults()
+	require.NoError(t, em.Add(exch))
+
+	pair := currency.NewPair(currency.BTC, currency.USD)
+	evt := &Event{
+		EventCondition: EventCondition{
+			Exchange:  testExchange,
+			Item:      ItemPrice,
+			Condition: EventConditionParams{Condition: ConditionGreaterThan, Price: 1337},
+			Pair:      pair,
+			Asset:     asset.Spot,
+		},
+		Conditions: []EventCondition{
+			{
+				Exchange:  testExchange,
+				Item:      ItemImbalance,
+				Condition: EventConditionParams{Condition: ConditionGreaterThan, Value: 2},
+				Pair:      pair,
+				Asset:     asset.Spot,
+			},
+		},
+		Action: ActionTest,
+	}
+	_, err = m.AddEvent(evt)
+	require.ErrorIs(t, err, errInvalidCondition)
+
+	evt.Conditions[0].Condition.Value = 0.5
+	evt.Logic = "XOR"
+	_, err = m.AddEvent(evt)
+	require.ErrorIs(t, err, errInvalidLogic)
+
+	evt.Logic = "or"
+	evt.Conditions = append(evt.Conditions, EventCondition{
+		Exchange:  testExchange,
+		Item:      ItemSpread,
+		Condition: EventConditionParams{Condition: ConditionGreaterThan, Value: 1, SecondaryExchange: testExchange},
+		Pair:      pair,
+		Asset:     asset.Spot,
+	})
+	_, err = m.AddEvent(evt)
+	require.ErrorIs(t, err, errInvalidCondition)
+
+	evt.Conditions[1].Item = ItemRSI
+	_, err = m.AddEvent(evt)
+	require.ErrorIs(t, err, errInvalidCondition, "RSI without an interval must error")
+
+	evt.Conditions[1].Condition.Interval = kline.OneHour
+	evt.Cooldown = -time.Second
+	_, err = m.AddEvent(evt)
+	require.ErrorIs(t, err, errInvalidCondition, "negative cooldown must error")
+
+	evt.Cooldown = time.Minute
+	evt.Action = ActionSubmitOrder
+	_, err = m.AddEvent(evt)
+	require.ErrorIs(t, err, errOrderActionNotSet)
+
+	evt.SubmitOrder = &order.Submit{
+		Exchange:  testExchange,
+		Pair:      pair,
+		AssetType: asset.Spot,
+		Side:      order.Buy,
+		Type:      order.Market,
+		Amount:    1,
+	}
+	_, err = m.AddEvent(evt)
+	require.ErrorIs(t, err, errNilOrderManager)
+
+	evt.Action = ActionTest
+	id, err := m.AddEvent(evt)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), id)
+
+	events, err := m.GetEvents()
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, LogicOr, events[0].Logic)
+	assert.Len(t, events[0].Conditions, 2)
+	assert.Equal(t, time.Minute, events[0].Cooldown)
+}
+
+func TestCheckEventConditionComposite(t *testing.T) {
+	t.Parallel()
+	m, err := setupEventManager(&CommunicationManager{}, NewExchangeManager(), nil, nil, 0, false)
+	require.NoError(t, err)
+	require.NoError(t, m.Start())
+
+	pair := currency.NewPair(currency.NewCode("EVENT"), currency.NewCode("COMPOSITE"))
+	for exch, last := range map[string]float64{"eventexchangeone": 105, "eventexchangetwo": 100} {
+		err = ticker.ProcessTicker(&ticker.Price{
+			ExchangeName: exch,
+			Pair:         pair,
+			AssetType:    asset.Spot,
+			Last:         last,
+		})
+		require.NoError(t, err)
+	}
+	err = (&orderbook.Base{
+		Exchange: "eventexchangeone",
+		Pair:     pair,
+		Asset:    asset.Spot,
+		Bids:     orderbook.Items{{Price: 104, Amount: 3}},
+		Asks:     orderbook.Items{{Price: 106, Amount: 1}},
+	}).Process()
+	require.NoError(t, err)
+
+	spread := EventCondition{
+		Exchange: "eventexchangeone",
+		Item:     ItemSpread,
+		Condition: EventConditionParams{
+			Condition:         ConditionGreaterThanOrEqual,
+			Value:             5,
+			SecondaryExchange: "eventexchangetwo",
+		},
+		Pair:  pair,
+		Asset: asset.Spot,
+	}
+	imbalance := EventCondition{
+		Exchange:  "eventexchangeone",
+		Item:      ItemImbalance,
+		Condition: EventConditionParams{Condition: ConditionGreaterThan, Value: 0.6},
+		Pair:      pair,
+		Asset:     asset.Spot,
+	}
+
+	evt := &Event{EventCondition: spread, Conditions: []EventCondition{imbalance}, Logic: LogicAnd}
+	assert.ErrorIs(t, m.checkEventCondition(evt), errConditionNotMet, "imbalance of 0.5 should not meet AND conditions")
+
+	evt.Logic = LogicOr
+	assert.NoError(t, m.checkEventCondition(evt), "spread of 5% should meet OR conditions")
+
+	evt.Conditions[0].Condition.Value = 0.4
+	evt.Logic = LogicAnd
+	assert.NoError(t, m.checkEventCondition(evt), "spread and imbalance should meet AND conditions")
+
+	evt.EventCondition.Condition.Value = 6
+	evt.Conditions[0].Condition.Value = 0.6
+	evt.Logic = LogicOr
+	assert.ErrorIs(t, m.checkEventCondition(evt), errConditionNotMet, "no condition met for OR conditions")
+}
+
+func TestExecuteEventCooldownAndAction(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err)
+	exch.SetDefaults()
+	require.NoError(t, em.Add(omfExchange{IBotExchange: exch}))
+	var wg sync.WaitGroup
+	om, err := SetupOrderManager(em, &CommunicationManager{}, &wg, &config.OrderManager{})
+	require.NoError(t, err)
+	om.started = 1
+
+	pair := currency.NewPair(currency.BTC, currency.USD)
+	require.NoError(t, om.orderStore.add(&order.Detail{
+		Exchange:  testExchange,
+		OrderID:   "eventmanager",
+		Pair:      pair,
+		AssetType: asset.Spot,
+		Side:      order.Buy,
+		Type:      order.Limit,
+		Status:    order.New,
+		Amount:    1,
+		Price:     1,
+	}))
+
+	m, err := setupEventManager(&CommunicationManager{}, em, om, nil, 0, false)
+	require.NoError(t, err)
+	// The event loop is not run so events are only processed by the test
+	m.started = 1
+
+	pair = currency.NewPair(currency.NewCode("EVENT"), currency.NewCode("COOLDOWN"))
+	err = ticker.ProcessTicker(&ticker.Price{
+		ExchangeName: testExchange,
+		Pair:         pair,
+		AssetType:    asset.Spot,
+		Last:         1338,
+	})
+	require.NoError(t, err)
+
+	m.events = append(m.events, Event{
+		ID: 1,
+		EventCondition: EventCondition{
+			Exchange:  testExchange,
+			Item:      ItemPrice,
+			Condition: EventConditionParams{Condition: ConditionGreaterThan, Price: 1337},
+			Pair:      pair,
+			Asset:     asset.Spot,
+		},
+		Action:   ActionCancelOrder,
+		Cooldown: time.Hour,
+		CancelOrder: &order.Cancel{
+			Exchange:  testExchange,
+			OrderID:   "eventmanager",
+			Pair:      currency.NewPair(currency.BTC, currency.USD),
+			AssetType: asset.Spot,
+		},
+	})
+	m.processEvents()
+	assert.True(t, m.events[0].Executed, "event should be executed")
+	assert.Equal(t, int64(1), m.events[0].ExecutionCount)
+
+	od, err := om.GetByExchangeAndID(testExchange, "eventmanager")
+	require.NoError(t, err)
+	assert.Equal(t, order.Cancelled, od.Status, "cancel order action should cancel the order")
+
+	m.processEvents()
+	assert.Equal(t, int64(1), m.events[0].ExecutionCount, "event should not execute during cooldown")
+
+	m.events[0].LastExecuted = time.Now().Add(-time.Hour)
+	m.processEvents()
+	assert.Equal(t, int64(2), m.events[0].ExecutionCount, "event should re-arm after cooldown")
+}
+
+func TestEventDatabaseConversion(t *testing.T) {
+	t.Parallel()
+	pair := currency.NewPairWithDelimiter("BTC", "USD", currency.DashDelimiter)
+	e := &Event{
+		ID: 1337,
+		EventCondition: EventCondition{
+			Exchange:  testExchange,
+			Item:      ItemPrice,
+			Condition: EventConditionParams{Condition: ConditionGreaterThan, Price: 1337},
+			Pair:      pair,
+			Asset:     asset.Spot,
+		},
+		Conditions: []EventCondition{
+			{
+				Exchange:  testExchange,
+				Item:      ItemRSI,
+				Condition: EventConditionParams{Condition: ConditionLessThan, Value: 30, Interval: kline.OneDay, Period: 14},
+				Pair:      pair,
+				Asset:     asset.Spot,
+			},
+		},
+		Logic:  LogicOr,
+		Action: ActionSubmitOrder,
+		SubmitOrder: &order.Submit{
+			Exchange:   testExchange,
+			Pair:       pair,
+			AssetType:  asset.Spot,
+			Side:       order.Buy,
+			Type:       order.Limit,
+			Amount:     1,
+			Price:      1337,
+			MarginType: margin.Isolated,
+		},
+		CancelOrder: &order.Cancel{
+			Exchange:  testExchange,
+			OrderID:   "1337",
+			Type:      order.StopLimit,
+			Pair:      pair,
+			AssetType: asset.Spot,
+		},
+		Cooldown:       time.Hour,
+		Executed:       true,
+		LastExecuted:   time.Now().Truncate(time.Second),
+		ExecutionCount: 2,
+	}
+	d, err := e.toDB()
+	require.NoError(t, err)
+	assert.Equal(t, e.ID, d.ID)
+	assert.Equal(t, testExchange, d.ExchangeName)
+	assert.Contains(t, d.Definition, `"type":"LIMIT"`, "order types should be stored as strings")
+	assert.Contains(t, d.Definition, `"side":"BUY"`, "order sides should be stored as strings")
+	assert.Contains(t, d.Definition, `"marginType":"isolated"`, "margin types should be stored as strings")
+
+	restored, err := eventFromDB(d)
+	require.NoError(t, err)
+	assert.Equal(t, e, restored, "event should survive a database round trip")
+
+	_, err = eventFromDB(&dbevent.Event{Definition: "{}"})
+	assert.ErrorIs(t, err, errInvalidCondition)
+
+	d.Definition = strings.Replace(d.Definition, `"type":"LIMIT"`, `"type":"1337"`, 1)
+	_, err = eventFromDB(d)
+	assert.Error(t, err, "unknown order types should error")
+}
+
+func TestEventManagerDatabase(t *testing.T) {
+	engerino := RPCTestSetup(t)
+	defer CleanRPCTest(t, engerino)
+
+	m, err := setupEventManager(&CommunicationManager{}, engerino.ExchangeManager, nil, engerino.DatabaseManager, 0, false)
+	require.NoError(t, err)
+	require.NotNil(t, m.db, "database service should be set")
+	require.NoError(t, m.Start())
+
+	pair := currency.NewPair(currency.NewCode("EVENT"), currency.NewCode("DATABASE"))
+	err = ticker.ProcessTicker(&ticker.Price{
+		ExchangeName: testExchange,
+		Pair:         pair,
+		AssetType:    asset.Spot,
+		Last:         1338,
+	})
+	require.NoError(t, err)
+
+	id, err := m.Add(testExchange, ItemPrice, EventConditionParams{Condition: ConditionGreaterThan, Price: 1337}, pair, asset.Spot, ActionTest)
+	require.NoError(t, err)
+
+	executions, err := m.GetEventExecutions(id)
+	require.NoError(t, err)
+	assert.Empty(t, executions)
+
+	m.processEvents()
+
+	executions, err = m.GetEventExecutions(id)
+	require.NoError(t, err)
+	require.Len(t, executions, 1)
+	assert.True(t, executions[0].Success)
+	assert.Equal(t, ActionTest, executions[0].Action)
+	require.NoError(t, m.Stop())
+
+	// A new event manager should restore the stored events
+	m, err = setupEventManager(&CommunicationManager{}, engerino.ExchangeManager, nil, engerino.DatabaseManager, 0, false)
+	require.NoError(t, err)
+	require.NoError(t, m.Start())
+	events, err := m.GetEvents()
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, id, events[0].ID)
+	assert.True(t, events[0].Executed, "event execution state should be restored")
+	assert.Equal(t, int64(1), events[0].ExecutionCount)
+	assert.True(t, events[0].Pair.Equal(pair))
+
+	id2, err := m.Add(testExchange, ItemPrice, EventConditionParams{Condition: ConditionGreaterThan, Price: 1337}, pair, asset.Spot, ActionTest)
+	require.NoError(t, err)
+	assert.Equal(t, id+1, id2, "event IDs should continue from the stored events")
+
+	assert.True(t, m.Remove(id2))
+	id3, err := m.Add(testExchange, ItemPrice, EventConditionParams{Condition: ConditionGreaterThan, Price: 1337}, pair, asset.Spot, ActionTest)
+	require.NoError(t, err)
+	assert.Equal(t, id2+1, id3, "IDs of removed events should not be reused")
+	id2 = id3
+
+	assert.True(t, m.Remove(id))
+	_, err = m.GetEventExecutions(id)
+	assert.ErrorIs(t, err, errEventNotFound)
+	stored, err := m.db.GetExecutionsByEventID(id)
+	require.NoError(t, err)
+	assert.Empty(t, stored, "removing an event should remove its execution history")
+	require.NoError(t, m.Stop())
+
+	m, err = setupEventManager(&CommunicationManager{}, engerino.ExchangeManager, nil, nil, 0, false)
+	require.NoError(t, err)
+	require.NoError(t, m.Start())
+	_, err = m.GetEventExecutions(id2)
+	assert.ErrorIs(t, err, errEventDatabaseNotSet)
+}
+
+// rsiTestExchange counts candle requests and returns rising candles
+type rsiTestExchange struct {
+	exchange.IBotExchange
+	requests *int32
+	err      error
+	// release blocks candle requests for pairs other than BTC-USD until
+	// closed when set
+	release chan struct{}
+}
+
+func (r rsiTestExchange) GetHistoricCandles(_ context.Context, p currency.Pair, a asset.Item, interval kline.Interval, start, _ time.Time) (*kline.Item, error) {
+	atomic.AddInt32(r.requests, 1)
+	if r.release != nil && !p.Equal(currency.NewPair(currency.BTC, currency.USD)) {
+		<-r.release
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	candles := generateCandles(int(defaultRSIPeriod*3), start, interval)
+	for i := range candles {
+		candles[i].Close = float64(i + 1)
+	}
+	return &kline.Item{Exchange: testExchange, Pair: p, Asset: a, Interval: interval, Candles: candles}, nil
+}
+
+func TestProcessRSI(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err)
+	exch.SetDefaults()
+	var requests int32
+	errCandles := errors.New("candles unavailable")
+	rsiExch := &rsiTestExchange{IBotExchange: exch, requests: &requests}
+	require.NoError(t, em.Add(rsiExch))
+	m, err := setupEventManager(&CommunicationManager{}, em, nil, nil, 0, false)
+	require.NoError(t, err)
+
+	c := &EventCondition{
+		Exchange:  testExchange,
+		Item:      ItemRSI,
+		Condition: EventConditionParams{Condition: ConditionGreaterThan, Value: 70, Interval: kline.OneDay},
+		Pair:      currency.NewPair(currency.BTC, currency.USD),
+		Asset:     asset.Spot,
+	}
+	require.NoError(t, m.processRSI(c), "rising candles should have an RSI above 70")
+	require.NoError(t, m.processRSI(c))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests), "candles should only be requested once per closed candle")
+
+	c.Condition.Condition = ConditionLessThan
+	assert.ErrorIs(t, m.processRSI(c), errConditionNotMet)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests), "the cached RSI should be compared against")
+
+	// A newly closed candle requires the candles to be retrieved again
+	rsiExch.err = errCandles
+	for _, v := range m.rsi {
+		v.closed = v.closed.Add(-kline.OneDay.Duration())
+	}
+	assert.ErrorIs(t, m.processRSI(c), errCandles)
+	assert.ErrorIs(t, m.processRSI(c), errCandles)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests), "failed requests should not be retried until the retry delay has elapsed")
+
+	rsiExch.err = nil
+	for _, v := range m.rsi {
+		v.attempted = v.attempted.Add(-eventRetryDelay)
+	}
+	assert.ErrorIs(t, m.processRSI(c), errConditionNotMet)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+}
+
+func TestProcessRSIConcurrentRequests(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err)
+	exch.SetDefaults()
+	var requests int32
+	rsiExch := &rsiTestExchange{IBotExchange: exch, requests: &requests, release: make(chan struct{})}
+	require.NoError(t, em.Add(rsiExch))
+	m, err := setupEventManager(&CommunicationManager{}, em, nil, nil, 0, false)
+	require.NoError(t, err)
+
+	c := &EventCondition{
+		Exchange:  testExchange,
+		Item:      ItemRSI,
+		Condition: EventConditionParams{Condition: ConditionGreaterThan, Value: 70, Interval: kline.OneDay},
+		Pair:      currency.NewPair(currency.ETH, currency.USD),
+		Asset:     asset.Spot,
+	}
+	var wg sync.WaitGroup
+	errs := make(chan error, 5)
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- m.processRSI(c)
+		}()
+	}
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&requests) == 1
+	}, time.Second, time.Millisecond, "a candle request should be in flight")
+
+	// Other pairs are not held up by the request in flight
+	other := *c
+	other.Pair = currency.NewPair(currency.BTC, currency.USD)
+	assert.NoError(t, m.processRSI(&other))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+
+	close(rsiExch.release)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests), "concurrent conditions should share the candle request in flight")
+}
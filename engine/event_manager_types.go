@@ -6,13 +6,19 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	dbevent "github.com/thrasher-corp/gocryptotrader/database/repository/event"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
 
 // Event const vars
 const (
 	ItemPrice     = "PRICE"
 	ItemOrderbook = "ORDERBOOK"
+	ItemSpread    = "SPREAD"
+	ItemRSI       = "RSI"
+	ItemImbalance = "IMBALANCE"
 
 	ConditionGreaterThan        = ">"
 	ConditionGreaterThanOrEqual = ">="
@@ -20,11 +26,23 @@ const (
 	ConditionLessThanOrEqual    = "<="
 	ConditionIsEqual            = "=="
 
+	LogicAnd = "AND"
+	LogicOr  = "OR"
+
 	ActionSMSNotify    = "SMS"
 	ActionConsolePrint = "CONSOLE_PRINT"
 	ActionTest         = "ACTION_TEST"
+	ActionSubmitOrder  = "SUBMIT_ORDER"
+	ActionCancelOrder  = "CANCEL_ORDER"
 
 	defaultSleepDelay = time.Millisecond * 500
+	// defaultRSIPeriod is used when an RSI condition does not specify a period
+	defaultRSIPeriod = 14
+	// eventRequestTimeout limits candle requests and order actions so a slow
+	// exchange cannot hold up other events
+	eventRequestTimeout = time.Second * 30
+	// eventRetryDelay is the delay before failed candle requests are retried
+	eventRetryDelay = time.Second * 30
 )
 
 // vars related to events package
@@ -33,10 +51,16 @@ var (
 	errInvalidItem         = errors.New("invalid item")
 	errInvalidCondition    = errors.New("invalid conditional option")
 	errInvalidAction       = errors.New("invalid action")
+	errInvalidLogic        = errors.New("invalid logic operator")
 	errExchangeDisabled    = errors.New("desired exchange is disabled")
 	errNilEvent            = errors.New("nil event received")
 	errNilComManager       = errors.New("nil communications manager received")
 	errTickerLastPriceZero = errors.New("ticker last price is 0")
+	errConditionNotMet     = errors.New("does not meet conditions")
+	errOrderActionNotSet   = errors.New("order action details not set")
+	errNilOrderManager     = errors.New("nil order manager received")
+	errEventDatabaseNotSet = errors.New("event database storage is not enabled")
+	errEventNotFound       = errors.New("event not found")
 )
 
 // EventConditionParams holds the event condition variables
@@ -47,28 +71,133 @@ type EventConditionParams struct {
 	CheckBids       bool
 	CheckAsks       bool
 	OrderbookAmount float64
+
+	// Value is the threshold compared against for SPREAD, RSI and IMBALANCE
+	// items. SPREAD is a percentage, RSI is between 0 and 100 and IMBALANCE
+	// is between -1 and 1
+	Value float64
+	// SecondaryExchange is the exchange the SPREAD item compares against
+	SecondaryExchange string
+	// Interval and Period are used by the RSI item to fetch candles
+	Interval kline.Interval
+	Period   int64
 }
 
-// Event struct holds the event variables
-type Event struct {
-	ID        int64
+// EventCondition is a single condition which is checked against the market
+// data for an exchange, pair and asset
+type EventCondition struct {
 	Exchange  string
 	Item      string
 	Condition EventConditionParams
 	Pair      currency.Pair
 	Asset     asset.Item
-	Action    string
-	Executed  bool
+}
+
+// Event struct holds the event variables. The embedded EventCondition is the
+// primary condition, any additional Conditions are combined with it using
+// Logic
+type Event struct {
+	ID int64
+	EventCondition
+	Conditions []EventCondition
+	Logic      string
+	Action     string
+	// SubmitOrder is submitted via the order manager for ActionSubmitOrder
+	SubmitOrder *order.Submit
+	// CancelOrder is cancelled via the order manager for ActionCancelOrder
+	CancelOrder *order.Cancel
+	// Cooldown allows an executed event to re-arm once the duration has
+	// elapsed. A zero value means the event only executes once
+	Cooldown       time.Duration
+	Executed       bool
+	LastExecuted   time.Time
+	ExecutionCount int64
+}
+
+// eventDefinition is the serialised form of an event's conditions and
+// actions which is stored in the database
+type eventDefinition struct {
+	Conditions  []EventCondition  `json:"conditions"`
+	Logic       string            `json:"logic"`
+	SubmitOrder *eventSubmitOrder `json:"submitOrder,omitempty"`
+	CancelOrder *eventCancelOrder `json:"cancelOrder,omitempty"`
+	Cooldown    time.Duration     `json:"cooldown"`
+}
+
+// eventSubmitOrder is the stored form of a submit order action. Order types,
+// sides and margin types are stored as strings
+type eventSubmitOrder struct {
+	Exchange          string          `json:"exchange"`
+	Type              string          `json:"type"`
+	Side              string          `json:"side"`
+	Pair              currency.Pair   `json:"pair"`
+	AssetType         asset.Item      `json:"assetType"`
+	ImmediateOrCancel bool            `json:"immediateOrCancel,omitempty"`
+	FillOrKill        bool            `json:"fillOrKill,omitempty"`
+	PostOnly          bool            `json:"postOnly,omitempty"`
+	ReduceOnly        bool            `json:"reduceOnly,omitempty"`
+	Leverage          float64         `json:"leverage,omitempty"`
+	Price             float64         `json:"price,omitempty"`
+	Amount            float64         `json:"amount,omitempty"`
+	QuoteAmount       float64         `json:"quoteAmount,omitempty"`
+	TriggerPrice      float64         `json:"triggerPrice,omitempty"`
+	TriggerPriceType  order.PriceType `json:"triggerPriceType,omitempty"`
+	ClientID          string          `json:"clientID,omitempty"`
+	ClientOrderID     string          `json:"clientOrderID,omitempty"`
+	AutoBorrow        bool            `json:"autoBorrow,omitempty"`
+	MarginType        string          `json:"marginType,omitempty"`
+	RetrieveFees      bool            `json:"retrieveFees,omitempty"`
+	RetrieveFeeDelay  time.Duration   `json:"retrieveFeeDelay,omitempty"`
+	Hidden            bool            `json:"hidden,omitempty"`
+	TradeMode         string          `json:"tradeMode,omitempty"`
+}
+
+// eventCancelOrder is the stored form of a cancel order action
+type eventCancelOrder struct {
+	Exchange      string        `json:"exchange"`
+	OrderID       string        `json:"orderID,omitempty"`
+	ClientOrderID string        `json:"clientOrderID,omitempty"`
+	AccountID     string        `json:"accountID,omitempty"`
+	ClientID      string        `json:"clientID,omitempty"`
+	WalletAddress string        `json:"walletAddress,omitempty"`
+	Type          string        `json:"type,omitempty"`
+	Side          string        `json:"side,omitempty"`
+	AssetType     asset.Item    `json:"assetType"`
+	Pair          currency.Pair `json:"pair"`
+	MarginType    string        `json:"marginType,omitempty"`
 }
 
 // eventManager holds communication manager data
 type eventManager struct {
 	started         int32
 	comms           iCommsManager
+	orderManager    iOrderManager
+	db              dbevent.IDBService
 	events          []Event
+	nextID          int64
 	verbose         bool
 	sleepDelay      time.Duration
 	exchangeManager iExchangeManager
 	shutdown        chan struct{}
 	m               sync.Mutex
+	rsi             map[rsiKey]*rsiValue
+	rsiFetches      map[rsiKey]chan struct{}
+	rsiM            sync.Mutex
+}
+
+// rsiKey identifies the candles an RSI condition is calculated from
+type rsiKey struct {
+	exchange string
+	pair     string
+	asset    asset.Item
+	interval kline.Interval
+	period   int64
+}
+
+// rsiValue is the cached relative strength index of the latest closed candle
+type rsiValue struct {
+	closed    time.Time
+	attempted time.Time
+	value     float64
+	err       error
 }
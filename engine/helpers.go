@@ -21,6 +21,7 @@ import (
 	"github.com/pquerna/otp/totp"
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/common/tracing"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/dispatch"
@@ -65,6 +66,8 @@ var (
 	errCertTypeInvalid     = errors.New("gRPC TLS certificate type is invalid")
 	errSubsystemNotFound   = errors.New("subsystem not found")
 	errGRPCManagementFault = errors.New("cannot manage GRPC subsystem via GRPC. Please manually change your config")
+
+	errUnknownTracingExporter = errors.New("unknown tracing exporter")
 )
 
 // GetSubsystemsStatus returns the status of various subsystems
@@ -85,6 +88,11 @@ func (bot *Engine) GetSubsystemsStatus() map[string]bool {
 		dispatch.Name:                 dispatch.IsRunning(),
 		dataHistoryManagerName:        bot.dataHistoryManager.IsRunning(),
 		CurrencyStateManagementName:   bot.currencyStateManager.IsRunning(),
+		MetricsManagerName:            bot.metricsManager.IsRunning(),
+		MarketDataCaptureManagerName:  bot.marketDataCapture.IsRunning(),
+		ArbitrageManagerName:          bot.arbitrageManager.IsRunning(),
+		MarketMakerName:               bot.marketMaker.IsRunning(),
+		StrategyManagerName:           bot.strategyManager.IsRunning(),
 	}
 }
 
@@ -161,6 +169,12 @@ func (bot *Engine) SetSubsystem(subSystemName string, enable bool) error {
 				if err != nil {
 					return err
 				}
+				if bot.DatabaseManager != nil {
+					err = bot.OrderManager.SetDatabaseManager(bot.DatabaseManager)
+					if err != nil {
+						return err
+					}
+				}
 			}
 			return bot.OrderManager.Start()
 		}
@@ -168,10 +182,16 @@ func (bot *Engine) SetSubsystem(subSystemName string, enable bool) error {
 	case PortfolioManagerName:
 		if enable {
 			if bot.portfolioManager == nil {
-				bot.portfolioManager, err = setupPortfolioManager(bot.ExchangeManager, bot.Settings.PortfolioManagerDelay, &bot.Config.Portfolio)
+				bot.portfolioManager, err = setupPortfolioManager(bot.ExchangeManager, bot.OrderManager, bot.Settings.PortfolioManagerDelay, &bot.Config.Portfolio, bot.Config.Currency.FiatDisplayCurrency)
 				if err != nil {
 					return err
 				}
+				if bot.DatabaseManager != nil {
+					err = bot.portfolioManager.SetDatabaseManager(bot.DatabaseManager)
+					if err != nil {
+						return err
+					}
+				}
 			}
 			return bot.portfolioManager.Start(&bot.ServicesWG)
 		}
@@ -1064,3 +1084,29 @@ func NewExchangeByNameWithDefaults(ctx context.Context, name string) (exchange.I
 	}
 	return exch, nil
 }
+
+// setupTracer returns a tracer which exports spans via the configured
+// exporter. Trace files default to the data directory
+func setupTracer(cfg *config.TracingConfig, dataDir string) (*tracing.Tracer, error) {
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	var exporter tracing.Exporter
+	var err error
+	switch cfg.Exporter {
+	case config.TracingExporterOTLP:
+		exporter, err = tracing.NewHTTPExporter(cfg.Endpoint, nil)
+	case config.TracingExporterFile:
+		path := cfg.FilePath
+		if path == "" {
+			path = filepath.Join(dataDir, "traces", "traces.json")
+		}
+		exporter, err = tracing.NewFileExporter(path)
+	default:
+		return nil, fmt.Errorf("%w %q", errUnknownTracingExporter, cfg.Exporter)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return tracing.NewTracer(cfg.ServiceName, exporter)
+}
@@ -100,8 +100,8 @@ func CreateTestBot(tb testing.TB) *Engine {
 
 func TestGetSubsystemsStatus(t *testing.T) {
 	m := (&Engine{}).GetSubsystemsStatus()
-	if len(m) != 15 {
-		t.Fatalf("subsystem count is wrong expecting: %d but received: %d", 15, len(m))
+	if len(m) != 20 {
+		t.Fatalf("subsystem count is wrong expecting: %d but received: %d", 20, len(m))
 	}
 }
 
@@ -1392,3 +1392,25 @@ func TestNewExchangeByNameWithDefaults(t *testing.T) {
 		})
 	}
 }
+
+func TestSetupTracer(t *testing.T) {
+	t.Parallel()
+	_, err := setupTracer(nil, "")
+	assert.ErrorIs(t, err, errNilConfig)
+
+	_, err = setupTracer(&config.TracingConfig{Exporter: "jaeger"}, "")
+	assert.ErrorIs(t, err, errUnknownTracingExporter)
+
+	_, err = setupTracer(&config.TracingConfig{Exporter: config.TracingExporterOTLP, Endpoint: "localhost"}, "")
+	assert.Error(t, err, "setupTracer should error on an invalid endpoint")
+
+	tr, err := setupTracer(&config.TracingConfig{Exporter: config.TracingExporterOTLP, Endpoint: "http://localhost:4318/v1/traces"}, "")
+	assert.NoError(t, err)
+	assert.NotNil(t, tr)
+
+	dir := t.TempDir()
+	tr, err = setupTracer(&config.TracingConfig{Exporter: config.TracingExporterFile}, dir)
+	assert.NoError(t, err)
+	assert.NotNil(t, tr)
+	assert.FileExists(t, filepath.Join(dir, "traces", "traces.json"), "trace files should default to the data directory")
+}
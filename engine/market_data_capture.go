@@ -0,0 +1,228 @@
+package engine
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/capture"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// setupMarketDataCaptureManager creates a market data capture manager. Files
+// are written to the marketdata directory within the data directory unless
+// configured otherwise
+func setupMarketDataCaptureManager(em iExchangeManager, cfg *config.MarketDataCaptureConfig, dataDir string) (*marketDataCaptureManager, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	m := &marketDataCaptureManager{
+		exchangeManager:  em,
+		directory:        cfg.Directory,
+		exchanges:        make(map[string]bool, len(cfg.Exchanges)),
+		snapshotInterval: cfg.SnapshotInterval,
+		flushInterval:    cfg.FlushInterval,
+	}
+	if m.directory == "" {
+		m.directory = filepath.Join(dataDir, "marketdata")
+	}
+	for i := range cfg.Exchanges {
+		m.exchanges[strings.ToLower(cfg.Exchanges[i])] = true
+	}
+	return m, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *marketDataCaptureManager) IsRunning() bool {
+	if m == nil {
+		return false
+	}
+	return atomic.LoadInt32(&m.started) == 1
+}
+
+// Start opens the capture directory and subscribes to exchange orderbooks
+func (m *marketDataCaptureManager) Start() error {
+	if m == nil {
+		return fmt.Errorf("market data capture manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return fmt.Errorf("market data capture manager %w", ErrSubSystemAlreadyStarted)
+	}
+	w, err := capture.NewWriter(m.directory)
+	if err != nil {
+		atomic.StoreInt32(&m.started, 0)
+		return err
+	}
+	m.writer = w
+	m.shutdown = make(chan struct{})
+	m.subscribed = make(map[string]bool)
+	m.books = make(map[capture.Key]*capturedBook)
+	m.wg.Add(1)
+	go m.run()
+	log.Debugf(log.DataHistory, "Market data capture manager %s writing to %s", MsgSubSystemStarted, m.directory)
+	return nil
+}
+
+// Stop stops capturing and closes all capture files
+func (m *marketDataCaptureManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("market data capture manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 1, 0) {
+		return fmt.Errorf("market data capture manager %w", ErrSubSystemNotStarted)
+	}
+	close(m.shutdown)
+	m.wg.Wait()
+	if err := m.writer.Close(); err != nil {
+		return err
+	}
+	log.Debugf(log.DataHistory, "Market data capture manager %s", MsgSubSystemShutdown)
+	return nil
+}
+
+func (m *marketDataCaptureManager) run() {
+	defer m.wg.Done()
+	subscribe := time.NewTicker(marketDataSubscribeInterval)
+	defer subscribe.Stop()
+	flush := time.NewTicker(m.flushInterval)
+	defer flush.Stop()
+	m.subscribe()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-subscribe.C:
+			m.subscribe()
+		case <-flush.C:
+			if err := m.writer.Flush(); err != nil {
+				log.Errorf(log.DataHistory, "Market data capture manager unable to flush: %v", err)
+			}
+		}
+	}
+}
+
+// subscribe subscribes to the orderbooks of every captured exchange which is
+// not yet subscribed. Exchanges without orderbooks are retried later
+func (m *marketDataCaptureManager) subscribe() {
+	exchanges, err := m.exchangeManager.GetExchanges()
+	if err != nil {
+		log.Errorf(log.DataHistory, "Market data capture manager unable to get exchanges: %v", err)
+		return
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	for i := range exchanges {
+		name := strings.ToLower(exchanges[i].GetName())
+		if !m.captures(name) || m.subscribed[name] {
+			continue
+		}
+		pipe, err := orderbook.SubscribeToExchangeOrderbooks(name)
+		if err != nil {
+			continue
+		}
+		m.subscribed[name] = true
+		m.wg.Add(1)
+		go m.captureOrderbooks(name, pipe)
+	}
+}
+
+// captures returns whether the exchange's market data is captured
+func (m *marketDataCaptureManager) captures(exchange string) bool {
+	return len(m.exchanges) == 0 || m.exchanges[strings.ToLower(exchange)]
+}
+
+func (m *marketDataCaptureManager) captureOrderbooks(name string, pipe dispatch.Pipe) {
+	defer m.wg.Done()
+	defer func() {
+		if err := pipe.Release(); err != nil {
+			log.Errorf(log.DataHistory, "Market data capture manager unable to release %s orderbook pipe: %v", name, err)
+		}
+	}()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case data, ok := <-pipe.Channel():
+			if !ok {
+				m.m.Lock()
+				delete(m.subscribed, name)
+				m.m.Unlock()
+				return
+			}
+			d, ok := data.(orderbook.Outbound)
+			if !ok {
+				log.Errorln(log.DataHistory, common.GetTypeAssertError("orderbook.Outbound", data))
+				continue
+			}
+			if err := m.captureOrderbook(d); err != nil {
+				log.Errorf(log.DataHistory, "Market data capture manager unable to capture %s orderbook: %v", name, err)
+			}
+		}
+	}
+}
+
+// captureOrderbook writes a snapshot of the book when one is due, otherwise
+// the price levels which changed since it was last captured. Invalid books are
+// skipped as the next valid book is compared to the last valid one
+func (m *marketDataCaptureManager) captureOrderbook(d orderbook.Outbound) error {
+	b, err := d.Retrieve()
+	if err != nil {
+		return nil //nolint:nilerr // Invalid books are expected while exchanges resync
+	}
+	if b.LastUpdated.IsZero() {
+		b.LastUpdated = time.Now()
+	}
+	k := capture.Key{Exchange: b.Exchange, Asset: b.Asset, Pair: b.Pair}
+	m.m.Lock()
+	defer m.m.Unlock()
+	book, ok := m.books[k]
+	if !ok {
+		book = &capturedBook{}
+		m.books[k] = book
+	}
+	var r *capture.Record
+	if book.previous == nil || m.writer.NeedsSnapshot(k, b.LastUpdated) || b.LastUpdated.Sub(book.lastSnapshot) >= m.snapshotInterval {
+		r = capture.SnapshotRecord(b)
+		book.lastSnapshot = b.LastUpdated
+	} else {
+		r = capture.UpdateRecord(book.previous, b)
+	}
+	book.previous = b
+	if r == nil {
+		return nil
+	}
+	return m.writer.Write(k, r)
+}
+
+// websocketDataHandler captures trades received from exchange websockets
+func (m *marketDataCaptureManager) websocketDataHandler(exchName string, data interface{}) error {
+	trades, ok := data.([]trade.Data)
+	if !ok || !m.IsRunning() || !m.captures(exchName) {
+		return nil
+	}
+	var errs error
+	for i := range trades {
+		k := capture.Key{Exchange: trades[i].Exchange, Asset: trades[i].AssetType, Pair: trades[i].CurrencyPair}
+		if k.Exchange == "" {
+			k.Exchange = exchName
+		}
+		r := capture.TradeRecord(&trades[i])
+		if r.Time.IsZero() {
+			r.Time = time.Now()
+		}
+		if err := m.writer.Write(k, r); err != nil {
+			errs = common.AppendError(errs, err)
+		}
+	}
+	return errs
+}
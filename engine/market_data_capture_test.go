@@ -0,0 +1,98 @@
+package engine
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/capture"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+func TestSetupMarketDataCaptureManager(t *testing.T) {
+	t.Parallel()
+	_, err := setupMarketDataCaptureManager(nil, nil, "")
+	assert.ErrorIs(t, err, errNilExchangeManager)
+	_, err = setupMarketDataCaptureManager(NewExchangeManager(), nil, "")
+	assert.ErrorIs(t, err, errNilConfig)
+
+	m, err := setupMarketDataCaptureManager(NewExchangeManager(), &config.MarketDataCaptureConfig{Exchanges: []string{"Binance"}}, "data")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join("data", "marketdata"), m.directory)
+	assert.True(t, m.captures("BINANCE"))
+	assert.False(t, m.captures(testExchange))
+}
+
+func TestMarketDataCaptureManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *marketDataCaptureManager
+	assert.ErrorIs(t, m.Start(), ErrNilSubsystem)
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem)
+	assert.False(t, m.IsRunning())
+
+	m, err := setupMarketDataCaptureManager(NewExchangeManager(), &config.MarketDataCaptureConfig{Directory: t.TempDir(), FlushInterval: time.Second}, "")
+	require.NoError(t, err)
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+	require.NoError(t, m.Start())
+	assert.True(t, m.IsRunning())
+	assert.ErrorIs(t, m.Start(), ErrSubSystemAlreadyStarted)
+	assert.NoError(t, m.Stop())
+	assert.False(t, m.IsRunning())
+}
+
+func TestMarketDataCapture(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	m, err := setupMarketDataCaptureManager(NewExchangeManager(), &config.MarketDataCaptureConfig{
+		Directory:        dir,
+		SnapshotInterval: time.Hour,
+		FlushInterval:    time.Hour,
+	}, "")
+	require.NoError(t, err)
+	require.NoError(t, m.Start())
+
+	k := capture.Key{Exchange: testExchange, Asset: asset.Spot, Pair: btcusdPair}
+	d := capture.NewDepth(k)
+	start := time.Now().UTC().Truncate(time.Second)
+	require.NoError(t, d.LoadSnapshot(orderbook.Items{{Price: 100, Amount: 1}}, orderbook.Items{{Price: 101, Amount: 1}}, 1, start, false))
+	require.NoError(t, m.captureOrderbook(d))
+	require.NoError(t, m.captureOrderbook(d), "unchanged books should not be captured")
+	require.NoError(t, d.UpdateBidAskByPrice(&orderbook.Update{UpdateID: 2, UpdateTime: start.Add(time.Second), Bids: orderbook.Items{{Price: 100, Amount: 3}}}))
+	require.NoError(t, m.captureOrderbook(d))
+
+	require.NoError(t, m.websocketDataHandler(testExchange, []trade.Data{{
+		Exchange:     testExchange,
+		AssetType:    asset.Spot,
+		CurrencyPair: btcusdPair,
+		Side:         order.Buy,
+		Price:        101,
+		Amount:       0.5,
+		Timestamp:    start.Add(time.Second * 2),
+	}}))
+	require.NoError(t, m.websocketDataHandler(testExchange, "not trades"), "other websocket data should be ignored")
+	require.NoError(t, m.Stop())
+
+	var kinds []capture.RecordKind
+	r := capture.NewReader(dir)
+	require.NoError(t, r.Read(k, start, start.Add(time.Minute), func(rec *capture.Record) error {
+		kinds = append(kinds, rec.Kind)
+		return nil
+	}))
+	assert.Equal(t, []capture.RecordKind{capture.KindSnapshot, capture.KindUpdate, capture.KindTrade}, kinds)
+
+	b, err := r.Depth(k, start.Add(time.Minute))
+	require.NoError(t, err)
+	book, err := b.Retrieve()
+	require.NoError(t, err)
+	assert.Equal(t, 3.0, book.Bids[0].Amount)
+	trades, err := r.Trades(k, start, start.Add(time.Minute))
+	require.NoError(t, err)
+	require.Len(t, trades, 1)
+	assert.Equal(t, 101.0, trades[0].Price)
+}
@@ -0,0 +1,43 @@
+package engine
+
+import (
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/capture"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+const (
+	// MarketDataCaptureManagerName is an exported subsystem name
+	MarketDataCaptureManagerName = "market_data_capture"
+
+	// marketDataSubscribeInterval is how often exchanges are subscribed to
+	// when their orderbooks are not yet available
+	marketDataSubscribeInterval = time.Second * 10
+)
+
+// marketDataCaptureManager writes orderbook snapshots, price level updates and
+// trades to compressed files for later reconstruction
+type marketDataCaptureManager struct {
+	started          int32
+	shutdown         chan struct{}
+	wg               sync.WaitGroup
+	exchangeManager  iExchangeManager
+	directory        string
+	exchanges        map[string]bool
+	snapshotInterval time.Duration
+	flushInterval    time.Duration
+	writer           *capture.Writer
+
+	m          sync.Mutex
+	subscribed map[string]bool
+	books      map[capture.Key]*capturedBook
+}
+
+// capturedBook is the last captured state of a book, which updates are
+// derived from
+type capturedBook struct {
+	previous     *orderbook.Base
+	lastSnapshot time.Time
+}
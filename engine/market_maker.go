@@ -0,0 +1,430 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync/atomic"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order/tca"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/paper"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// setupMarketMaker creates a market maker for the configured markets, each of
+// which must have a registered fair value and spread model
+func setupMarketMaker(em iExchangeManager, om iQuoteOrderManager, cfg *config.MarketMakerConfig) (*marketMaker, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if om == nil {
+		return nil, errNilOrderManager
+	}
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	if cfg.QuoteInterval <= 0 {
+		return nil, errInvalidQuoteInterval
+	}
+	m := &marketMaker{
+		exchangeManager: em,
+		orderManager:    om,
+		interval:        cfg.QuoteInterval,
+		markets:         make([]*quotedMarket, len(cfg.Markets)),
+	}
+	for i := range cfg.Markets {
+		mkt := &cfg.Markets[i]
+		if mkt.Exchange == "" {
+			return nil, ErrExchangeNameIsEmpty
+		}
+		if mkt.Pair.IsEmpty() {
+			return nil, fmt.Errorf("%s %w", mkt.Exchange, currency.ErrCurrencyPairEmpty)
+		}
+		a, err := asset.New(mkt.Asset)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s %w", mkt.Exchange, mkt.Pair, err)
+		}
+		if mkt.OrderAmount <= 0 {
+			return nil, fmt.Errorf("%s %s %w", mkt.Exchange, mkt.Pair, errInvalidQuoteAmount)
+		}
+		fairValue, spread, err := getMarketMakerModels(mkt)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s %w", mkt.Exchange, mkt.Pair, err)
+		}
+		m.markets[i] = &quotedMarket{cfg: *mkt, asset: a, fairValue: fairValue, spread: spread}
+	}
+	return m, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *marketMaker) IsRunning() bool {
+	if m == nil {
+		return false
+	}
+	return atomic.LoadInt32(&m.started) == 1
+}
+
+// Start begins quoting the configured markets
+func (m *marketMaker) Start() error {
+	if m == nil {
+		return fmt.Errorf("market maker %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return fmt.Errorf("market maker %w", ErrSubSystemAlreadyStarted)
+	}
+	m.shutdown = make(chan struct{})
+	m.wg.Add(1)
+	go m.run()
+	log.Debugf(log.Global, "Market maker %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops quoting and cancels every resting quote
+func (m *marketMaker) Stop() error {
+	if m == nil {
+		return fmt.Errorf("market maker %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 1, 0) {
+		return fmt.Errorf("market maker %w", ErrSubSystemNotStarted)
+	}
+	close(m.shutdown)
+	m.wg.Wait()
+	for _, mkt := range m.markets {
+		m.withdrawQuotes(context.Background(), mkt)
+	}
+	log.Debugf(log.Global, "Market maker %s", MsgSubSystemShutdown)
+	return nil
+}
+
+func (m *marketMaker) run() {
+	defer m.wg.Done()
+	t := time.NewTicker(m.interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-t.C:
+			if !m.orderManager.IsRunning() {
+				continue
+			}
+			for _, mkt := range m.markets {
+				if err := m.quote(context.Background(), mkt); err != nil {
+					log.Errorf(log.Global, "Market maker unable to quote %s %s %s: %v", mkt.cfg.Exchange, mkt.asset, mkt.cfg.Pair, err)
+				}
+			}
+		}
+	}
+}
+
+// quote derives the desired bid and ask of a market and brings its resting
+// quotes in line. Quotes which have moved are modified in place when the
+// exchange supports it, otherwise the stale quotes are cancelled together
+// before their replacements are submitted
+func (m *marketMaker) quote(ctx context.Context, mkt *quotedMarket) error {
+	exch, err := m.exchangeManager.GetExchangeByName(mkt.cfg.Exchange)
+	if err != nil {
+		return err
+	}
+	if _, ok := exch.(*paper.Exchange); mkt.cfg.Paper && !ok {
+		return errNotPaperTrading
+	}
+	depth, err := orderbook.GetDepth(exch.GetName(), mkt.cfg.Pair, mkt.asset)
+	if err != nil {
+		return err
+	}
+	fairValue, err := mkt.fairValue.FairValue(depth)
+	if err != nil {
+		return err
+	}
+	if fairValue <= 0 {
+		return fmt.Errorf("%w: %v", errInvalidFairValue, fairValue)
+	}
+	bidOffset, askOffset, err := mkt.spread.Spread(depth, fairValue)
+	if err != nil {
+		return err
+	}
+	inventory, err := m.getInventory(ctx, exch, mkt)
+	if err != nil {
+		return err
+	}
+	limits, err := exch.GetOrderExecutionLimits(mkt.asset, mkt.cfg.Pair)
+	if err != nil {
+		// Quotes are placed without conforming to limits which have not been
+		// loaded
+		limits = order.MinMaxLevel{}
+	}
+	desired := mkt.desiredQuotes(fairValue, bidOffset, askOffset, inventory, &limits)
+
+	var cancels []order.Cancel
+	for i := range mkt.quotes {
+		resting := m.getActiveQuote(mkt, mkt.quotes[i])
+		switch {
+		case resting == nil:
+		case desired[i] == nil:
+			cancels = append(cancels, mkt.cancel(resting))
+		case resting.matches(desired[i], mkt.cfg.RequoteThresholdPercentage):
+			desired[i] = resting
+			continue
+		case m.modify(ctx, mkt, resting, desired[i]):
+			desired[i] = resting
+			continue
+		default:
+			cancels = append(cancels, mkt.cancel(resting))
+		}
+		mkt.quotes[i] = nil
+	}
+	m.cancelQuotes(ctx, exch, cancels)
+
+	for i := range desired {
+		if desired[i] == nil || desired[i] == mkt.quotes[i] {
+			continue
+		}
+		var clientOrderID string
+		clientOrderID, err = tca.StrategyClientOrderID(marketMakerStrategy)
+		if err != nil {
+			log.Errorf(log.Global, "Market maker unable to quote %s %s %s %s: %v", mkt.cfg.Exchange, mkt.asset, mkt.cfg.Pair, desired[i].side, err)
+			continue
+		}
+		var resp *OrderSubmitResponse
+		resp, err = m.orderManager.Submit(ctx, &order.Submit{
+			Exchange:      mkt.cfg.Exchange,
+			Pair:          mkt.cfg.Pair,
+			AssetType:     mkt.asset,
+			Side:          desired[i].side,
+			Type:          order.Limit,
+			Amount:        desired[i].amount,
+			Price:         desired[i].price,
+			PostOnly:      mkt.cfg.PostOnly,
+			ClientOrderID: clientOrderID,
+		})
+		if err != nil {
+			log.Errorf(log.Global, "Market maker unable to quote %s %s %s %s: %v", mkt.cfg.Exchange, mkt.asset, mkt.cfg.Pair, desired[i].side, err)
+			continue
+		}
+		desired[i].orderID = resp.OrderID
+		if resp.IsActive() {
+			mkt.quotes[i] = desired[i]
+		}
+	}
+	return nil
+}
+
+// desiredQuotes returns the bid and ask to rest around the fair value. Both
+// are shifted away from the side which would move the inventory further from
+// its target, and that side is withdrawn once the maximum deviation is reached
+func (mkt *quotedMarket) desiredQuotes(fairValue, bidOffset, askOffset float64, inventory marketInventory, limits *order.MinMaxLevel) [2]*marketQuote {
+	var deviation float64
+	if mkt.cfg.MaximumInventoryDeviation > 0 {
+		deviation = (inventory.base - mkt.cfg.TargetInventory) / mkt.cfg.MaximumInventoryDeviation
+		deviation = math.Max(-1, math.Min(1, deviation))
+	}
+	skew := -deviation * mkt.cfg.InventorySkewPercentage / 100 * fairValue
+
+	var quotes [2]*marketQuote
+	if deviation < 1 {
+		price := conformQuotePrice(fairValue-bidOffset+skew, limits.PriceStepIncrementSize, false)
+		amount := mkt.cfg.OrderAmount
+		if !mkt.asset.IsFutures() && price > 0 {
+			amount = math.Min(amount, inventory.quote/price)
+		}
+		quotes[0] = mkt.conform(order.Buy, price, amount, limits)
+	}
+	if deviation > -1 {
+		price := conformQuotePrice(fairValue+askOffset+skew, limits.PriceStepIncrementSize, true)
+		amount := mkt.cfg.OrderAmount
+		if !mkt.asset.IsFutures() {
+			amount = math.Min(amount, inventory.base)
+		}
+		quotes[1] = mkt.conform(order.Sell, price, amount, limits)
+	}
+	return quotes
+}
+
+// conform returns a quote which meets the exchange's order limits, or nil if
+// the side cannot be quoted
+func (mkt *quotedMarket) conform(side order.Side, price, amount float64, limits *order.MinMaxLevel) *marketQuote {
+	amount = limits.ConformToAmount(amount)
+	if price <= 0 || amount <= 0 {
+		return nil
+	}
+	if err := limits.Conforms(price, amount, order.Limit); err != nil {
+		log.Debugf(log.Global, "Market maker not quoting %s %s %s %s: %v", mkt.cfg.Exchange, mkt.asset, mkt.cfg.Pair, side, err)
+		return nil
+	}
+	return &marketQuote{side: side, price: price, amount: amount}
+}
+
+// cancel returns the cancellation of a resting quote
+func (mkt *quotedMarket) cancel(q *marketQuote) order.Cancel {
+	return order.Cancel{
+		Exchange:  mkt.cfg.Exchange,
+		OrderID:   q.orderID,
+		Side:      q.side,
+		Pair:      mkt.cfg.Pair,
+		AssetType: mkt.asset,
+	}
+}
+
+// matches returns whether a resting quote is close enough to the desired
+// quote to be left in place
+func (q *marketQuote) matches(desired *marketQuote, thresholdPercentage float64) bool {
+	return q.amount == desired.amount &&
+		math.Abs(q.price-desired.price)/desired.price*100 <= thresholdPercentage
+}
+
+// getActiveQuote returns a resting quote with its remaining amount, or nil if
+// it has been filled or cancelled
+func (m *marketMaker) getActiveQuote(mkt *quotedMarket, q *marketQuote) *marketQuote {
+	if q == nil {
+		return nil
+	}
+	det, err := m.orderManager.GetByExchangeAndID(mkt.cfg.Exchange, q.orderID)
+	if err != nil || !det.IsActive() {
+		return nil
+	}
+	if det.RemainingAmount > 0 {
+		q.amount = det.RemainingAmount
+	}
+	return q
+}
+
+// modify amends a resting quote to the desired price and amount, returning
+// false if it must be replaced instead
+func (m *marketMaker) modify(ctx context.Context, mkt *quotedMarket, resting, desired *marketQuote) bool {
+	if mkt.modifyUnsupported {
+		return false
+	}
+	resp, err := m.orderManager.Modify(ctx, &order.Modify{
+		Exchange:  mkt.cfg.Exchange,
+		OrderID:   resting.orderID,
+		Side:      resting.side,
+		Pair:      mkt.cfg.Pair,
+		AssetType: mkt.asset,
+		Type:      order.Limit,
+		Price:     desired.price,
+		Amount:    desired.amount,
+	})
+	if err != nil {
+		if isUnsupported(err) {
+			mkt.modifyUnsupported = true
+		} else {
+			log.Errorf(log.Global, "Market maker unable to modify %s order %s: %v", mkt.cfg.Exchange, resting.orderID, err)
+		}
+		return false
+	}
+	if resp.OrderID != "" {
+		resting.orderID = resp.OrderID
+	}
+	resting.price = desired.price
+	resting.amount = desired.amount
+	return true
+}
+
+// cancelQuotes cancels quotes in a single batch, falling back to cancelling
+// them individually through the order manager when the exchange cannot batch
+// them. Batch cancellations are reconciled with the order manager by its
+// order syncing
+func (m *marketMaker) cancelQuotes(ctx context.Context, exch exchange.IBotExchange, cancels []order.Cancel) {
+	if len(cancels) == 0 {
+		return
+	}
+	if len(cancels) > 1 {
+		resp, err := exch.CancelBatchOrders(ctx, cancels)
+		if err == nil {
+			for id, status := range resp.Status {
+				log.Debugf(log.Global, "Market maker %s order %s cancel status: %s", exch.GetName(), id, status)
+			}
+			return
+		}
+		if !isUnsupported(err) {
+			log.Errorf(log.Global, "Market maker unable to batch cancel %s orders: %v", exch.GetName(), err)
+		}
+	}
+	for i := range cancels {
+		if err := m.orderManager.Cancel(ctx, &cancels[i]); err != nil {
+			log.Errorf(log.Global, "Market maker unable to cancel %s order %s: %v", exch.GetName(), cancels[i].OrderID, err)
+		}
+	}
+}
+
+// withdrawQuotes cancels a market's resting quotes
+func (m *marketMaker) withdrawQuotes(ctx context.Context, mkt *quotedMarket) {
+	exch, err := m.exchangeManager.GetExchangeByName(mkt.cfg.Exchange)
+	if err != nil {
+		log.Errorf(log.Global, "Market maker unable to withdraw %s quotes: %v", mkt.cfg.Exchange, err)
+		return
+	}
+	var cancels []order.Cancel
+	for i := range mkt.quotes {
+		if q := m.getActiveQuote(mkt, mkt.quotes[i]); q != nil {
+			cancels = append(cancels, mkt.cancel(q))
+		}
+		mkt.quotes[i] = nil
+	}
+	m.cancelQuotes(ctx, exch, cancels)
+}
+
+// getInventory returns the base and quote balances of a market available to
+// the market maker. These are the free balances of the exchange's account
+// holdings plus the funds reserved by the market maker's own resting quotes,
+// which are released when the quotes are replaced. Funds reserved by other
+// orders are excluded
+func (m *marketMaker) getInventory(ctx context.Context, exch exchange.IBotExchange, mkt *quotedMarket) (marketInventory, error) {
+	h, err := exch.FetchAccountInfo(ctx, mkt.asset)
+	if err != nil {
+		return marketInventory{}, err
+	}
+	var inventory marketInventory
+	for i := range h.Accounts {
+		for j := range h.Accounts[i].Currencies {
+			switch c := h.Accounts[i].Currencies[j]; {
+			case c.Currency.Equal(mkt.cfg.Pair.Base):
+				inventory.base += c.Free
+			case c.Currency.Equal(mkt.cfg.Pair.Quote):
+				inventory.quote += c.Free
+			}
+		}
+	}
+	for i := range mkt.quotes {
+		resting := m.getActiveQuote(mkt, mkt.quotes[i])
+		switch {
+		case resting == nil:
+		case resting.side.IsLong():
+			inventory.quote += resting.price * resting.amount
+		default:
+			inventory.base += resting.amount
+		}
+	}
+	return inventory, nil
+}
+
+// conformQuotePrice rounds a price to the price step, down for bids and up
+// for asks so quotes never move towards the fair value
+func conformQuotePrice(price, step float64, roundUp bool) float64 {
+	if step <= 0 {
+		return price
+	}
+	steps := decimal.NewFromFloat(price).Div(decimal.NewFromFloat(step))
+	if roundUp {
+		steps = steps.Ceil()
+	} else {
+		steps = steps.Floor()
+	}
+	return steps.Mul(decimal.NewFromFloat(step)).InexactFloat64()
+}
+
+// isUnsupported returns whether an exchange does not support a request
+func isUnsupported(err error) bool {
+	return errors.Is(err, common.ErrFunctionNotSupported) || errors.Is(err, common.ErrNotYetImplemented)
+}
@@ -0,0 +1,119 @@
+package engine
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+var (
+	marketMakerModelsMtx sync.RWMutex
+	fairValueModels      = map[string]NewFairValueModel{
+		"mid":        func(*config.MarketMakerMarket) (FairValueModel, error) { return midPriceModel{}, nil },
+		"microprice": func(*config.MarketMakerMarket) (FairValueModel, error) { return micropriceModel{}, nil },
+	}
+	spreadModels = map[string]NewSpreadModel{
+		"fixed": newFixedSpreadModel,
+		"book":  newBookSpreadModel,
+	}
+)
+
+// RegisterFairValueModel makes a fair value model available to market maker
+// configurations by name
+func RegisterFairValueModel(name string, newModel NewFairValueModel) error {
+	marketMakerModelsMtx.Lock()
+	defer marketMakerModelsMtx.Unlock()
+	name = strings.ToLower(name)
+	if _, ok := fairValueModels[name]; ok {
+		return fmt.Errorf("fair value %w: %s", errMarketMakerModelRegistered, name)
+	}
+	fairValueModels[name] = newModel
+	return nil
+}
+
+// RegisterSpreadModel makes a spread model available to market maker
+// configurations by name
+func RegisterSpreadModel(name string, newModel NewSpreadModel) error {
+	marketMakerModelsMtx.Lock()
+	defer marketMakerModelsMtx.Unlock()
+	name = strings.ToLower(name)
+	if _, ok := spreadModels[name]; ok {
+		return fmt.Errorf("spread %w: %s", errMarketMakerModelRegistered, name)
+	}
+	spreadModels[name] = newModel
+	return nil
+}
+
+// getMarketMakerModels creates the fair value and spread models of a market
+func getMarketMakerModels(cfg *config.MarketMakerMarket) (FairValueModel, SpreadModel, error) {
+	marketMakerModelsMtx.RLock()
+	newFairValue, ok := fairValueModels[strings.ToLower(cfg.FairValueModel)]
+	newSpread, ok2 := spreadModels[strings.ToLower(cfg.SpreadModel)]
+	marketMakerModelsMtx.RUnlock()
+	if !ok {
+		return nil, nil, fmt.Errorf("fair value %w: %q", errMarketMakerModelNotFound, cfg.FairValueModel)
+	}
+	if !ok2 {
+		return nil, nil, fmt.Errorf("spread %w: %q", errMarketMakerModelNotFound, cfg.SpreadModel)
+	}
+	fairValue, err := newFairValue(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	spread, err := newSpread(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	return fairValue, spread, nil
+}
+
+// FairValue returns the mid price
+func (midPriceModel) FairValue(d *orderbook.Depth) (float64, error) {
+	return d.GetMidPrice()
+}
+
+// FairValue returns the microprice
+func (micropriceModel) FairValue(d *orderbook.Depth) (float64, error) {
+	asks, bids, err := d.GetTranches(1)
+	if err != nil {
+		return 0, err
+	}
+	if len(asks) == 0 || len(bids) == 0 {
+		return 0, errNoTopOfBook
+	}
+	return (bids[0].Price*asks[0].Amount + asks[0].Price*bids[0].Amount) / (asks[0].Amount + bids[0].Amount), nil
+}
+
+func newFixedSpreadModel(cfg *config.MarketMakerMarket) (SpreadModel, error) {
+	if cfg.SpreadPercentage <= 0 {
+		return nil, errInvalidSpreadPercentage
+	}
+	return fixedSpreadModel{percentage: cfg.SpreadPercentage}, nil
+}
+
+// Spread returns half of the fixed spread for each side
+func (f fixedSpreadModel) Spread(_ *orderbook.Depth, fairValue float64) (bidOffset, askOffset float64, err error) {
+	half := fairValue * f.percentage / 200
+	return half, half, nil
+}
+
+func newBookSpreadModel(cfg *config.MarketMakerMarket) (SpreadModel, error) {
+	if cfg.SpreadPercentage < 0 {
+		return nil, errInvalidSpreadPercentage
+	}
+	return bookSpreadModel{minimumPercentage: cfg.SpreadPercentage}, nil
+}
+
+// Spread returns half of the wider of the orderbook's spread and the minimum
+// spread for each side
+func (b bookSpreadModel) Spread(d *orderbook.Depth, fairValue float64) (bidOffset, askOffset float64, err error) {
+	spread, err := d.GetSpreadAmount()
+	if err != nil {
+		return 0, 0, err
+	}
+	half := max(spread, fairValue*b.minimumPercentage/100) / 2
+	return half, half, nil
+}
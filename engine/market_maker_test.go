@@ -0,0 +1,362 @@
+package engine
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order/tca"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/paper"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
+)
+
+// quoteTestExchange records quoting requests without any API calls
+type quoteTestExchange struct {
+	exchange.IBotExchange
+	base              *exchange.Base
+	limits            order.MinMaxLevel
+	modifyUnsupported bool
+	m                 sync.Mutex
+	balances          []account.Balance
+	submitted         []*order.Submit
+	modified          []*order.Modify
+	cancelled         []order.Cancel
+	batches           [][]order.Cancel
+}
+
+func (q *quoteTestExchange) GetName() string         { return q.base.Name }
+func (q *quoteTestExchange) GetBase() *exchange.Base { return q.base }
+
+func (q *quoteTestExchange) GetWebsocket() (*stream.Websocket, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+func (q *quoteTestExchange) GetAssetTypes(bool) asset.Items {
+	return asset.Items{asset.Spot}
+}
+
+func (q *quoteTestExchange) GetOrderExecutionLimits(asset.Item, currency.Pair) (order.MinMaxLevel, error) {
+	return q.limits, nil
+}
+
+func (q *quoteTestExchange) CheckOrderExecutionLimits(asset.Item, currency.Pair, float64, float64, order.Type) error {
+	return nil
+}
+
+func (q *quoteTestExchange) CanTradePair(currency.Pair, asset.Item) error {
+	return nil
+}
+
+func (q *quoteTestExchange) FetchAccountInfo(context.Context, asset.Item) (account.Holdings, error) {
+	q.m.Lock()
+	defer q.m.Unlock()
+	return account.Holdings{
+		Exchange: q.base.Name,
+		Accounts: []account.SubAccount{{AssetType: asset.Spot, Currencies: append([]account.Balance(nil), q.balances...)}},
+	}, nil
+}
+
+func (q *quoteTestExchange) SubmitOrder(_ context.Context, s *order.Submit) (*order.SubmitResponse, error) {
+	q.m.Lock()
+	defer q.m.Unlock()
+	q.submitted = append(q.submitted, s)
+	return s.DeriveSubmitResponse(q.base.Name + strconv.Itoa(len(q.submitted)))
+}
+
+func (q *quoteTestExchange) ModifyOrder(_ context.Context, mod *order.Modify) (*order.ModifyResponse, error) {
+	if q.modifyUnsupported {
+		return nil, common.ErrFunctionNotSupported
+	}
+	q.m.Lock()
+	defer q.m.Unlock()
+	q.modified = append(q.modified, mod)
+	return mod.DeriveModifyResponse()
+}
+
+func (q *quoteTestExchange) CancelOrder(_ context.Context, c *order.Cancel) error {
+	q.m.Lock()
+	defer q.m.Unlock()
+	q.cancelled = append(q.cancelled, *c)
+	return nil
+}
+
+func (q *quoteTestExchange) CancelBatchOrders(_ context.Context, c []order.Cancel) (*order.CancelBatchResponse, error) {
+	q.m.Lock()
+	defer q.m.Unlock()
+	q.batches = append(q.batches, c)
+	resp := &order.CancelBatchResponse{Status: make(map[string]string, len(c))}
+	for i := range c {
+		resp.Status[c[i].OrderID] = order.Cancelled.String()
+	}
+	return resp, nil
+}
+
+func (q *quoteTestExchange) setBalances(base, quote float64) {
+	q.setHoldings(base, quote, base, quote)
+}
+
+func (q *quoteTestExchange) setHoldings(freeBase, freeQuote, totalBase, totalQuote float64) {
+	q.m.Lock()
+	defer q.m.Unlock()
+	q.balances = []account.Balance{
+		{Currency: currency.NewCode("QUOTEBASE"), Total: totalBase, Free: freeBase},
+		{Currency: currency.USDT, Total: totalQuote, Free: freeQuote},
+	}
+}
+
+func quoteTestSetup(t *testing.T, exchs ...exchange.IBotExchange) *OrderManager {
+	t.Helper()
+	em := NewExchangeManager()
+	for i := range exchs {
+		require.NoError(t, em.Add(exchs[i]))
+	}
+	var wg sync.WaitGroup
+	m, err := SetupOrderManager(em, &CommunicationManager{}, &wg, &config.OrderManager{})
+	require.NoError(t, err)
+	m.started = 1
+	return m
+}
+
+func quoteTestBook(t *testing.T, exch string, p currency.Pair, bid, ask float64) {
+	t.Helper()
+	require.NoError(t, (&orderbook.Base{
+		Exchange:    exch,
+		Pair:        p,
+		Asset:       asset.Spot,
+		Bids:        orderbook.Items{{Price: bid, Amount: 1}},
+		Asks:        orderbook.Items{{Price: ask, Amount: 3}},
+		LastUpdated: time.Now(),
+	}).Process())
+}
+
+func TestSetupMarketMaker(t *testing.T) {
+	t.Parallel()
+	om := &OrderManager{}
+	_, err := setupMarketMaker(nil, om, &config.MarketMakerConfig{})
+	assert.ErrorIs(t, err, errNilExchangeManager)
+	_, err = setupMarketMaker(NewExchangeManager(), nil, &config.MarketMakerConfig{})
+	assert.ErrorIs(t, err, errNilOrderManager)
+	_, err = setupMarketMaker(NewExchangeManager(), om, nil)
+	assert.ErrorIs(t, err, errNilConfig)
+	cfg := &config.MarketMakerConfig{}
+	_, err = setupMarketMaker(NewExchangeManager(), om, cfg)
+	assert.ErrorIs(t, err, errInvalidQuoteInterval)
+
+	cfg.QuoteInterval = time.Second
+	cfg.Markets = []config.MarketMakerMarket{{}}
+	_, err = setupMarketMaker(NewExchangeManager(), om, cfg)
+	assert.ErrorIs(t, err, ErrExchangeNameIsEmpty)
+	cfg.Markets[0].Exchange = "quoter"
+	_, err = setupMarketMaker(NewExchangeManager(), om, cfg)
+	assert.ErrorIs(t, err, currency.ErrCurrencyPairEmpty)
+	cfg.Markets[0].Pair = currency.NewPair(currency.BTC, currency.USDT)
+	_, err = setupMarketMaker(NewExchangeManager(), om, cfg)
+	assert.ErrorIs(t, err, asset.ErrNotSupported)
+	cfg.Markets[0].Asset = "spot"
+	_, err = setupMarketMaker(NewExchangeManager(), om, cfg)
+	assert.ErrorIs(t, err, errInvalidQuoteAmount)
+	cfg.Markets[0].OrderAmount = 1
+	_, err = setupMarketMaker(NewExchangeManager(), om, cfg)
+	assert.ErrorIs(t, err, errMarketMakerModelNotFound)
+	cfg.Markets[0].FairValueModel = "mid"
+	cfg.Markets[0].SpreadModel = "fixed"
+	_, err = setupMarketMaker(NewExchangeManager(), om, cfg)
+	assert.ErrorIs(t, err, errInvalidSpreadPercentage)
+	cfg.Markets[0].SpreadPercentage = 1
+	m, err := setupMarketMaker(NewExchangeManager(), om, cfg)
+	require.NoError(t, err)
+	require.Len(t, m.markets, 1)
+	assert.Equal(t, asset.Spot, m.markets[0].asset)
+
+	var nilManager *marketMaker
+	assert.False(t, nilManager.IsRunning())
+	assert.ErrorIs(t, nilManager.Start(), ErrNilSubsystem)
+	assert.ErrorIs(t, nilManager.Stop(), ErrNilSubsystem)
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+}
+
+func TestMarketMakerModels(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.NewCode("QUOTEMODELS"), currency.USDT)
+	quoteTestBook(t, "quotemodels", p, 99, 101)
+	d, err := orderbook.GetDepth("quotemodels", p, asset.Spot)
+	require.NoError(t, err)
+
+	fairValue, err := midPriceModel{}.FairValue(d)
+	require.NoError(t, err)
+	assert.Equal(t, 100.0, fairValue)
+	fairValue, err = micropriceModel{}.FairValue(d)
+	require.NoError(t, err)
+	assert.Equal(t, 99.5, fairValue, "more resting on the ask should move the microprice towards the bid")
+
+	bidOffset, askOffset, err := fixedSpreadModel{percentage: 1}.Spread(d, 100)
+	require.NoError(t, err)
+	assert.Equal(t, 0.5, bidOffset)
+	assert.Equal(t, 0.5, askOffset)
+	bidOffset, _, err = bookSpreadModel{minimumPercentage: 1}.Spread(d, 100)
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, bidOffset, "the book spread should be used when wider than the minimum")
+	bidOffset, _, err = bookSpreadModel{minimumPercentage: 4}.Spread(d, 100)
+	require.NoError(t, err)
+	assert.Equal(t, 2.0, bidOffset, "the minimum spread should be used when wider than the book")
+
+	assert.ErrorIs(t, RegisterFairValueModel("MID", nil), errMarketMakerModelRegistered)
+	assert.ErrorIs(t, RegisterSpreadModel("Fixed", nil), errMarketMakerModelRegistered)
+	require.NoError(t, RegisterFairValueModel("quotetestlast", func(*config.MarketMakerMarket) (FairValueModel, error) {
+		return midPriceModel{}, nil
+	}))
+	require.NoError(t, RegisterSpreadModel("quotetestbook", newBookSpreadModel))
+	_, _, err = getMarketMakerModels(&config.MarketMakerMarket{FairValueModel: "quoteTestLast", SpreadModel: "quoteTestBook"})
+	assert.NoError(t, err)
+}
+
+func TestMarketMakerQuote(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.NewCode("QUOTEBASE"), currency.USDT)
+	exch := &quoteTestExchange{base: &exchange.Base{Name: "quoter"}, limits: order.MinMaxLevel{PriceStepIncrementSize: 0.5}}
+	exch.setBalances(5, 1000)
+	quoteTestBook(t, exch.GetName(), p, 99, 101)
+	om := quoteTestSetup(t, exch)
+	m, err := setupMarketMaker(om.orderStore.exchangeManager, om, &config.MarketMakerConfig{
+		QuoteInterval: time.Hour,
+		Markets: []config.MarketMakerMarket{{
+			Exchange:                   exch.GetName(),
+			Pair:                       p,
+			Asset:                      "spot",
+			FairValueModel:             "mid",
+			SpreadModel:                "fixed",
+			SpreadPercentage:           2,
+			OrderAmount:                1,
+			TargetInventory:            5,
+			MaximumInventoryDeviation:  5,
+			InventorySkewPercentage:    1,
+			RequoteThresholdPercentage: 0.1,
+		}},
+	})
+	require.NoError(t, err)
+	mkt := m.markets[0]
+
+	require.NoError(t, m.quote(context.Background(), mkt))
+	require.Len(t, exch.submitted, 2)
+	assert.Equal(t, order.Buy, exch.submitted[0].Side)
+	assert.Equal(t, 99.0, exch.submitted[0].Price)
+	assert.Equal(t, order.Sell, exch.submitted[1].Side)
+	assert.Equal(t, 101.0, exch.submitted[1].Price)
+	assert.Equal(t, marketMakerStrategy, tca.StrategyFromClientOrderID(exch.submitted[0].ClientOrderID), "quotes should be tagged with the market maker")
+	assert.NotEqual(t, exch.submitted[0].ClientOrderID, exch.submitted[1].ClientOrderID)
+
+	// funds reserved by resting quotes count towards the inventory, funds
+	// reserved by other orders do not
+	exch.setHoldings(4, 901, 6, 1500)
+	inventory, err := m.getInventory(context.Background(), exch, mkt)
+	require.NoError(t, err)
+	assert.Equal(t, marketInventory{base: 5, quote: 1000}, inventory)
+
+	require.NoError(t, m.quote(context.Background(), mkt))
+	assert.Len(t, exch.submitted, 2, "quotes at their desired price should be left in place")
+
+	// moved quotes are amended in place
+	quoteTestBook(t, exch.GetName(), p, 100, 102)
+	require.NoError(t, m.quote(context.Background(), mkt))
+	assert.Len(t, exch.submitted, 2)
+	require.Len(t, exch.modified, 2)
+	assert.Equal(t, 99.5, exch.modified[0].Price)
+	assert.Equal(t, 102.5, exch.modified[1].Price)
+
+	// excess inventory lowers both quotes to encourage selling, the resting
+	// ask holds the remainder of the inventory
+	exch.setBalances(6.5, 1000)
+	require.NoError(t, m.quote(context.Background(), mkt))
+	require.Len(t, exch.modified, 4)
+	assert.Equal(t, 99.0, exch.modified[2].Price)
+	assert.Equal(t, 102.0, exch.modified[3].Price)
+
+	// without modification support stale quotes are cancelled as a batch
+	// then replaced
+	exch.modifyUnsupported = true
+	quoteTestBook(t, exch.GetName(), p, 104, 106)
+	require.NoError(t, m.quote(context.Background(), mkt))
+	assert.True(t, mkt.modifyUnsupported)
+	require.Len(t, exch.batches, 1)
+	assert.Len(t, exch.batches[0], 2)
+	require.Len(t, exch.submitted, 4)
+	assert.Equal(t, 103.0, exch.submitted[2].Price)
+	assert.Equal(t, 106.0, exch.submitted[3].Price)
+
+	// at the maximum deviation the bid is withdrawn and the ask replaced
+	exch.setBalances(9, 1000)
+	require.NoError(t, m.quote(context.Background(), mkt))
+	require.Len(t, exch.batches, 2)
+	require.Len(t, exch.batches[1], 2)
+	assert.Equal(t, order.Buy, exch.batches[1][0].Side)
+	assert.Nil(t, mkt.quotes[0])
+	require.NotNil(t, mkt.quotes[1])
+	assert.Equal(t, 105.0, mkt.quotes[1].price)
+
+	mkt.cfg.Paper = true
+	assert.ErrorIs(t, m.quote(context.Background(), mkt), errNotPaperTrading)
+}
+
+func TestMarketMakerPaper(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.NewCode("QUOTEBASE"), currency.USDT)
+	base := &exchange.Base{Name: "quoterpaper"}
+	quoteTestBook(t, base.Name, p, 99, 101)
+	exch, err := paper.New(&quoteTestExchange{base: base}, &config.PaperTrading{
+		Enabled:  true,
+		Balances: map[string]float64{"QUOTEBASE": 2, "USDT": 1000},
+	})
+	require.NoError(t, err)
+	om := quoteTestSetup(t, exch)
+	m, err := setupMarketMaker(om.orderStore.exchangeManager, om, &config.MarketMakerConfig{
+		QuoteInterval: time.Hour,
+		Markets: []config.MarketMakerMarket{{
+			Exchange:         base.Name,
+			Pair:             p,
+			Asset:            "spot",
+			FairValueModel:   "microprice",
+			SpreadModel:      "book",
+			SpreadPercentage: 1,
+			OrderAmount:      1,
+			PostOnly:         true,
+			Paper:            true,
+		}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, m.Start())
+
+	activeOrders := func() order.FilteredOrders {
+		orders, err := exch.GetActiveOrders(context.Background(), &order.MultiOrderRequest{AssetType: asset.Spot, Side: order.AnySide, Type: order.AnyType})
+		require.NoError(t, err)
+		return orders
+	}
+	require.NoError(t, m.quote(context.Background(), m.markets[0]))
+	orders := activeOrders()
+	require.Len(t, orders, 2, "both quotes should rest on the simulated exchange")
+
+	// paper trading cannot modify orders so moved quotes are replaced
+	quoteTestBook(t, base.Name, p, 103, 105)
+	require.NoError(t, m.quote(context.Background(), m.markets[0]))
+	assert.True(t, m.markets[0].modifyUnsupported)
+	replaced := activeOrders()
+	require.Len(t, replaced, 2)
+	for i := range replaced {
+		assert.NotEqual(t, orders[0].OrderID, replaced[i].OrderID)
+		assert.NotEqual(t, orders[1].OrderID, replaced[i].OrderID)
+	}
+
+	require.NoError(t, m.Stop())
+	assert.Empty(t, activeOrders(), "stopping should withdraw all quotes")
+}
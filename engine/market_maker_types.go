@@ -0,0 +1,117 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// MarketMakerName is an exported subsystem name
+const MarketMakerName = "market_maker"
+
+// marketMakerStrategy tags the client order IDs of quotes so TCA reports can
+// attribute them to the market maker. Client order IDs are alphanumeric so
+// the subsystem name cannot be used
+const marketMakerStrategy = "marketmaker"
+
+var (
+	errInvalidQuoteInterval       = errors.New("quote interval must be greater than zero")
+	errInvalidQuoteAmount         = errors.New("order amount must be greater than zero")
+	errInvalidSpreadPercentage    = errors.New("spread percentage must be greater than zero")
+	errInvalidFairValue           = errors.New("fair value must be greater than zero")
+	errNoTopOfBook                = errors.New("orderbook has no best bid and ask")
+	errMarketMakerModelNotFound   = errors.New("market maker model not found")
+	errMarketMakerModelRegistered = errors.New("market maker model already registered")
+	errNotPaperTrading            = errors.New("exchange is not paper trading")
+)
+
+// FairValueModel derives the price a market's quotes are centred on from its
+// orderbook depth
+type FairValueModel interface {
+	FairValue(d *orderbook.Depth) (float64, error)
+}
+
+// SpreadModel derives how far below and above the fair value a market's bid
+// and ask are quoted
+type SpreadModel interface {
+	Spread(d *orderbook.Depth, fairValue float64) (bidOffset, askOffset float64, err error)
+}
+
+// NewFairValueModel creates a fair value model for a market
+type NewFairValueModel func(*config.MarketMakerMarket) (FairValueModel, error)
+
+// NewSpreadModel creates a spread model for a market
+type NewSpreadModel func(*config.MarketMakerMarket) (SpreadModel, error)
+
+// iQuoteOrderManager limits exposure of the order manager to the market maker
+type iQuoteOrderManager interface {
+	IsRunning() bool
+	Submit(context.Context, *order.Submit) (*OrderSubmitResponse, error)
+	Modify(context.Context, *order.Modify) (*order.ModifyResponse, error)
+	Cancel(context.Context, *order.Cancel) error
+	GetByExchangeAndID(string, string) (*order.Detail, error)
+}
+
+// marketMaker maintains two-sided quotes on markets around a fair value,
+// skewed by the inventory held
+type marketMaker struct {
+	started         int32
+	shutdown        chan struct{}
+	wg              sync.WaitGroup
+	exchangeManager iExchangeManager
+	orderManager    iQuoteOrderManager
+	interval        time.Duration
+	markets         []*quotedMarket
+}
+
+// quotedMarket is a market being quoted and its resting quotes
+type quotedMarket struct {
+	cfg       config.MarketMakerMarket
+	asset     asset.Item
+	fairValue FairValueModel
+	spread    SpreadModel
+	// modifyUnsupported is set once the exchange rejects order modification,
+	// quotes are then cancelled and replaced
+	modifyUnsupported bool
+	// quotes holds the resting bid and ask
+	quotes [2]*marketQuote
+}
+
+// marketQuote is a quote on one side of a market
+type marketQuote struct {
+	side    order.Side
+	orderID string
+	price   float64
+	amount  float64
+}
+
+// marketInventory is the balance held of a market's currencies
+type marketInventory struct {
+	base  float64
+	quote float64
+}
+
+// midPriceModel values a market at the midpoint of the best bid and ask
+type midPriceModel struct{}
+
+// micropriceModel values a market at the best bid and ask weighted by the
+// amount resting on the opposite side, moving the fair value towards the side
+// more likely to be consumed
+type micropriceModel struct{}
+
+// fixedSpreadModel quotes a constant percentage spread around the fair value
+type fixedSpreadModel struct {
+	percentage float64
+}
+
+// bookSpreadModel quotes the orderbook's current spread around the fair
+// value, widened to a minimum percentage
+type bookSpreadModel struct {
+	minimumPercentage float64
+}
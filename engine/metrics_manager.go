@@ -0,0 +1,401 @@
+package engine
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// setupMetricsManager creates a new metrics manager and registers all engine
+// metric families
+func setupMetricsManager(cfg *config.MetricsConfig) (*metricsManager, error) {
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	m := &metricsManager{listenAddress: cfg.ListenAddress}
+	m.requestDuration = m.register("gct_exchange_request_duration_seconds", "Latency of successful exchange REST requests", metricHistogram, latencyBuckets, "exchange", "method", "endpoint")
+	m.rateLimitWait = m.register("gct_exchange_rate_limit_wait_seconds", "Time exchange REST requests waited on the rate limiter", metricHistogram, rateLimitBuckets, "exchange")
+	m.websocketRequestDuration = m.register("gct_websocket_request_duration_seconds", "Latency of websocket requests which expect a response", metricHistogram, latencyBuckets, "exchange")
+	m.websocketMessages = m.register("gct_websocket_messages_received", "Websocket messages received", metricCounter, nil, "exchange")
+	m.websocketBytes = m.register("gct_websocket_received_bytes", "Websocket message bytes received", metricCounter, nil, "exchange")
+	m.websocketReconnections = m.register("gct_websocket_reconnections", "Websocket reconnections after a connection was lost", metricCounter, nil, "exchange")
+	m.orderbookUpdateLag = m.register("gct_orderbook_update_lag_seconds", "Time between an exchange's orderbook update and its processing", metricHistogram, orderbookLagBuckets, "exchange", "asset")
+	m.orderSubmissions = m.register("gct_order_submissions", "Orders submitted via the order manager by outcome", metricCounter, nil, "exchange", "asset", "outcome")
+	m.dispatchRunning = m.register("gct_dispatch_running", "Whether the dispatch system is running", metricGauge, nil)
+	m.dispatchWorkers = m.register("gct_dispatch_workers", "Dispatch relay workers", metricGauge, nil)
+	m.dispatchQueueDepth = m.register("gct_dispatch_queue_depth", "Jobs waiting in the dispatch queue", metricGauge, nil)
+	m.dispatchQueueCapacity = m.register("gct_dispatch_queue_capacity", "Capacity of the dispatch queue", metricGauge, nil)
+	m.dispatchSubscribers = m.register("gct_dispatch_subscribers", "Subscribers to dispatched data", metricGauge, nil)
+	m.dispatchDroppedJobs = m.register("gct_dispatch_dropped_jobs", "Jobs dropped because the dispatch queue was full", metricCounter, nil)
+	return m, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *metricsManager) IsRunning() bool {
+	if m == nil {
+		return false
+	}
+	return atomic.LoadInt32(&m.started) == 1
+}
+
+// Start starts serving metrics over HTTP
+func (m *metricsManager) Start() error {
+	if m == nil {
+		return fmt.Errorf("metrics manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return fmt.Errorf("metrics manager %w", ErrSubSystemAlreadyStarted)
+	}
+	listener, err := net.Listen("tcp", m.listenAddress)
+	if err != nil {
+		atomic.StoreInt32(&m.started, 0)
+		return err
+	}
+	mux := http.NewServeMux()
+	mux.Handle(metricsPath, m)
+	m.server = &http.Server{
+		Addr:              listener.Addr().String(),
+		Handler:           mux,
+		ReadHeaderTimeout: time.Minute,
+	}
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		if err := m.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Errorf(log.Global, "Metrics manager server error: %v", err)
+		}
+	}()
+	log.Debugf(log.Global, "Metrics manager %s Listen URL: http://%s%s", MsgSubSystemStarted, listener.Addr(), metricsPath)
+	return nil
+}
+
+// Stop stops serving metrics. Metrics continue to be recorded
+func (m *metricsManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("metrics manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 1, 0) {
+		return fmt.Errorf("metrics manager %w", ErrSubSystemNotStarted)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	err := m.server.Shutdown(ctx)
+	m.wg.Wait()
+	if err != nil {
+		return err
+	}
+	log.Debugf(log.Global, "Metrics manager %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// requestReporter returns a reporter for exchange REST requests
+func (m *metricsManager) requestReporter() request.Reporter {
+	return &requestMetrics{m: m}
+}
+
+// websocketReporter returns a reporter for exchange websocket connections
+func (m *metricsManager) websocketReporter() stream.Reporter {
+	return &websocketMetrics{m: m}
+}
+
+// Latency records the latency of a successful exchange REST request
+func (r *requestMetrics) Latency(name, method, path string, t time.Duration) {
+	r.m.requestDuration.observe(t.Seconds(), name, method, endpointLabel(path))
+}
+
+// RateLimitWait records the time an exchange REST request waited on its rate
+// limiter
+func (r *requestMetrics) RateLimitWait(name string, t time.Duration) {
+	r.m.rateLimitWait.observe(t.Seconds(), name)
+}
+
+// Latency records the time taken to receive a websocket response
+func (w *websocketMetrics) Latency(name string, _ []byte, t time.Duration) {
+	w.m.websocketRequestDuration.observe(t.Seconds(), name)
+}
+
+// Reconnected records a websocket reconnection
+func (w *websocketMetrics) Reconnected(name string) {
+	w.m.websocketReconnections.add(1, name)
+}
+
+// MessageReceived records an inbound websocket message
+func (w *websocketMetrics) MessageReceived(name string, size int) {
+	w.m.websocketMessages.add(1, name)
+	w.m.websocketBytes.add(float64(size), name)
+}
+
+// OrderSubmitted records the outcome of an order submitted via the order
+// manager
+func (m *metricsManager) OrderSubmitted(exchange string, a asset.Item, outcome string) {
+	m.orderSubmissions.add(1, exchange, a.String(), outcome)
+}
+
+// websocketDataHandler records the lag of websocket orderbook updates. It is
+// registered with the websocket routine manager and never consumes data
+func (m *metricsManager) websocketDataHandler(exchName string, data interface{}) error {
+	d, ok := data.(*orderbook.Depth)
+	if !ok {
+		return nil
+	}
+	// Invalid books are not measured, their errors are handled by the
+	// default data handler
+	updated, err := d.LastUpdated()
+	if err != nil || updated.IsZero() {
+		return nil //nolint:nilerr // see above
+	}
+	a, err := d.GetAsset()
+	if err != nil {
+		return nil //nolint:nilerr // see above
+	}
+	m.orderbookUpdateLag.observe(max(time.Since(updated).Seconds(), 0), exchName, a.String())
+	return nil
+}
+
+// collect updates metrics which are sampled at scrape time
+func (m *metricsManager) collect() {
+	s := dispatch.GetStatistics()
+	var running float64
+	if s.Running {
+		running = 1
+	}
+	m.dispatchRunning.set(running)
+	m.dispatchWorkers.set(float64(s.Workers))
+	m.dispatchQueueDepth.set(float64(s.QueueDepth))
+	m.dispatchQueueCapacity.set(float64(s.QueueCapacity))
+	m.dispatchSubscribers.set(float64(s.Subscribers))
+	m.dispatchDroppedJobs.set(float64(s.DroppedJobs))
+}
+
+// ServeHTTP writes all metrics in the OpenMetrics format when requested by
+// the scraper, otherwise in the Prometheus text format
+func (m *metricsManager) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.collect()
+	openMetrics := strings.Contains(r.Header.Get("Accept"), "application/openmetrics-text")
+	if openMetrics {
+		w.Header().Set("Content-Type", contentTypeOpenMetrics)
+	} else {
+		w.Header().Set("Content-Type", contentTypePrometheus)
+	}
+	if err := m.write(w, openMetrics); err != nil {
+		log.Errorf(log.Global, "Metrics manager unable to write metrics: %v", err)
+	}
+}
+
+// write writes every registered metric family
+func (m *metricsManager) write(w io.Writer, openMetrics bool) error {
+	b := bufio.NewWriter(w)
+	for i := range m.families {
+		m.families[i].write(b, openMetrics)
+	}
+	if openMetrics {
+		if _, err := b.WriteString("# EOF\n"); err != nil {
+			return err
+		}
+	}
+	return b.Flush()
+}
+
+func (m *metricsManager) register(name, help string, kind metricType, buckets []float64, labels ...string) *metricFamily {
+	f := &metricFamily{
+		name:    name,
+		help:    help,
+		kind:    kind,
+		labels:  labels,
+		buckets: buckets,
+		series:  make(map[string]*metricSeries),
+	}
+	m.families = append(m.families, f)
+	return f
+}
+
+// endpointLabel reduces a request URL to its host and path, replacing numeric
+// and UUID path segments so that the number of series remains bounded
+func endpointLabel(path string) string {
+	u, err := url.Parse(path)
+	if err != nil {
+		return "unknown"
+	}
+	segments := strings.Split(u.Path, "/")
+	for i := range segments {
+		if isIdentifier(segments[i]) {
+			segments[i] = ":id"
+		}
+	}
+	return u.Host + strings.Join(segments, "/")
+}
+
+func isIdentifier(segment string) bool {
+	if segment == "" {
+		return false
+	}
+	if _, err := uuid.FromString(segment); err == nil {
+		return true
+	}
+	for _, r := range segment {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// add increments a counter or gauge series
+func (f *metricFamily) add(v float64, labelValues ...string) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	if s := f.getSeries(labelValues); s != nil {
+		s.value += v
+	}
+}
+
+// set sets a gauge series, or a counter series sampled from a cumulative
+// total
+func (f *metricFamily) set(v float64, labelValues ...string) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	if s := f.getSeries(labelValues); s != nil {
+		s.value = v
+	}
+}
+
+// observe records a value in a histogram series
+func (f *metricFamily) observe(v float64, labelValues ...string) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	s := f.getSeries(labelValues)
+	if s == nil {
+		return
+	}
+	s.value += v
+	s.count++
+	if i := sort.SearchFloat64s(f.buckets, v); i < len(f.buckets) {
+		s.counts[i]++
+	}
+}
+
+// getSeries returns the series for the label values, creating it if needed.
+// It returns nil if the label values do not match the family's labels
+func (f *metricFamily) getSeries(labelValues []string) *metricSeries {
+	if len(labelValues) != len(f.labels) {
+		log.Errorf(log.Global, "%s %v: %v", f.name, errMetricLabelMismatch, labelValues)
+		return nil
+	}
+	k := strings.Join(labelValues, "\xff")
+	s, ok := f.series[k]
+	if !ok {
+		s = &metricSeries{labelValues: append([]string(nil), labelValues...)}
+		if f.kind == metricHistogram {
+			s.counts = make([]uint64, len(f.buckets))
+		}
+		f.series[k] = s
+	}
+	return s
+}
+
+// write writes the family's metadata and series sorted by label values.
+// Errors are returned by the buffered writer when it is flushed
+func (f *metricFamily) write(w *bufio.Writer, openMetrics bool) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	if len(f.series) == 0 {
+		return
+	}
+	name := f.name
+	if f.kind == metricCounter && !openMetrics {
+		name += "_total"
+	}
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, f.help, name, f.kind)
+
+	keys := make([]string, 0, len(f.series))
+	for k := range f.series {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		s := f.series[k]
+		switch f.kind {
+		case metricCounter:
+			writeSample(w, f.name+"_total", f.labels, s.labelValues, "", "", s.value)
+		case metricGauge:
+			writeSample(w, f.name, f.labels, s.labelValues, "", "", s.value)
+		case metricHistogram:
+			var cumulative uint64
+			for i := range f.buckets {
+				cumulative += s.counts[i]
+				writeSample(w, f.name+"_bucket", f.labels, s.labelValues, "le", formatMetricValue(f.buckets[i]), float64(cumulative))
+			}
+			writeSample(w, f.name+"_bucket", f.labels, s.labelValues, "le", "+Inf", float64(s.count))
+			writeSample(w, f.name+"_sum", f.labels, s.labelValues, "", "", s.value)
+			writeSample(w, f.name+"_count", f.labels, s.labelValues, "", "", float64(s.count))
+		}
+	}
+}
+
+// writeSample writes a single sample line with an optional extra label
+func writeSample(w *bufio.Writer, name string, labels, labelValues []string, extraLabel, extraValue string, v float64) {
+	w.WriteString(name)
+	if len(labels) > 0 || extraLabel != "" {
+		w.WriteByte('{')
+		for i := range labels {
+			if i > 0 {
+				w.WriteByte(',')
+			}
+			w.WriteString(labels[i])
+			w.WriteString(`="`)
+			w.WriteString(escapeLabelValue(labelValues[i]))
+			w.WriteByte('"')
+		}
+		if extraLabel != "" {
+			if len(labels) > 0 {
+				w.WriteByte(',')
+			}
+			w.WriteString(extraLabel)
+			w.WriteString(`="`)
+			w.WriteString(extraValue)
+			w.WriteByte('"')
+		}
+		w.WriteByte('}')
+	}
+	w.WriteByte(' ')
+	w.WriteString(formatMetricValue(v))
+	w.WriteByte('\n')
+}
+
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escapeLabelValue(v string) string {
+	return labelValueEscaper.Replace(v)
+}
+
+func formatMetricValue(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
@@ -0,0 +1,233 @@
+package engine
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
+)
+
+func TestSetupMetricsManager(t *testing.T) {
+	t.Parallel()
+	_, err := setupMetricsManager(nil)
+	assert.ErrorIs(t, err, errNilConfig)
+
+	m, err := setupMetricsManager(&config.MetricsConfig{ListenAddress: "localhost:0"})
+	require.NoError(t, err)
+	assert.Equal(t, "localhost:0", m.listenAddress)
+	assert.NotEmpty(t, m.families)
+}
+
+func TestMetricsManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *metricsManager
+	assert.ErrorIs(t, m.Start(), ErrNilSubsystem)
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem)
+	assert.False(t, m.IsRunning())
+
+	m, err := setupMetricsManager(&config.MetricsConfig{ListenAddress: "localhost:0"})
+	require.NoError(t, err)
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+	require.NoError(t, m.Start())
+	assert.True(t, m.IsRunning())
+	assert.ErrorIs(t, m.Start(), ErrSubSystemAlreadyStarted)
+	assert.NoError(t, m.Stop())
+	assert.False(t, m.IsRunning())
+
+	m.listenAddress = "invalid address"
+	assert.Error(t, m.Start())
+	assert.False(t, m.IsRunning(), "a failed start should not leave the manager running")
+}
+
+func TestMetricsManagerServeHTTP(t *testing.T) {
+	t.Parallel()
+	m, err := setupMetricsManager(&config.MetricsConfig{ListenAddress: "localhost:0"})
+	require.NoError(t, err)
+	m.requestReporter().Latency(testExchange, http.MethodGet, "https://www.bitstamp.net/api/v2/order_status/1234", time.Millisecond*20)
+	m.OrderSubmitted(testExchange, asset.Spot, orderSubmissionAccepted)
+	m.websocketReporter().(stream.ConnectionReporter).MessageReceived(testExchange, 42)
+
+	require.NoError(t, m.Start())
+	t.Cleanup(func() { assert.NoError(t, m.Stop()) })
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "http://"+m.server.Addr+metricsPath, http.NoBody)
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, contentTypePrometheus, resp.Header.Get("Content-Type"))
+	assert.Contains(t, string(body), "# TYPE gct_order_submissions_total counter\n")
+	assert.Contains(t, string(body), `gct_order_submissions_total{exchange="Bitstamp",asset="spot",outcome="accepted"} 1`)
+	assert.Contains(t, string(body), `gct_exchange_request_duration_seconds_bucket{exchange="Bitstamp",method="GET",endpoint="www.bitstamp.net/api/v2/order_status/:id",le="0.025"} 1`)
+	assert.Contains(t, string(body), `gct_websocket_received_bytes_total{exchange="Bitstamp"} 42`)
+	assert.Contains(t, string(body), "gct_dispatch_running ")
+	assert.NotContains(t, string(body), "# EOF")
+
+	req.Header.Set("Accept", "application/openmetrics-text; version=1.0.0")
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	body, err = io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, contentTypeOpenMetrics, resp.Header.Get("Content-Type"))
+	assert.Contains(t, string(body), "# TYPE gct_order_submissions counter\n", "OpenMetrics counter families should not have a _total suffix")
+	assert.True(t, strings.HasSuffix(string(body), "# EOF\n"))
+}
+
+func TestMetricFamilyWrite(t *testing.T) {
+	t.Parallel()
+	m := &metricsManager{}
+	h := m.register("test_histogram", "help", metricHistogram, []float64{1, 5}, "label")
+	h.observe(0.5, `a"b`)
+	h.observe(3, `a"b`)
+	h.observe(10, `a"b`)
+	h.observe(1, "wrong", "number of labels")
+	g := m.register("test_gauge", "help", metricGauge, nil)
+	g.set(math.Inf(1))
+	m.register("test_empty", "help", metricCounter, nil)
+
+	var b bytes.Buffer
+	require.NoError(t, m.write(&b, false))
+	assert.Equal(t, `# HELP test_histogram help
+# TYPE test_histogram histogram
+test_histogram_bucket{label="a\"b",le="1"} 1
+test_histogram_bucket{label="a\"b",le="5"} 2
+test_histogram_bucket{label="a\"b",le="+Inf"} 3
+test_histogram_sum{label="a\"b"} 13.5
+test_histogram_count{label="a\"b"} 3
+# HELP test_gauge help
+# TYPE test_gauge gauge
+test_gauge +Inf
+`, b.String(), "families without series should not be written")
+}
+
+func TestEndpointLabel(t *testing.T) {
+	t.Parallel()
+	for path, exp := range map[string]string{
+		"https://api.exchange.com/v1/orders?symbol=BTCUSD":                        "api.exchange.com/v1/orders",
+		"https://api.exchange.com/v1/orders/12345/cancel":                         "api.exchange.com/v1/orders/:id/cancel",
+		"https://api.exchange.com/v1/orders/6ba7b810-9dad-11d1-80b4-00c04fd430c8": "api.exchange.com/v1/orders/:id",
+		"https://api.exchange.com/v1/orders/BTC-USD":                              "api.exchange.com/v1/orders/BTC-USD",
+		"://bad": "unknown",
+	} {
+		assert.Equal(t, exp, endpointLabel(path), path)
+	}
+}
+
+func TestMetricsReporters(t *testing.T) {
+	t.Parallel()
+	m, err := setupMetricsManager(&config.MetricsConfig{})
+	require.NoError(t, err)
+	r, ok := m.requestReporter().(request.RateLimitReporter)
+	require.True(t, ok, "request reporter should report rate limiter waits")
+	r.RateLimitWait(testExchange, time.Second)
+	assert.Equal(t, uint64(1), m.rateLimitWait.series[testExchange].count)
+
+	w, ok := m.websocketReporter().(stream.ConnectionReporter)
+	require.True(t, ok, "websocket reporter should report connection events")
+	w.Reconnected(testExchange)
+	w.MessageReceived(testExchange, 10)
+	w.MessageReceived(testExchange, 5)
+	assert.Equal(t, 1.0, m.websocketReconnections.series[testExchange].value)
+	assert.Equal(t, 2.0, m.websocketMessages.series[testExchange].value)
+	assert.Equal(t, 15.0, m.websocketBytes.series[testExchange].value)
+	m.websocketReporter().Latency(testExchange, nil, time.Millisecond)
+	assert.Equal(t, uint64(1), m.websocketRequestDuration.series[testExchange].count)
+}
+
+func TestMetricsWebsocketDataHandler(t *testing.T) {
+	t.Parallel()
+	m, err := setupMetricsManager(&config.MetricsConfig{})
+	require.NoError(t, err)
+	assert.NoError(t, m.websocketDataHandler(testExchange, "not an orderbook"))
+
+	id, err := uuid.NewV4()
+	require.NoError(t, err)
+	d := orderbook.NewDepth(id)
+	d.AssignOptions(&orderbook.Base{Exchange: testExchange, Pair: btcusdPair, Asset: asset.Spot})
+	assert.NoError(t, m.websocketDataHandler(testExchange, d))
+	assert.Empty(t, m.orderbookUpdateLag.series, "books without an update time should not be measured")
+
+	require.NoError(t, d.LoadSnapshot([]orderbook.Item{{Price: 1, Amount: 1}}, []orderbook.Item{{Price: 2, Amount: 1}}, 0, time.Now().Add(-time.Second), false))
+	assert.NoError(t, m.websocketDataHandler(testExchange, d))
+	s := m.orderbookUpdateLag.series[testExchange+"\xffspot"]
+	require.NotNil(t, s)
+	assert.Equal(t, uint64(1), s.count)
+	assert.GreaterOrEqual(t, s.value, 1.0)
+}
+
+type submissionExchange struct {
+	exchange.IBotExchange
+	err error
+}
+
+func (s *submissionExchange) GetName() string {
+	return testExchange
+}
+
+func (s *submissionExchange) CheckOrderExecutionLimits(asset.Item, currency.Pair, float64, float64, order.Type) error {
+	return nil
+}
+
+func (s *submissionExchange) CanTradePair(currency.Pair, asset.Item) error {
+	return nil
+}
+
+func (s *submissionExchange) SubmitOrder(_ context.Context, o *order.Submit) (*order.SubmitResponse, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return o.DeriveSubmitResponse("1337")
+}
+
+func TestOrderSubmissionOutcomes(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	exch := &submissionExchange{err: errExchange}
+	require.NoError(t, em.Add(exch))
+	var wg sync.WaitGroup
+	m, err := SetupOrderManager(em, &CommunicationManager{}, &wg, &config.OrderManager{})
+	require.NoError(t, err)
+	m.started = 1
+	metrics, err := setupMetricsManager(&config.MetricsConfig{})
+	require.NoError(t, err)
+	m.submissionReporter = metrics
+
+	o := &order.Submit{Exchange: testExchange, AssetType: asset.Spot, Type: order.Limit}
+	_, err = m.Submit(context.Background(), o)
+	assert.Error(t, err, "Submit should error on an invalid order")
+	o.Pair = btcusdPair
+	o.Side = order.Buy
+	o.Amount = 1
+	o.Price = 1
+	_, err = m.Submit(context.Background(), o)
+	assert.ErrorIs(t, err, errExchange)
+	exch.err = nil
+	_, err = m.Submit(context.Background(), o)
+	assert.NoError(t, err)
+
+	for _, outcome := range []string{orderSubmissionRejected, orderSubmissionFailed, orderSubmissionAccepted} {
+		s := metrics.orderSubmissions.series[testExchange+"\xffspot\xff"+outcome]
+		require.NotNil(t, s, outcome)
+		assert.Equal(t, 1.0, s.value, outcome)
+	}
+}
@@ -0,0 +1,99 @@
+package engine
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+)
+
+const (
+	// MetricsManagerName is an exported subsystem name
+	MetricsManagerName = "metrics"
+
+	metricsPath = "/metrics"
+
+	contentTypePrometheus  = "text/plain; version=0.0.4; charset=utf-8"
+	contentTypeOpenMetrics = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+
+	orderSubmissionAccepted = "accepted"
+	orderSubmissionRejected = "rejected"
+	orderSubmissionFailed   = "failed"
+)
+
+const (
+	metricCounter   metricType = "counter"
+	metricGauge     metricType = "gauge"
+	metricHistogram metricType = "histogram"
+)
+
+var (
+	errMetricLabelMismatch = errors.New("metric label values do not match label names")
+
+	// latencyBuckets are the upper bounds in seconds of request latency
+	// histograms
+	latencyBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+	// rateLimitBuckets are the upper bounds in seconds of rate limiter wait
+	// histograms, most requests should not wait at all
+	rateLimitBuckets = []float64{0.0001, 0.001, 0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+	// orderbookLagBuckets are the upper bounds in seconds of the time between
+	// an exchange's orderbook update time and its processing
+	orderbookLagBuckets = []float64{0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+)
+
+// metricsManager serves engine, exchange request and websocket telemetry in
+// the Prometheus text format
+type metricsManager struct {
+	started       int32
+	listenAddress string
+	server        *http.Server
+	wg            sync.WaitGroup
+
+	families                 []*metricFamily
+	requestDuration          *metricFamily
+	rateLimitWait            *metricFamily
+	websocketRequestDuration *metricFamily
+	websocketMessages        *metricFamily
+	websocketBytes           *metricFamily
+	websocketReconnections   *metricFamily
+	orderbookUpdateLag       *metricFamily
+	orderSubmissions         *metricFamily
+	dispatchRunning          *metricFamily
+	dispatchWorkers          *metricFamily
+	dispatchQueueDepth       *metricFamily
+	dispatchQueueCapacity    *metricFamily
+	dispatchSubscribers      *metricFamily
+	dispatchDroppedJobs      *metricFamily
+}
+
+// requestMetrics implements request.Reporter and request.RateLimitReporter
+type requestMetrics struct {
+	m *metricsManager
+}
+
+// websocketMetrics implements stream.Reporter and stream.ConnectionReporter
+type websocketMetrics struct {
+	m *metricsManager
+}
+
+// metricType is the type of a metric family as exposed to Prometheus
+type metricType string
+
+// metricFamily holds every labelled series of a single metric
+type metricFamily struct {
+	name    string
+	help    string
+	kind    metricType
+	labels  []string
+	buckets []float64
+	m       sync.Mutex
+	series  map[string]*metricSeries
+}
+
+// metricSeries is a single labelled value of a metric family. For histograms
+// value is the sum of observations and bucket counts are not cumulative
+type metricSeries struct {
+	labelValues []string
+	value       float64
+	counts      []uint64
+	count       uint64
+}
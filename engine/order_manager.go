@@ -13,9 +13,11 @@ import (
 	"github.com/gofrs/uuid"
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/tracing"
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
@@ -54,12 +56,27 @@ func SetupOrderManager(exchangeManager iExchangeManager, communicationsManager i
 			commsManager:              communicationsManager,
 			wg:                        wg,
 			futuresPositionController: futures.SetupPositionController(),
+			persistence: orderPersistence{
+				notify: make(chan struct{}, 1),
+			},
+		},
+		syntheticOrders: syntheticOrderStore{
+			orders:  make(map[string]*syntheticOrder),
+			pipes:   make(map[string]dispatch.Pipe),
+			updates: make(chan *syntheticMarketUpdate, syntheticUpdateBuffer),
 		},
 		verbose: cfg.Verbose,
 		cfg: orderManagerConfig{
 			CancelOrdersOnShutdown: cfg.CancelOrdersOnShutdown,
+			RiskLimits:             cfg.RiskLimits,
 		},
 	}
+	if om.cfg.RiskLimits.Currency.IsEmpty() {
+		om.cfg.RiskLimits.Currency = currency.USD
+	}
+	if cfg.RiskLimits.KillSwitch {
+		om.killSwitch = 1
+	}
 	if cfg.ActivelyTrackFuturesPositions {
 		if cfg.FuturesTrackingSeekDuration > 0 {
 			cfg.FuturesTrackingSeekDuration *= -1
@@ -86,9 +103,16 @@ func (m *OrderManager) Start() error {
 		return fmt.Errorf("order manager %w", ErrSubSystemAlreadyStarted)
 	}
 	log.Debugln(log.OrderMgr, "Order manager starting...")
+	restored, err := m.loadOrders()
+	if err != nil {
+		atomic.StoreInt32(&m.started, 0)
+		return err
+	}
 	m.shutdown = make(chan struct{})
-	m.orderStore.wg.Add(1)
-	go m.run()
+	m.orderStore.wg.Add(3)
+	go m.run(restored)
+	go m.runSyntheticOrders(m.shutdown)
+	go m.runPersistence(m.shutdown)
 	return nil
 }
 
@@ -121,13 +145,15 @@ func (m *OrderManager) gracefulShutdown() {
 }
 
 // run will periodically process orders
-func (m *OrderManager) run() {
+func (m *OrderManager) run(restored []order.Detail) {
 	log.Debugln(log.OrderMgr, "Order manager started.")
+	m.reconcileOrders(restored)
 	m.processOrders()
 	for {
 		select {
 		case <-m.shutdown:
 			m.gracefulShutdown()
+			m.orderStore.persistence.flush()
 			m.orderStore.wg.Done()
 			log.Debugln(log.OrderMgr, "Order manager shutdown.")
 			return
@@ -150,6 +176,7 @@ func (m *OrderManager) CancelAllOrders(ctx context.Context, exchanges []exchange
 	}
 
 	for i := range exchanges {
+		m.cancelSyntheticOrdersForExchange(ctx, exchanges[i].GetName())
 		orders, ok := allOrders[strings.ToLower(exchanges[i].GetName())]
 		if !ok {
 			continue
@@ -171,15 +198,17 @@ func (m *OrderManager) CancelAllOrders(ctx context.Context, exchanges []exchange
 
 // Cancel will find the order in the OrderManager, send a cancel request
 // to the exchange and if successful, update the status of the order
-func (m *OrderManager) Cancel(ctx context.Context, cancel *order.Cancel) error {
+func (m *OrderManager) Cancel(ctx context.Context, cancel *order.Cancel) (err error) {
 	if m == nil {
 		return fmt.Errorf("order manager %w", ErrNilSubsystem)
 	}
 	if atomic.LoadInt32(&m.started) == 0 {
 		return fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
 	}
-	var err error
+	ctx, span := tracing.StartSpan(ctx, "OrderManager.Cancel", tracing.SpanKindInternal)
 	defer func() {
+		span.RecordError(err)
+		span.End()
 		if err != nil {
 			m.orderStore.commsManager.PushEvent(base.Event{
 				Type:    "order",
@@ -200,6 +229,15 @@ func (m *OrderManager) Cancel(ctx context.Context, cancel *order.Cancel) error {
 		err = errors.New("order id is empty")
 		return err
 	}
+	span.SetAttributes(
+		tracing.String("exchange", cancel.Exchange),
+		tracing.String("asset", cancel.AssetType.String()),
+		tracing.String("order.id", cancel.OrderID))
+
+	if m.isSyntheticOrder(cancel.OrderID) {
+		err = m.cancelSyntheticOrder(ctx, cancel)
+		return err
+	}
 
 	exch, err := m.orderStore.exchangeManager.GetExchangeByName(cancel.Exchange)
 	if err != nil {
@@ -383,18 +421,25 @@ func (m *OrderManager) validate(newOrder *order.Submit) error {
 			return errors.New("order pair not found in allowed list")
 		}
 	}
-	return nil
+	return m.checkRiskLimits(newOrder)
 }
 
 // Modify depends on the order.Modify.ID and order.Modify.Exchange fields to uniquely
 // identify an order to modify.
-func (m *OrderManager) Modify(ctx context.Context, mod *order.Modify) (*order.ModifyResponse, error) {
+func (m *OrderManager) Modify(ctx context.Context, mod *order.Modify) (_ *order.ModifyResponse, err error) {
 	if m == nil {
 		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
 	}
 	if atomic.LoadInt32(&m.started) == 0 {
 		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
 	}
+	ctx, span := tracing.StartSpan(ctx, "OrderManager.Modify", tracing.SpanKindInternal,
+		tracing.String("exchange", mod.Exchange),
+		tracing.String("order.id", mod.OrderID))
+	defer func() {
+		span.RecordError(err)
+		span.End()
+	}()
 
 	// Fetch details from locally managed order store.
 	det, err := m.orderStore.getByExchangeAndID(mod.Exchange, mod.OrderID)
@@ -459,7 +504,7 @@ func (m *OrderManager) Modify(ctx context.Context, mod *order.Modify) (*order.Mo
 
 // Submit will take in an order struct, send it to the exchange and
 // populate it in the OrderManager if successful
-func (m *OrderManager) Submit(ctx context.Context, newOrder *order.Submit) (*OrderSubmitResponse, error) {
+func (m *OrderManager) Submit(ctx context.Context, newOrder *order.Submit) (_ *OrderSubmitResponse, err error) {
 	if m == nil {
 		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
 	}
@@ -467,7 +512,28 @@ func (m *OrderManager) Submit(ctx context.Context, newOrder *order.Submit) (*Ord
 		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
 	}
 
-	err := m.validate(newOrder)
+	ctx, span := tracing.StartSpan(ctx, "OrderManager.Submit", tracing.SpanKindInternal)
+	outcome := orderSubmissionRejected
+	defer func() {
+		span.RecordError(err)
+		span.End()
+		if m.submissionReporter != nil && newOrder != nil {
+			m.submissionReporter.OrderSubmitted(newOrder.Exchange, newOrder.AssetType, outcome)
+		}
+	}()
+	if newOrder != nil {
+		span.SetAttributes(
+			tracing.String("exchange", newOrder.Exchange),
+			tracing.String("asset", newOrder.AssetType.String()),
+			tracing.String("pair", newOrder.Pair.String()),
+			tracing.String("order.side", newOrder.Side.String()),
+			tracing.String("order.type", newOrder.Type.String()))
+	}
+
+	_, validateSpan := tracing.StartSpan(ctx, "OrderManager.validate", tracing.SpanKindInternal)
+	err = m.validate(newOrder)
+	validateSpan.RecordError(err)
+	validateSpan.End()
 	if err != nil {
 		return nil, err
 	}
@@ -500,9 +566,11 @@ func (m *OrderManager) Submit(ctx context.Context, newOrder *order.Submit) (*Ord
 
 	result, err := exch.SubmitOrder(ctx, newOrder)
 	if err != nil {
+		outcome = orderSubmissionFailed
 		return nil, err
 	}
 
+	outcome = orderSubmissionAccepted
 	return m.processSubmittedOrder(result)
 }
 
@@ -571,7 +639,11 @@ func (m *OrderManager) GetOrdersFiltered(f *order.Filter) ([]order.Detail, error
 	if atomic.LoadInt32(&m.started) == 0 {
 		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
 	}
-	return m.orderStore.getFilteredOrders(f)
+	orders, err := m.orderStore.getFilteredOrders(f)
+	if err != nil {
+		return nil, err
+	}
+	return append(orders, m.getSyntheticOrders(f, false)...), nil
 }
 
 // GetOrdersActive returns a snapshot of all orders in the order store
@@ -583,7 +655,7 @@ func (m *OrderManager) GetOrdersActive(f *order.Filter) ([]order.Detail, error)
 	if atomic.LoadInt32(&m.started) == 0 {
 		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
 	}
-	return m.orderStore.getActiveOrders(f), nil
+	return append(m.orderStore.getActiveOrders(f), m.getSyntheticOrders(f, true)...), nil
 }
 
 // processSubmittedOrder adds a new order to the manager
@@ -982,10 +1054,14 @@ func (s *store) updateExisting(od *order.Detail) error {
 		if r[x].OrderID != od.OrderID {
 			continue
 		}
+		lastUpdated, trades := r[x].LastUpdated, len(r[x].Trades)
 		err := r[x].UpdateOrderFromDetail(od)
 		if err != nil {
 			return err
 		}
+		if !r[x].LastUpdated.Equal(lastUpdated) {
+			s.persistence.queue(r[x], trades)
+		}
 		if !r[x].AssetType.IsFutures() {
 			return nil
 		}
@@ -1011,7 +1087,11 @@ func (s *store) modifyExisting(id string, mod *order.ModifyResponse) error {
 		if r[x].OrderID != id {
 			continue
 		}
+		lastUpdated := r[x].LastUpdated
 		r[x].UpdateOrderFromModifyResponse(mod)
+		if !r[x].LastUpdated.Equal(lastUpdated) {
+			s.persistence.queue(r[x], len(r[x].Trades))
+		}
 		if !r[x].AssetType.IsFutures() {
 			return nil
 		}
@@ -1050,10 +1130,14 @@ func (s *store) upsert(od *order.Detail) (*OrderUpsertResponse, error) {
 		if exchangeOrders[x].OrderID != od.OrderID {
 			continue
 		}
+		lastUpdated, trades := exchangeOrders[x].LastUpdated, len(exchangeOrders[x].Trades)
 		err := exchangeOrders[x].UpdateOrderFromDetail(od)
 		if err != nil {
 			return nil, err
 		}
+		if !exchangeOrders[x].LastUpdated.Equal(lastUpdated) {
+			s.persistence.queue(exchangeOrders[x], trades)
+		}
 		return &OrderUpsertResponse{
 			OrderDetails: exchangeOrders[x].Copy(),
 			IsNewOrder:   false,
@@ -1062,6 +1146,7 @@ func (s *store) upsert(od *order.Detail) (*OrderUpsertResponse, error) {
 	// Untracked websocket orders will not have internalIDs yet
 	od.GenerateInternalOrderID()
 	s.Orders[lName] = append(s.Orders[lName], od)
+	s.persistence.queue(od, 0)
 	return &OrderUpsertResponse{OrderDetails: od.Copy(), IsNewOrder: true}, nil
 }
 
@@ -1089,6 +1174,18 @@ func (s *store) getByDetail(det *order.Detail) *order.Detail {
 
 // Add Adds an order to the orderStore for tracking the lifecycle
 func (s *store) add(det *order.Detail) error {
+	return s.insert(det, true)
+}
+
+// restore adds an order loaded from the database to the orderStore without
+// recording it again
+func (s *store) restore(det *order.Detail) error {
+	return s.insert(det, false)
+}
+
+// insert adds an order to the orderStore and optionally records it in the
+// database
+func (s *store) insert(det *order.Detail, record bool) error {
 	if det == nil {
 		return errNilOrder
 	}
@@ -1111,6 +1208,9 @@ func (s *store) add(det *order.Detail) error {
 	// Untracked websocket orders will not have internalIDs yet
 	det.GenerateInternalOrderID()
 	s.Orders[name] = append(s.Orders[name], det)
+	if record {
+		s.persistence.queue(det, 0)
+	}
 	if !det.AssetType.IsFutures() {
 		return nil
 	}
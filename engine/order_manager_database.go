@@ -0,0 +1,372 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	dbfill "github.com/thrasher-corp/gocryptotrader/database/repository/fill"
+	dborder "github.com/thrasher-corp/gocryptotrader/database/repository/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// openOrderStatuses are the stored order statuses which are restored to the
+// order store on startup
+var openOrderStatuses = []string{
+	order.Active.String(),
+	order.Open.String(),
+	order.PartiallyFilled.String(),
+	order.New.String(),
+	order.PendingCancel.String(),
+	order.Hidden.String(),
+	order.AutoDeleverage.String(),
+	order.Pending.String(),
+}
+
+// SetDatabaseManager enables the persistence of orders and fills when the
+// database is connected. It must be set before the order manager is started
+func (m *OrderManager) SetDatabaseManager(dcm iDatabaseConnectionManager) error {
+	if m == nil {
+		return fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if dcm == nil {
+		return errNilDatabaseConnectionManager
+	}
+	if atomic.LoadInt32(&m.started) == 1 {
+		return fmt.Errorf("order manager %w", ErrSubSystemAlreadyStarted)
+	}
+	orderDB, err := dborder.Setup(dcm.GetInstance())
+	if err != nil {
+		return err
+	}
+	fillDB, err := dbfill.Setup(dcm.GetInstance())
+	if err != nil {
+		return err
+	}
+	if orderDB == nil || fillDB == nil {
+		return nil
+	}
+	m.orderStore.persistence.orderDB = orderDB
+	m.orderStore.persistence.fillDB = fillDB
+	return nil
+}
+
+// RecordFills queues fills to be stored in the database when database
+// persistence is available
+func (m *OrderManager) RecordFills(fills []fill.Data) error {
+	if m == nil {
+		return fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	m.orderStore.persistence.queueFills(fills)
+	return nil
+}
+
+// loadOrders adds open orders stored in the database to the order store and
+// returns them so they can be reconciled against the exchange
+func (m *OrderManager) loadOrders() ([]order.Detail, error) {
+	if m.orderStore.persistence.orderDB == nil {
+		return nil, nil
+	}
+	stored, err := m.orderStore.persistence.orderDB.GetByStatus(openOrderStatuses...)
+	if err != nil {
+		return nil, fmt.Errorf("order manager could not load orders: %w", err)
+	}
+	restored := make([]order.Detail, 0, len(stored))
+	for i := range stored {
+		det, err := orderFromDB(&stored[i])
+		if err != nil {
+			log.Errorf(log.OrderMgr, "Order manager: %s order %s could not be restored: %v", stored[i].Exchange, stored[i].OrderID, err)
+			continue
+		}
+		err = m.orderStore.restore(det)
+		if err != nil {
+			log.Warnf(log.OrderMgr, "Order manager: %s order %s could not be restored: %v", det.Exchange, det.OrderID, err)
+			continue
+		}
+		restored = append(restored, det.Copy())
+	}
+	if len(restored) > 0 {
+		log.Infof(log.OrderMgr, "Order manager: restored %d open order(s) from the database", len(restored))
+	}
+	return restored, nil
+}
+
+// reconcileOrders checks restored orders against the exchange's active orders.
+// Orders which are no longer active are updated via GetOrderInfo where
+// supported, otherwise they are marked as closed
+func (m *OrderManager) reconcileOrders(restored []order.Detail) {
+	grouped := make(map[string]map[asset.Item][]order.Detail)
+	for i := range restored {
+		assets, ok := grouped[restored[i].Exchange]
+		if !ok {
+			assets = make(map[asset.Item][]order.Detail)
+			grouped[restored[i].Exchange] = assets
+		}
+		assets[restored[i].AssetType] = append(assets[restored[i].AssetType], restored[i])
+	}
+	for exchName, assets := range grouped {
+		exch, err := m.orderStore.exchangeManager.GetExchangeByName(exchName)
+		if err != nil {
+			log.Errorln(log.OrderMgr, err)
+			continue
+		}
+		if !exch.IsRESTAuthenticationSupported() {
+			continue
+		}
+		canGetOrder := exch.GetBase().GetSupportedFeatures().RESTCapabilities.GetOrder
+		for a, orders := range assets {
+			var pairs currency.Pairs
+			for i := range orders {
+				pairs = pairs.Add(orders[i].Pair)
+			}
+			active, err := exch.GetActiveOrders(context.TODO(), &order.MultiOrderRequest{
+				Side:      order.AnySide,
+				Type:      order.AnyType,
+				Pairs:     pairs,
+				AssetType: a,
+			})
+			if err != nil {
+				log.Errorf(log.OrderMgr, "Unable to reconcile restored orders for %s and asset type %s: %s", exchName, a, err)
+				continue
+			}
+			for i := range active {
+				if _, err = m.UpsertOrder(&active[i]); err != nil {
+					log.Errorln(log.OrderMgr, err)
+					continue
+				}
+				for j := range orders {
+					if orders[j].OrderID != active[i].OrderID {
+						continue
+					}
+					orders[j] = orders[len(orders)-1]
+					orders = orders[:len(orders)-1]
+					break
+				}
+			}
+			for i := range orders {
+				if canGetOrder {
+					if err = m.FetchAndUpdateExchangeOrder(exch, &orders[i], a); err == nil {
+						continue
+					}
+					log.Errorf(log.OrderMgr, "Unable to reconcile restored order %s for %s: %s", orders[i].OrderID, exchName, err)
+				}
+				closed := orders[i].Copy()
+				closed.Status = order.Closed
+				closed.LastUpdated = time.Now()
+				if err = m.orderStore.updateExisting(&closed); err != nil {
+					log.Errorln(log.OrderMgr, err)
+				}
+			}
+		}
+	}
+}
+
+// runPersistence writes queued orders and fills to the database until
+// shutdown
+func (m *OrderManager) runPersistence(shutdown <-chan struct{}) {
+	defer m.orderStore.wg.Done()
+	for {
+		select {
+		case <-shutdown:
+			return
+		case <-m.orderStore.persistence.notify:
+			m.orderStore.persistence.flush()
+		}
+	}
+}
+
+// queue records the state of an order along with its trades from index trades
+// onwards, which are stored as fills. Callers must hold the store lock
+func (p *orderPersistence) queue(det *order.Detail, trades int) {
+	if p.orderDB == nil {
+		return
+	}
+	p.m.Lock()
+	p.orders = append(p.orders, orderToDB(det))
+	for i := trades; i < len(det.Trades); i++ {
+		p.fills = append(p.fills, tradeToDB(det, &det.Trades[i]))
+	}
+	p.m.Unlock()
+	p.wake()
+}
+
+// queueFills records fills received from an exchange
+func (p *orderPersistence) queueFills(fills []fill.Data) {
+	if p.fillDB == nil || len(fills) == 0 {
+		return
+	}
+	p.m.Lock()
+	for i := range fills {
+		p.fills = append(p.fills, fillToDB(&fills[i]))
+	}
+	p.m.Unlock()
+	p.wake()
+}
+
+// wake notifies the persistence worker without blocking
+func (p *orderPersistence) wake() {
+	select {
+	case p.notify <- struct{}{}:
+	default:
+	}
+}
+
+// flush writes all queued orders and fills to the database
+func (p *orderPersistence) flush() {
+	p.write.Lock()
+	defer p.write.Unlock()
+	p.m.Lock()
+	orders, fills := p.orders, p.fills
+	p.orders, p.fills = nil, nil
+	p.m.Unlock()
+	for i := range orders {
+		if err := p.orderDB.Upsert(orders[i]); err != nil {
+			log.Errorf(log.OrderMgr, "Order manager: %s order %s could not be stored: %v", orders[i].Exchange, orders[i].OrderID, err)
+		}
+	}
+	if len(fills) == 0 {
+		return
+	}
+	if err := p.fillDB.Insert(fills...); err != nil {
+		log.Errorf(log.OrderMgr, "Order manager: %d fill(s) could not be stored: %v", len(fills), err)
+	}
+}
+
+func orderToDB(d *order.Detail) *dborder.Order {
+	return &dborder.Order{
+		Exchange:             d.Exchange,
+		OrderID:              d.OrderID,
+		ClientOrderID:        d.ClientOrderID,
+		Base:                 d.Pair.Base.String(),
+		Quote:                d.Pair.Quote.String(),
+		AssetType:            d.AssetType.String(),
+		Side:                 d.Side.String(),
+		Type:                 d.Type.String(),
+		Status:               d.Status.String(),
+		Price:                d.Price,
+		Amount:               d.Amount,
+		ExecutedAmount:       d.ExecutedAmount,
+		RemainingAmount:      d.RemainingAmount,
+		AverageExecutedPrice: d.AverageExecutedPrice,
+		Fee:                  d.Fee,
+		FeeAsset:             d.FeeAsset.String(),
+		Cost:                 d.Cost,
+		Date:                 d.Date,
+		LastUpdated:          d.LastUpdated,
+	}
+}
+
+func orderFromDB(d *dborder.Order) (*order.Detail, error) {
+	a, err := asset.New(d.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	side, err := order.StringToOrderSide(d.Side)
+	if err != nil {
+		return nil, err
+	}
+	orderType, err := order.StringToOrderType(d.Type)
+	if err != nil {
+		return nil, err
+	}
+	status, err := order.StringToOrderStatus(d.Status)
+	if err != nil {
+		return nil, err
+	}
+	return &order.Detail{
+		Exchange:             d.Exchange,
+		OrderID:              d.OrderID,
+		ClientOrderID:        d.ClientOrderID,
+		Pair:                 currency.NewPair(currency.NewCode(d.Base), currency.NewCode(d.Quote)),
+		AssetType:            a,
+		Side:                 side,
+		Type:                 orderType,
+		Status:               status,
+		Price:                d.Price,
+		Amount:               d.Amount,
+		ExecutedAmount:       d.ExecutedAmount,
+		RemainingAmount:      d.RemainingAmount,
+		AverageExecutedPrice: d.AverageExecutedPrice,
+		Fee:                  d.Fee,
+		FeeAsset:             currency.NewCode(d.FeeAsset),
+		Cost:                 d.Cost,
+		Date:                 d.Date,
+		LastUpdated:          d.LastUpdated,
+	}, nil
+}
+
+func fillToDB(f *fill.Data) *dbfill.Fill {
+	return &dbfill.Fill{
+		Exchange:      f.Exchange,
+		OrderID:       f.OrderID,
+		ClientOrderID: f.ClientOrderID,
+		TradeID:       f.TradeID,
+		Base:          f.CurrencyPair.Base.String(),
+		Quote:         f.CurrencyPair.Quote.String(),
+		AssetType:     f.AssetType.String(),
+		Side:          f.Side.String(),
+		Price:         f.Price,
+		Amount:        f.Amount,
+		Timestamp:     f.Timestamp,
+	}
+}
+
+// tradeToDB converts a trade returned with an order's details to a fill
+func tradeToDB(d *order.Detail, t *order.TradeHistory) *dbfill.Fill {
+	side := t.Side
+	if side == order.UnknownSide || side == order.AnySide {
+		side = d.Side
+	}
+	timestamp := t.Timestamp
+	if timestamp.IsZero() {
+		timestamp = d.LastUpdated
+	}
+	feeAsset := t.FeeAsset
+	if feeAsset == "" {
+		feeAsset = d.FeeAsset.String()
+	}
+	return &dbfill.Fill{
+		Exchange:      d.Exchange,
+		OrderID:       d.OrderID,
+		ClientOrderID: d.ClientOrderID,
+		TradeID:       t.TID,
+		Base:          d.Pair.Base.String(),
+		Quote:         d.Pair.Quote.String(),
+		AssetType:     d.AssetType.String(),
+		Side:          side.String(),
+		Price:         t.Price,
+		Amount:        t.Amount,
+		Fee:           t.Fee,
+		FeeAsset:      feeAsset,
+		Timestamp:     timestamp,
+	}
+}
+
+func tradesFromFills(fills []dbfill.Fill) []order.TradeHistory {
+	trades := make([]order.TradeHistory, len(fills))
+	for i := range fills {
+		side, err := order.StringToOrderSide(fills[i].Side)
+		if err != nil {
+			side = order.UnknownSide
+		}
+		trades[i] = order.TradeHistory{
+			Price:     fills[i].Price,
+			Amount:    fills[i].Amount,
+			Fee:       fills[i].Fee,
+			FeeAsset:  fills[i].FeeAsset,
+			Exchange:  fills[i].Exchange,
+			TID:       fills[i].TradeID,
+			Side:      side,
+			Timestamp: fills[i].Timestamp,
+		}
+	}
+	return trades
+}
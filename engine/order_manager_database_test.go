@@ -0,0 +1,221 @@
+package engine
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	dborder "github.com/thrasher-corp/gocryptotrader/database/repository/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
+)
+
+func TestOrderDatabaseConversion(t *testing.T) {
+	t.Parallel()
+	d := &order.Detail{
+		Exchange:             testExchange,
+		OrderID:              "1337",
+		ClientOrderID:        "client",
+		Pair:                 btcusdPair,
+		AssetType:            asset.Spot,
+		Side:                 order.Buy,
+		Type:                 order.Limit,
+		Status:               order.PartiallyFilled,
+		Price:                1337,
+		Amount:               2,
+		ExecutedAmount:       1,
+		RemainingAmount:      1,
+		AverageExecutedPrice: 1336,
+		Fee:                  0.1,
+		FeeAsset:             currency.BTC,
+		Cost:                 1336,
+		Date:                 time.Now().Add(-time.Minute),
+		LastUpdated:          time.Now(),
+	}
+	restored, err := orderFromDB(orderToDB(d))
+	require.NoError(t, err)
+	assert.Equal(t, d, restored, "order should survive a database round trip")
+
+	stored := orderToDB(d)
+	stored.Side = "sideways"
+	_, err = orderFromDB(stored)
+	assert.ErrorIs(t, err, order.ErrSideIsInvalid)
+
+	f := fillToDB(&fill.Data{
+		Exchange:     testExchange,
+		CurrencyPair: btcusdPair,
+		AssetType:    asset.Spot,
+		Side:         order.Sell,
+		OrderID:      "1337",
+		TradeID:      "1",
+	})
+	assert.Equal(t, "BTC", f.Base)
+	assert.Equal(t, "USD", f.Quote)
+	assert.Equal(t, order.Sell.String(), f.Side)
+}
+
+func TestOrderManagerDatabase(t *testing.T) {
+	engerino := RPCTestSetup(t)
+	defer CleanRPCTest(t, engerino)
+
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err)
+	exch.SetDefaults()
+	exch.GetBase().API.AuthenticatedSupport = true
+	exch.GetBase().Features.Supports.RESTCapabilities = protocol.Features{GetOrder: true}
+	require.NoError(t, em.Add(omfExchange{IBotExchange: exch}))
+
+	var wg sync.WaitGroup
+	m, err := SetupOrderManager(em, &CommunicationManager{}, &wg, &config.OrderManager{})
+	require.NoError(t, err)
+	assert.ErrorIs(t, m.SetDatabaseManager(nil), errNilDatabaseConnectionManager)
+	require.NoError(t, m.SetDatabaseManager(engerino.DatabaseManager))
+	require.NotNil(t, m.orderStore.persistence.orderDB, "order database service should be set")
+	require.NotNil(t, m.orderStore.persistence.fillDB, "fill database service should be set")
+	m.started = 1
+	assert.ErrorIs(t, m.SetDatabaseManager(engerino.DatabaseManager), ErrSubSystemAlreadyStarted)
+
+	for _, id := range []string{"Order2-active-to-inactive", "Order3-unknown-to-active", "restored"} {
+		require.NoError(t, m.Add(&order.Detail{
+			Exchange:    testExchange,
+			OrderID:     id,
+			Pair:        btcusdPair,
+			AssetType:   asset.Spot,
+			Side:        order.Buy,
+			Type:        order.Limit,
+			Status:      order.New,
+			Price:       1337,
+			Amount:      1,
+			Date:        time.Now(),
+			LastUpdated: time.Now().Add(-time.Hour),
+		}))
+	}
+	require.NoError(t, m.UpdateExistingOrder(&order.Detail{
+		Exchange:       testExchange,
+		OrderID:        "restored",
+		Status:         order.PartiallyFilled,
+		ExecutedAmount: 0.5,
+		LastUpdated:    time.Now(),
+		Trades: []order.TradeHistory{{
+			TID:       "rest",
+			Price:     1337,
+			Amount:    0.5,
+			Fee:       0.01,
+			FeeAsset:  "BNB",
+			Timestamp: time.Now(),
+		}},
+	}))
+	transitions, err := m.orderStore.persistence.orderDB.GetTransitions(testExchange, "restored")
+	require.NoError(t, err)
+	assert.Empty(t, transitions, "orders should not be written until the queue is flushed")
+
+	m.orderStore.persistence.flush()
+	transitions, err = m.orderStore.persistence.orderDB.GetTransitions(testExchange, "restored")
+	require.NoError(t, err)
+	require.Len(t, transitions, 2)
+	assert.Equal(t, order.New.String(), transitions[0].Status)
+	assert.Equal(t, order.PartiallyFilled.String(), transitions[1].Status)
+
+	require.NoError(t, m.RecordFills([]fill.Data{{
+		Exchange:     testExchange,
+		OrderID:      "restored",
+		TradeID:      "1",
+		CurrencyPair: btcusdPair,
+		AssetType:    asset.Spot,
+		Side:         order.Buy,
+		Price:        1337,
+		Amount:       0.5,
+		Timestamp:    time.Now(),
+	}}))
+	m.orderStore.persistence.flush()
+	fills, err := m.orderStore.persistence.fillDB.GetByOrderID(testExchange, "restored")
+	require.NoError(t, err)
+	require.Len(t, fills, 2, "REST trades and websocket fills should both be stored")
+	for i := range fills {
+		if fills[i].TradeID != "rest" {
+			continue
+		}
+		assert.Equal(t, order.Buy.String(), fills[i].Side, "trade side should default to the order side")
+		assert.Equal(t, 0.01, fills[i].Fee)
+		assert.Equal(t, "BNB", fills[i].FeeAsset)
+	}
+
+	// A new order manager should restore the open orders and reconcile them
+	m, err = SetupOrderManager(em, &CommunicationManager{}, &wg, &config.OrderManager{})
+	require.NoError(t, err)
+	require.NoError(t, m.SetDatabaseManager(engerino.DatabaseManager))
+	restored, err := m.loadOrders()
+	require.NoError(t, err)
+	require.Len(t, restored, 3)
+	o, err := m.orderStore.getByExchangeAndID(testExchange, "restored")
+	require.NoError(t, err)
+	assert.Equal(t, order.PartiallyFilled, o.Status)
+	assert.Equal(t, 0.5, o.ExecutedAmount)
+	assert.True(t, o.Pair.Equal(btcusdPair))
+
+	m.started = 1
+	m.reconcileOrders(restored)
+	o, err = m.orderStore.getByExchangeAndID(testExchange, "Order3-unknown-to-active")
+	require.NoError(t, err)
+	assert.Equal(t, order.Active, o.Status, "order returned by GetActiveOrders should be updated")
+	o, err = m.orderStore.getByExchangeAndID(testExchange, "Order2-active-to-inactive")
+	require.NoError(t, err)
+	assert.Equal(t, order.Cancelled, o.Status, "missing order should be updated from GetOrderInfo")
+
+	// Without GetOrderInfo support missing orders are closed
+	exch.GetBase().Features.Supports.RESTCapabilities.GetOrder = false
+	require.NoError(t, m.Add(&order.Detail{
+		Exchange:  testExchange,
+		OrderID:   "closed",
+		Pair:      btcusdPair,
+		AssetType: asset.Spot,
+		Side:      order.Buy,
+		Type:      order.Limit,
+		Status:    order.Open,
+		Amount:    1,
+	}))
+	o, err = m.orderStore.getByExchangeAndID(testExchange, "closed")
+	require.NoError(t, err)
+	m.reconcileOrders([]order.Detail{*o})
+	o, err = m.orderStore.getByExchangeAndID(testExchange, "closed")
+	require.NoError(t, err)
+	assert.Equal(t, order.Closed, o.Status)
+
+	// The persistence worker writes queued orders in the background
+	shutdown := make(chan struct{})
+	wg.Add(1)
+	go m.runPersistence(shutdown)
+	m.orderStore.persistence.wake()
+	var open []dborder.Order
+	assert.Eventually(t, func() bool {
+		open, err = m.orderStore.persistence.orderDB.GetByStatus(openOrderStatuses...)
+		return err == nil && len(open) == 1
+	}, time.Second*5, time.Millisecond*10)
+	require.Len(t, open, 1)
+	assert.Equal(t, "Order3-unknown-to-active", open[0].OrderID)
+	close(shutdown)
+	wg.Wait()
+}
+
+func TestRecordFills(t *testing.T) {
+	t.Parallel()
+	var m *OrderManager
+	err := m.RecordFills(nil)
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	m, err = SetupOrderManager(NewExchangeManager(), &CommunicationManager{}, &sync.WaitGroup{}, &config.OrderManager{})
+	require.NoError(t, err)
+	err = m.RecordFills(nil)
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+
+	m.started = 1
+	assert.NoError(t, m.RecordFills([]fill.Data{{Exchange: testExchange}}), "fills should be ignored without a database")
+	assert.Empty(t, m.orderStore.persistence.fills)
+}
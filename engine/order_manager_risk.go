@@ -0,0 +1,371 @@
+package engine
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetKillSwitch engages or releases the risk kill switch. While engaged all
+// new orders are rejected, regardless of whether risk limits are enabled
+func (m *OrderManager) SetKillSwitch(engaged bool) error {
+	if m == nil {
+		return fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	var state int32
+	if engaged {
+		state = 1
+	}
+	if atomic.SwapInt32(&m.killSwitch, state) == state {
+		return nil
+	}
+	msg := "Order manager risk kill switch released."
+	if engaged {
+		msg = "Order manager risk kill switch engaged, all new orders will be rejected."
+	}
+	log.Warnln(log.OrderMgr, msg)
+	audit.Event("", riskAuditType, msg)
+	if m.orderStore.commsManager != nil {
+		m.orderStore.commsManager.PushEvent(base.Event{Type: riskAuditType, Message: msg})
+	}
+	return nil
+}
+
+// IsKillSwitchEngaged returns whether all new orders are being rejected
+func (m *OrderManager) IsKillSwitchEngaged() bool {
+	return m != nil && atomic.LoadInt32(&m.killSwitch) == 1
+}
+
+// checkRiskLimits ensures a new order does not breach any configured pre-trade
+// risk limits
+func (m *OrderManager) checkRiskLimits(s *order.Submit) error {
+	if atomic.LoadInt32(&m.killSwitch) == 1 {
+		return m.rejectOrder(s, ErrRiskKillSwitchEngaged)
+	}
+	limits := &m.cfg.RiskLimits
+	if !limits.Enabled {
+		return nil
+	}
+
+	if limits.MaxOpenOrdersPerExchange > 0 {
+		open := len(m.orderStore.getActiveOrders(&order.Filter{Exchange: s.Exchange}))
+		if int64(open) >= limits.MaxOpenOrdersPerExchange {
+			return m.rejectOrder(s, fmt.Errorf("%w: %d open orders, limit %d",
+				ErrRiskMaxOpenOrders, open, limits.MaxOpenOrdersPerExchange))
+		}
+	}
+
+	if limits.PriceBandPercentage > 0 && s.Price > 0 {
+		reference, err := riskReferencePrice(s)
+		if err != nil {
+			return m.rejectOrder(s, err)
+		}
+		deviation := math.Abs(s.Price-reference) / reference * 100
+		if deviation > limits.PriceBandPercentage {
+			return m.rejectOrder(s, fmt.Errorf("%w: price %v deviates %.2f%% from last price %v, limit %v%%",
+				ErrRiskPriceBand, s.Price, deviation, reference, limits.PriceBandPercentage))
+		}
+	}
+
+	if limits.MaxOrderNotional > 0 || limits.MaxPositionPerPair > 0 {
+		price := s.Price
+		if price == 0 {
+			var err error
+			price, err = riskReferencePrice(s)
+			if err != nil {
+				return m.rejectOrder(s, err)
+			}
+		}
+		amount, notional := s.Amount, s.QuoteAmount
+		switch {
+		case amount == 0:
+			amount = notional / price
+		case notional == 0:
+			notional = amount * price
+		}
+
+		if limits.MaxOrderNotional > 0 {
+			value, err := m.riskValue(notional, s.Exchange, s.Pair.Quote)
+			if err != nil {
+				return m.rejectOrder(s, err)
+			}
+			if value > limits.MaxOrderNotional {
+				return m.rejectOrder(s, fmt.Errorf("%w: notional %v %s, limit %v %s",
+					ErrRiskMaxOrderNotional, value, limits.Currency, limits.MaxOrderNotional, limits.Currency))
+			}
+		}
+
+		if limits.MaxPositionPerPair > 0 {
+			position, buying, selling := m.pairExposure(s)
+			projected := position
+			if s.Side.IsLong() {
+				projected += buying + amount
+			} else {
+				projected -= selling + amount
+			}
+			if math.Abs(projected) > limits.MaxPositionPerPair {
+				return m.rejectOrder(s, fmt.Errorf("%w: projected position %v, limit %v",
+					ErrRiskMaxPosition, projected, limits.MaxPositionPerPair))
+			}
+		}
+	}
+
+	if limits.MaxDailyLoss > 0 {
+		pnl, err := m.dailyPNL(time.Now())
+		if err != nil {
+			return m.rejectOrder(s, err)
+		}
+		if -pnl >= limits.MaxDailyLoss {
+			return m.rejectOrder(s, fmt.Errorf("%w: daily loss %v %s, limit %v %s",
+				ErrRiskMaxDailyLoss, -pnl, limits.Currency, limits.MaxDailyLoss, limits.Currency))
+		}
+	}
+	return nil
+}
+
+// rejectOrder records a risk limit breach to the log, audit repository and
+// communications before returning the error to the caller
+func (m *OrderManager) rejectOrder(s *order.Submit, err error) error {
+	msg := fmt.Sprintf("Exchange %s rejected %v %v order pair=%v asset=%v amount=%v price=%v: %v",
+		s.Exchange,
+		s.Side,
+		s.Type,
+		s.Pair,
+		s.AssetType,
+		s.Amount,
+		s.Price,
+		err)
+	log.Warnln(log.OrderMgr, msg)
+	audit.Event(s.Exchange, riskAuditType, msg)
+	if m.orderStore.commsManager != nil {
+		m.orderStore.commsManager.PushEvent(base.Event{Type: riskAuditType, Message: msg})
+	}
+	return err
+}
+
+// pairExposure returns the net executed base position for the order's
+// exchange, asset and pair along with the amounts still working on each side
+func (m *OrderManager) pairExposure(s *order.Submit) (position, buying, selling float64) {
+	orders, err := m.orderStore.getFilteredOrders(&order.Filter{
+		Exchange:  s.Exchange,
+		AssetType: s.AssetType,
+		Pair:      s.Pair,
+	})
+	if err != nil {
+		return 0, 0, 0
+	}
+	for i := range orders {
+		executed := orders[i].ExecutedAmount
+		if executed == 0 && orders[i].Status == order.Filled {
+			executed = orders[i].Amount
+		}
+		var working float64
+		if orders[i].IsActive() {
+			working = orders[i].Amount - executed
+		}
+		switch {
+		case orders[i].Side.IsLong():
+			position += executed
+			buying += working
+		case orders[i].Side.IsShort():
+			position -= executed
+			selling += working
+		}
+	}
+	return position, buying, selling
+}
+
+// dailyPNL returns the profit or loss since midnight UTC in the risk limit
+// currency, marking any remaining position to the last price. Positions
+// carried into the day are valued at their average cost, so selling inventory
+// bought on an earlier day realises against what was paid for it. Fills are
+// placed in the day by their trade timestamps, falling back to the order date
+// when the exchange has not reported any trades
+func (m *OrderManager) dailyPNL(now time.Time) (float64, error) {
+	start := now.UTC().Truncate(24 * time.Hour)
+	type fill struct {
+		executed time.Time
+		long     bool
+		amount   float64
+		price    float64
+		fee      float64
+	}
+	type book struct {
+		carried []fill
+		today   []fill
+		detail  *order.Detail
+	}
+	books := make(map[string]*book)
+	for _, orders := range m.orderStore.get() {
+		for _, o := range orders {
+			if !o.Side.IsLong() && !o.Side.IsShort() {
+				continue
+			}
+			fills := make([]fill, 0, len(o.Trades))
+			for i := range o.Trades {
+				if o.Trades[i].Amount == 0 || o.Trades[i].Price == 0 {
+					continue
+				}
+				fills = append(fills, fill{
+					executed: o.Trades[i].Timestamp,
+					long:     o.Side.IsLong(),
+					amount:   o.Trades[i].Amount,
+					price:    o.Trades[i].Price,
+					fee:      o.Trades[i].Fee,
+				})
+			}
+			if len(fills) == 0 {
+				executed := o.ExecutedAmount
+				if executed == 0 && o.Status == order.Filled {
+					executed = o.Amount
+				}
+				price := o.AverageExecutedPrice
+				if price == 0 {
+					price = o.Price
+				}
+				if executed == 0 || price == 0 {
+					continue
+				}
+				fills = append(fills, fill{
+					executed: o.Date,
+					long:     o.Side.IsLong(),
+					amount:   executed,
+					price:    price,
+					fee:      o.Fee,
+				})
+			}
+			k := strings.ToLower(o.Exchange) + o.AssetType.String() + o.Pair.String()
+			b, ok := books[k]
+			if !ok {
+				b = &book{detail: o}
+				books[k] = b
+			}
+			for i := range fills {
+				if fills[i].executed.Before(start) {
+					b.carried = append(b.carried, fills[i])
+				} else {
+					b.today = append(b.today, fills[i])
+				}
+			}
+		}
+	}
+
+	var pnl float64
+	for _, b := range books {
+		// Build the position carried into the day at its average cost
+		sort.Slice(b.carried, func(i, j int) bool { return b.carried[i].executed.Before(b.carried[j].executed) })
+		var position, cost float64
+		for i := range b.carried {
+			signed := b.carried[i].amount
+			if !b.carried[i].long {
+				signed = -signed
+			}
+			switch {
+			case position == 0 || (position > 0) == (signed > 0):
+				cost = (cost*math.Abs(position) + b.carried[i].price*b.carried[i].amount) / (math.Abs(position) + b.carried[i].amount)
+			case math.Abs(signed) > math.Abs(position):
+				// The position reversed, the remainder is opened at this price
+				cost = b.carried[i].price
+			}
+			position += signed
+			if position == 0 {
+				cost = 0
+			}
+		}
+
+		cash := -position * cost
+		mark := cost
+		sort.Slice(b.today, func(i, j int) bool { return b.today[i].executed.Before(b.today[j].executed) })
+		for i := range b.today {
+			if b.today[i].long {
+				cash -= b.today[i].amount * b.today[i].price
+				position += b.today[i].amount
+			} else {
+				cash += b.today[i].amount * b.today[i].price
+				position -= b.today[i].amount
+			}
+			cash -= b.today[i].fee
+			mark = b.today[i].price
+		}
+		if t, err := ticker.GetTicker(b.detail.Exchange, b.detail.Pair, b.detail.AssetType); err == nil && t.Last > 0 {
+			mark = t.Last
+		}
+		value, err := m.riskValue(cash+position*mark, b.detail.Exchange, b.detail.Pair.Quote)
+		if err != nil {
+			return 0, err
+		}
+		pnl += value
+	}
+	return pnl, nil
+}
+
+// riskValue converts an amount in the quote currency of a pair traded on an
+// exchange to the currency the risk limits are denominated in. Fiat
+// currencies are converted using foreign exchange rates, otherwise the last
+// price of a spot pair on the same exchange is used
+func (m *OrderManager) riskValue(amount float64, exchName string, quote currency.Code) (float64, error) {
+	target := m.cfg.RiskLimits.Currency
+	if amount == 0 || quote.Equal(target) {
+		return amount, nil
+	}
+	if quote.IsFiatCurrency() && target.IsFiatCurrency() {
+		converted, err := currency.ConvertFiat(amount, quote, target)
+		if err != nil {
+			return 0, fmt.Errorf("%w: %v", ErrRiskReferencePriceUnavailable, err)
+		}
+		return converted, nil
+	}
+	if t, err := ticker.GetTicker(exchName, currency.NewPair(quote, target), asset.Spot); err == nil && t.Last > 0 {
+		return amount * t.Last, nil
+	}
+	if t, err := ticker.GetTicker(exchName, currency.NewPair(target, quote), asset.Spot); err == nil && t.Last > 0 {
+		return amount / t.Last, nil
+	}
+	var pairs currency.Pairs
+	if exch, err := m.orderStore.exchangeManager.GetExchangeByName(exchName); err == nil && target.IsFiatCurrency() {
+		pairs, _ = exch.GetEnabledPairs(asset.Spot)
+	}
+	// Bridge through a pair quoting the currency in another fiat currency
+	for i := range pairs {
+		if !pairs[i].Base.Equal(quote) || !pairs[i].Quote.IsFiatCurrency() {
+			continue
+		}
+		t, err := ticker.GetTicker(exchName, pairs[i], asset.Spot)
+		if err != nil || t.Last <= 0 {
+			continue
+		}
+		if converted, err := currency.ConvertFiat(amount*t.Last, pairs[i].Quote, target); err == nil {
+			return converted, nil
+		}
+	}
+	return 0, fmt.Errorf("%w: cannot value %s in %s on %s",
+		ErrRiskReferencePriceUnavailable, quote, target, exchName)
+}
+
+// riskReferencePrice returns the last ticker price for an order's exchange,
+// asset and pair
+func riskReferencePrice(s *order.Submit) (float64, error) {
+	t, err := ticker.GetTicker(s.Exchange, s.Pair, s.AssetType)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrRiskReferencePriceUnavailable, err)
+	}
+	switch {
+	case t.Last > 0:
+		return t.Last, nil
+	case t.Bid > 0 && t.Ask > 0:
+		return (t.Bid + t.Ask) / 2, nil
+	}
+	return 0, fmt.Errorf("%w: %s %s %s ticker has no price",
+		ErrRiskReferencePriceUnavailable, s.Exchange, s.Pair, s.AssetType)
+}
@@ -0,0 +1,287 @@
+package engine
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+type riskTestComms struct {
+	m      sync.Mutex
+	events []base.Event
+}
+
+func (r *riskTestComms) PushEvent(evt base.Event) {
+	r.m.Lock()
+	r.events = append(r.events, evt)
+	r.m.Unlock()
+}
+
+func riskSetup(t *testing.T, exch string, limits config.RiskLimits) (*OrderManager, *riskTestComms) {
+	t.Helper()
+	var wg sync.WaitGroup
+	comms := &riskTestComms{}
+	m, err := SetupOrderManager(NewExchangeManager(), comms, &wg, &config.OrderManager{RiskLimits: limits})
+	require.NoError(t, err)
+	pair := currency.NewPair(currency.NewCode("RISK"), currency.USD)
+	err = ticker.ProcessTicker(&ticker.Price{
+		ExchangeName: exch,
+		Pair:         pair,
+		AssetType:    asset.Spot,
+		Last:         100,
+	})
+	require.NoError(t, err)
+	return m, comms
+}
+
+func riskSubmit(exch string) *order.Submit {
+	return &order.Submit{
+		Exchange:  exch,
+		Pair:      currency.NewPair(currency.NewCode("RISK"), currency.USD),
+		AssetType: asset.Spot,
+		Side:      order.Buy,
+		Type:      order.Limit,
+		Amount:    1,
+		Price:     100,
+	}
+}
+
+func riskAddOrder(t *testing.T, m *OrderManager, exch string, side order.Side, status order.Status, amount, executed, price float64) *order.Detail {
+	t.Helper()
+	id, err := uuid.NewV4()
+	require.NoError(t, err)
+	m.orderStore.m.Lock()
+	defer m.orderStore.m.Unlock()
+	d := &order.Detail{
+		Exchange:             exch,
+		OrderID:              id.String(),
+		Pair:                 currency.NewPair(currency.NewCode("RISK"), currency.USD),
+		AssetType:            asset.Spot,
+		Side:                 side,
+		Type:                 order.Limit,
+		Status:               status,
+		Amount:               amount,
+		ExecutedAmount:       executed,
+		Price:                price,
+		AverageExecutedPrice: price,
+		Date:                 time.Now(),
+		LastUpdated:          time.Now(),
+	}
+	m.orderStore.Orders[exch] = append(m.orderStore.Orders[exch], d)
+	return d
+}
+
+func riskDailyPNL(t *testing.T, m *OrderManager, now time.Time) float64 {
+	t.Helper()
+	pnl, err := m.dailyPNL(now)
+	require.NoError(t, err)
+	return pnl
+}
+
+func TestRiskKillSwitch(t *testing.T) {
+	t.Parallel()
+	m, comms := riskSetup(t, "riskkillswitch", config.RiskLimits{KillSwitch: true})
+	assert.True(t, m.IsKillSwitchEngaged())
+	err := m.validate(riskSubmit("riskkillswitch"))
+	assert.ErrorIs(t, err, ErrRiskKillSwitchEngaged)
+	require.Len(t, comms.events, 1)
+	assert.Equal(t, riskAuditType, comms.events[0].Type)
+
+	require.NoError(t, m.SetKillSwitch(false))
+	assert.False(t, m.IsKillSwitchEngaged())
+	assert.NoError(t, m.validate(riskSubmit("riskkillswitch")))
+	assert.Len(t, comms.events, 2)
+
+	require.NoError(t, m.SetKillSwitch(true))
+	assert.ErrorIs(t, m.validate(riskSubmit("riskkillswitch")), ErrRiskKillSwitchEngaged)
+
+	var nilManager *OrderManager
+	assert.ErrorIs(t, nilManager.SetKillSwitch(true), ErrNilSubsystem)
+	assert.False(t, nilManager.IsKillSwitchEngaged())
+}
+
+func TestRiskMaxOrderNotional(t *testing.T) {
+	t.Parallel()
+	m, comms := riskSetup(t, "risknotional", config.RiskLimits{Enabled: true, MaxOrderNotional: 500})
+	s := riskSubmit("risknotional")
+	s.Amount = 5
+	assert.NoError(t, m.validate(s))
+
+	s.Amount = 6
+	assert.ErrorIs(t, m.validate(s), ErrRiskMaxOrderNotional)
+	assert.Len(t, comms.events, 1)
+
+	// Market orders are valued at the last ticker price
+	s.Type = order.Market
+	s.Price = 0
+	assert.ErrorIs(t, m.validate(s), ErrRiskMaxOrderNotional)
+	s.Amount = 4
+	assert.NoError(t, m.validate(s))
+
+	s.Amount = 0
+	s.QuoteAmount = 501
+	assert.ErrorIs(t, m.validate(s), ErrRiskMaxOrderNotional)
+
+	s = riskSubmit("riskunknown")
+	s.Type = order.Market
+	s.Price = 0
+	assert.ErrorIs(t, m.validate(s), ErrRiskReferencePriceUnavailable)
+}
+
+func TestRiskMaxPositionPerPair(t *testing.T) {
+	t.Parallel()
+	m, _ := riskSetup(t, "riskposition", config.RiskLimits{Enabled: true, MaxPositionPerPair: 5})
+	riskAddOrder(t, m, "riskposition", order.Buy, order.Filled, 2, 2, 100)
+	riskAddOrder(t, m, "riskposition", order.Buy, order.Active, 2, 0, 100)
+	riskAddOrder(t, m, "riskposition", order.Sell, order.Active, 1, 0, 110)
+
+	position, buying, selling := m.pairExposure(riskSubmit("riskposition"))
+	assert.Equal(t, 2.0, position)
+	assert.Equal(t, 2.0, buying)
+	assert.Equal(t, 1.0, selling)
+
+	s := riskSubmit("riskposition")
+	assert.NoError(t, m.validate(s))
+	s.Amount = 2
+	assert.ErrorIs(t, m.validate(s), ErrRiskMaxPosition)
+
+	s.Side = order.Sell
+	s.Amount = 6
+	assert.NoError(t, m.validate(s))
+	s.Amount = 7
+	assert.ErrorIs(t, m.validate(s), ErrRiskMaxPosition)
+}
+
+func TestRiskMaxOpenOrdersPerExchange(t *testing.T) {
+	t.Parallel()
+	m, _ := riskSetup(t, "riskopenorders", config.RiskLimits{Enabled: true, MaxOpenOrdersPerExchange: 2})
+	riskAddOrder(t, m, "riskopenorders", order.Buy, order.Active, 1, 0, 100)
+	riskAddOrder(t, m, "riskopenorders", order.Buy, order.Filled, 1, 1, 100)
+	assert.NoError(t, m.validate(riskSubmit("riskopenorders")))
+
+	riskAddOrder(t, m, "riskopenorders", order.Sell, order.New, 1, 0, 100)
+	assert.ErrorIs(t, m.validate(riskSubmit("riskopenorders")), ErrRiskMaxOpenOrders)
+}
+
+func TestRiskMaxDailyLoss(t *testing.T) {
+	t.Parallel()
+	m, _ := riskSetup(t, "riskdailyloss", config.RiskLimits{Enabled: true, MaxDailyLoss: 50})
+	riskAddOrder(t, m, "riskdailyloss", order.Buy, order.Filled, 2, 2, 120)
+	// Two bought at 120 marked at 100
+	assert.Equal(t, -40.0, riskDailyPNL(t, m, time.Now()))
+	assert.NoError(t, m.validate(riskSubmit("riskdailyloss")))
+
+	riskAddOrder(t, m, "riskdailyloss", order.Sell, order.Filled, 1, 1, 110)
+	// 10 realised, 20 unrealised
+	assert.Equal(t, -30.0, riskDailyPNL(t, m, time.Now()))
+
+	riskAddOrder(t, m, "riskdailyloss", order.Buy, order.Filled, 1, 1, 130)
+	assert.Equal(t, -60.0, riskDailyPNL(t, m, time.Now()))
+	assert.ErrorIs(t, m.validate(riskSubmit("riskdailyloss")), ErrRiskMaxDailyLoss)
+
+	// Two carried at an average cost of 125 marked at 100
+	assert.Equal(t, -50.0, riskDailyPNL(t, m, time.Now().Add(time.Hour*48)), "positions from previous days should be valued at cost")
+}
+
+func TestRiskMaxDailyLossCarriedPosition(t *testing.T) {
+	t.Parallel()
+	m, _ := riskSetup(t, "riskdailycarried", config.RiskLimits{Enabled: true, MaxDailyLoss: 50})
+	yesterday := time.Now().Add(-time.Hour * 24)
+	for _, d := range []*order.Detail{
+		riskAddOrder(t, m, "riskdailycarried", order.Buy, order.Filled, 3, 3, 130),
+		riskAddOrder(t, m, "riskdailycarried", order.Buy, order.Filled, 1, 1, 170),
+	} {
+		d.Date = yesterday
+		d.LastUpdated = yesterday
+	}
+	// Four carried at an average cost of 140 marked at 100
+	assert.Equal(t, -160.0, riskDailyPNL(t, m, time.Now()))
+	assert.ErrorIs(t, m.validate(riskSubmit("riskdailycarried")), ErrRiskMaxDailyLoss)
+
+	// Selling carried inventory realises against its cost rather than as gain
+	riskAddOrder(t, m, "riskdailycarried", order.Sell, order.Filled, 4, 4, 100)
+	assert.Equal(t, -160.0, riskDailyPNL(t, m, time.Now()))
+	assert.Zero(t, riskDailyPNL(t, m, time.Now().Add(time.Hour*48)), "a flat position carries nothing into the next day")
+}
+
+func TestRiskMaxDailyLossTradeTimestamps(t *testing.T) {
+	t.Parallel()
+	m, _ := riskSetup(t, "riskdailytrades", config.RiskLimits{Enabled: true, MaxDailyLoss: 50})
+	yesterday := time.Now().Add(-time.Hour * 24)
+	d := riskAddOrder(t, m, "riskdailytrades", order.Buy, order.Filled, 3, 3, 130)
+	d.Date = yesterday
+	d.Trades = []order.TradeHistory{
+		{Price: 120, Amount: 2, Timestamp: yesterday},
+		{Price: 150, Amount: 1, Timestamp: time.Now()},
+	}
+	// Two carried at 120 and one bought today at 150, all marked at 100,
+	// regardless of the order being updated today
+	assert.Equal(t, -90.0, riskDailyPNL(t, m, time.Now()))
+}
+
+func TestRiskLimitCurrency(t *testing.T) {
+	t.Parallel()
+	m, _ := riskSetup(t, "riskcurrency", config.RiskLimits{Enabled: true, MaxOrderNotional: 300, MaxDailyLoss: 70})
+	assert.Equal(t, currency.USD, m.cfg.RiskLimits.Currency)
+	usdtPair := currency.NewPair(currency.NewCode("RISK"), currency.USDT)
+	for _, tick := range []*ticker.Price{
+		{ExchangeName: "riskcurrency", Pair: usdtPair, AssetType: asset.Spot, Last: 100},
+		{ExchangeName: "riskcurrency", Pair: currency.NewPair(currency.USDT, currency.USD), AssetType: asset.Spot, Last: 0.5},
+	} {
+		require.NoError(t, ticker.ProcessTicker(tick))
+	}
+
+	s := riskSubmit("riskcurrency")
+	s.Pair = usdtPair
+	s.Amount = 5
+	assert.NoError(t, m.validate(s), "500 USDT should be valued at 250 USD")
+	s.Amount = 7
+	assert.ErrorIs(t, m.validate(s), ErrRiskMaxOrderNotional)
+
+	s.Amount = 1
+	s.Pair = currency.NewPair(currency.NewCode("RISK"), currency.NewCode("RISKQUOTE"))
+	assert.ErrorIs(t, m.validate(s), ErrRiskReferencePriceUnavailable, "notional which cannot be valued should be rejected")
+
+	riskAddOrder(t, m, "riskcurrency", order.Buy, order.Filled, 2, 2, 120)
+	d := riskAddOrder(t, m, "riskcurrency", order.Buy, order.Filled, 2, 2, 120)
+	d.Pair = usdtPair
+	// 40 USD lost on RISK-USD and 40 USDT worth 20 USD on RISK-USDT
+	assert.Equal(t, -60.0, riskDailyPNL(t, m, time.Now()))
+	assert.NoError(t, m.validate(riskSubmit("riskcurrency")))
+}
+
+func TestRiskPriceBand(t *testing.T) {
+	t.Parallel()
+	m, _ := riskSetup(t, "riskpriceband", config.RiskLimits{Enabled: true, PriceBandPercentage: 5})
+	s := riskSubmit("riskpriceband")
+	s.Price = 105
+	assert.NoError(t, m.validate(s))
+	s.Price = 94
+	assert.ErrorIs(t, m.validate(s), ErrRiskPriceBand)
+
+	s.Type = order.Market
+	s.Price = 0
+	assert.NoError(t, m.validate(s), "market orders have no price to check")
+
+	s = riskSubmit("riskunknown")
+	assert.ErrorIs(t, m.validate(s), ErrRiskReferencePriceUnavailable)
+}
+
+func TestRiskLimitsDisabled(t *testing.T) {
+	t.Parallel()
+	m, comms := riskSetup(t, "riskdisabled", config.RiskLimits{MaxOrderNotional: 1, PriceBandPercentage: 1})
+	s := riskSubmit("riskdisabled")
+	s.Price = 1000
+	assert.NoError(t, m.validate(s))
+	assert.Empty(t, comms.events)
+}
@@ -11,8 +11,10 @@ import (
 	"github.com/gofrs/uuid"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/convert"
+	"github.com/thrasher-corp/gocryptotrader/common/tracing"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -1727,3 +1729,38 @@ func TestGetByDetail(t *testing.T) {
 		assert.Equal(t, od.ClientOrderID, byID.ClientOrderID, "Retrieve by id pointer should contain the correct ClientOrderID")
 	}
 }
+
+// TestOrderManagerTracing is not parallel as it sets the global tracer
+func TestOrderManagerTracing(t *testing.T) {
+	em := NewExchangeManager()
+	require.NoError(t, em.Add(&submissionExchange{}))
+	var wg sync.WaitGroup
+	m, err := SetupOrderManager(em, &CommunicationManager{}, &wg, &config.OrderManager{})
+	require.NoError(t, err)
+	m.started = 1
+
+	tr, e := startTestTracer(t)
+	_, err = m.Submit(context.Background(), &order.Submit{
+		Exchange:  testExchange,
+		Pair:      btcusdPair,
+		AssetType: asset.Spot,
+		Side:      order.Buy,
+		Type:      order.Limit,
+		Amount:    1,
+		Price:     1,
+	})
+	require.NoError(t, err)
+	err = m.Cancel(context.Background(), &order.Cancel{Exchange: testExchange})
+	assert.Error(t, err)
+	require.NoError(t, tr.Stop(context.Background()))
+
+	submit := e.span("OrderManager.Submit")
+	require.NotNil(t, submit)
+	assert.Contains(t, submit.Attributes, tracing.String("exchange", testExchange))
+	validate := e.span("OrderManager.validate")
+	require.NotNil(t, validate)
+	assert.Equal(t, submit.SpanContext.SpanID, validate.ParentSpanID, "validate should be traced as part of the submission")
+	cancel := e.span("OrderManager.Cancel")
+	require.NotNil(t, cancel)
+	assert.Equal(t, tracing.StatusError, cancel.StatusCode)
+}
@@ -5,7 +5,10 @@ import (
 	"sync"
 	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	dbfill "github.com/thrasher-corp/gocryptotrader/database/repository/fill"
+	dborder "github.com/thrasher-corp/gocryptotrader/database/repository/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
@@ -21,6 +24,26 @@ var (
 	ErrOrderIDCannotBeEmpty = errors.New("orderID cannot be empty")
 	// ErrOrderNotFound occurs when an order is not found in the orderstore
 	ErrOrderNotFound = errors.New("order does not exist")
+	// ErrRiskKillSwitchEngaged occurs when the risk kill switch is rejecting
+	// all new orders
+	ErrRiskKillSwitchEngaged = errors.New("risk kill switch engaged")
+	// ErrRiskMaxOrderNotional occurs when an order's notional value exceeds
+	// the configured limit
+	ErrRiskMaxOrderNotional = errors.New("order notional exceeds risk limit")
+	// ErrRiskMaxPosition occurs when an order would take the position for a
+	// pair beyond the configured limit
+	ErrRiskMaxPosition = errors.New("position exceeds risk limit")
+	// ErrRiskMaxOpenOrders occurs when an exchange already has the maximum
+	// number of open orders
+	ErrRiskMaxOpenOrders = errors.New("open orders exceed risk limit")
+	// ErrRiskMaxDailyLoss occurs when the daily loss limit has been reached
+	ErrRiskMaxDailyLoss = errors.New("daily loss exceeds risk limit")
+	// ErrRiskPriceBand occurs when an order price deviates too far from the
+	// last traded price
+	ErrRiskPriceBand = errors.New("order price outside of risk price band")
+	// ErrRiskReferencePriceUnavailable occurs when a risk limit requires the
+	// last traded price and no ticker is available
+	ErrRiskReferencePriceUnavailable = errors.New("risk reference price unavailable")
 
 	errNilCommunicationsManager = errors.New("cannot start with nil communications manager")
 	errNilOrder                 = errors.New("nil order received")
@@ -29,6 +52,8 @@ var (
 	defaultOrderSeekTime        = -time.Hour * 24 * 365
 )
 
+const riskAuditType = "risk"
+
 type orderManagerConfig struct {
 	EnforceLimitConfig     bool
 	AllowMarketOrders      bool
@@ -37,12 +62,14 @@ type orderManagerConfig struct {
 	AllowedPairs           currency.Pairs
 	AllowedExchanges       []string
 	OrderSubmissionRetries int64
+	RiskLimits             config.RiskLimits
 }
 
 // OrderManager processes and stores orders across enabled exchanges
 type OrderManager struct {
 	started                       int32
 	processingOrders              int32
+	killSwitch                    int32
 	shutdown                      chan struct{}
 	orderStore                    store
 	cfg                           orderManagerConfig
@@ -50,6 +77,9 @@ type OrderManager struct {
 	activelyTrackFuturesPositions bool
 	futuresPositionSeekDuration   time.Duration
 	respectOrderHistoryLimits     bool
+	syntheticOrders               syntheticOrderStore
+	// submissionReporter is optionally set to observe order submissions
+	submissionReporter iOrderSubmissionReporter
 }
 
 // store holds all orders by exchange
@@ -60,6 +90,20 @@ type store struct {
 	exchangeManager           iExchangeManager
 	wg                        *sync.WaitGroup
 	futuresPositionController futures.PositionController
+	persistence               orderPersistence
+}
+
+// orderPersistence queues order and fill writes for the database, so the
+// database is never written to while the order store lock is held
+type orderPersistence struct {
+	orderDB dborder.IDBService
+	fillDB  dbfill.IDBService
+	m       sync.Mutex
+	orders  []*dborder.Order
+	fills   []*dbfill.Fill
+	notify  chan struct{}
+	// write serialises flushes from the worker and shutdown
+	write sync.Mutex
 }
 
 // OrderSubmitResponse contains the order response along with an internal order ID
@@ -0,0 +1,363 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync/atomic"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// Validate checks the order route request
+func (r *OrderRouteRequest) Validate() error {
+	if r == nil {
+		return fmt.Errorf("%w OrderRouteRequest", common.ErrNilPointer)
+	}
+	if r.Pair.IsEmpty() {
+		return order.ErrPairIsEmpty
+	}
+	if !r.AssetType.IsValid() {
+		return fmt.Errorf("%s %w", r.AssetType, asset.ErrNotSupported)
+	}
+	if r.Side != order.Buy && r.Side != order.Sell {
+		return fmt.Errorf("%w %v", order.ErrSideIsInvalid, r.Side)
+	}
+	if r.Amount <= 0 {
+		return fmt.Errorf("%w %v", order.ErrAmountIsInvalid, r.Amount)
+	}
+	if r.MaxSlippage <= 0 {
+		return fmt.Errorf("%w %v", errInvalidMaxSlippage, r.MaxSlippage)
+	}
+	return nil
+}
+
+// RouteOrder splits an order across exchanges by the orderbook liquidity
+// available within the maximum slippage, limited by each exchange's free
+// balance and order execution limits. Exchanges which cannot be used are
+// listed in the route with the reason. No orders are placed until the route is
+// executed
+func (m *OrderManager) RouteOrder(ctx context.Context, r *OrderRouteRequest) (*OrderRoute, error) {
+	if m == nil {
+		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	err := r.Validate()
+	if err != nil {
+		return nil, err
+	}
+	exchanges, err := m.routeExchanges(r.Exchanges)
+	if err != nil {
+		return nil, err
+	}
+	route := &OrderRoute{
+		Pair:          r.Pair,
+		AssetType:     r.AssetType,
+		Side:          r.Side,
+		Amount:        r.Amount,
+		ClientOrderID: r.ClientOrderID,
+	}
+	venues := make([]*routeVenue, 0, len(exchanges))
+	for i := range exchanges {
+		var v *routeVenue
+		v, err = getRouteVenue(ctx, exchanges[i], r)
+		if err != nil {
+			route.Skipped = append(route.Skipped, OrderRouteSkip{Exchange: exchanges[i].GetName(), Reason: err.Error()})
+			continue
+		}
+		venues = append(venues, v)
+	}
+
+	// Allocations which break an exchange's limits remove that exchange and
+	// the remaining exchanges are allocated to again
+allocate:
+	for {
+		var amounts []float64
+		route.ReferencePrice, amounts = allocateRoute(venues, r)
+		route.Allocations = route.Allocations[:0]
+		for i := range venues {
+			if amounts[i] <= 0 {
+				continue
+			}
+			var allocation *OrderRouteAllocation
+			allocation, err = venues[i].allocate(r.Side, amounts[i], route.ReferencePrice)
+			if err != nil {
+				route.Skipped = append(route.Skipped, OrderRouteSkip{Exchange: venues[i].name, Reason: err.Error()})
+				venues = append(venues[:i], venues[i+1:]...)
+				continue allocate
+			}
+			route.Allocations = append(route.Allocations, *allocation)
+		}
+		break
+	}
+
+	var total float64
+	for i := range route.Allocations {
+		route.AllocatedAmount += route.Allocations[i].Amount
+		total += route.Allocations[i].Total
+	}
+	if route.AllocatedAmount > 0 {
+		route.AveragePrice = total / route.AllocatedAmount
+	}
+	return route, nil
+}
+
+// ExecuteOrderRoute places each allocation of a route as an immediate or
+// cancel limit order at its worst price. A failed allocation does not stop the
+// others and its error is set on the allocation
+func (m *OrderManager) ExecuteOrderRoute(ctx context.Context, route *OrderRoute) error {
+	if m == nil {
+		return fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	if route == nil {
+		return fmt.Errorf("%w OrderRoute", common.ErrNilPointer)
+	}
+	if route.executed {
+		return errOrderRouteExecuted
+	}
+	if len(route.Allocations) == 0 {
+		return errNoRoutableLiquidity
+	}
+	route.executed = true
+	for i := range route.Allocations {
+		a := &route.Allocations[i]
+		resp, err := m.Submit(ctx, &order.Submit{
+			Exchange:          a.Exchange,
+			Type:              order.Limit,
+			Side:              route.Side,
+			Pair:              route.Pair,
+			AssetType:         route.AssetType,
+			Price:             a.WorstPrice,
+			Amount:            a.Amount,
+			ImmediateOrCancel: true,
+			ClientOrderID:     route.ClientOrderID,
+		})
+		if err != nil {
+			log.Errorf(log.OrderMgr, "Order route unable to submit %s %s %v %v: %v", a.Exchange, route.Pair, route.Side, a.Amount, err)
+			a.Error = err.Error()
+			continue
+		}
+		a.OrderID = resp.OrderID
+	}
+	return nil
+}
+
+// routeExchanges returns the named exchanges, or all exchanges when none are
+// named
+func (m *OrderManager) routeExchanges(names []string) ([]exchange.IBotExchange, error) {
+	if len(names) == 0 {
+		return m.orderStore.exchangeManager.GetExchanges()
+	}
+	exchanges := make([]exchange.IBotExchange, 0, len(names))
+	for i := range names {
+		exch, err := m.orderStore.exchangeManager.GetExchangeByName(names[i])
+		if err != nil {
+			return nil, err
+		}
+		exchanges = append(exchanges, exch)
+	}
+	return exchanges, nil
+}
+
+// getRouteVenue returns the orderbook side, fee rate, free balance and limits
+// of an exchange which can trade the requested pair
+func getRouteVenue(ctx context.Context, exch exchange.IBotExchange, r *OrderRouteRequest) (*routeVenue, error) {
+	pairs, err := exch.GetEnabledPairs(r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	if !pairs.Contains(r.Pair, true) {
+		return nil, fmt.Errorf("%s %w", r.Pair, currency.ErrPairNotEnabled)
+	}
+	v := &routeVenue{name: exch.GetName()}
+	v.depth, err = orderbook.GetDepth(v.name, r.Pair, r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	book, err := v.depth.Retrieve()
+	if err != nil {
+		return nil, err
+	}
+	v.feeRate, err = routeFeeRate(ctx, exch, r.Pair)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get trading fee: %w", err)
+	}
+
+	creds, err := exch.GetCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+	spent := r.Pair.Quote
+	if r.Side == order.Sell {
+		spent = r.Pair.Base
+	}
+	balance, err := account.GetBalance(v.name, creds.SubAccount, creds, r.AssetType, spent)
+	if err != nil {
+		return nil, err
+	}
+	v.capacity = balance.GetFree()
+	if v.capacity <= 0 {
+		return nil, fmt.Errorf("no free %s balance", spent)
+	}
+	// Exchanges without loaded limits are not restricted
+	v.limits, err = exch.GetOrderExecutionLimits(r.AssetType, r.Pair)
+	if err != nil {
+		v.limits = order.MinMaxLevel{}
+	}
+
+	items := book.Asks
+	if r.Side == order.Sell {
+		items = book.Bids
+	}
+	v.levels = make([]routeLevel, len(items))
+	for i := range items {
+		v.levels[i] = routeLevel{price: items[i].Price, amount: items[i].Amount}
+		if r.Side == order.Buy {
+			v.levels[i].effective = items[i].Price * (1 + v.feeRate)
+		} else {
+			v.levels[i].effective = items[i].Price * (1 - v.feeRate)
+		}
+	}
+	return v, nil
+}
+
+// routeFeeRate returns the taker fee rate of a pair, derived from the fee of
+// trading one unit at a price of one. The offline fee is used when the
+// exchange cannot retrieve its fees
+func routeFeeRate(ctx context.Context, exch exchange.IBotExchange, p currency.Pair) (float64, error) {
+	f := &exchange.FeeBuilder{
+		FeeType:       exchange.CryptocurrencyTradeFee,
+		Pair:          p,
+		PurchasePrice: 1,
+		Amount:        1,
+	}
+	fee, err := exch.GetFeeByType(ctx, f)
+	if err == nil && fee >= 0 {
+		return fee, nil
+	}
+	f.FeeType = exchange.OfflineTradeFee
+	return exch.GetFeeByType(ctx, f)
+}
+
+// allocateRoute fills the requested amount from the best fee inclusive levels
+// of all venues until the maximum slippage from the best price is reached or a
+// venue's balance or maximum order amount is used. It returns the best price
+// and the base amount allocated to each venue
+func allocateRoute(venues []*routeVenue, r *OrderRouteRequest) (reference float64, amounts []float64) {
+	amounts = make([]float64, len(venues))
+	var levels []routeLevel
+	for i := range venues {
+		for j := range venues[i].levels {
+			l := venues[i].levels[j]
+			l.venue = i
+			levels = append(levels, l)
+		}
+	}
+	if len(levels) == 0 {
+		return 0, amounts
+	}
+	sort.SliceStable(levels, func(i, j int) bool {
+		if r.Side == order.Buy {
+			return levels[i].effective < levels[j].effective
+		}
+		return levels[i].effective > levels[j].effective
+	})
+	reference = levels[0].effective
+	limit := reference * (1 + r.MaxSlippage/100)
+	if r.Side == order.Sell {
+		limit = reference * (1 - r.MaxSlippage/100)
+	}
+
+	spent := make([]float64, len(venues))
+	remaining := r.Amount
+	for i := range levels {
+		if remaining <= 0 {
+			break
+		}
+		if (r.Side == order.Buy && levels[i].effective > limit) ||
+			(r.Side == order.Sell && levels[i].effective < limit) {
+			break
+		}
+		v := venues[levels[i].venue]
+		take := math.Min(levels[i].amount, remaining)
+		if r.Side == order.Buy {
+			take = math.Min(take, (v.capacity-spent[levels[i].venue])/levels[i].effective)
+		} else {
+			take = math.Min(take, v.capacity-amounts[levels[i].venue])
+		}
+		if v.limits.MaximumBaseAmount > 0 {
+			take = math.Min(take, v.limits.MaximumBaseAmount-amounts[levels[i].venue])
+		}
+		if take <= 0 {
+			continue
+		}
+		amounts[levels[i].venue] += take
+		spent[levels[i].venue] += take * levels[i].effective
+		remaining -= take
+	}
+	return reference, amounts
+}
+
+// allocate conforms an amount to the venue's limits and prices it against the
+// orderbook depth
+func (v *routeVenue) allocate(side order.Side, amount, reference float64) (*OrderRouteAllocation, error) {
+	amount = v.limits.ConformToAmount(amount)
+	if amount <= 0 {
+		return nil, fmt.Errorf("%w step: %v", order.ErrAmountBelowMin, v.limits.AmountStepIncrementSize)
+	}
+	var movement *orderbook.Movement
+	var err error
+	if side == order.Buy {
+		movement, err = v.depth.LiftTheAsksFromBest(amount, true)
+	} else {
+		movement, err = v.depth.HitTheBidsFromBest(amount, false)
+	}
+	if err != nil {
+		return nil, err
+	}
+	worst := v.worstPrice(amount)
+	err = v.limits.Conforms(worst, amount, order.Limit)
+	if err != nil {
+		return nil, err
+	}
+	a := &OrderRouteAllocation{
+		Exchange:     v.name,
+		Amount:       amount,
+		AveragePrice: movement.AverageOrderCost,
+		WorstPrice:   worst,
+		FeeRate:      v.feeRate,
+	}
+	if side == order.Buy {
+		a.Fee = movement.Sold * v.feeRate
+		a.Total = movement.Sold + a.Fee
+		a.SlippagePercentage = (a.Total/amount - reference) / reference * 100
+	} else {
+		a.Fee = movement.Purchased * v.feeRate
+		a.Total = movement.Purchased - a.Fee
+		a.SlippagePercentage = (reference - a.Total/amount) / reference * 100
+	}
+	return a, nil
+}
+
+// worstPrice returns the price of the last level needed to fill an amount
+func (v *routeVenue) worstPrice(amount float64) float64 {
+	var filled, price float64
+	for i := range v.levels {
+		price = v.levels[i].price
+		filled += v.levels[i].amount
+		if filled >= amount {
+			break
+		}
+	}
+	return price
+}
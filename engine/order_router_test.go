@@ -0,0 +1,231 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+var errRouterTestSubmit = errors.New("rejected")
+
+// routerTestExchange provides pairs, fees, credentials and limits and records
+// submitted orders without any API calls
+type routerTestExchange struct {
+	exchange.IBotExchange
+	name    string
+	pairs   currency.Pairs
+	feeRate float64
+	limits  order.MinMaxLevel
+	reject  bool
+	m       sync.Mutex
+	orders  []*order.Submit
+}
+
+func (r *routerTestExchange) GetName() string {
+	return r.name
+}
+
+func (r *routerTestExchange) GetEnabledPairs(asset.Item) (currency.Pairs, error) {
+	return r.pairs, nil
+}
+
+func (r *routerTestExchange) GetFeeByType(_ context.Context, f *exchange.FeeBuilder) (float64, error) {
+	return r.feeRate * f.PurchasePrice * f.Amount, nil
+}
+
+func (r *routerTestExchange) GetCredentials(context.Context) (*account.Credentials, error) {
+	return &account.Credentials{Key: r.name, Secret: r.name}, nil
+}
+
+func (r *routerTestExchange) GetOrderExecutionLimits(asset.Item, currency.Pair) (order.MinMaxLevel, error) {
+	return r.limits, nil
+}
+
+func (r *routerTestExchange) CheckOrderExecutionLimits(asset.Item, currency.Pair, float64, float64, order.Type) error {
+	return nil
+}
+
+func (r *routerTestExchange) CanTradePair(currency.Pair, asset.Item) error {
+	return nil
+}
+
+func (r *routerTestExchange) SubmitOrder(_ context.Context, s *order.Submit) (*order.SubmitResponse, error) {
+	if r.reject {
+		return nil, errRouterTestSubmit
+	}
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.orders = append(r.orders, s)
+	return s.DeriveSubmitResponse(r.name + strconv.Itoa(len(r.orders)))
+}
+
+func routerTestSetup(t *testing.T, exchs ...*routerTestExchange) *OrderManager {
+	t.Helper()
+	em := NewExchangeManager()
+	for i := range exchs {
+		require.NoError(t, em.Add(exchs[i]))
+	}
+	var wg sync.WaitGroup
+	m, err := SetupOrderManager(em, &CommunicationManager{}, &wg, &config.OrderManager{})
+	require.NoError(t, err)
+	m.started = 1
+	return m
+}
+
+func routerTestBalance(t *testing.T, exch *routerTestExchange, c currency.Code, free float64) {
+	t.Helper()
+	creds, err := exch.GetCredentials(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, account.Process(&account.Holdings{
+		Exchange: exch.name,
+		Accounts: []account.SubAccount{{
+			AssetType:  asset.Spot,
+			Currencies: []account.Balance{{Currency: c, Total: free, Free: free}},
+		}},
+	}, creds))
+}
+
+func TestOrderRouteRequestValidate(t *testing.T) {
+	t.Parallel()
+	var r *OrderRouteRequest
+	assert.ErrorIs(t, r.Validate(), common.ErrNilPointer)
+	r = &OrderRouteRequest{}
+	assert.ErrorIs(t, r.Validate(), order.ErrPairIsEmpty)
+	r.Pair = btcusdPair
+	assert.ErrorIs(t, r.Validate(), asset.ErrNotSupported)
+	r.AssetType = asset.Spot
+	assert.ErrorIs(t, r.Validate(), order.ErrSideIsInvalid)
+	r.Side = order.Buy
+	assert.ErrorIs(t, r.Validate(), order.ErrAmountIsInvalid)
+	r.Amount = 1
+	assert.ErrorIs(t, r.Validate(), errInvalidMaxSlippage)
+	r.MaxSlippage = 1
+	assert.NoError(t, r.Validate())
+}
+
+func TestRouteOrder(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.NewCode("ROUTERBASE"), currency.USDT)
+	one := &routerTestExchange{name: "routerone", pairs: currency.Pairs{p}, feeRate: 0.001}
+	two := &routerTestExchange{name: "routertwo", pairs: currency.Pairs{p}, limits: order.MinMaxLevel{AmountStepIncrementSize: 0.1}}
+	unlisted := &routerTestExchange{name: "routerunlisted"}
+	require.NoError(t, (&orderbook.Base{
+		Exchange: one.name,
+		Pair:     p,
+		Asset:    asset.Spot,
+		Bids:     orderbook.Items{{Price: 99, Amount: 1}},
+		Asks:     orderbook.Items{{Price: 100, Amount: 1}, {Price: 100.5, Amount: 1}, {Price: 102, Amount: 5}},
+	}).Process())
+	require.NoError(t, (&orderbook.Base{
+		Exchange: two.name,
+		Pair:     p,
+		Asset:    asset.Spot,
+		Bids:     orderbook.Items{{Price: 99.5, Amount: 1}},
+		Asks:     orderbook.Items{{Price: 100.2, Amount: 1.5}, {Price: 101, Amount: 5}},
+	}).Process())
+	routerTestBalance(t, one, currency.USDT, 1000)
+	routerTestBalance(t, two, currency.USDT, 100000)
+	m := routerTestSetup(t, one, two, unlisted)
+
+	var nilManager *OrderManager
+	_, err := nilManager.RouteOrder(context.Background(), &OrderRouteRequest{})
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+	_, err = m.RouteOrder(context.Background(), &OrderRouteRequest{})
+	assert.ErrorIs(t, err, order.ErrPairIsEmpty)
+
+	r := &OrderRouteRequest{
+		Pair:        p,
+		AssetType:   asset.Spot,
+		Side:        order.Buy,
+		Amount:      3,
+		MaxSlippage: 1,
+		Exchanges:   []string{one.name, two.name, unlisted.name},
+	}
+	route, err := m.RouteOrder(context.Background(), r)
+	require.NoError(t, err)
+	require.Len(t, route.Skipped, 1)
+	assert.Equal(t, unlisted.name, route.Skipped[0].Exchange)
+	assert.InDelta(t, 100.1, route.ReferencePrice, 1e-9)
+	assert.InDelta(t, 3, route.AllocatedAmount, 1e-9)
+	require.Len(t, route.Allocations, 2)
+	// the best fee inclusive levels are split between both exchanges and the
+	// level beyond one percent of slippage is not used
+	assert.Equal(t, one.name, route.Allocations[0].Exchange)
+	assert.InDelta(t, 1.5, route.Allocations[0].Amount, 1e-9)
+	assert.Equal(t, 100.5, route.Allocations[0].WorstPrice)
+	assert.InDelta(t, 0.15025, route.Allocations[0].Fee, 1e-9)
+	assert.InDelta(t, 150.40025, route.Allocations[0].Total, 1e-9)
+	assert.Equal(t, two.name, route.Allocations[1].Exchange)
+	assert.InDelta(t, 1.5, route.Allocations[1].Amount, 1e-9)
+	assert.Equal(t, 100.2, route.Allocations[1].WorstPrice)
+	assert.InDelta(t, 150.3, route.Allocations[1].Total, 1e-9)
+	assert.InDelta(t, 300.70025/3, route.AveragePrice, 1e-9)
+
+	// an allocation below an exchange's minimum moves to the other exchanges
+	two.limits.MinimumBaseAmount = 2
+	route, err = m.RouteOrder(context.Background(), r)
+	require.NoError(t, err)
+	require.Len(t, route.Skipped, 2)
+	assert.Equal(t, two.name, route.Skipped[1].Exchange)
+	require.Len(t, route.Allocations, 1)
+	assert.InDelta(t, 2, route.Allocations[0].Amount, 1e-9)
+	two.limits.MinimumBaseAmount = 0
+
+	// without a free balance to sell nothing can be allocated
+	r.Side = order.Sell
+	route, err = m.RouteOrder(context.Background(), r)
+	require.NoError(t, err)
+	assert.Len(t, route.Skipped, 3)
+	assert.Empty(t, route.Allocations)
+	assert.ErrorIs(t, m.ExecuteOrderRoute(context.Background(), route), errNoRoutableLiquidity)
+}
+
+func TestExecuteOrderRoute(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.NewCode("ROUTEREXEC"), currency.USDT)
+	one := &routerTestExchange{name: "routerexecone"}
+	two := &routerTestExchange{name: "routerexectwo", reject: true}
+	m := routerTestSetup(t, one, two)
+
+	var nilManager *OrderManager
+	assert.ErrorIs(t, nilManager.ExecuteOrderRoute(context.Background(), nil), ErrNilSubsystem)
+	assert.ErrorIs(t, m.ExecuteOrderRoute(context.Background(), nil), common.ErrNilPointer)
+
+	route := &OrderRoute{
+		Pair:          p,
+		AssetType:     asset.Spot,
+		Side:          order.Buy,
+		ClientOrderID: "router:1",
+		Allocations: []OrderRouteAllocation{
+			{Exchange: one.name, Amount: 1, WorstPrice: 100.5},
+			{Exchange: two.name, Amount: 2, WorstPrice: 100.2},
+		},
+	}
+	require.NoError(t, m.ExecuteOrderRoute(context.Background(), route))
+	assert.Equal(t, "routerexecone1", route.Allocations[0].OrderID)
+	assert.Empty(t, route.Allocations[0].Error)
+	assert.Empty(t, route.Allocations[1].OrderID)
+	assert.Contains(t, route.Allocations[1].Error, errRouterTestSubmit.Error())
+	require.Len(t, one.orders, 1)
+	assert.Equal(t, order.Limit, one.orders[0].Type)
+	assert.True(t, one.orders[0].ImmediateOrCancel)
+	assert.Equal(t, 100.5, one.orders[0].Price)
+	assert.Equal(t, "router:1", one.orders[0].ClientOrderID)
+
+	assert.ErrorIs(t, m.ExecuteOrderRoute(context.Background(), route), errOrderRouteExecuted)
+	m.started = 0
+	assert.ErrorIs(t, m.ExecuteOrderRoute(context.Background(), route), ErrSubSystemNotStarted)
+}
@@ -0,0 +1,96 @@
+package engine
+
+import (
+	"errors"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+var (
+	errInvalidMaxSlippage  = errors.New("maximum slippage must be greater than zero")
+	errNoRoutableLiquidity = errors.New("no exchange has liquidity within the maximum slippage")
+	errOrderRouteExecuted  = errors.New("order route has already been executed")
+)
+
+// OrderRouteRequest defines an order to be split across exchanges by their
+// available liquidity
+type OrderRouteRequest struct {
+	Pair      currency.Pair
+	AssetType asset.Item
+	Side      order.Side
+	// Amount is the base amount to buy or sell
+	Amount float64
+	// MaxSlippage is the percentage the worst price of any allocation may be
+	// from the best fee inclusive price across all exchanges
+	MaxSlippage float64
+	// Exchanges limits routing to the named exchanges, every exchange with
+	// the pair enabled is used when empty
+	Exchanges     []string
+	ClientOrderID string
+}
+
+// OrderRoute is the allocation of a routed order across exchanges. Prices
+// include fees
+type OrderRoute struct {
+	Pair            currency.Pair
+	AssetType       asset.Item
+	Side            order.Side
+	Amount          float64
+	AllocatedAmount float64
+	ReferencePrice  float64
+	AveragePrice    float64
+	Allocations     []OrderRouteAllocation
+	// Skipped holds the exchanges which could not be allocated to and why
+	Skipped       []OrderRouteSkip
+	ClientOrderID string
+	executed      bool
+}
+
+// OrderRouteAllocation is the part of a routed order placed on an exchange.
+// Orders are placed as immediate or cancel limit orders at the worst price,
+// so no level beyond the maximum slippage is filled
+type OrderRouteAllocation struct {
+	Exchange     string
+	Amount       float64
+	AveragePrice float64
+	WorstPrice   float64
+	FeeRate      float64
+	// Fee and Total are in the quote currency. Total is the cost of a buy or
+	// the proceeds of a sell after fees
+	Fee   float64
+	Total float64
+	// SlippagePercentage is the distance of the fee inclusive average price
+	// from the route's reference price
+	SlippagePercentage float64
+	OrderID            string
+	Error              string
+}
+
+// OrderRouteSkip is an exchange which was not allocated to
+type OrderRouteSkip struct {
+	Exchange string
+	Reason   string
+}
+
+// routeVenue is an exchange considered for routing
+type routeVenue struct {
+	name    string
+	feeRate float64
+	// capacity is the free balance of the currency spent, quote for buys and
+	// base for sells
+	capacity float64
+	limits   order.MinMaxLevel
+	depth    *orderbook.Depth
+	levels   []routeLevel
+}
+
+// routeLevel is an orderbook level of a venue with its fee inclusive price
+type routeLevel struct {
+	venue     int
+	price     float64
+	effective float64
+	amount    float64
+}
@@ -2,25 +2,57 @@ package engine
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/costbasislot"
+	dborder "github.com/thrasher-corp/gocryptotrader/database/repository/order"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/gocryptotrader/portfolio"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/costbasis"
 )
 
 // PortfolioManagerName is an exported subsystem name
 const PortfolioManagerName = "portfolio"
 
+// maxPriceHops is the number of intermediary currencies used to price a
+// currency in the fiat display currency e.g. ETH via ETH-BTC and BTC-USD
+const maxPriceHops = 1
+
+// openingBalanceTradeID identifies lots given a cost basis at the current price
+// as the holding predates the tracked order history
+const openingBalanceTradeID = "opening-balance"
+
+// storedPriceInterval is the interval of stored candles used to value orders
+// restored from the database at the time they executed
+const storedPriceInterval = kline.OneHour
+
+// maxStoredPriceAge is the oldest a stored candle can be relative to an order
+// to value it
+const maxStoredPriceAge = 24 * time.Hour
+
+// openingBalanceDust is the fraction of a holding below which a difference
+// from the tracked amount is ignored when seeding opening balances
+const openingBalanceDust = 1e-8
+
 var (
 	// PortfolioSleepDelay defines the default sleep time between portfolio manager runs
 	PortfolioSleepDelay = time.Minute
+
+	errNoPriceAvailable       = errors.New("no price available")
+	errNoStoredPriceAvailable = errors.New("no stored candle price available")
 )
 
 // portfolioManager routinely retrieves a user's holdings through exchange APIs as well
@@ -33,10 +65,61 @@ type portfolioManager struct {
 	shutdown              chan struct{}
 	base                  *portfolio.Base
 	m                     sync.Mutex
+
+	orderManager iOrderHistory
+	fiatCurrency currency.Code
+	costBasis    *costbasis.Tracker
+	// executions holds the execution of each order already applied to the
+	// cost basis tracker
+	executions   map[orderKey]orderExecution
+	costBasisMtx sync.Mutex
+	// seeded is set once the stored order history and lots have been loaded
+	// into the cost basis tracker
+	seeded bool
+	// openingBalances is set once holdings which predate the tracked order
+	// history have been given a cost basis
+	openingBalances bool
+	orderDB         dborder.IDBService
+	lotDB           costbasislot.IDBService
+	candles         candleLoader
+}
+
+// candleLoader returns stored candles between the start and end times
+type candleLoader func(exchange string, pair currency.Pair, a asset.Item, interval kline.Interval, start, end time.Time) (*kline.Item, error)
+
+// storedPrices values currencies in the fiat display currency using the close
+// of the most recent stored candle before a point in time
+type storedPrices struct {
+	candles    candleLoader
+	fiat       currency.Code
+	exchanges  []string
+	start, end time.Time
+	cache      map[storedPriceKey][]kline.Candle
 }
 
-// setupPortfolioManager creates a new portfolio manager
-func setupPortfolioManager(e *ExchangeManager, portfolioManagerDelay time.Duration, cfg *portfolio.Base) (*portfolioManager, error) {
+type storedPriceKey struct {
+	exchange string
+	base     *currency.Item
+	quote    *currency.Item
+}
+
+// orderKey identifies an order on an exchange
+type orderKey struct {
+	exchange string
+	orderID  string
+}
+
+// orderExecution is the executed amount, cost and fee of an order
+type orderExecution struct {
+	amount float64
+	cost   float64
+	fee    float64
+}
+
+// setupPortfolioManager creates a new portfolio manager. Spot orders executed
+// through the order manager are tracked to calculate the profit and loss of
+// holdings in the fiat display currency
+func setupPortfolioManager(e *ExchangeManager, orderManager iOrderHistory, portfolioManagerDelay time.Duration, cfg *portfolio.Base, fiatDisplayCurrency currency.Code) (*portfolioManager, error) {
 	if e == nil {
 		return nil, errNilExchangeManager
 	}
@@ -46,11 +129,27 @@ func setupPortfolioManager(e *ExchangeManager, portfolioManagerDelay time.Durati
 	if cfg == nil {
 		cfg = &portfolio.Base{Addresses: []portfolio.Address{}}
 	}
+	if fiatDisplayCurrency.IsEmpty() {
+		fiatDisplayCurrency = currency.USD
+	}
+	method, err := costbasis.MethodFromString(cfg.CostBasisMethod)
+	if err != nil {
+		return nil, err
+	}
+	tracker, err := costbasis.NewTracker(method, fiatDisplayCurrency)
+	if err != nil {
+		return nil, err
+	}
 	m := &portfolioManager{
 		portfolioManagerDelay: portfolioManagerDelay,
 		exchangeManager:       e,
 		shutdown:              make(chan struct{}),
 		base:                  cfg,
+		orderManager:          orderManager,
+		fiatCurrency:          fiatDisplayCurrency,
+		costBasis:             tracker,
+		executions:            make(map[orderKey]orderExecution),
+		candles:               kline.LoadFromDatabase,
 	}
 	return m, nil
 }
@@ -148,9 +247,441 @@ func (m *portfolioManager) processPortfolio() {
 			key,
 			value)
 	}
+	m.updateCostBasis()
+	if len(allExchangesHoldings) > 0 {
+		m.seedOpeningBalances(m.base.GetExchangePortfolio())
+	}
 	atomic.CompareAndSwapInt32(&m.processing, 1, 0)
 }
 
+// SetDatabaseManager enables loading the cost basis of holdings from the
+// stored order history and persisting the cost basis of opening balances
+func (m *portfolioManager) SetDatabaseManager(dcm iDatabaseConnectionManager) error {
+	if m == nil {
+		return fmt.Errorf("portfolio manager %w", ErrNilSubsystem)
+	}
+	if dcm == nil {
+		return errNilDatabaseConnectionManager
+	}
+	if atomic.LoadInt32(&m.started) == 1 {
+		return fmt.Errorf("portfolio manager %w", ErrSubSystemAlreadyStarted)
+	}
+	orderDB, err := dborder.Setup(dcm.GetInstance())
+	if err != nil {
+		return err
+	}
+	lotDB, err := costbasislot.Setup(dcm.GetInstance())
+	if err != nil {
+		return err
+	}
+	if orderDB == nil || lotDB == nil {
+		return nil
+	}
+	m.orderDB = orderDB
+	m.lotDB = lotDB
+	return nil
+}
+
+// updateCostBasis applies spot order executions which have not yet been
+// recorded to the cost basis tracker
+func (m *portfolioManager) updateCostBasis() {
+	m.costBasisMtx.Lock()
+	defer m.costBasisMtx.Unlock()
+	if !m.seeded {
+		if err := m.seedCostBasis(); err != nil {
+			log.Warnf(log.PortfolioMgr, "Portfolio manager cannot load the stored cost basis, retrying next run: %v", err)
+			return
+		}
+	}
+	if m.orderManager == nil || !m.orderManager.IsRunning() {
+		return
+	}
+	orders, err := m.orderManager.GetOrdersFiltered(&order.Filter{AssetType: asset.Spot})
+	if err != nil {
+		log.Errorf(log.PortfolioMgr, "Portfolio manager cannot get orders: %v", err)
+		return
+	}
+
+	type pendingTrade struct {
+		key       orderKey
+		execution orderExecution
+		trade     *costbasis.Trade
+	}
+	var pending []pendingTrade
+	for i := range orders {
+		key := orderKey{exchange: strings.ToLower(orders[i].Exchange), orderID: orders[i].OrderID}
+		previous := m.executions[key]
+		if orders[i].ExecutedAmount <= previous.amount {
+			continue
+		}
+		current := executionOf(&orders[i])
+		if current.cost <= 0 {
+			if m.base.Verbose {
+				log.Debugf(log.PortfolioMgr, "Portfolio manager: %s order %s has no execution price and cannot be costed", orders[i].Exchange, orders[i].OrderID)
+			}
+			continue
+		}
+		trade, err := m.tradeFromExecution(&orders[i], previous, current, func(c currency.Code) (float64, error) {
+			return m.fiatPrice(c, maxPriceHops)
+		})
+		if err != nil {
+			log.Warnf(log.PortfolioMgr, "Portfolio manager: %s order %s cannot be costed: %v", orders[i].Exchange, orders[i].OrderID, err)
+			continue
+		}
+		pending = append(pending, pendingTrade{key: key, execution: current, trade: trade})
+	}
+	sort.SliceStable(pending, func(i, j int) bool {
+		return pending[i].trade.Time.Before(pending[j].trade.Time)
+	})
+	for i := range pending {
+		if err := m.costBasis.AddTrade(pending[i].trade); err != nil {
+			log.Errorf(log.PortfolioMgr, "Portfolio manager: %s order %s cannot be costed: %v", pending[i].trade.Exchange, pending[i].trade.ID, err)
+			continue
+		}
+		m.executions[pending[i].key] = pending[i].execution
+	}
+}
+
+// seedCostBasis loads the stored order history and lots into a new cost basis
+// tracker so holdings acquired before a restart keep their cost basis. Stored
+// orders are valued using stored candles at the time they executed and are
+// recorded as executed so they are not applied again. Stored orders which
+// cannot be valued are skipped
+func (m *portfolioManager) seedCostBasis() error {
+	if m.orderDB == nil || m.lotDB == nil {
+		m.seeded = true
+		return nil
+	}
+	tracker, err := costbasis.NewTracker(m.costBasis.Method(), m.fiatCurrency)
+	if err != nil {
+		return err
+	}
+	lots, err := m.lotDB.GetAll()
+	if err != nil {
+		return err
+	}
+	exchanges, err := m.exchangeManager.GetExchanges()
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	prices := &storedPrices{
+		candles: m.candles,
+		fiat:    m.fiatCurrency,
+		start:   now,
+		end:     now,
+		cache:   make(map[storedPriceKey][]kline.Candle),
+	}
+	var stored []dborder.Order
+	for x := range exchanges {
+		orders, err := m.orderDB.GetInRange(exchanges[x].GetName(), time.Time{}, now)
+		if err != nil {
+			return err
+		}
+		for i := range orders {
+			if executed := executedAt(orders[i].LastUpdated, orders[i].Date); executed.Before(prices.start) {
+				prices.start = executed
+			}
+		}
+		stored = append(stored, orders...)
+		prices.exchanges = append(prices.exchanges, exchanges[x].GetName())
+	}
+	prices.start = prices.start.Add(-maxStoredPriceAge)
+
+	type seed struct {
+		time      time.Time
+		lot       *costbasis.Lot
+		trade     *costbasis.Trade
+		key       orderKey
+		execution orderExecution
+	}
+	seeds := make([]seed, 0, len(lots)+len(stored))
+	for i := range lots {
+		seeds = append(seeds, seed{
+			time: lots[i].Acquired,
+			lot: &costbasis.Lot{
+				Currency: currency.NewCode(lots[i].Currency),
+				Exchange: lots[i].Exchange,
+				TradeID:  lots[i].TradeID,
+				Acquired: lots[i].Acquired,
+				Amount:   lots[i].Amount,
+				Cost:     lots[i].Cost,
+			},
+		})
+	}
+	for i := range stored {
+		if stored[i].ExecutedAmount <= 0 {
+			continue
+		}
+		d, err := orderFromDB(&stored[i])
+		if err != nil {
+			log.Warnf(log.PortfolioMgr, "Portfolio manager: stored %s order %s cannot be costed: %v", stored[i].Exchange, stored[i].OrderID, err)
+			continue
+		}
+		if d.AssetType != asset.Spot {
+			continue
+		}
+		current := executionOf(d)
+		if current.cost <= 0 {
+			continue
+		}
+		executed := executedAt(d.LastUpdated, d.Date)
+		trade, err := m.tradeFromExecution(d, orderExecution{}, current, func(c currency.Code) (float64, error) {
+			return prices.price(d.Exchange, c, executed)
+		})
+		if err != nil {
+			log.Warnf(log.PortfolioMgr, "Portfolio manager: stored %s order %s cannot be costed: %v", d.Exchange, d.OrderID, err)
+			continue
+		}
+		seeds = append(seeds, seed{
+			time:      trade.Time,
+			trade:     trade,
+			key:       orderKey{exchange: strings.ToLower(d.Exchange), orderID: d.OrderID},
+			execution: current,
+		})
+	}
+	sort.SliceStable(seeds, func(i, j int) bool {
+		return seeds[i].time.Before(seeds[j].time)
+	})
+
+	executions := make(map[orderKey]orderExecution)
+	for i := range seeds {
+		if seeds[i].lot != nil {
+			if err := tracker.AddLot(seeds[i].lot); err != nil {
+				log.Errorf(log.PortfolioMgr, "Portfolio manager: stored %s lot cannot be costed: %v", seeds[i].lot.Currency, err)
+			}
+			continue
+		}
+		if err := tracker.AddTrade(seeds[i].trade); err != nil {
+			log.Errorf(log.PortfolioMgr, "Portfolio manager: stored %s order %s cannot be costed: %v", seeds[i].trade.Exchange, seeds[i].trade.ID, err)
+			continue
+		}
+		executions[seeds[i].key] = seeds[i].execution
+	}
+	m.costBasis = tracker
+	m.executions = executions
+	m.seeded = true
+	return nil
+}
+
+// seedOpeningBalances gives exchange holdings which exceed the amount tracked
+// from the order history a cost basis at the current price, so disposing of
+// them is not reported as unmatched. This is done once the tracker has been
+// seeded and lots are persisted so later runs keep their original cost
+func (m *portfolioManager) seedOpeningBalances(holdings map[currency.Code]float64) {
+	m.costBasisMtx.Lock()
+	defer m.costBasisMtx.Unlock()
+	if !m.seeded || m.openingBalances || len(holdings) == 0 {
+		return
+	}
+	tracked := make(map[*currency.Item]float64)
+	lots := m.costBasis.Lots()
+	for i := range lots {
+		tracked[lots[i].Currency.Item] += lots[i].Amount
+	}
+	now := time.Now()
+	var seeded []*costbasislot.Lot
+	complete := true
+	for c, total := range holdings {
+		if !m.costBasis.Tracks(c) {
+			continue
+		}
+		amount := total - tracked[c.Item]
+		if amount <= total*openingBalanceDust {
+			continue
+		}
+		price, err := m.fiatPrice(c, maxPriceHops)
+		if err != nil {
+			complete = false
+			if m.base.Verbose {
+				log.Debugf(log.PortfolioMgr, "Portfolio manager: %s opening balance cannot be costed: %v", c, err)
+			}
+			continue
+		}
+		lot := &costbasis.Lot{
+			Currency: c,
+			TradeID:  openingBalanceTradeID,
+			Acquired: now,
+			Amount:   amount,
+			Cost:     amount * price,
+		}
+		if err := m.costBasis.AddLot(lot); err != nil {
+			log.Errorf(log.PortfolioMgr, "Portfolio manager: %s opening balance cannot be costed: %v", c, err)
+			continue
+		}
+		seeded = append(seeded, &costbasislot.Lot{
+			Currency: lot.Currency.Upper().String(),
+			TradeID:  lot.TradeID,
+			Amount:   lot.Amount,
+			Cost:     lot.Cost,
+			Acquired: lot.Acquired,
+		})
+	}
+	m.openingBalances = complete
+	if m.lotDB == nil || len(seeded) == 0 {
+		return
+	}
+	if err := m.lotDB.Insert(seeded...); err != nil {
+		log.Errorf(log.PortfolioMgr, "Portfolio manager cannot store opening balances: %v", err)
+	}
+}
+
+// executionOf returns the executed amount, cost and fee of an order
+func executionOf(d *order.Detail) orderExecution {
+	e := orderExecution{
+		amount: d.ExecutedAmount,
+		cost:   d.ExecutedAmount * d.AverageExecutedPrice,
+		fee:    d.Fee,
+	}
+	if e.cost <= 0 {
+		e.cost = d.Cost
+	}
+	return e
+}
+
+// executedAt returns when an order last executed, falling back to when it was
+// placed
+func executedAt(lastUpdated, date time.Time) time.Time {
+	if lastUpdated.IsZero() {
+		return date
+	}
+	return lastUpdated
+}
+
+// tradeFromExecution values the change in an order's execution in the fiat
+// display currency using the price function supplied
+func (m *portfolioManager) tradeFromExecution(d *order.Detail, previous, current orderExecution, fiatPrice func(currency.Code) (float64, error)) (*costbasis.Trade, error) {
+	amount := current.amount - previous.amount
+	cost := current.cost - previous.cost
+	if cost <= 0 {
+		return nil, fmt.Errorf("invalid execution cost %v for amount %v", cost, amount)
+	}
+	price := cost / amount
+	quotePrice, err := fiatPrice(d.Pair.Quote)
+	if err != nil {
+		return nil, err
+	}
+	var feeValue float64
+	if fee := current.fee - previous.fee; fee > 0 {
+		switch {
+		case d.FeeAsset.IsEmpty(), d.FeeAsset.Equal(d.Pair.Quote):
+			feeValue = fee * quotePrice
+		case d.FeeAsset.Equal(d.Pair.Base):
+			feeValue = fee * price * quotePrice
+		default:
+			feePrice, err := fiatPrice(d.FeeAsset)
+			if err != nil {
+				return nil, err
+			}
+			feeValue = fee * feePrice
+		}
+	}
+	return &costbasis.Trade{
+		Exchange: d.Exchange,
+		ID:       d.OrderID,
+		Pair:     d.Pair,
+		Side:     d.Side,
+		Amount:   amount,
+		Price:    price,
+		Value:    cost * quotePrice,
+		Fee:      feeValue,
+		Time:     executedAt(d.LastUpdated, d.Date),
+	}, nil
+}
+
+// price returns the close of the most recent stored candle of the currency in
+// the fiat display currency at the time supplied. Candles from the order's
+// exchange are preferred, inverse pairs are used when no direct pair is stored
+func (p *storedPrices) price(exch string, c currency.Code, at time.Time) (float64, error) {
+	if c.Equal(p.fiat) {
+		return 1, nil
+	}
+	exchanges := append([]string{exch}, p.exchanges...)
+	for i := range exchanges {
+		if price, ok := p.candleClose(exchanges[i], currency.NewPair(c, p.fiat), at); ok {
+			return price, nil
+		}
+		if price, ok := p.candleClose(exchanges[i], currency.NewPair(p.fiat, c), at); ok {
+			return 1 / price, nil
+		}
+	}
+	return 0, fmt.Errorf("%w for %s in %s at %s", errNoStoredPriceAvailable, c, p.fiat, at.UTC().Format(time.RFC3339))
+}
+
+func (p *storedPrices) candleClose(exch string, pair currency.Pair, at time.Time) (float64, bool) {
+	k := storedPriceKey{exchange: strings.ToLower(exch), base: pair.Base.Item, quote: pair.Quote.Item}
+	candles, ok := p.cache[k]
+	if !ok {
+		item, err := p.candles(exch, pair, asset.Spot, storedPriceInterval, p.start, p.end)
+		if err == nil {
+			candles = item.Candles
+		}
+		p.cache[k] = candles
+	}
+	i := sort.Search(len(candles), func(i int) bool {
+		return candles[i].Time.After(at)
+	}) - 1
+	if i < 0 || candles[i].Close <= 0 || at.Sub(candles[i].Time) > maxStoredPriceAge {
+		return 0, false
+	}
+	return candles[i].Close, true
+}
+
+// fiatPrice returns the price of a currency in the fiat display currency.
+// Fiat currencies are converted using foreign exchange rates, otherwise the
+// last price of an enabled spot pair is used. Pairs quoted in another
+// cryptocurrency are priced through that currency up to the number of hops
+func (m *portfolioManager) fiatPrice(c currency.Code, hops int) (float64, error) {
+	if c.Equal(m.fiatCurrency) {
+		return 1, nil
+	}
+	if c.IsFiatCurrency() {
+		return currency.ConvertFiat(1, c, m.fiatCurrency)
+	}
+	exchanges, err := m.exchangeManager.GetExchanges()
+	if err != nil {
+		return 0, err
+	}
+	var crossed []*ticker.Price
+	for x := range exchanges {
+		if !exchanges[x].IsEnabled() {
+			continue
+		}
+		pairs, err := exchanges[x].GetEnabledPairs(asset.Spot)
+		if err != nil {
+			continue
+		}
+		for y := range pairs {
+			if !pairs[y].Base.Equal(c) {
+				continue
+			}
+			t, err := ticker.GetTicker(exchanges[x].GetName(), pairs[y], asset.Spot)
+			if err != nil || t.Last <= 0 {
+				continue
+			}
+			if pairs[y].Quote.Equal(m.fiatCurrency) {
+				return t.Last, nil
+			}
+			if pairs[y].Quote.IsFiatCurrency() {
+				if converted, err := currency.ConvertFiat(t.Last, pairs[y].Quote, m.fiatCurrency); err == nil {
+					return converted, nil
+				}
+				continue
+			}
+			crossed = append(crossed, t)
+		}
+	}
+	if hops > 0 {
+		for i := range crossed {
+			quotePrice, err := m.fiatPrice(crossed[i].Pair.Quote, hops-1)
+			if err == nil {
+				return crossed[i].Last * quotePrice, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("%w for %s in %s", errNoPriceAvailable, c, m.fiatCurrency)
+}
+
 // seedExchangeAccountInfo seeds account info
 func (m *portfolioManager) seedExchangeAccountInfo(accounts []account.Holdings) {
 	if len(accounts) == 0 {
@@ -332,6 +863,21 @@ func (m *portfolioManager) GetPortfolio() *portfolio.Base {
 	return resp
 }
 
+// GetPortfolioPNL returns the cost basis and profit and loss of spot holdings
+// traded through the order manager in the fiat display currency
+func (m *portfolioManager) GetPortfolioPNL() (*costbasis.Summary, error) {
+	if m == nil {
+		return nil, fmt.Errorf("portfolio manager %w", ErrNilSubsystem)
+	}
+	if !m.IsRunning() {
+		return nil, fmt.Errorf("portfolio manager %w", ErrSubSystemNotStarted)
+	}
+	m.updateCostBasis()
+	return m.costBasis.Summary(func(c currency.Code) (float64, error) {
+		return m.fiatPrice(c, maxPriceHops)
+	})
+}
+
 // IsWhiteListed checks if an address is whitelisted to withdraw to
 func (m *portfolioManager) IsWhiteListed(address string) bool {
 	if m == nil || !m.IsRunning() {
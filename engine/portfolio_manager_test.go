@@ -2,17 +2,32 @@ package engine
 
 import (
 	"errors"
+	"strings"
 	"sync"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common/convert"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/costbasislot"
+	dborder "github.com/thrasher-corp/gocryptotrader/database/repository/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/portfolio"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/costbasis"
 )
 
 func TestSetupPortfolioManager(t *testing.T) {
-	_, err := setupPortfolioManager(nil, 0, nil)
+	_, err := setupPortfolioManager(nil, nil, 0, nil, currency.EMPTYCODE)
 	if !errors.Is(err, errNilExchangeManager) {
 		t.Errorf("error '%v', expected '%v'", err, errNilExchangeManager)
 	}
 
-	m, err := setupPortfolioManager(NewExchangeManager(), 0, nil)
+	m, err := setupPortfolioManager(NewExchangeManager(), nil, 0, nil, currency.EMPTYCODE)
 	if !errors.Is(err, nil) {
 		t.Errorf("error '%v', expected '%v'", err, nil)
 	}
@@ -27,7 +42,7 @@ func TestIsPortfolioManagerRunning(t *testing.T) {
 		t.Error("expected false")
 	}
 
-	m, err := setupPortfolioManager(NewExchangeManager(), 0, nil)
+	m, err := setupPortfolioManager(NewExchangeManager(), nil, 0, nil, currency.EMPTYCODE)
 	if !errors.Is(err, nil) {
 		t.Errorf("error '%v', expected '%v'", err, nil)
 	}
@@ -52,7 +67,7 @@ func TestPortfolioManagerStart(t *testing.T) {
 		t.Errorf("error '%v', expected '%v'", err, ErrNilSubsystem)
 	}
 
-	m, err = setupPortfolioManager(NewExchangeManager(), 0, nil)
+	m, err = setupPortfolioManager(NewExchangeManager(), nil, 0, nil, currency.EMPTYCODE)
 	if !errors.Is(err, nil) {
 		t.Errorf("error '%v', expected '%v'", err, nil)
 	}
@@ -81,7 +96,7 @@ func TestPortfolioManagerStop(t *testing.T) {
 		t.Errorf("error '%v', expected '%v'", err, ErrNilSubsystem)
 	}
 
-	m, err = setupPortfolioManager(NewExchangeManager(), 0, nil)
+	m, err = setupPortfolioManager(NewExchangeManager(), nil, 0, nil, currency.EMPTYCODE)
 	if !errors.Is(err, nil) {
 		t.Errorf("error '%v', expected '%v'", err, nil)
 	}
@@ -111,10 +126,314 @@ func TestProcessPortfolio(t *testing.T) {
 	if !errors.Is(err, nil) {
 		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
 	}
-	m, err := setupPortfolioManager(em, 0, nil)
+	m, err := setupPortfolioManager(em, nil, 0, nil, currency.EMPTYCODE)
 	if !errors.Is(err, nil) {
 		t.Errorf("error '%v', expected '%v'", err, nil)
 	}
 
 	m.processPortfolio()
 }
+
+type fakeOrderHistory struct {
+	orders []order.Detail
+}
+
+func (f *fakeOrderHistory) IsRunning() bool {
+	return true
+}
+
+func (f *fakeOrderHistory) GetOrdersFiltered(*order.Filter) ([]order.Detail, error) {
+	return f.orders, nil
+}
+
+func TestGetPortfolioPNL(t *testing.T) {
+	t.Parallel()
+	pnla := currency.NewCode("PNLA")
+	pnlb := currency.NewCode("PNLB")
+	fiatPair := currency.NewPair(pnla, currency.USD)
+	crossPair := currency.NewPair(pnlb, pnla)
+
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err)
+	exch.SetDefaults()
+	b := exch.GetBase()
+	b.CurrencyPairs.Pairs = map[asset.Item]*currency.PairStore{
+		asset.Spot: {
+			Available:     currency.Pairs{fiatPair, crossPair},
+			Enabled:       currency.Pairs{fiatPair, crossPair},
+			AssetEnabled:  convert.BoolPtr(true),
+			ConfigFormat:  &currency.PairFormat{Uppercase: true},
+			RequestFormat: &currency.PairFormat{Uppercase: true},
+		},
+	}
+	require.NoError(t, em.Add(exch))
+	require.NoError(t, ticker.ProcessTicker(&ticker.Price{ExchangeName: testExchange, Pair: fiatPair, AssetType: asset.Spot, Last: 120}))
+	require.NoError(t, ticker.ProcessTicker(&ticker.Price{ExchangeName: testExchange, Pair: crossPair, AssetType: asset.Spot, Last: 0.2}))
+
+	_, err = setupPortfolioManager(em, nil, 0, &portfolio.Base{CostBasisMethod: "hifo"}, currency.USD)
+	assert.ErrorIs(t, err, costbasis.ErrUnknownMethod)
+
+	history := &fakeOrderHistory{}
+	m, err := setupPortfolioManager(em, history, 0, nil, currency.USD)
+	require.NoError(t, err)
+	_, err = m.GetPortfolioPNL()
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+	m.started = 1
+
+	start := time.Now().Add(-time.Hour)
+	history.orders = []order.Detail{
+		{
+			Exchange:             testExchange,
+			OrderID:              "buy",
+			Pair:                 fiatPair,
+			AssetType:            asset.Spot,
+			Side:                 order.Buy,
+			Amount:               2,
+			ExecutedAmount:       1,
+			AverageExecutedPrice: 100,
+			Fee:                  1,
+			LastUpdated:          start,
+		},
+		{
+			Exchange:       testExchange,
+			OrderID:        "unpriced",
+			Pair:           fiatPair,
+			AssetType:      asset.Spot,
+			Side:           order.Sell,
+			Amount:         1,
+			ExecutedAmount: 1,
+		},
+	}
+	s, err := m.GetPortfolioPNL()
+	require.NoError(t, err)
+	require.Len(t, s.Positions, 1)
+	assert.Equal(t, 1.0, s.Positions[0].Amount)
+	assert.Equal(t, 101.0, s.Positions[0].CostBasis)
+	assert.Equal(t, 19.0, s.UnrealisedPNL)
+
+	history.orders[0].ExecutedAmount = 2
+	history.orders[0].AverageExecutedPrice = 105
+	history.orders[0].Fee = 2
+	history.orders[0].LastUpdated = start.Add(time.Minute)
+	history.orders = append(history.orders, order.Detail{
+		Exchange:             testExchange,
+		OrderID:              "cross",
+		Pair:                 crossPair,
+		AssetType:            asset.Spot,
+		Side:                 order.Buy,
+		Amount:               10,
+		ExecutedAmount:       10,
+		AverageExecutedPrice: 0.1,
+		LastUpdated:          start.Add(2 * time.Minute),
+	})
+	s, err = m.GetPortfolioPNL()
+	require.NoError(t, err)
+	require.Len(t, s.Positions, 2)
+	assert.Equal(t, currency.USD, s.Currency)
+	assert.Equal(t, costbasis.FIFO, s.Method)
+	assert.True(t, s.Positions[0].Currency.Equal(pnla))
+	assert.Equal(t, 1.0, s.Positions[0].Amount)
+	assert.Equal(t, 111.0, s.Positions[0].CostBasis)
+	assert.Equal(t, 19.0, s.Positions[0].RealisedPNL, "buying with PNLA should realise the first lot")
+	assert.Equal(t, 9.0, s.Positions[0].UnrealisedPNL)
+	assert.True(t, s.Positions[1].Currency.Equal(pnlb))
+	assert.Equal(t, 120.0, s.Positions[1].CostBasis)
+	assert.InDelta(t, 24, s.Positions[1].Price, 1e-9, "PNLB should be priced through PNLA")
+	assert.InDelta(t, 120, s.Positions[1].UnrealisedPNL, 1e-9)
+	assert.Equal(t, 19.0, s.RealisedPNL)
+
+	s, err = m.GetPortfolioPNL()
+	require.NoError(t, err)
+	assert.Equal(t, 19.0, s.RealisedPNL, "executions should only be recorded once")
+
+	history.orders = append(history.orders, order.Detail{
+		Exchange:             testExchange,
+		OrderID:              "unknown",
+		Pair:                 currency.NewPair(currency.NewCode("PNLC"), currency.USD),
+		AssetType:            asset.Spot,
+		Side:                 order.Buy,
+		Amount:               1,
+		ExecutedAmount:       1,
+		AverageExecutedPrice: 10,
+		LastUpdated:          start.Add(3 * time.Minute),
+	})
+	_, err = m.GetPortfolioPNL()
+	assert.ErrorIs(t, err, errNoPriceAvailable, "holdings which cannot be priced should return an error")
+}
+
+func TestPortfolioManagerSetDatabaseManager(t *testing.T) {
+	engerino := RPCTestSetup(t)
+	defer CleanRPCTest(t, engerino)
+
+	var m *portfolioManager
+	assert.ErrorIs(t, m.SetDatabaseManager(engerino.DatabaseManager), ErrNilSubsystem)
+	m, err := setupPortfolioManager(NewExchangeManager(), nil, 0, nil, currency.USD)
+	require.NoError(t, err)
+	assert.ErrorIs(t, m.SetDatabaseManager(nil), errNilDatabaseConnectionManager)
+	require.NoError(t, m.SetDatabaseManager(engerino.DatabaseManager))
+	assert.NotNil(t, m.orderDB, "order database service should be set")
+	assert.NotNil(t, m.lotDB, "lot database service should be set")
+	m.started = 1
+	assert.ErrorIs(t, m.SetDatabaseManager(engerino.DatabaseManager), ErrSubSystemAlreadyStarted)
+}
+
+type fakeStoredOrders struct {
+	orders []dborder.Order
+}
+
+func (f *fakeStoredOrders) Upsert(...*dborder.Order) error {
+	return nil
+}
+
+func (f *fakeStoredOrders) GetByStatus(...string) ([]dborder.Order, error) {
+	return nil, nil
+}
+
+func (f *fakeStoredOrders) GetInRange(exchange string, _, _ time.Time) ([]dborder.Order, error) {
+	var resp []dborder.Order
+	for i := range f.orders {
+		if f.orders[i].Exchange == exchange {
+			resp = append(resp, f.orders[i])
+		}
+	}
+	return resp, nil
+}
+
+func (f *fakeStoredOrders) GetTransitions(string, string) ([]dborder.Transition, error) {
+	return nil, nil
+}
+
+type fakeLotStore struct {
+	lots []costbasislot.Lot
+}
+
+func (f *fakeLotStore) Insert(lots ...*costbasislot.Lot) error {
+	for i := range lots {
+		f.lots = append(f.lots, *lots[i])
+	}
+	return nil
+}
+
+func (f *fakeLotStore) GetAll() ([]costbasislot.Lot, error) {
+	return f.lots, nil
+}
+
+func TestPortfolioCostBasisSeeding(t *testing.T) {
+	t.Parallel()
+	seeda := currency.NewCode("SEEDA")
+	seedb := currency.NewCode("SEEDB")
+	fiatPair := currency.NewPair(seeda, currency.USD)
+	quotePair := currency.NewPair(seedb, currency.USD)
+	crossPair := currency.NewPair(seeda, seedb)
+
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err)
+	exch.SetDefaults()
+	b := exch.GetBase()
+	b.CurrencyPairs.Pairs = map[asset.Item]*currency.PairStore{
+		asset.Spot: {
+			Available:     currency.Pairs{fiatPair, quotePair, crossPair},
+			Enabled:       currency.Pairs{fiatPair, quotePair, crossPair},
+			AssetEnabled:  convert.BoolPtr(true),
+			ConfigFormat:  &currency.PairFormat{Uppercase: true},
+			RequestFormat: &currency.PairFormat{Uppercase: true},
+		},
+	}
+	require.NoError(t, em.Add(exch))
+	require.NoError(t, ticker.ProcessTicker(&ticker.Price{ExchangeName: testExchange, Pair: fiatPair, AssetType: asset.Spot, Last: 120}))
+
+	start := time.Now().Add(-time.Hour)
+	stored := dborder.Order{
+		Exchange:             testExchange,
+		OrderID:              "buy",
+		Base:                 seeda.String(),
+		Quote:                seedb.String(),
+		AssetType:            asset.Spot.String(),
+		Side:                 order.Buy.String(),
+		Type:                 order.Limit.String(),
+		Status:               order.Filled.String(),
+		Amount:               1,
+		ExecutedAmount:       1,
+		AverageExecutedPrice: 2,
+		Date:                 start,
+		LastUpdated:          start,
+	}
+	unpriced := stored
+	unpriced.OrderID = "unpriced"
+	unpriced.Quote = "SEEDC"
+	unpriced.Date = start.Add(-time.Minute)
+	unpriced.LastUpdated = unpriced.Date
+	orders := &fakeStoredOrders{orders: []dborder.Order{stored, unpriced}}
+	lots := &fakeLotStore{lots: []costbasislot.Lot{{
+		Currency: seeda.String(),
+		TradeID:  openingBalanceTradeID,
+		Amount:   0.5,
+		Cost:     40,
+		Acquired: start.Add(-time.Hour),
+	}}}
+	restored, err := orderFromDB(&stored)
+	require.NoError(t, err)
+	history := &fakeOrderHistory{orders: []order.Detail{
+		*restored,
+		{
+			Exchange:             testExchange,
+			OrderID:              "sell",
+			Pair:                 fiatPair,
+			AssetType:            asset.Spot,
+			Side:                 order.Sell,
+			Amount:               1.2,
+			ExecutedAmount:       1.2,
+			AverageExecutedPrice: 150,
+			LastUpdated:          start.Add(time.Minute),
+		},
+	}}
+	m, err := setupPortfolioManager(em, history, 0, nil, currency.USD)
+	require.NoError(t, err)
+	m.orderDB = orders
+	m.lotDB = lots
+	m.candles = func(_ string, pair currency.Pair, _ asset.Item, _ kline.Interval, _, _ time.Time) (*kline.Item, error) {
+		if !pair.Equal(quotePair) {
+			return nil, errors.New("no candles")
+		}
+		return &kline.Item{Candles: []kline.Candle{
+			{Time: start.Add(-time.Hour), Close: 50},
+			{Time: start.Add(time.Hour), Close: 70},
+		}}, nil
+	}
+	m.started = 1
+
+	// Stored orders are valued when they executed rather than at the last price
+	require.NoError(t, ticker.ProcessTicker(&ticker.Price{ExchangeName: testExchange, Pair: quotePair, AssetType: asset.Spot, Last: 60}))
+	s, err := m.GetPortfolioPNL()
+	require.NoError(t, err)
+	assert.True(t, m.seeded, "stored orders which cannot be valued should not prevent seeding")
+	_, ok := m.executions[orderKey{exchange: strings.ToLower(testExchange), orderID: "unpriced"}]
+	assert.False(t, ok, "stored orders which cannot be valued should be skipped")
+	require.Len(t, s.Positions, 2)
+	assert.InDelta(t, 0.3, s.Positions[0].Amount, 1e-9)
+	assert.InDelta(t, 30, s.Positions[0].CostBasis, 1e-9)
+	assert.Zero(t, s.Positions[0].UnmatchedAmount, "the sale should be matched against the stored lot and order")
+	assert.InDelta(t, 70, s.RealisedPNL, 1e-9)
+	assert.True(t, s.Positions[1].Currency.Equal(seedb))
+	assert.Equal(t, 2.0, s.Positions[1].UnmatchedAmount, "SEEDB spent without a tracked holding should be unmatched")
+	assert.InDelta(t, 100, s.UnmatchedProceeds, 1e-9, "unmatched proceeds should not be realised")
+
+	m.seedOpeningBalances(map[currency.Code]float64{seeda: 1, currency.USD: 500})
+	assert.True(t, m.openingBalances)
+	require.Len(t, lots.lots, 2, "opening balances should be stored")
+	assert.InDelta(t, 0.7, lots.lots[1].Amount, 1e-9)
+	assert.InDelta(t, 84, lots.lots[1].Cost, 1e-9)
+	assert.Equal(t, openingBalanceTradeID, lots.lots[1].TradeID)
+
+	m.openingBalances = false
+	m.seedOpeningBalances(map[currency.Code]float64{seeda: 1})
+	assert.Len(t, lots.lots, 2, "tracked holdings should not be seeded again")
+
+	s, err = m.GetPortfolioPNL()
+	require.NoError(t, err)
+	require.Len(t, s.Positions, 2)
+	assert.InDelta(t, 1, s.Positions[0].Amount, 1e-9)
+	assert.InDelta(t, 114, s.Positions[0].CostBasis, 1e-9)
+}
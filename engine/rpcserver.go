@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"github.com/gofrs/uuid"
+	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpcauth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/pquerna/otp/totp"
@@ -26,12 +27,16 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common/file/archive"
 	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/common/timeperiods"
+	"github.com/thrasher-corp/gocryptotrader/common/tracing"
+	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
 	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
 	exchangeDB "github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	dbfill "github.com/thrasher-corp/gocryptotrader/database/repository/fill"
+	dborder "github.com/thrasher-corp/gocryptotrader/database/repository/order"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
@@ -41,6 +46,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order/tca"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
@@ -127,6 +133,43 @@ func (s *RPCServer) authenticateClient(ctx context.Context) (context.Context, er
 	return ctx, nil
 }
 
+// traceUnaryInterceptor traces unary calls, continuing any trace propagated by
+// the client via traceparent metadata
+func traceUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, span := startRPCSpan(ctx, info.FullMethod)
+	defer span.End()
+	resp, err := handler(ctx, req)
+	span.RecordError(err)
+	return resp, err
+}
+
+// traceStreamInterceptor traces streaming calls for their whole duration
+func traceStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, span := startRPCSpan(ss.Context(), info.FullMethod)
+	defer span.End()
+	wrapped := grpcmiddleware.WrapServerStream(ss)
+	wrapped.WrappedContext = ctx
+	err := handler(srv, wrapped)
+	span.RecordError(err)
+	return err
+}
+
+func startRPCSpan(ctx context.Context, fullMethod string) (context.Context, *tracing.Span) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if tp := md.Get(tracing.TraceParentHeader); len(tp) > 0 {
+			if sc, err := tracing.ParseTraceParent(tp[0]); err == nil {
+				ctx = tracing.ContextWithRemoteSpanContext(ctx, sc)
+			}
+		}
+	}
+	name := strings.TrimPrefix(fullMethod, "/")
+	service, method, _ := strings.Cut(name, "/")
+	return tracing.StartSpan(ctx, name, tracing.SpanKindServer,
+		tracing.String("rpc.system", "grpc"),
+		tracing.String("rpc.service", service),
+		tracing.String("rpc.method", method))
+}
+
 // StartRPCServer starts a gRPC server with TLS auth
 func StartRPCServer(engine *Engine) {
 	targetDir := utils.GetTLSDir(engine.Settings.DataDir)
@@ -150,8 +193,8 @@ func StartRPCServer(engine *Engine) {
 	s := RPCServer{Engine: engine}
 	opts := []grpc.ServerOption{
 		grpc.Creds(creds),
-		grpc.UnaryInterceptor(grpcauth.UnaryServerInterceptor(s.authenticateClient)),
-		grpc.StreamInterceptor(grpcauth.StreamServerInterceptor(s.authenticateClient)),
+		grpc.ChainUnaryInterceptor(traceUnaryInterceptor, grpcauth.UnaryServerInterceptor(s.authenticateClient)),
+		grpc.ChainStreamInterceptor(traceStreamInterceptor, grpcauth.StreamServerInterceptor(s.authenticateClient)),
 	}
 	server := grpc.NewServer(opts...)
 	gctrpc.RegisterGoCryptoTraderServiceServer(server, &s)
@@ -851,6 +894,40 @@ func (s *RPCServer) GetPortfolioSummary(_ context.Context, _ *gctrpc.GetPortfoli
 	return &resp, nil
 }
 
+// GetPortfolioPNL returns the cost basis and realised and unrealised profit
+// and loss of spot holdings in the fiat display currency
+func (s *RPCServer) GetPortfolioPNL(_ context.Context, _ *gctrpc.GetPortfolioPNLRequest) (*gctrpc.GetPortfolioPNLResponse, error) {
+	summary, err := s.portfolioManager.GetPortfolioPNL()
+	if err != nil {
+		return nil, err
+	}
+	positions := make([]*gctrpc.PortfolioPNLPosition, len(summary.Positions))
+	for i := range summary.Positions {
+		positions[i] = &gctrpc.PortfolioPNLPosition{
+			Currency:          summary.Positions[i].Currency.String(),
+			Amount:            summary.Positions[i].Amount,
+			CostBasis:         summary.Positions[i].CostBasis,
+			AverageCost:       summary.Positions[i].AverageCost,
+			Price:             summary.Positions[i].Price,
+			MarketValue:       summary.Positions[i].MarketValue,
+			RealisedPnl:       summary.Positions[i].RealisedPNL,
+			UnrealisedPnl:     summary.Positions[i].UnrealisedPNL,
+			UnmatchedAmount:   summary.Positions[i].UnmatchedAmount,
+			UnmatchedProceeds: summary.Positions[i].UnmatchedProceeds,
+		}
+	}
+	return &gctrpc.GetPortfolioPNLResponse{
+		FiatCurrency:      summary.Currency.String(),
+		CostBasisMethod:   summary.Method.String(),
+		Positions:         positions,
+		CostBasis:         summary.CostBasis,
+		MarketValue:       summary.MarketValue,
+		RealisedPnl:       summary.RealisedPNL,
+		UnrealisedPnl:     summary.UnrealisedPNL,
+		UnmatchedProceeds: summary.UnmatchedProceeds,
+	}, nil
+}
+
 // AddPortfolioAddress adds an address to the portfoliomanager manager
 func (s *RPCServer) AddPortfolioAddress(_ context.Context, r *gctrpc.AddPortfolioAddressRequest) (*gctrpc.GenericResponse, error) {
 	err := s.portfolioManager.AddAddress(r.Address,
@@ -1298,6 +1375,154 @@ func (s *RPCServer) SubmitOrder(ctx context.Context, r *gctrpc.SubmitOrderReques
 	}, nil
 }
 
+// SubmitSyntheticOrder submits a stop, trailing stop, OCO, iceberg, TWAP or
+// VWAP order which is managed locally by the order manager
+func (s *RPCServer) SubmitSyntheticOrder(ctx context.Context, r *gctrpc.SubmitSyntheticOrderRequest) (*gctrpc.SubmitSyntheticOrderResponse, error) {
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Pair == nil {
+		return nil, errCurrencyPairUnset
+	}
+
+	p := currency.Pair{
+		Delimiter: r.Pair.Delimiter,
+		Base:      currency.NewCode(r.Pair.Base),
+		Quote:     currency.NewCode(r.Pair.Quote),
+	}
+
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkParams(r.Exchange, exch, a, p)
+	if err != nil {
+		return nil, err
+	}
+
+	side, err := order.StringToOrderSide(r.Side)
+	if err != nil {
+		return nil, err
+	}
+
+	oType, err := order.StringToOrderType(r.OrderType)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.OrderManager.SubmitSyntheticOrder(ctx, &SyntheticOrder{
+		Exchange:          r.Exchange,
+		Pair:              p,
+		AssetType:         a,
+		Side:              side,
+		Type:              oType,
+		Amount:            r.Amount,
+		ClientOrderID:     r.ClientOrderId,
+		Price:             r.Price,
+		TriggerPrice:      r.TriggerPrice,
+		TrailingOffset:    r.TrailingOffset,
+		SliceAmount:       r.SliceAmount,
+		Duration:          time.Duration(r.Duration),
+		Slices:            r.Slices,
+		ParticipationRate: r.ParticipationRate,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &gctrpc.SubmitSyntheticOrderResponse{
+		OrderId: resp.OrderID,
+		Status:  resp.Status.String(),
+	}, nil
+}
+
+// RouteOrder splits an order across exchanges by their available liquidity.
+// The allocation is returned as a preview unless execute is set
+func (s *RPCServer) RouteOrder(ctx context.Context, r *gctrpc.RouteOrderRequest) (*gctrpc.RouteOrderResponse, error) {
+	if r == nil {
+		return nil, errInvalidArguments
+	}
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Pair == nil {
+		return nil, errCurrencyPairUnset
+	}
+
+	p := currency.Pair{
+		Delimiter: r.Pair.Delimiter,
+		Base:      currency.NewCode(r.Pair.Base),
+		Quote:     currency.NewCode(r.Pair.Quote),
+	}
+
+	side, err := order.StringToOrderSide(r.Side)
+	if err != nil {
+		return nil, err
+	}
+
+	route, err := s.OrderManager.RouteOrder(ctx, &OrderRouteRequest{
+		Pair:          p,
+		AssetType:     a,
+		Side:          side,
+		Amount:        r.Amount,
+		MaxSlippage:   r.MaxSlippage,
+		Exchanges:     r.Exchanges,
+		ClientOrderID: r.ClientOrderId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if r.Execute {
+		err = s.OrderManager.ExecuteOrderRoute(ctx, route)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp := &gctrpc.RouteOrderResponse{
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: route.Pair.Delimiter,
+			Base:      route.Pair.Base.String(),
+			Quote:     route.Pair.Quote.String(),
+		},
+		AssetType:       route.AssetType.String(),
+		Side:            route.Side.String(),
+		Amount:          route.Amount,
+		AllocatedAmount: route.AllocatedAmount,
+		ReferencePrice:  route.ReferencePrice,
+		AveragePrice:    route.AveragePrice,
+		Allocations:     make([]*gctrpc.OrderRouteAllocation, len(route.Allocations)),
+		Skipped:         make([]*gctrpc.OrderRouteSkip, len(route.Skipped)),
+		Executed:        r.Execute,
+	}
+	for i := range route.Allocations {
+		resp.Allocations[i] = &gctrpc.OrderRouteAllocation{
+			Exchange:           route.Allocations[i].Exchange,
+			Amount:             route.Allocations[i].Amount,
+			AveragePrice:       route.Allocations[i].AveragePrice,
+			WorstPrice:         route.Allocations[i].WorstPrice,
+			FeeRate:            route.Allocations[i].FeeRate,
+			Fee:                route.Allocations[i].Fee,
+			Total:              route.Allocations[i].Total,
+			SlippagePercentage: route.Allocations[i].SlippagePercentage,
+			OrderId:            route.Allocations[i].OrderID,
+			Error:              route.Allocations[i].Error,
+		}
+	}
+	for i := range route.Skipped {
+		resp.Skipped[i] = &gctrpc.OrderRouteSkip{
+			Exchange: route.Skipped[i].Exchange,
+			Reason:   route.Skipped[i].Reason,
+		}
+	}
+	return resp, nil
+}
+
 // SimulateOrder simulates an order specified by exchange, currency pair and asset
 // type
 func (s *RPCServer) SimulateOrder(ctx context.Context, r *gctrpc.SimulateOrderRequest) (*gctrpc.SimulateOrderResponse, error) {
@@ -1540,6 +1765,25 @@ func (s *RPCServer) CancelAllOrders(ctx context.Context, r *gctrpc.CancelAllOrde
 	}, nil
 }
 
+// GetKillSwitch returns whether the order manager risk kill switch is
+// engaged
+func (s *RPCServer) GetKillSwitch(_ context.Context, _ *gctrpc.GetKillSwitchRequest) (*gctrpc.KillSwitchResponse, error) {
+	if s.OrderManager == nil {
+		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	return &gctrpc.KillSwitchResponse{Engaged: s.OrderManager.IsKillSwitchEngaged()}, nil
+}
+
+// SetKillSwitch engages or releases the order manager risk kill switch. While
+// engaged all new orders are rejected
+func (s *RPCServer) SetKillSwitch(_ context.Context, r *gctrpc.SetKillSwitchRequest) (*gctrpc.KillSwitchResponse, error) {
+	err := s.OrderManager.SetKillSwitch(r.Engaged)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.KillSwitchResponse{Engaged: s.OrderManager.IsKillSwitchEngaged()}, nil
+}
+
 // ModifyOrder modifies an existing order if it exists
 func (s *RPCServer) ModifyOrder(ctx context.Context, r *gctrpc.ModifyOrderRequest) (*gctrpc.ModifyOrderResponse, error) {
 	assetType, err := asset.New(r.Asset)
@@ -1579,27 +1823,199 @@ func (s *RPCServer) ModifyOrder(ctx context.Context, r *gctrpc.ModifyOrderReques
 
 // GetEvents returns the stored events list
 func (s *RPCServer) GetEvents(_ context.Context, _ *gctrpc.GetEventsRequest) (*gctrpc.GetEventsResponse, error) {
-	return &gctrpc.GetEventsResponse{}, common.ErrNotYetImplemented
+	events, err := s.eventManager.GetEvents()
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetEventsResponse{
+		Events: make([]*gctrpc.Event, len(events)),
+	}
+	for i := range events {
+		evt := &gctrpc.Event{
+			Id:              events[i].ID,
+			Exchange:        events[i].Exchange,
+			Item:            events[i].Item,
+			ConditionParams: eventConditionParamsToRPC(&events[i].Condition),
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: events[i].Pair.Delimiter,
+				Base:      events[i].Pair.Base.String(),
+				Quote:     events[i].Pair.Quote.String(),
+			},
+			AssetType:      events[i].Asset.String(),
+			Action:         events[i].Action,
+			Executed:       events[i].Executed,
+			Logic:          events[i].Logic,
+			Cooldown:       int64(events[i].Cooldown),
+			ExecutionCount: events[i].ExecutionCount,
+			Conditions:     make([]*gctrpc.EventCondition, len(events[i].Conditions)),
+		}
+		if !events[i].LastExecuted.IsZero() {
+			evt.LastExecuted = events[i].LastExecuted.Format(common.SimpleTimeFormatWithTimezone)
+		}
+		for j := range events[i].Conditions {
+			evt.Conditions[j] = &gctrpc.EventCondition{
+				Exchange:        events[i].Conditions[j].Exchange,
+				Item:            events[i].Conditions[j].Item,
+				ConditionParams: eventConditionParamsToRPC(&events[i].Conditions[j].Condition),
+				Pair: &gctrpc.CurrencyPair{
+					Delimiter: events[i].Conditions[j].Pair.Delimiter,
+					Base:      events[i].Conditions[j].Pair.Base.String(),
+					Quote:     events[i].Conditions[j].Pair.Quote.String(),
+				},
+				AssetType: events[i].Conditions[j].Asset.String(),
+			}
+		}
+		if so := events[i].SubmitOrder; so != nil {
+			evt.SubmitOrder = &gctrpc.SubmitOrderRequest{
+				Exchange: so.Exchange,
+				Pair: &gctrpc.CurrencyPair{
+					Delimiter: so.Pair.Delimiter,
+					Base:      so.Pair.Base.String(),
+					Quote:     so.Pair.Quote.String(),
+				},
+				Side:      so.Side.String(),
+				OrderType: so.Type.String(),
+				Amount:    so.Amount,
+				Price:     so.Price,
+				ClientId:  so.ClientID,
+				AssetType: so.AssetType.String(),
+			}
+			if so.MarginType != margin.Unset {
+				evt.SubmitOrder.MarginType = so.MarginType.String()
+			}
+		}
+		if co := events[i].CancelOrder; co != nil {
+			evt.CancelOrder = &gctrpc.CancelOrderRequest{
+				Exchange:  co.Exchange,
+				AccountId: co.AccountID,
+				OrderId:   co.OrderID,
+				Pair: &gctrpc.CurrencyPair{
+					Delimiter: co.Pair.Delimiter,
+					Base:      co.Pair.Base.String(),
+					Quote:     co.Pair.Quote.String(),
+				},
+				AssetType:     co.AssetType.String(),
+				WalletAddress: co.WalletAddress,
+				Side:          co.Side.String(),
+			}
+		}
+		resp.Events[i] = evt
+	}
+	return resp, nil
 }
 
 // AddEvent adds an event
 func (s *RPCServer) AddEvent(_ context.Context, r *gctrpc.AddEventRequest) (*gctrpc.AddEventResponse, error) {
-	evtCondition := EventConditionParams{
-		CheckBids:       r.ConditionParams.CheckBids,
-		CheckAsks:       r.ConditionParams.CheckAsks,
-		Condition:       r.ConditionParams.Condition,
-		OrderbookAmount: r.ConditionParams.OrderbookAmount,
-		Price:           r.ConditionParams.Price,
+	primary, err := s.eventConditionFromRPC(r.Exchange, r.Item, r.ConditionParams, r.Pair, r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+
+	evt := &Event{
+		EventCondition: *primary,
+		Conditions:     make([]EventCondition, len(r.Conditions)),
+		Logic:          r.Logic,
+		Action:         r.Action,
+		Cooldown:       time.Duration(r.Cooldown),
+	}
+	for i := range r.Conditions {
+		if r.Conditions[i] == nil {
+			return nil, fmt.Errorf("%w condition %d", common.ErrNilPointer, i)
+		}
+		var c *EventCondition
+		c, err = s.eventConditionFromRPC(r.Conditions[i].Exchange,
+			r.Conditions[i].Item,
+			r.Conditions[i].ConditionParams,
+			r.Conditions[i].Pair,
+			r.Conditions[i].AssetType)
+		if err != nil {
+			return nil, err
+		}
+		evt.Conditions[i] = *c
+	}
+
+	if r.SubmitOrder != nil {
+		evt.SubmitOrder, err = s.eventSubmitOrderFromRPC(r.SubmitOrder)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if r.CancelOrder != nil {
+		evt.CancelOrder, err = s.eventCancelOrderFromRPC(r.CancelOrder)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	id, err := s.eventManager.AddEvent(evt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gctrpc.AddEventResponse{Id: id}, nil
+}
+
+// eventConditionFromRPC converts and checks an event condition received via
+// gRPC
+func (s *RPCServer) eventConditionFromRPC(exchName, item string, params *gctrpc.ConditionParams, pair *gctrpc.CurrencyPair, assetType string) (*EventCondition, error) {
+	if params == nil {
+		return nil, fmt.Errorf("%w condition params", common.ErrNilPointer)
+	}
+	if pair == nil {
+		return nil, errCurrencyPairUnset
+	}
+
+	p := currency.NewPairWithDelimiter(pair.Base, pair.Quote, pair.Delimiter)
+
+	a, err := asset.New(assetType)
+	if err != nil {
+		return nil, err
+	}
+
+	exch, err := s.GetExchangeByName(exchName)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkParams(exchName, exch, a, p)
+	if err != nil {
+		return nil, err
 	}
 
-	p := currency.NewPairWithDelimiter(r.Pair.Base,
-		r.Pair.Quote, r.Pair.Delimiter)
+	return &EventCondition{
+		Exchange: exchName,
+		Item:     item,
+		Condition: EventConditionParams{
+			CheckBids:         params.CheckBids,
+			CheckAsks:         params.CheckAsks,
+			Condition:         params.Condition,
+			OrderbookAmount:   params.OrderbookAmount,
+			Price:             params.Price,
+			Value:             params.Value,
+			SecondaryExchange: params.SecondaryExchange,
+			Interval:          kline.Interval(params.Interval),
+			Period:            params.Period,
+		},
+		Pair:  p,
+		Asset: a,
+	}, nil
+}
+
+// eventSubmitOrderFromRPC converts an event order submission received via
+// gRPC
+func (s *RPCServer) eventSubmitOrderFromRPC(r *gctrpc.SubmitOrderRequest) (*order.Submit, error) {
+	if r.Pair == nil {
+		return nil, errCurrencyPairUnset
+	}
 
 	a, err := asset.New(r.AssetType)
 	if err != nil {
 		return nil, err
 	}
 
+	p := currency.NewPairWithDelimiter(r.Pair.Base, r.Pair.Quote, r.Pair.Delimiter)
+
 	exch, err := s.GetExchangeByName(r.Exchange)
 	if err != nil {
 		return nil, err
@@ -1610,12 +2026,88 @@ func (s *RPCServer) AddEvent(_ context.Context, r *gctrpc.AddEventRequest) (*gct
 		return nil, err
 	}
 
-	id, err := s.eventManager.Add(r.Exchange, r.Item, evtCondition, p, a, r.Action)
+	side, err := order.StringToOrderSide(r.Side)
 	if err != nil {
 		return nil, err
 	}
 
-	return &gctrpc.AddEventResponse{Id: id}, nil
+	oType, err := order.StringToOrderType(r.OrderType)
+	if err != nil {
+		return nil, err
+	}
+
+	submission := &order.Submit{
+		Pair:          p,
+		Side:          side,
+		Type:          oType,
+		Amount:        r.Amount,
+		Price:         r.Price,
+		ClientID:      r.ClientId,
+		ClientOrderID: r.ClientId,
+		Exchange:      r.Exchange,
+		AssetType:     a,
+	}
+	if r.MarginType != "" {
+		submission.MarginType, err = margin.StringToMarginType(r.MarginType)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return submission, nil
+}
+
+// eventCancelOrderFromRPC converts an event order cancellation received via
+// gRPC
+func (s *RPCServer) eventCancelOrderFromRPC(r *gctrpc.CancelOrderRequest) (*order.Cancel, error) {
+	if r.Pair == nil {
+		return nil, errCurrencyPairUnset
+	}
+
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+
+	p := currency.NewPairWithDelimiter(r.Pair.Base, r.Pair.Quote, r.Pair.Delimiter)
+
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkParams(r.Exchange, exch, a, p)
+	if err != nil {
+		return nil, err
+	}
+
+	side, err := order.StringToOrderSide(r.Side)
+	if err != nil {
+		return nil, err
+	}
+
+	return &order.Cancel{
+		Exchange:      r.Exchange,
+		AccountID:     r.AccountId,
+		OrderID:       r.OrderId,
+		Side:          side,
+		WalletAddress: r.WalletAddress,
+		Pair:          p,
+		AssetType:     a,
+	}, nil
+}
+
+func eventConditionParamsToRPC(c *EventConditionParams) *gctrpc.ConditionParams {
+	return &gctrpc.ConditionParams{
+		Condition:         c.Condition,
+		Price:             c.Price,
+		CheckBids:         c.CheckBids,
+		CheckAsks:         c.CheckAsks,
+		OrderbookAmount:   c.OrderbookAmount,
+		Value:             c.Value,
+		SecondaryExchange: c.SecondaryExchange,
+		Interval:          int64(c.Interval.Duration()),
+		Period:            c.Period,
+	}
 }
 
 // RemoveEvent removes an event, specified by an event ID
@@ -1627,6 +2119,28 @@ func (s *RPCServer) RemoveEvent(_ context.Context, r *gctrpc.RemoveEventRequest)
 		Data: fmt.Sprintf("event %d removed", r.Id)}, nil
 }
 
+// GetEventExecutions returns the stored execution history of an event
+func (s *RPCServer) GetEventExecutions(_ context.Context, r *gctrpc.GetEventExecutionsRequest) (*gctrpc.GetEventExecutionsResponse, error) {
+	executions, err := s.eventManager.GetEventExecutions(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetEventExecutionsResponse{
+		Executions: make([]*gctrpc.EventExecution, len(executions)),
+	}
+	for i := range executions {
+		resp.Executions[i] = &gctrpc.EventExecution{
+			Id:         executions[i].ID,
+			EventId:    executions[i].EventID,
+			Action:     executions[i].Action,
+			Success:    executions[i].Success,
+			Result:     executions[i].Result,
+			ExecutedAt: executions[i].ExecutedAt.Format(common.SimpleTimeFormatWithTimezone),
+		}
+	}
+	return resp, nil
+}
+
 // GetCryptocurrencyDepositAddresses returns a list of cryptocurrency deposit
 // addresses specified by an exchange
 func (s *RPCServer) GetCryptocurrencyDepositAddresses(_ context.Context, r *gctrpc.GetCryptocurrencyDepositAddressesRequest) (*gctrpc.GetCryptocurrencyDepositAddressesResponse, error) {
@@ -2264,6 +2778,172 @@ func (s *RPCServer) GetExchangeOrderbookStream(r *gctrpc.GetExchangeOrderbookStr
 	}
 }
 
+// GetConsolidatedOrderbook returns the orderbook of a pair merged across
+// exchanges with each level tagged by its exchange
+func (s *RPCServer) GetConsolidatedOrderbook(_ context.Context, r *gctrpc.GetConsolidatedOrderbookRequest) (*gctrpc.ConsolidatedOrderbookResponse, error) {
+	c, err := s.newConsolidatedOrderbook(r)
+	if err != nil {
+		return nil, err
+	}
+	err = c.Update()
+	if err != nil {
+		return nil, err
+	}
+	var ob *orderbook.ConsolidatedBase
+	ob, err = c.Retrieve()
+	if err != nil {
+		return nil, err
+	}
+	return consolidatedOrderbookToRPC(ob, r.Depth), nil
+}
+
+// GetConsolidatedOrderbookStream streams the orderbook of a pair merged across
+// exchanges whenever one of the merged orderbooks is updated
+func (s *RPCServer) GetConsolidatedOrderbookStream(r *gctrpc.GetConsolidatedOrderbookRequest, stream gctrpc.GoCryptoTraderService_GetConsolidatedOrderbookStreamServer) error {
+	c, err := s.newConsolidatedOrderbook(r)
+	if err != nil {
+		return err
+	}
+	pipe, err := c.Subscribe()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		pipeErr := pipe.Release()
+		if pipeErr != nil {
+			log.Errorln(log.DispatchMgr, pipeErr)
+		}
+	}()
+
+	err = c.Start()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		stopErr := c.Stop()
+		if stopErr != nil {
+			log.Errorln(log.DispatchMgr, stopErr)
+		}
+	}()
+
+	if err = c.Update(); err != nil {
+		// Nothing is published without a merged orderbook, so the error is
+		// sent until one arrives
+		if err = stream.Send(&gctrpc.ConsolidatedOrderbookResponse{
+			Pair:        r.Pair,
+			AssetType:   r.AssetType,
+			LastUpdated: time.Now().UnixMicro(),
+			Error:       err.Error(),
+		}); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case data, ok := <-pipe.Channel():
+			if !ok {
+				return errDispatchSystem
+			}
+			consolidated, ok := data.(*orderbook.Consolidated)
+			if !ok {
+				return common.GetTypeAssertError("*orderbook.Consolidated", data)
+			}
+			resp := &gctrpc.ConsolidatedOrderbookResponse{
+				Pair:      r.Pair,
+				AssetType: r.AssetType,
+			}
+			ob, retrieveErr := consolidated.Retrieve()
+			if retrieveErr != nil {
+				resp.Error = retrieveErr.Error()
+				resp.LastUpdated = time.Now().UnixMicro()
+			} else {
+				resp = consolidatedOrderbookToRPC(ob, r.Depth)
+			}
+			err = stream.Send(resp)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// newConsolidatedOrderbook returns the consolidated orderbook of a request,
+// merging all loaded exchanges when none are specified. Quote currencies are
+// converted with the forex rates when requested
+func (s *RPCServer) newConsolidatedOrderbook(r *gctrpc.GetConsolidatedOrderbookRequest) (*orderbook.Consolidated, error) {
+	if r == nil {
+		return nil, errInvalidArguments
+	}
+	if r.Pair == nil || r.Pair.Base == "" || r.Pair.Quote == "" {
+		return nil, errCurrencyPairUnset
+	}
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	exchanges := r.Exchanges
+	if len(exchanges) == 0 {
+		var exchs []exchange.IBotExchange
+		exchs, err = s.ExchangeManager.GetExchanges()
+		if err != nil {
+			return nil, err
+		}
+		for i := range exchs {
+			exchanges = append(exchanges, exchs[i].GetName())
+		}
+	}
+	for i := range exchanges {
+		if _, err = s.GetExchangeByName(exchanges[i]); err != nil {
+			return nil, err
+		}
+	}
+	var rate orderbook.QuoteRate
+	if r.ConvertQuotes {
+		rate = func(from, to currency.Code) (float64, error) {
+			return currency.ConvertFiat(1, from, to)
+		}
+	}
+	p := currency.NewPair(currency.NewCode(r.Pair.Base), currency.NewCode(r.Pair.Quote))
+	p.Delimiter = r.Pair.Delimiter
+	return orderbook.NewConsolidated(exchanges, p, a, rate)
+}
+
+// consolidatedOrderbookToRPC converts a consolidated orderbook, keeping at most
+// depth levels of each side when depth is positive
+func consolidatedOrderbookToRPC(ob *orderbook.ConsolidatedBase, depth int64) *gctrpc.ConsolidatedOrderbookResponse {
+	side := func(items []orderbook.ConsolidatedItem) []*gctrpc.ConsolidatedOrderbookItem {
+		if depth > 0 && int64(len(items)) > depth {
+			items = items[:depth]
+		}
+		resp := make([]*gctrpc.ConsolidatedOrderbookItem, len(items))
+		for i := range items {
+			resp[i] = &gctrpc.ConsolidatedOrderbookItem{
+				Amount:   items[i].Amount,
+				Price:    items[i].Price,
+				Exchange: items[i].Exchange,
+				Pair:     items[i].Pair.String(),
+			}
+		}
+		return resp
+	}
+	return &gctrpc.ConsolidatedOrderbookResponse{
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: ob.Pair.Delimiter,
+			Base:      ob.Pair.Base.String(),
+			Quote:     ob.Pair.Quote.String(),
+		},
+		AssetType:   ob.Asset.String(),
+		Bids:        side(ob.Bids),
+		Asks:        side(ob.Asks),
+		LastUpdated: ob.LastUpdated.UnixMicro(),
+	}
+}
+
 // GetTickerStream streams the requested updated ticker
 func (s *RPCServer) GetTickerStream(r *gctrpc.GetTickerStreamRequest, stream gctrpc.GoCryptoTraderService_GetTickerStreamServer) error {
 	if r.Exchange == "" {
@@ -5258,6 +5938,471 @@ func (s *RPCServer) GetTechnicalAnalysis(ctx context.Context, r *gctrpc.GetTechn
 	return &gctrpc.GetTechnicalAnalysisResponse{Signals: signals}, nil
 }
 
+// GetTCAReport analyses the execution quality of completed orders from the
+// order manager and order repository against candles stored in the database
+func (s *RPCServer) GetTCAReport(_ context.Context, r *gctrpc.GetTCAReportRequest) (*gctrpc.GetTCAReportResponse, error) {
+	if r == nil {
+		return nil, errInvalidArguments
+	}
+	start, end := r.Start.AsTime(), r.End.AsTime()
+	err := common.StartEndTimeCheck(start, end)
+	if err != nil {
+		return nil, err
+	}
+	var a asset.Item
+	if r.AssetType != "" {
+		a, err = asset.New(r.AssetType)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var pair currency.Pair
+	if r.Pair != nil && (r.Pair.Base != "" || r.Pair.Quote != "") {
+		pair, err = currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
+		if err != nil {
+			return nil, err
+		}
+	}
+	groupBy := tca.GroupByExchange | tca.GroupByPair | tca.GroupByStrategy
+	if len(r.GroupBy) > 0 {
+		groupBy, err = tca.GroupByFromStrings(r.GroupBy...)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if r.Period < 0 {
+		return nil, fmt.Errorf("%w period cannot be negative", errInvalidArguments)
+	}
+	interval := kline.OneMin
+	if r.Interval > 0 {
+		interval = kline.Interval(r.Interval)
+	}
+
+	orders, err := s.getTCAOrders(r.Exchange, a, pair, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	candles := make(map[key.ExchangePairAsset][]kline.Candle)
+	results := make([]tca.Result, 0, len(orders))
+	for i := range orders {
+		strategy := tca.StrategyFromClientOrderID(orders[i].ClientOrderID)
+		if r.Strategy != "" && r.Strategy != strategy {
+			continue
+		}
+		k := key.ExchangePairAsset{
+			Exchange: strings.ToLower(orders[i].Exchange),
+			Base:     orders[i].Pair.Base.Item,
+			Quote:    orders[i].Pair.Quote.Item,
+			Asset:    orders[i].AssetType,
+		}
+		c, ok := candles[k]
+		if !ok && s.DatabaseManager.IsConnected() {
+			var klineItem *kline.Item
+			klineItem, err = kline.LoadFromDatabase(orders[i].Exchange, orders[i].Pair, orders[i].AssetType, interval, start.Add(-interval.Duration()), end.Add(interval.Duration()))
+			if err != nil {
+				log.Debugf(log.GRPCSys, "TCA report: no candles for %s %s %s: %v", orders[i].Exchange, orders[i].AssetType, orders[i].Pair, err)
+			} else {
+				c = klineItem.Candles
+			}
+			candles[k] = c
+		}
+		var result *tca.Result
+		result, err = tca.Analyse(&orders[i], strategy, c)
+		if err != nil {
+			log.Errorf(log.GRPCSys, "TCA report: %v", err)
+			continue
+		}
+		results = append(results, *result)
+	}
+
+	summaries := tca.Aggregate(results, groupBy, time.Duration(r.Period))
+	resp := &gctrpc.GetTCAReportResponse{
+		Summaries: make([]*gctrpc.TCASummary, len(summaries)),
+	}
+	for i := range summaries {
+		summary := &gctrpc.TCASummary{
+			Exchange:                   summaries[i].Exchange,
+			Strategy:                   summaries[i].Strategy,
+			Quote:                      summaries[i].Quote.String(),
+			Orders:                     int64(summaries[i].Orders),
+			Amount:                     summaries[i].Amount,
+			ExecutedAmount:             summaries[i].ExecutedAmount,
+			Notional:                   summaries[i].Notional,
+			Fee:                        summaries[i].Fee,
+			ArrivalSlippageBps:         summaries[i].ArrivalSlippage,
+			ImplementationShortfall:    summaries[i].ImplementationShortfall,
+			ImplementationShortfallBps: summaries[i].ImplementationShortfallBps,
+			VwapDeviationBps:           summaries[i].VWAPDeviation,
+			FeeDragBps:                 summaries[i].FeeDrag,
+			FillRate:                   summaries[i].FillRate,
+		}
+		if !summaries[i].Pair.IsEmpty() {
+			summary.Pair = &gctrpc.CurrencyPair{
+				Delimiter: summaries[i].Pair.Delimiter,
+				Base:      summaries[i].Pair.Base.String(),
+				Quote:     summaries[i].Pair.Quote.String(),
+			}
+			summary.AssetType = summaries[i].Asset.String()
+		}
+		if !summaries[i].PeriodStart.IsZero() {
+			summary.PeriodStart = timestamppb.New(summaries[i].PeriodStart)
+		}
+		resp.Summaries[i] = summary
+	}
+	if !r.IncludeOrders {
+		return resp, nil
+	}
+	resp.Orders = make([]*gctrpc.TCAOrder, len(results))
+	for i := range results {
+		resp.Orders[i] = &gctrpc.TCAOrder{
+			Exchange: results[i].Exchange,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: results[i].Pair.Delimiter,
+				Base:      results[i].Pair.Base.String(),
+				Quote:     results[i].Pair.Quote.String(),
+			},
+			AssetType:                  results[i].Asset.String(),
+			Strategy:                   results[i].Strategy,
+			OrderId:                    results[i].OrderID,
+			Side:                       results[i].Side.String(),
+			Type:                       results[i].Type.String(),
+			Status:                     results[i].Status.String(),
+			Date:                       timestamppb.New(results[i].Date),
+			Amount:                     results[i].Amount,
+			ExecutedAmount:             results[i].ExecutedAmount,
+			AverageExecutedPrice:       results[i].AverageExecutedPrice,
+			Notional:                   results[i].Notional,
+			Fee:                        results[i].Fee,
+			HasMarketData:              results[i].HasMarketData,
+			ArrivalPrice:               results[i].ArrivalPrice,
+			MarketVwap:                 results[i].MarketVWAP,
+			ClosingPrice:               results[i].ClosingPrice,
+			ArrivalSlippageBps:         results[i].ArrivalSlippage,
+			ImplementationShortfall:    results[i].ImplementationShortfall,
+			ImplementationShortfallBps: results[i].ImplementationShortfallBps,
+			VwapDeviationBps:           results[i].VWAPDeviation,
+			FeeDragBps:                 results[i].FeeDrag,
+			FillRate:                   results[i].FillRate,
+		}
+	}
+	return resp, nil
+}
+
+// getTCAOrders returns completed orders placed between start and end. Orders
+// are sourced from the order repository, with their fills as trades, and the
+// order manager which takes precedence for orders held in both
+func (s *RPCServer) getTCAOrders(exchName string, a asset.Item, pair currency.Pair, start, end time.Time) ([]order.Detail, error) {
+	type orderKey struct {
+		exchange string
+		orderID  string
+	}
+	found := make(map[orderKey]order.Detail)
+
+	orderDB, err := dborder.Setup(s.DatabaseManager.GetInstance())
+	if err != nil {
+		return nil, err
+	}
+	if orderDB != nil {
+		var fillDB *dbfill.DBService
+		fillDB, err = dbfill.Setup(s.DatabaseManager.GetInstance())
+		if err != nil {
+			return nil, err
+		}
+		names := []string{exchName}
+		if exchName == "" {
+			var exchanges []exchange.IBotExchange
+			exchanges, err = s.ExchangeManager.GetExchanges()
+			if err != nil {
+				return nil, err
+			}
+			names = make([]string, len(exchanges))
+			for i := range exchanges {
+				names[i] = exchanges[i].GetName()
+			}
+		}
+		for i := range names {
+			var stored []dborder.Order
+			stored, err = orderDB.GetInRange(names[i], start, end)
+			if err != nil {
+				return nil, err
+			}
+			for j := range stored {
+				var d *order.Detail
+				d, err = orderFromDB(&stored[j])
+				if err != nil {
+					log.Errorf(log.GRPCSys, "TCA report: %s order %s could not be loaded: %v", stored[j].Exchange, stored[j].OrderID, err)
+					continue
+				}
+				if fillDB != nil {
+					var fills []dbfill.Fill
+					fills, err = fillDB.GetByOrderID(stored[j].Exchange, stored[j].OrderID)
+					if err != nil {
+						return nil, err
+					}
+					d.Trades = tradesFromFills(fills)
+				}
+				found[orderKey{strings.ToLower(d.Exchange), d.OrderID}] = *d
+			}
+		}
+	}
+
+	if s.OrderManager.IsRunning() {
+		// The order store is used directly as synthetic orders would double
+		// count the execution of their child orders
+		var managed []order.Detail
+		managed, err = s.OrderManager.orderStore.getFilteredOrders(&order.Filter{Exchange: exchName})
+		if err != nil {
+			return nil, err
+		}
+		for i := range managed {
+			found[orderKey{strings.ToLower(managed[i].Exchange), managed[i].OrderID}] = managed[i]
+		}
+	}
+
+	orders := make([]order.Detail, 0, len(found))
+	for _, d := range found {
+		// Synthetic parents have no execution price of their own, their
+		// execution is analysed through their child orders
+		if s.OrderManager.IsRunning() && s.OrderManager.isSyntheticOrder(d.OrderID) {
+			continue
+		}
+		if !d.IsInactive() ||
+			d.Date.Before(start) ||
+			d.Date.After(end) ||
+			(a != asset.Empty && d.AssetType != a) ||
+			(!pair.IsEmpty() && !d.Pair.Equal(pair)) {
+			continue
+		}
+		orders = append(orders, d)
+	}
+	sort.Slice(orders, func(i, j int) bool {
+		return orders[i].Date.Before(orders[j].Date)
+	})
+	return orders, nil
+}
+
+// GetArbitrageOpportunities returns the opportunities found by the latest
+// arbitrage scan
+func (s *RPCServer) GetArbitrageOpportunities(_ context.Context, r *gctrpc.GetArbitrageOpportunitiesRequest) (*gctrpc.GetArbitrageOpportunitiesResponse, error) {
+	if r == nil {
+		return nil, errInvalidArguments
+	}
+	scan, err := s.arbitrageManager.getLatestScan()
+	if err != nil {
+		return nil, err
+	}
+	return arbitrageScanToRPC(scan, r)
+}
+
+// GetArbitrageOpportunitiesStream streams the opportunities found by every
+// arbitrage scan
+func (s *RPCServer) GetArbitrageOpportunitiesStream(r *gctrpc.GetArbitrageOpportunitiesRequest, stream gctrpc.GoCryptoTraderService_GetArbitrageOpportunitiesStreamServer) error {
+	if r == nil {
+		return errInvalidArguments
+	}
+	if _, err := (&ArbitrageScan{}).filter(r.Exchange, ArbitrageType(r.Type), r.MinimumProfitPercentage, int(r.Limit)); err != nil {
+		return err
+	}
+	pipe, err := s.arbitrageManager.subscribe()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		pipeErr := pipe.Release()
+		if pipeErr != nil {
+			log.Errorln(log.DispatchMgr, pipeErr)
+		}
+	}()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case data, ok := <-pipe.Channel():
+			if !ok {
+				return errDispatchSystem
+			}
+			scan, ok := data.(*ArbitrageScan)
+			if !ok {
+				return common.GetTypeAssertError("*ArbitrageScan", data)
+			}
+			resp, err := arbitrageScanToRPC(scan, r)
+			if err != nil {
+				return err
+			}
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// arbitrageScanToRPC converts the opportunities of a scan which match the
+// request
+func arbitrageScanToRPC(scan *ArbitrageScan, r *gctrpc.GetArbitrageOpportunitiesRequest) (*gctrpc.GetArbitrageOpportunitiesResponse, error) {
+	opportunities, err := scan.filter(r.Exchange, ArbitrageType(r.Type), r.MinimumProfitPercentage, int(r.Limit))
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetArbitrageOpportunitiesResponse{
+		Opportunities: make([]*gctrpc.ArbitrageOpportunity, len(opportunities)),
+		Timestamp:     timestamppb.New(scan.Time),
+	}
+	for i := range opportunities {
+		legs := make([]*gctrpc.ArbitrageLeg, len(opportunities[i].Legs))
+		for j := range opportunities[i].Legs {
+			leg := &opportunities[i].Legs[j]
+			legs[j] = &gctrpc.ArbitrageLeg{
+				Exchange: leg.Exchange,
+				Pair: &gctrpc.CurrencyPair{
+					Delimiter: leg.Pair.Delimiter,
+					Base:      leg.Pair.Base.String(),
+					Quote:     leg.Pair.Quote.String(),
+				},
+				Side:         leg.Side.String(),
+				Amount:       leg.Amount,
+				AveragePrice: leg.AveragePrice,
+				FeeRate:      leg.FeeRate,
+				Fee:          leg.Fee,
+			}
+		}
+		resp.Opportunities[i] = &gctrpc.ArbitrageOpportunity{
+			Type:             string(opportunities[i].Type),
+			AssetType:        opportunities[i].Asset.String(),
+			Currency:         opportunities[i].Currency.String(),
+			Amount:           opportunities[i].Amount,
+			Returned:         opportunities[i].Return,
+			Profit:           opportunities[i].Profit,
+			ProfitPercentage: opportunities[i].ProfitPercentage,
+			WithdrawalFee:    opportunities[i].WithdrawalFee,
+			Legs:             legs,
+			Time:             timestamppb.New(opportunities[i].Time),
+		}
+	}
+	return resp, nil
+}
+
+// StartLiveStrategy starts running a backtester strategy against the live
+// market data of an exchange
+func (s *RPCServer) StartLiveStrategy(_ context.Context, r *gctrpc.StartLiveStrategyRequest) (*gctrpc.LiveStrategy, error) {
+	if r == nil {
+		return nil, errInvalidArguments
+	}
+	if r.Pair == nil {
+		return nil, errCurrencyPairUnset
+	}
+	cfg := &config.LiveStrategyConfig{
+		Strategy:      r.Strategy,
+		Exchange:      r.Exchange,
+		Pair:          currency.NewPairWithDelimiter(r.Pair.Base, r.Pair.Quote, r.Pair.Delimiter),
+		Asset:         r.AssetType,
+		Interval:      time.Duration(r.Interval),
+		OrderAmount:   r.OrderAmount,
+		WarmupCandles: r.WarmupCandles,
+		Paper:         r.Paper,
+	}
+	if r.CustomSettings != "" {
+		if err := json.Unmarshal([]byte(r.CustomSettings), &cfg.CustomSettings); err != nil {
+			return nil, fmt.Errorf("%w custom settings: %v", errInvalidArguments, err)
+		}
+	}
+	d, err := s.strategyManager.startStrategy(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return liveStrategyToRPC(d), nil
+}
+
+// StopLiveStrategy stops a running live strategy, its orders are left in
+// place
+func (s *RPCServer) StopLiveStrategy(_ context.Context, r *gctrpc.StopLiveStrategyRequest) (*gctrpc.GenericResponse, error) {
+	if r == nil {
+		return nil, errInvalidArguments
+	}
+	id, err := uuid.FromString(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.strategyManager.stopStrategy(id); err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess, Data: "strategy " + r.Id + " stopped"}, nil
+}
+
+// GetLiveStrategies returns every live strategy started along with its PNL
+func (s *RPCServer) GetLiveStrategies(_ context.Context, r *gctrpc.GetLiveStrategiesRequest) (*gctrpc.GetLiveStrategiesResponse, error) {
+	if r == nil {
+		return nil, errInvalidArguments
+	}
+	strategies, err := s.strategyManager.getStrategies()
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetLiveStrategiesResponse{Strategies: make([]*gctrpc.LiveStrategy, len(strategies))}
+	for i := range strategies {
+		resp.Strategies[i] = liveStrategyToRPC(&strategies[i])
+	}
+	return resp, nil
+}
+
+// GetLiveStrategyLogs returns the most recent log entries of a live strategy
+func (s *RPCServer) GetLiveStrategyLogs(_ context.Context, r *gctrpc.GetLiveStrategyLogsRequest) (*gctrpc.GetLiveStrategyLogsResponse, error) {
+	if r == nil {
+		return nil, errInvalidArguments
+	}
+	id, err := uuid.FromString(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	logs, err := s.strategyManager.getStrategyLogs(id, int(r.Limit))
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetLiveStrategyLogsResponse{Logs: make([]*gctrpc.LiveStrategyLog, len(logs))}
+	for i := range logs {
+		resp.Logs[i] = &gctrpc.LiveStrategyLog{
+			Time:    timestamppb.New(logs[i].Time),
+			Message: logs[i].Message,
+		}
+	}
+	return resp, nil
+}
+
+// liveStrategyToRPC converts the state of a live strategy
+func liveStrategyToRPC(d *LiveStrategyDetail) *gctrpc.LiveStrategy {
+	resp := &gctrpc.LiveStrategy{
+		Id:          d.ID.String(),
+		Strategy:    d.Strategy,
+		Description: d.Description,
+		Exchange:    d.Exchange,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: d.Pair.Delimiter,
+			Base:      d.Pair.Base.String(),
+			Quote:     d.Pair.Quote.String(),
+		},
+		AssetType: d.Asset.String(),
+		Interval:  int64(d.Interval),
+		Running:   d.Running,
+		Started:   timestamppb.New(d.Started),
+		Candles:   int64(d.Candles),
+		Pnl: &gctrpc.LiveStrategyPNL{
+			Position:      d.PNL.Position,
+			AveragePrice:  d.PNL.AveragePrice,
+			LastPrice:     d.PNL.LastPrice,
+			RealisedPnl:   d.PNL.RealisedPNL,
+			UnrealisedPnl: d.PNL.UnrealisedPNL,
+			Fees:          d.PNL.Fees,
+			Orders:        d.PNL.Orders,
+		},
+	}
+	if !d.Stopped.IsZero() {
+		resp.Stopped = timestamppb.New(d.Stopped)
+	}
+	return resp
+}
+
 // GetMarginRatesHistory returns the margin lending or borrow rates for an exchange, asset, currency along with many customisable options
 func (s *RPCServer) GetMarginRatesHistory(ctx context.Context, r *gctrpc.GetMarginRatesHistoryRequest) (*gctrpc.GetMarginRatesHistoryResponse, error) {
 	if r == nil {
@@ -28,12 +28,16 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/convert"
 	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/common/tracing"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/database/drivers"
 	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	dbevent "github.com/thrasher-corp/gocryptotrader/database/repository/event"
 	dbexchange "github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	dbfill "github.com/thrasher-corp/gocryptotrader/database/repository/fill"
+	dborder "github.com/thrasher-corp/gocryptotrader/database/repository/order"
 	sqltrade "github.com/thrasher-corp/gocryptotrader/database/repository/trade"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
@@ -50,10 +54,13 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/thrasher-corp/gocryptotrader/portfolio"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 	"github.com/thrasher-corp/goose"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -4290,3 +4297,636 @@ func TestRPCProxyAuthClient(t *testing.T) {
 		})
 	}
 }
+
+func TestAddAndGetEvents(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err)
+	exch.SetDefaults()
+	b := exch.GetBase()
+	b.Enabled = true
+	pair := currency.NewPair(currency.BTC, currency.USD)
+	b.CurrencyPairs.Pairs = map[asset.Item]*currency.PairStore{
+		asset.Spot: {
+			AssetEnabled: convert.BoolPtr(true),
+			Enabled:      currency.Pairs{pair},
+			Available:    currency.Pairs{pair},
+			ConfigFormat: &currency.PairFormat{Uppercase: true},
+		},
+	}
+	require.NoError(t, em.Add(exch))
+	m, err := setupEventManager(&CommunicationManager{}, em, nil, nil, 0, false)
+	require.NoError(t, err)
+	require.NoError(t, m.Start())
+	s := RPCServer{Engine: &Engine{ExchangeManager: em, eventManager: m}}
+
+	rpcPair := &gctrpc.CurrencyPair{Base: pair.Base.String(), Quote: pair.Quote.String()}
+	_, err = s.AddEvent(context.Background(), &gctrpc.AddEventRequest{
+		Exchange:  testExchange,
+		Item:      ItemPrice,
+		Pair:      rpcPair,
+		AssetType: asset.Spot.String(),
+		Action:    ActionConsolePrint,
+	})
+	require.ErrorIs(t, err, common.ErrNilPointer)
+
+	resp, err := s.AddEvent(context.Background(), &gctrpc.AddEventRequest{
+		Exchange:        testExchange,
+		Item:            ItemPrice,
+		ConditionParams: &gctrpc.ConditionParams{Condition: ConditionGreaterThan, Price: 1337},
+		Pair:            rpcPair,
+		AssetType:       asset.Spot.String(),
+		Action:          ActionConsolePrint,
+		Logic:           LogicOr,
+		Cooldown:        int64(time.Minute),
+		Conditions: []*gctrpc.EventCondition{
+			{
+				Exchange:        testExchange,
+				Item:            ItemImbalance,
+				ConditionParams: &gctrpc.ConditionParams{Condition: ConditionLessThan, Value: -0.5},
+				Pair:            rpcPair,
+				AssetType:       asset.Spot.String(),
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	events, err := s.GetEvents(context.Background(), &gctrpc.GetEventsRequest{})
+	require.NoError(t, err)
+	require.Len(t, events.Events, 1)
+	assert.Equal(t, resp.Id, events.Events[0].Id)
+	assert.Equal(t, LogicOr, events.Events[0].Logic)
+	assert.Equal(t, int64(time.Minute), events.Events[0].Cooldown)
+	require.Len(t, events.Events[0].Conditions, 1)
+	assert.Equal(t, ItemImbalance, events.Events[0].Conditions[0].Item)
+}
+
+func TestGetEventExecutions(t *testing.T) {
+	engerino := RPCTestSetup(t)
+	defer CleanRPCTest(t, engerino)
+
+	m, err := setupEventManager(&CommunicationManager{}, engerino.ExchangeManager, nil, engerino.DatabaseManager, 0, false)
+	require.NoError(t, err)
+	require.NoError(t, m.Start())
+	s := RPCServer{Engine: &Engine{ExchangeManager: engerino.ExchangeManager, eventManager: m}}
+
+	_, err = s.GetEventExecutions(context.Background(), &gctrpc.GetEventExecutionsRequest{Id: 1})
+	require.ErrorIs(t, err, errEventNotFound)
+
+	pair := currency.NewPair(currency.BTC, currency.USD)
+	id, err := m.Add(testExchange, ItemPrice, EventConditionParams{Condition: ConditionGreaterThan, Price: 1337}, pair, asset.Spot, ActionTest)
+	require.NoError(t, err)
+	now := time.Now()
+	require.NoError(t, m.db.InsertExecutions(&dbevent.Execution{EventID: id, Action: ActionTest, Success: true, ExecutedAt: now}))
+
+	resp, err := s.GetEventExecutions(context.Background(), &gctrpc.GetEventExecutionsRequest{Id: id})
+	require.NoError(t, err)
+	require.Len(t, resp.Executions, 1)
+	assert.Equal(t, id, resp.Executions[0].EventId)
+	assert.True(t, resp.Executions[0].Success)
+	assert.NotEmpty(t, resp.Executions[0].Id)
+}
+
+func TestRPCServerSubmitSyntheticOrder(t *testing.T) {
+	t.Parallel()
+	om, _ := syntheticOrdersSetup(t)
+	em, ok := om.orderStore.exchangeManager.(*ExchangeManager)
+	require.True(t, ok)
+	s := RPCServer{Engine: &Engine{ExchangeManager: em, OrderManager: om}}
+
+	_, err := s.SubmitSyntheticOrder(context.Background(), &gctrpc.SubmitSyntheticOrderRequest{
+		AssetType: asset.Spot.String(),
+	})
+	assert.ErrorIs(t, err, errCurrencyPairUnset)
+
+	req := &gctrpc.SubmitSyntheticOrderRequest{
+		Exchange: testExchange,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: btcusdPair.Delimiter,
+			Base:      btcusdPair.Base.String(),
+			Quote:     btcusdPair.Quote.String(),
+		},
+		AssetType: asset.Spot.String(),
+		Side:      order.Sell.String(),
+		OrderType: "bruh",
+		Amount:    1,
+	}
+	_, err = s.SubmitSyntheticOrder(context.Background(), req)
+	assert.Error(t, err)
+
+	req.OrderType = order.Stop.String()
+	_, err = s.SubmitSyntheticOrder(context.Background(), req)
+	assert.ErrorIs(t, err, errInvalidTriggerPrice)
+
+	req.TriggerPrice = 1000
+	resp, err := s.SubmitSyntheticOrder(context.Background(), req)
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.OrderId)
+	assert.Equal(t, order.Pending.String(), resp.Status)
+
+	managed, err := s.GetManagedOrders(context.Background(), &gctrpc.GetOrdersRequest{
+		Exchange:  testExchange,
+		AssetType: asset.Spot.String(),
+		Pair:      req.Pair,
+	})
+	require.NoError(t, err)
+	require.Len(t, managed.Orders, 1)
+	assert.Equal(t, resp.OrderId, managed.Orders[0].Id)
+}
+
+func TestRPCServerRouteOrder(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.NewCode("ROUTERRPC"), currency.USDT)
+	exch := &routerTestExchange{name: "routerrpc", pairs: currency.Pairs{p}}
+	require.NoError(t, (&orderbook.Base{
+		Exchange: exch.name,
+		Pair:     p,
+		Asset:    asset.Spot,
+		Bids:     orderbook.Items{{Price: 99, Amount: 1}},
+		Asks:     orderbook.Items{{Price: 100, Amount: 1}, {Price: 101, Amount: 1}},
+	}).Process())
+	routerTestBalance(t, exch, currency.USDT, 1000)
+	s := RPCServer{Engine: &Engine{OrderManager: routerTestSetup(t, exch)}}
+
+	_, err := s.RouteOrder(context.Background(), nil)
+	assert.ErrorIs(t, err, errInvalidArguments)
+	_, err = s.RouteOrder(context.Background(), &gctrpc.RouteOrderRequest{AssetType: asset.Spot.String()})
+	assert.ErrorIs(t, err, errCurrencyPairUnset)
+
+	req := &gctrpc.RouteOrderRequest{
+		Pair:        &gctrpc.CurrencyPair{Base: p.Base.String(), Quote: p.Quote.String()},
+		AssetType:   asset.Spot.String(),
+		Side:        order.Buy.String(),
+		Amount:      1.5,
+		MaxSlippage: 0.5,
+	}
+	resp, err := s.RouteOrder(context.Background(), req)
+	require.NoError(t, err)
+	assert.False(t, resp.Executed)
+	require.Len(t, resp.Allocations, 1)
+	assert.Equal(t, 1.0, resp.Allocations[0].Amount, "the second level is beyond the maximum slippage")
+	assert.Empty(t, resp.Allocations[0].OrderId)
+	assert.Empty(t, exch.orders, "a preview should not place orders")
+
+	req.MaxSlippage = 2
+	req.Execute = true
+	resp, err = s.RouteOrder(context.Background(), req)
+	require.NoError(t, err)
+	assert.True(t, resp.Executed)
+	require.Len(t, resp.Allocations, 1)
+	assert.Equal(t, 1.5, resp.Allocations[0].Amount)
+	assert.Equal(t, 101.0, resp.Allocations[0].WorstPrice)
+	assert.NotEmpty(t, resp.Allocations[0].OrderId)
+	assert.Len(t, exch.orders, 1)
+}
+
+func TestGetTCAReport(t *testing.T) {
+	engerino := RPCTestSetup(t)
+	defer CleanRPCTest(t, engerino)
+
+	start := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	_, err := kline.StoreInDatabase(&kline.Item{
+		Exchange: testExchange,
+		Pair:     btcusdPair,
+		Asset:    asset.Spot,
+		Interval: kline.OneMin,
+		Candles: []kline.Candle{
+			{Time: start, Open: 100, High: 102, Low: 98, Close: 101, Volume: 10},
+			{Time: start.Add(time.Minute), Open: 101, High: 103, Low: 100, Close: 102, Volume: 30},
+		},
+	}, false)
+	require.NoError(t, err)
+
+	orderDB, err := dborder.Setup(engerino.DatabaseManager.GetInstance())
+	require.NoError(t, err)
+	require.NoError(t, orderDB.Upsert(&dborder.Order{
+		Exchange:             testExchange,
+		OrderID:              "stored",
+		ClientOrderID:        "05alpha3f2a9c1b7e4d",
+		Base:                 "BTC",
+		Quote:                "USD",
+		AssetType:            asset.Spot.String(),
+		Side:                 order.Buy.String(),
+		Type:                 order.Limit.String(),
+		Status:               order.Cancelled.String(),
+		Amount:               2,
+		ExecutedAmount:       1,
+		AverageExecutedPrice: 101,
+		Date:                 start.Add(30 * time.Second),
+		LastUpdated:          start.Add(40 * time.Second),
+	}))
+	fillDB, err := dbfill.Setup(engerino.DatabaseManager.GetInstance())
+	require.NoError(t, err)
+	require.NoError(t, fillDB.Insert(&dbfill.Fill{
+		Exchange:  testExchange,
+		OrderID:   "stored",
+		TradeID:   "1",
+		Base:      "BTC",
+		Quote:     "USD",
+		AssetType: asset.Spot.String(),
+		Side:      order.Buy.String(),
+		Price:     101,
+		Amount:    1,
+		Timestamp: start.Add(70 * time.Second),
+	}))
+
+	var wg sync.WaitGroup
+	om, err := SetupOrderManager(engerino.ExchangeManager, &CommunicationManager{}, &wg, &config.OrderManager{})
+	require.NoError(t, err)
+	om.started = 1
+	require.NoError(t, om.orderStore.add(&order.Detail{
+		Exchange:             testExchange,
+		OrderID:              "managed",
+		ClientOrderID:        "04beta3f2a9c1b7e4d",
+		Pair:                 btcusdPair,
+		AssetType:            asset.Spot,
+		Side:                 order.Sell,
+		Type:                 order.Market,
+		Status:               order.Filled,
+		Amount:               1,
+		ExecutedAmount:       1,
+		AverageExecutedPrice: 99,
+		Date:                 start.Add(10 * time.Second),
+		LastUpdated:          start.Add(20 * time.Second),
+	}))
+	require.NoError(t, orderDB.Upsert(&dborder.Order{
+		Exchange:             testExchange,
+		OrderID:              "synthetic",
+		Base:                 "BTC",
+		Quote:                "USD",
+		AssetType:            asset.Spot.String(),
+		Side:                 order.Buy.String(),
+		Type:                 order.TWAP.String(),
+		Status:               order.Filled.String(),
+		Amount:               1,
+		ExecutedAmount:       1,
+		AverageExecutedPrice: 100,
+		Date:                 start.Add(30 * time.Second),
+		LastUpdated:          start.Add(40 * time.Second),
+	}))
+	om.syntheticOrders.orders["synthetic"] = &syntheticOrder{orderID: "synthetic", status: order.Filled}
+	require.NoError(t, om.orderStore.add(&order.Detail{
+		Exchange:  testExchange,
+		OrderID:   "open",
+		Pair:      btcusdPair,
+		AssetType: asset.Spot,
+		Side:      order.Buy,
+		Type:      order.Limit,
+		Status:    order.Open,
+		Amount:    1,
+		Date:      start.Add(10 * time.Second),
+	}))
+	engerino.OrderManager = om
+	s := RPCServer{Engine: engerino}
+
+	_, err = s.GetTCAReport(context.Background(), nil)
+	assert.ErrorIs(t, err, errInvalidArguments)
+
+	req := &gctrpc.GetTCAReportRequest{
+		Exchange:      testExchange,
+		Start:         timestamppb.New(start.Add(-time.Hour)),
+		End:           timestamppb.New(start.Add(time.Hour)),
+		GroupBy:       []string{"side"},
+		IncludeOrders: true,
+	}
+	_, err = s.GetTCAReport(context.Background(), req)
+	assert.Error(t, err, "unknown grouping should error")
+
+	req.GroupBy = []string{"strategy"}
+	resp, err := s.GetTCAReport(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, resp.Orders, 2, "open and synthetic orders should be excluded")
+	assert.Equal(t, "managed", resp.Orders[0].OrderId)
+	assert.Equal(t, "stored", resp.Orders[1].OrderId)
+	assert.True(t, resp.Orders[1].HasMarketData)
+	assert.Equal(t, 100.0, resp.Orders[1].ArrivalPrice)
+	assert.InDelta(t, 100, resp.Orders[1].ArrivalSlippageBps, 1e-9)
+	assert.Equal(t, 0.5, resp.Orders[1].FillRate)
+	require.Len(t, resp.Summaries, 2)
+	assert.Equal(t, "alpha", resp.Summaries[0].Strategy)
+	assert.Equal(t, "beta", resp.Summaries[1].Strategy)
+	assert.Equal(t, "USD", resp.Summaries[0].Quote)
+	assert.Nil(t, resp.Summaries[0].Pair, "pair should not be set when not grouped")
+
+	req.Strategy = "beta"
+	req.IncludeOrders = false
+	resp, err = s.GetTCAReport(context.Background(), req)
+	require.NoError(t, err)
+	assert.Empty(t, resp.Orders)
+	require.Len(t, resp.Summaries, 1)
+	assert.InDelta(t, 100, resp.Summaries[0].ArrivalSlippageBps, 1e-9)
+}
+
+func TestRPCServerGetPortfolioPNL(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	_, err := s.GetPortfolioPNL(context.Background(), &gctrpc.GetPortfolioPNLRequest{})
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	pair := currency.NewPair(currency.NewCode("RPCPNL"), currency.USD)
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err)
+	exch.SetDefaults()
+	b := exch.GetBase()
+	b.CurrencyPairs.Pairs = map[asset.Item]*currency.PairStore{
+		asset.Spot: {
+			Available:     currency.Pairs{pair},
+			Enabled:       currency.Pairs{pair},
+			AssetEnabled:  convert.BoolPtr(true),
+			ConfigFormat:  &currency.PairFormat{Uppercase: true},
+			RequestFormat: &currency.PairFormat{Uppercase: true},
+		},
+	}
+	require.NoError(t, em.Add(exch))
+
+	history := &fakeOrderHistory{orders: []order.Detail{{
+		Exchange:             testExchange,
+		OrderID:              "1337",
+		Pair:                 pair,
+		AssetType:            asset.Spot,
+		Side:                 order.Buy,
+		Amount:               2,
+		ExecutedAmount:       2,
+		AverageExecutedPrice: 50,
+		LastUpdated:          time.Now(),
+	}}}
+	pm, err := setupPortfolioManager(em, history, 0, &portfolio.Base{CostBasisMethod: "lifo"}, currency.USD)
+	require.NoError(t, err)
+	pm.started = 1
+	s.portfolioManager = pm
+
+	_, err = s.GetPortfolioPNL(context.Background(), &gctrpc.GetPortfolioPNLRequest{})
+	assert.ErrorIs(t, err, errNoPriceAvailable, "holdings without a ticker should return an error")
+
+	require.NoError(t, ticker.ProcessTicker(&ticker.Price{ExchangeName: testExchange, Pair: pair, AssetType: asset.Spot, Last: 60}))
+	resp, err := s.GetPortfolioPNL(context.Background(), &gctrpc.GetPortfolioPNLRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "USD", resp.FiatCurrency)
+	assert.Equal(t, "LIFO", resp.CostBasisMethod)
+	require.Len(t, resp.Positions, 1)
+	assert.Equal(t, "RPCPNL", resp.Positions[0].Currency)
+	assert.Equal(t, 100.0, resp.Positions[0].CostBasis)
+	assert.Equal(t, 50.0, resp.Positions[0].AverageCost)
+	assert.Equal(t, 120.0, resp.Positions[0].MarketValue)
+	assert.Equal(t, 100.0, resp.CostBasis)
+}
+
+type testSpanExporter struct {
+	m     sync.Mutex
+	spans []tracing.SpanData
+}
+
+func (e *testSpanExporter) ExportSpans(_ context.Context, _ string, spans []tracing.SpanData) error {
+	e.m.Lock()
+	e.spans = append(e.spans, spans...)
+	e.m.Unlock()
+	return nil
+}
+
+func (e *testSpanExporter) Shutdown(context.Context) error {
+	return nil
+}
+
+// span returns the first exported span with the name
+func (e *testSpanExporter) span(name string) *tracing.SpanData {
+	e.m.Lock()
+	defer e.m.Unlock()
+	for i := range e.spans {
+		if e.spans[i].Name == name {
+			return &e.spans[i]
+		}
+	}
+	return nil
+}
+
+func startTestTracer(t *testing.T) (*tracing.Tracer, *testSpanExporter) {
+	t.Helper()
+	e := &testSpanExporter{}
+	tr, err := tracing.NewTracer("test", e)
+	require.NoError(t, err)
+	require.NoError(t, tr.Start())
+	tracing.SetGlobalTracer(tr)
+	t.Cleanup(func() { tracing.SetGlobalTracer(nil) })
+	return tr, e
+}
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// TestTraceInterceptors is not parallel as it sets the global tracer
+func TestTraceInterceptors(t *testing.T) {
+	tr, e := startTestTracer(t)
+	const traceParent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	remote, err := tracing.ParseTraceParent(traceParent)
+	require.NoError(t, err)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(tracing.TraceParentHeader, traceParent))
+
+	_, err = traceUnaryInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/gctrpc.GoCryptoTraderService/SubmitOrder"},
+		func(ctx context.Context, _ interface{}) (interface{}, error) {
+			sc := tracing.SpanContextFromContext(ctx)
+			assert.Equal(t, remote.TraceID, sc.TraceID, "the handler should continue the client's trace")
+			assert.NotEqual(t, remote.SpanID, sc.SpanID)
+			return nil, errExchangeNotLoaded
+		})
+	assert.ErrorIs(t, err, errExchangeNotLoaded)
+
+	err = traceStreamInterceptor(nil, &testServerStream{ctx: context.Background()}, &grpc.StreamServerInfo{FullMethod: "/gctrpc.GoCryptoTraderService/GetTickerStream"},
+		func(_ interface{}, ss grpc.ServerStream) error {
+			assert.True(t, tracing.SpanContextFromContext(ss.Context()).IsValid(), "the stream context should carry the span")
+			return nil
+		})
+	assert.NoError(t, err)
+	require.NoError(t, tr.Stop(context.Background()))
+
+	s := e.span("gctrpc.GoCryptoTraderService/SubmitOrder")
+	require.NotNil(t, s)
+	assert.Equal(t, tracing.SpanKindServer, s.Kind)
+	assert.Equal(t, remote.SpanID, s.ParentSpanID)
+	assert.Equal(t, tracing.StatusError, s.StatusCode)
+	assert.Contains(t, s.Attributes, tracing.String("rpc.method", "SubmitOrder"))
+	s = e.span("gctrpc.GoCryptoTraderService/GetTickerStream")
+	require.NotNil(t, s)
+	assert.NotEqual(t, remote.TraceID, s.SpanContext.TraceID, "streams without a traceparent should start a new trace")
+}
+
+func TestGetArbitrageOpportunities(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	_, err := s.GetArbitrageOpportunities(context.Background(), nil)
+	assert.ErrorIs(t, err, errInvalidArguments)
+	_, err = s.GetArbitrageOpportunities(context.Background(), &gctrpc.GetArbitrageOpportunitiesRequest{})
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+
+	m, _ := arbitrageSetup(t, &config.ArbitrageConfig{})
+	require.NoError(t, m.Start())
+	defer func() { assert.NoError(t, m.Stop()) }()
+	s.arbitrageManager = m
+	_, err = s.GetArbitrageOpportunities(context.Background(), &gctrpc.GetArbitrageOpportunitiesRequest{})
+	assert.ErrorIs(t, err, errNoArbitrageScan)
+
+	m.setScan(&ArbitrageScan{Time: time.Now(), Opportunities: []ArbitrageOpportunity{
+		{
+			Type:             TriangularArbitrage,
+			Asset:            asset.Spot,
+			Currency:         currency.USDT,
+			Amount:           100,
+			Return:           101,
+			Profit:           1,
+			ProfitPercentage: 1,
+			Legs:             []ArbitrageLeg{{Exchange: testExchange, Pair: btcusdPair, Side: order.Buy, Amount: 1}},
+		},
+		{Type: CrossExchangeArbitrage, ProfitPercentage: 0.5},
+	}})
+	_, err = s.GetArbitrageOpportunities(context.Background(), &gctrpc.GetArbitrageOpportunitiesRequest{Type: "statistical"})
+	assert.ErrorIs(t, err, errArbitrageTypeUnsupported)
+	resp, err := s.GetArbitrageOpportunities(context.Background(), &gctrpc.GetArbitrageOpportunitiesRequest{Exchange: testExchange})
+	require.NoError(t, err)
+	require.Len(t, resp.Opportunities, 1)
+	assert.Equal(t, "triangular", resp.Opportunities[0].Type)
+	assert.Equal(t, 101.0, resp.Opportunities[0].Returned)
+	require.Len(t, resp.Opportunities[0].Legs, 1)
+	assert.Equal(t, "BUY", resp.Opportunities[0].Legs[0].Side)
+	assert.Equal(t, btcusdPair.Base.String(), resp.Opportunities[0].Legs[0].Pair.Base)
+}
+
+func TestGetConsolidatedOrderbook(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.NewCode("CONSOLIDATEDRPC"), currency.USDT)
+	one := &routerTestExchange{name: "consolidatedrpcone"}
+	two := &routerTestExchange{name: "consolidatedrpctwo"}
+	em := NewExchangeManager()
+	for _, exch := range []*routerTestExchange{one, two} {
+		require.NoError(t, em.Add(exch))
+	}
+	require.NoError(t, (&orderbook.Base{
+		Exchange: one.name,
+		Pair:     p,
+		Asset:    asset.Spot,
+		Bids:     orderbook.Items{{Price: 99, Amount: 1}, {Price: 98, Amount: 1}},
+		Asks:     orderbook.Items{{Price: 101, Amount: 1}},
+	}).Process())
+	require.NoError(t, (&orderbook.Base{
+		Exchange: two.name,
+		Pair:     p,
+		Asset:    asset.Spot,
+		Bids:     orderbook.Items{{Price: 99.5, Amount: 2}},
+		Asks:     orderbook.Items{{Price: 100.5, Amount: 2}},
+	}).Process())
+	s := RPCServer{Engine: &Engine{ExchangeManager: em}}
+
+	_, err := s.GetConsolidatedOrderbook(context.Background(), nil)
+	assert.ErrorIs(t, err, errInvalidArguments)
+	_, err = s.GetConsolidatedOrderbook(context.Background(), &gctrpc.GetConsolidatedOrderbookRequest{AssetType: asset.Spot.String()})
+	assert.ErrorIs(t, err, errCurrencyPairUnset)
+
+	req := &gctrpc.GetConsolidatedOrderbookRequest{
+		Exchanges: []string{"nonexistent"},
+		Pair:      &gctrpc.CurrencyPair{Base: p.Base.String(), Quote: p.Quote.String()},
+		AssetType: asset.Spot.String(),
+	}
+	_, err = s.GetConsolidatedOrderbook(context.Background(), req)
+	assert.ErrorIs(t, err, ErrExchangeNotFound)
+
+	req.Exchanges = nil
+	req.Depth = 2
+	resp, err := s.GetConsolidatedOrderbook(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, resp.Bids, 2, "depth should limit the levels returned")
+	assert.Equal(t, two.name, resp.Bids[0].Exchange)
+	assert.Equal(t, 99.5, resp.Bids[0].Price)
+	assert.Equal(t, one.name, resp.Bids[1].Exchange)
+	require.Len(t, resp.Asks, 2)
+	assert.Equal(t, 100.5, resp.Asks[0].Price)
+	assert.Equal(t, one.name, resp.Asks[1].Exchange)
+}
+
+func TestLiveStrategies(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	_, err := s.StartLiveStrategy(context.Background(), nil)
+	assert.ErrorIs(t, err, errInvalidArguments)
+	_, err = s.StartLiveStrategy(context.Background(), &gctrpc.StartLiveStrategyRequest{})
+	assert.ErrorIs(t, err, errCurrencyPairUnset)
+	req := &gctrpc.StartLiveStrategyRequest{
+		Strategy:       "livetest",
+		Exchange:       "strategyrpc",
+		Pair:           &gctrpc.CurrencyPair{Delimiter: "-", Base: "STRATEGYRPC", Quote: "USDT"},
+		AssetType:      "spot",
+		Interval:       int64(time.Hour),
+		CustomSettings: "{",
+		OrderAmount:    1,
+	}
+	_, err = s.StartLiveStrategy(context.Background(), req)
+	assert.ErrorIs(t, err, errInvalidArguments)
+	req.CustomSettings = `{"setting": 1}`
+	_, err = s.StartLiveStrategy(context.Background(), req)
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+	_, err = s.GetLiveStrategies(context.Background(), &gctrpc.GetLiveStrategiesRequest{})
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+
+	om := quoteTestSetup(t, &quoteTestExchange{base: &exchange.Base{Name: "strategyrpc"}})
+	m, err := setupStrategyManager(om.orderStore.exchangeManager, om, liveTestLoader, &config.StrategyManagerConfig{})
+	require.NoError(t, err)
+	require.NoError(t, m.Start())
+	s.strategyManager = m
+
+	started, err := s.StartLiveStrategy(context.Background(), req)
+	require.NoError(t, err)
+	assert.True(t, started.Running)
+	assert.Equal(t, "STRATEGYRPC", started.Pair.Base)
+	assert.Nil(t, started.Stopped)
+
+	list, err := s.GetLiveStrategies(context.Background(), &gctrpc.GetLiveStrategiesRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Strategies, 1)
+	assert.Equal(t, started.Id, list.Strategies[0].Id)
+	assert.NotNil(t, list.Strategies[0].Pnl)
+
+	_, err = s.GetLiveStrategyLogs(context.Background(), &gctrpc.GetLiveStrategyLogsRequest{Id: "invalid"})
+	assert.Error(t, err)
+	logs, err := s.GetLiveStrategyLogs(context.Background(), &gctrpc.GetLiveStrategyLogsRequest{Id: started.Id})
+	require.NoError(t, err)
+	assert.NotEmpty(t, logs.Logs)
+
+	_, err = s.StopLiveStrategy(context.Background(), &gctrpc.StopLiveStrategyRequest{Id: "invalid"})
+	assert.Error(t, err)
+	_, err = s.StopLiveStrategy(context.Background(), &gctrpc.StopLiveStrategyRequest{Id: started.Id})
+	require.NoError(t, err)
+	list, err = s.GetLiveStrategies(context.Background(), &gctrpc.GetLiveStrategiesRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Strategies, 1)
+	assert.False(t, list.Strategies[0].Running)
+	assert.NotNil(t, list.Strategies[0].Stopped)
+	require.NoError(t, m.Stop())
+}
+
+func TestRPCServerKillSwitch(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	_, err := s.GetKillSwitch(context.Background(), &gctrpc.GetKillSwitchRequest{})
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+	_, err = s.SetKillSwitch(context.Background(), &gctrpc.SetKillSwitchRequest{Engaged: true})
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	s.OrderManager, _ = riskSetup(t, "rpckillswitch", config.RiskLimits{})
+	resp, err := s.GetKillSwitch(context.Background(), &gctrpc.GetKillSwitchRequest{})
+	require.NoError(t, err)
+	assert.False(t, resp.Engaged)
+
+	resp, err = s.SetKillSwitch(context.Background(), &gctrpc.SetKillSwitchRequest{Engaged: true})
+	require.NoError(t, err)
+	assert.True(t, resp.Engaged)
+	assert.ErrorIs(t, s.OrderManager.validate(riskSubmit("rpckillswitch")), ErrRiskKillSwitchEngaged)
+
+	resp, err = s.SetKillSwitch(context.Background(), &gctrpc.SetKillSwitchRequest{})
+	require.NoError(t, err)
+	assert.False(t, resp.Engaged)
+}
@@ -0,0 +1,572 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order/tca"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/paper"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetLiveStrategyLoader sets how the strategy manager creates strategies by
+// name. It must be set before the engine starts
+func SetLiveStrategyLoader(loader LiveStrategyLoader) {
+	liveStrategyLoaderMtx.Lock()
+	liveStrategyLoader = loader
+	liveStrategyLoaderMtx.Unlock()
+}
+
+func getLiveStrategyLoader() LiveStrategyLoader {
+	liveStrategyLoaderMtx.RLock()
+	defer liveStrategyLoaderMtx.RUnlock()
+	return liveStrategyLoader
+}
+
+// setupStrategyManager creates a strategy manager which starts the configured
+// strategies when it starts
+func setupStrategyManager(em iExchangeManager, om iStrategyOrderManager, loader LiveStrategyLoader, cfg *config.StrategyManagerConfig) (*strategyManager, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if om == nil {
+		return nil, errNilOrderManager
+	}
+	if loader == nil {
+		return nil, errLiveStrategyLoaderNotSet
+	}
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	return &strategyManager{
+		exchangeManager: em,
+		orderManager:    om,
+		loader:          loader,
+		configured:      cfg.Strategies,
+	}, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *strategyManager) IsRunning() bool {
+	if m == nil {
+		return false
+	}
+	return atomic.LoadInt32(&m.started) == 1
+}
+
+// Start starts the strategy manager and the configured strategies
+func (m *strategyManager) Start() error {
+	if m == nil {
+		return fmt.Errorf("strategy manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return fmt.Errorf("strategy manager %w", ErrSubSystemAlreadyStarted)
+	}
+	for i := range m.configured {
+		if _, err := m.startStrategy(&m.configured[i]); err != nil {
+			log.Errorf(log.Global, "Strategy manager unable to start %s on %s %s %s: %v", m.configured[i].Strategy, m.configured[i].Exchange, m.configured[i].Asset, m.configured[i].Pair, err)
+		}
+	}
+	log.Debugf(log.Global, "Strategy manager %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops every running strategy. Their orders are left in place
+func (m *strategyManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("strategy manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 1, 0) {
+		return fmt.Errorf("strategy manager %w", ErrSubSystemNotStarted)
+	}
+	m.m.RLock()
+	strategies := m.strategies
+	m.m.RUnlock()
+	for _, s := range strategies {
+		s.stop()
+	}
+	log.Debugf(log.Global, "Strategy manager %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// startStrategy loads a strategy and starts running it on a market
+func (m *strategyManager) startStrategy(cfg *config.LiveStrategyConfig) (*LiveStrategyDetail, error) {
+	if !m.IsRunning() {
+		return nil, fmt.Errorf("strategy manager %w", ErrSubSystemNotStarted)
+	}
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	if cfg.Exchange == "" {
+		return nil, ErrExchangeNameIsEmpty
+	}
+	if cfg.Pair.IsEmpty() {
+		return nil, fmt.Errorf("%s %w", cfg.Exchange, currency.ErrCurrencyPairEmpty)
+	}
+	a, err := asset.New(cfg.Asset)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s %w", cfg.Exchange, cfg.Pair, err)
+	}
+	if cfg.Interval <= 0 {
+		return nil, errInvalidStrategyInterval
+	}
+	if cfg.OrderAmount <= 0 {
+		return nil, fmt.Errorf("%s %s %w", cfg.Exchange, cfg.Pair, errInvalidQuoteAmount)
+	}
+	exch, err := m.exchangeManager.GetExchangeByName(cfg.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := exch.(*paper.Exchange); cfg.Paper && !ok {
+		return nil, fmt.Errorf("%s %w", cfg.Exchange, errNotPaperTrading)
+	}
+	strategy, err := m.loader(cfg.Strategy, cfg.CustomSettings)
+	if err != nil {
+		return nil, err
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	s := &liveStrategy{
+		id:       id,
+		cfg:      *cfg,
+		asset:    a,
+		interval: kline.Interval(cfg.Interval),
+		strategy: strategy,
+		shutdown: make(chan struct{}),
+		running:  true,
+		started:  time.Now(),
+		orders:   make(map[string]*strategyOrder),
+	}
+	s.logf("Started %s on %s %s %s every %s", strategy.Name(), cfg.Exchange, a, cfg.Pair, s.interval)
+
+	m.m.Lock()
+	m.strategies = append(m.strategies, s)
+	m.m.Unlock()
+
+	s.wg.Add(1)
+	go m.run(s)
+	return s.detail(), nil
+}
+
+// stopStrategy stops a running strategy, leaving it listed with its PNL
+func (m *strategyManager) stopStrategy(id uuid.UUID) error {
+	s, err := m.getStrategy(id)
+	if err != nil {
+		return err
+	}
+	if !s.stop() {
+		return fmt.Errorf("%s %w", id, errLiveStrategyNotRunning)
+	}
+	return nil
+}
+
+// getStrategies returns the state of every strategy started
+func (m *strategyManager) getStrategies() ([]LiveStrategyDetail, error) {
+	if m == nil {
+		return nil, fmt.Errorf("strategy manager %w", ErrSubSystemNotStarted)
+	}
+	m.m.RLock()
+	defer m.m.RUnlock()
+	resp := make([]LiveStrategyDetail, len(m.strategies))
+	for i := range m.strategies {
+		resp[i] = *m.strategies[i].detail()
+	}
+	return resp, nil
+}
+
+// getStrategyLogs returns a strategy's most recent log entries, oldest first
+func (m *strategyManager) getStrategyLogs(id uuid.UUID, limit int) ([]LiveStrategyLog, error) {
+	s, err := m.getStrategy(id)
+	if err != nil {
+		return nil, err
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	logs := s.logs
+	if limit > 0 && len(logs) > limit {
+		logs = logs[len(logs)-limit:]
+	}
+	return append([]LiveStrategyLog(nil), logs...), nil
+}
+
+func (m *strategyManager) getStrategy(id uuid.UUID) (*liveStrategy, error) {
+	if m == nil {
+		return nil, fmt.Errorf("strategy manager %w", ErrSubSystemNotStarted)
+	}
+	m.m.RLock()
+	defer m.m.RUnlock()
+	for i := range m.strategies {
+		if m.strategies[i].id == id {
+			return m.strategies[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%s %w", id, errLiveStrategyNotFound)
+}
+
+// run builds candles from the ticker updates published by the sync manager
+// and websocket routine until the strategy is stopped, passing each closed
+// candle to the strategy
+func (m *strategyManager) run(s *liveStrategy) {
+	defer s.wg.Done()
+	m.warmUp(context.Background(), s)
+
+	var pipe dispatch.Pipe
+	var updates <-chan any
+	subscribe := func() {
+		var err error
+		pipe, err = ticker.SubscribeTicker(s.cfg.Exchange, s.cfg.Pair, s.asset)
+		if err != nil {
+			s.logf("Awaiting ticker data: %v", err)
+			return
+		}
+		updates = pipe.Channel()
+	}
+	subscribe()
+	defer func() {
+		if updates == nil {
+			return
+		}
+		if err := pipe.Release(); err != nil {
+			log.Errorln(log.DispatchMgr, err)
+		}
+	}()
+
+	next := time.Now().Truncate(s.interval.Duration()).Add(s.interval.Duration())
+	timer := time.NewTimer(time.Until(next))
+	defer timer.Stop()
+	for {
+		select {
+		case <-s.shutdown:
+			return
+		case data, ok := <-updates:
+			if !ok {
+				updates = nil
+				continue
+			}
+			if t, ok := data.(*ticker.Price); ok {
+				s.addPrice(t)
+			}
+		case <-timer.C:
+			if updates == nil {
+				subscribe()
+			}
+			if !m.orderManager.IsRunning() {
+				s.logf("Order manager is not running, candle %s not processed", next.Add(-s.interval.Duration()).UTC())
+			} else if err := m.onCandleClose(context.Background(), s, next.Add(-s.interval.Duration())); err != nil {
+				s.logf("Unable to process candle %s: %v", next.Add(-s.interval.Duration()).UTC(), err)
+			}
+			next = next.Add(s.interval.Duration())
+			timer.Reset(time.Until(next))
+		}
+	}
+}
+
+// warmUp loads the historic candles a strategy requires before it can decide
+// on live data
+func (m *strategyManager) warmUp(ctx context.Context, s *liveStrategy) {
+	if s.cfg.WarmupCandles <= 0 {
+		return
+	}
+	exch, err := m.exchangeManager.GetExchangeByName(s.cfg.Exchange)
+	if err != nil {
+		s.logf("Unable to retrieve warmup candles: %v", err)
+		return
+	}
+	end := time.Now().Truncate(s.interval.Duration())
+	start := end.Add(-s.interval.Duration() * time.Duration(s.cfg.WarmupCandles))
+	item, err := exch.GetHistoricCandles(ctx, s.cfg.Pair, s.asset, s.interval, start, end)
+	if err != nil {
+		s.logf("Unable to retrieve warmup candles: %v", err)
+		return
+	}
+	s.m.Lock()
+	for i := range item.Candles {
+		if item.Candles[i].Time.Before(end) {
+			s.candles = append(s.candles, item.Candles[i])
+		}
+	}
+	s.m.Unlock()
+	s.logf("Loaded %d warmup candles", len(item.Candles))
+}
+
+// onCandleClose closes the forming candle, applies any fills of the
+// strategy's orders to its PNL, then trades on the strategy's signal
+func (m *strategyManager) onCandleClose(ctx context.Context, s *liveStrategy, start time.Time) error {
+	exch, err := m.exchangeManager.GetExchangeByName(s.cfg.Exchange)
+	if err != nil {
+		return err
+	}
+	m.updateFills(s)
+
+	s.m.Lock()
+	if s.forming == nil {
+		s.m.Unlock()
+		return errNoCandleData
+	}
+	s.forming.Time = start
+	s.candles = append(s.candles, *s.forming)
+	s.forming = nil
+	price := s.candles[len(s.candles)-1].Close
+	s.pnl.LastPrice = price
+	s.pnl.UnrealisedPNL = s.pnl.Position * (price - s.pnl.AveragePrice)
+	d := &LiveStrategyData{
+		Exchange: s.cfg.Exchange,
+		Pair:     s.cfg.Pair,
+		Asset:    s.asset,
+		Interval: s.interval,
+		Candles:  append([]kline.Candle(nil), s.candles...),
+		Position: s.pnl.Position,
+	}
+	s.m.Unlock()
+
+	d.BaseFunds, d.QuoteFunds, err = m.getFunds(ctx, exch, s)
+	if err != nil {
+		return err
+	}
+	signal, err := s.strategy.OnCandles(d)
+	if err != nil {
+		return err
+	}
+	if signal == nil || (signal.Side != order.Buy && signal.Side != order.Sell) {
+		if signal != nil && signal.Reason != "" {
+			s.logf("No action at %v: %s", price, signal.Reason)
+		}
+		return nil
+	}
+	return m.trade(ctx, exch, s, signal, price, d)
+}
+
+// trade submits a market order sized by the signal, or the configured order
+// amount, and limited to the free balance for spot markets
+func (m *strategyManager) trade(ctx context.Context, exch exchange.IBotExchange, s *liveStrategy, signal *LiveStrategySignal, price float64, d *LiveStrategyData) error {
+	amount := signal.Amount
+	if amount <= 0 {
+		amount = s.cfg.OrderAmount
+	}
+	if !s.asset.IsFutures() {
+		if signal.Side == order.Buy {
+			amount = math.Min(amount, d.QuoteFunds/price)
+		} else {
+			amount = math.Min(amount, d.BaseFunds)
+		}
+	}
+	if limits, err := exch.GetOrderExecutionLimits(s.asset, s.cfg.Pair); err == nil {
+		amount = limits.ConformToAmount(amount)
+	}
+	if amount <= 0 {
+		s.logf("Insufficient funds to %s at %v: %s", signal.Side, price, signal.Reason)
+		return nil
+	}
+	clientOrderID, err := tca.StrategyClientOrderID(s.cfg.Strategy)
+	if err != nil {
+		return err
+	}
+	resp, err := m.orderManager.Submit(ctx, &order.Submit{
+		Exchange:      s.cfg.Exchange,
+		Pair:          s.cfg.Pair,
+		AssetType:     s.asset,
+		Side:          signal.Side,
+		Type:          order.Market,
+		Amount:        amount,
+		ClientOrderID: clientOrderID,
+	})
+	if err != nil {
+		return err
+	}
+	s.m.Lock()
+	s.orders[resp.OrderID] = &strategyOrder{}
+	s.pnl.Orders++
+	s.m.Unlock()
+	s.logf("Placed %s order %s for %v at %v: %s", signal.Side, resp.OrderID, amount, price, signal.Reason)
+	m.updateFills(s)
+	return nil
+}
+
+// updateFills applies the executions of a strategy's orders since they were
+// last checked to its PNL. Orders are no longer tracked once they are no
+// longer active
+func (m *strategyManager) updateFills(s *liveStrategy) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	for id, tracked := range s.orders {
+		d, err := m.orderManager.GetByExchangeAndID(s.cfg.Exchange, id)
+		if err != nil {
+			s.logfLocked("Unable to retrieve order %s: %v", id, err)
+			delete(s.orders, id)
+			continue
+		}
+		if executed, price := getExecution(d); executed > tracked.executed {
+			s.pnl.applyFill(d.Side, executed-tracked.executed, price)
+			s.logfLocked("Order %s %s filled %v at %v", id, d.Side, executed-tracked.executed, price)
+			tracked.executed = executed
+		}
+		if d.Fee > tracked.fee {
+			s.pnl.Fees += d.Fee - tracked.fee
+			tracked.fee = d.Fee
+		}
+		if !d.IsActive() {
+			delete(s.orders, id)
+		}
+	}
+	if s.pnl.LastPrice > 0 {
+		s.pnl.UnrealisedPNL = s.pnl.Position * (s.pnl.LastPrice - s.pnl.AveragePrice)
+	}
+}
+
+// getFunds returns the free base and quote balances of a strategy's market
+func (m *strategyManager) getFunds(ctx context.Context, exch exchange.IBotExchange, s *liveStrategy) (base, quote float64, err error) {
+	h, err := exch.FetchAccountInfo(ctx, s.asset)
+	if err != nil {
+		return 0, 0, err
+	}
+	for i := range h.Accounts {
+		for j := range h.Accounts[i].Currencies {
+			switch c := h.Accounts[i].Currencies[j]; {
+			case c.Currency.Equal(s.cfg.Pair.Base):
+				base += c.Free
+			case c.Currency.Equal(s.cfg.Pair.Quote):
+				quote += c.Free
+			}
+		}
+	}
+	return base, quote, nil
+}
+
+// addPrice updates the forming candle with a ticker's last price, or its mid
+// price when no trade has been reported
+func (s *liveStrategy) addPrice(t *ticker.Price) {
+	price := t.Last
+	if price <= 0 && t.Bid > 0 && t.Ask > 0 {
+		price = (t.Bid + t.Ask) / 2
+	}
+	if price <= 0 {
+		return
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.forming == nil {
+		s.forming = &kline.Candle{Open: price, High: price, Low: price, Close: price}
+		return
+	}
+	s.forming.High = math.Max(s.forming.High, price)
+	s.forming.Low = math.Min(s.forming.Low, price)
+	s.forming.Close = price
+}
+
+// stop stops the strategy, returning false if it was not running
+func (s *liveStrategy) stop() bool {
+	s.m.Lock()
+	if !s.running {
+		s.m.Unlock()
+		return false
+	}
+	s.running = false
+	s.stopped = time.Now()
+	close(s.shutdown)
+	s.m.Unlock()
+	s.wg.Wait()
+	s.logf("Stopped")
+	return true
+}
+
+// detail returns the state of the strategy
+func (s *liveStrategy) detail() *LiveStrategyDetail {
+	s.m.Lock()
+	defer s.m.Unlock()
+	return &LiveStrategyDetail{
+		ID:          s.id,
+		Strategy:    s.strategy.Name(),
+		Description: s.strategy.Description(),
+		Exchange:    s.cfg.Exchange,
+		Pair:        s.cfg.Pair,
+		Asset:       s.asset,
+		Interval:    s.interval,
+		Running:     s.running,
+		Started:     s.started,
+		Stopped:     s.stopped,
+		Candles:     len(s.candles),
+		PNL:         s.pnl,
+	}
+}
+
+// logf records an entry in the strategy's log
+func (s *liveStrategy) logf(format string, args ...any) {
+	s.m.Lock()
+	s.logfLocked(format, args...)
+	s.m.Unlock()
+}
+
+func (s *liveStrategy) logfLocked(format string, args ...any) {
+	msg := fmt.Sprintf(format, args...)
+	if len(s.logs) == liveStrategyLogLimit {
+		s.logs = append(s.logs[:0], s.logs[1:]...)
+	}
+	s.logs = append(s.logs, LiveStrategyLog{Time: time.Now(), Message: msg})
+	log.Debugf(log.Global, "Strategy %s %s: %s", s.cfg.Strategy, s.id, msg)
+}
+
+// applyFill updates the position and its average price with a fill, realising
+// the PNL of any amount which reduces the position
+func (p *LiveStrategyPNL) applyFill(side order.Side, amount, price float64) {
+	if side.IsShort() {
+		amount = -amount
+	}
+	switch {
+	case p.Position == 0 || (p.Position > 0) == (amount > 0):
+		p.AveragePrice = (math.Abs(p.Position)*p.AveragePrice + math.Abs(amount)*price) / (math.Abs(p.Position) + math.Abs(amount))
+		p.Position += amount
+	default:
+		closed := math.Min(math.Abs(amount), math.Abs(p.Position))
+		if p.Position > 0 {
+			p.RealisedPNL += closed * (price - p.AveragePrice)
+		} else {
+			p.RealisedPNL += closed * (p.AveragePrice - price)
+		}
+		p.Position += amount
+		switch {
+		case p.Position == 0:
+			p.AveragePrice = 0
+		case (p.Position > 0) == (amount > 0):
+			// the fill reversed the position
+			p.AveragePrice = price
+		}
+	}
+}
+
+// getExecution returns the amount of an order which has been filled and its
+// average price
+func getExecution(d *order.Detail) (executed, averagePrice float64) {
+	var cost float64
+	for i := range d.Trades {
+		executed += d.Trades[i].Amount
+		cost += d.Trades[i].Amount * d.Trades[i].Price
+	}
+	if d.ExecutedAmount > executed {
+		executed = d.ExecutedAmount
+	} else if executed == 0 && d.Status == order.Filled {
+		executed = d.Amount
+	}
+	switch {
+	case d.AverageExecutedPrice > 0:
+		averagePrice = d.AverageExecutedPrice
+	case cost > 0:
+		averagePrice = cost / executed
+	default:
+		averagePrice = d.Price
+	}
+	return executed, averagePrice
+}
@@ -0,0 +1,243 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order/tca"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/paper"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+var errLiveStrategyTest = errors.New("unknown strategy")
+
+// liveTestStrategy returns a preset signal and records the data it was given
+type liveTestStrategy struct {
+	signal *LiveStrategySignal
+	data   *LiveStrategyData
+}
+
+func (l *liveTestStrategy) Name() string        { return "livetest" }
+func (l *liveTestStrategy) Description() string { return "returns a preset signal" }
+
+func (l *liveTestStrategy) OnCandles(d *LiveStrategyData) (*LiveStrategySignal, error) {
+	l.data = d
+	return l.signal, nil
+}
+
+func liveTestLoader(name string, _ map[string]interface{}) (LiveStrategy, error) {
+	if name != "livetest" {
+		return nil, errLiveStrategyTest
+	}
+	return &liveTestStrategy{signal: &LiveStrategySignal{Side: order.DoNothing}}, nil
+}
+
+func TestSetupStrategyManager(t *testing.T) {
+	t.Parallel()
+	_, err := setupStrategyManager(nil, nil, nil, nil)
+	assert.ErrorIs(t, err, errNilExchangeManager)
+	_, err = setupStrategyManager(NewExchangeManager(), nil, nil, nil)
+	assert.ErrorIs(t, err, errNilOrderManager)
+	_, err = setupStrategyManager(NewExchangeManager(), &OrderManager{}, nil, nil)
+	assert.ErrorIs(t, err, errLiveStrategyLoaderNotSet)
+	_, err = setupStrategyManager(NewExchangeManager(), &OrderManager{}, liveTestLoader, nil)
+	assert.ErrorIs(t, err, errNilConfig)
+	m, err := setupStrategyManager(NewExchangeManager(), &OrderManager{}, liveTestLoader, &config.StrategyManagerConfig{})
+	require.NoError(t, err)
+	assert.NotNil(t, m)
+}
+
+func TestStrategyManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *strategyManager
+	assert.ErrorIs(t, m.Start(), ErrNilSubsystem)
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem)
+	_, err := m.getStrategies()
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+
+	base := &exchange.Base{Name: "strategystart"}
+	p := currency.NewPair(currency.NewCode("STRATEGYSTART"), currency.USDT)
+	om := quoteTestSetup(t, &quoteTestExchange{base: base})
+	cfg := config.LiveStrategyConfig{
+		Strategy:    "livetest",
+		Exchange:    base.Name,
+		Pair:        p,
+		Asset:       "spot",
+		Interval:    time.Hour,
+		OrderAmount: 1,
+	}
+	m, err = setupStrategyManager(om.orderStore.exchangeManager, om, liveTestLoader, &config.StrategyManagerConfig{
+		Strategies: []config.LiveStrategyConfig{cfg, {Strategy: "unknown"}},
+	})
+	require.NoError(t, err)
+	_, err = m.startStrategy(&cfg)
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+
+	require.NoError(t, m.Start())
+	assert.ErrorIs(t, m.Start(), ErrSubSystemAlreadyStarted)
+	strategies, err := m.getStrategies()
+	require.NoError(t, err)
+	require.Len(t, strategies, 1, "only the valid configured strategy should start")
+	assert.True(t, strategies[0].Running)
+	assert.Equal(t, "livetest", strategies[0].Strategy)
+	assert.Equal(t, kline.OneHour, strategies[0].Interval)
+
+	_, err = m.startStrategy(nil)
+	assert.ErrorIs(t, err, errNilConfig)
+	for _, tc := range []struct {
+		modify func(*config.LiveStrategyConfig)
+		err    error
+	}{
+		{func(c *config.LiveStrategyConfig) { c.Exchange = "" }, ErrExchangeNameIsEmpty},
+		{func(c *config.LiveStrategyConfig) { c.Pair = currency.EMPTYPAIR }, currency.ErrCurrencyPairEmpty},
+		{func(c *config.LiveStrategyConfig) { c.Asset = "" }, asset.ErrNotSupported},
+		{func(c *config.LiveStrategyConfig) { c.Interval = 0 }, errInvalidStrategyInterval},
+		{func(c *config.LiveStrategyConfig) { c.OrderAmount = 0 }, errInvalidQuoteAmount},
+		{func(c *config.LiveStrategyConfig) { c.Exchange = "missing" }, ErrExchangeNotFound},
+		{func(c *config.LiveStrategyConfig) { c.Paper = true }, errNotPaperTrading},
+		{func(c *config.LiveStrategyConfig) { c.Strategy = "unknown" }, errLiveStrategyTest},
+	} {
+		invalid := cfg
+		tc.modify(&invalid)
+		_, err = m.startStrategy(&invalid)
+		assert.ErrorIs(t, err, tc.err)
+	}
+
+	d, err := m.startStrategy(&cfg)
+	require.NoError(t, err)
+	assert.True(t, d.Running)
+	logs, err := m.getStrategyLogs(d.ID, 1)
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	assert.Contains(t, logs[0].Message, "Started livetest")
+
+	require.NoError(t, m.stopStrategy(d.ID))
+	assert.ErrorIs(t, m.stopStrategy(d.ID), errLiveStrategyNotRunning)
+	assert.ErrorIs(t, m.stopStrategy(uuid.Must(uuid.NewV4())), errLiveStrategyNotFound)
+	_, err = m.getStrategyLogs(uuid.Must(uuid.NewV4()), 0)
+	assert.ErrorIs(t, err, errLiveStrategyNotFound)
+
+	require.NoError(t, m.Stop())
+	strategies, err = m.getStrategies()
+	require.NoError(t, err)
+	require.Len(t, strategies, 2, "stopped strategies should remain listed")
+	for i := range strategies {
+		assert.False(t, strategies[i].Running)
+		assert.False(t, strategies[i].Stopped.IsZero())
+	}
+}
+
+func TestStrategyManagerOnCandleClose(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.NewCode("STRATEGYBASE"), currency.USDT)
+	base := &exchange.Base{Name: "strategypaper"}
+	quoteTestBook(t, base.Name, p, 99, 101)
+	exch, err := paper.New(&quoteTestExchange{base: base}, &config.PaperTrading{
+		Enabled:  true,
+		Balances: map[string]float64{"STRATEGYBASE": 0, "USDT": 1000},
+		TakerFee: 0.001,
+	})
+	require.NoError(t, err)
+	om := quoteTestSetup(t, exch)
+	m, err := setupStrategyManager(om.orderStore.exchangeManager, om, liveTestLoader, &config.StrategyManagerConfig{})
+	require.NoError(t, err)
+
+	strategy := &liveTestStrategy{signal: &LiveStrategySignal{Side: order.Buy, Reason: "buy"}}
+	s := &liveStrategy{
+		cfg:      config.LiveStrategyConfig{Strategy: "test", Exchange: base.Name, Pair: p, OrderAmount: 2},
+		asset:    asset.Spot,
+		interval: kline.OneMin,
+		strategy: strategy,
+		orders:   make(map[string]*strategyOrder),
+	}
+	start := time.Now().Truncate(time.Minute)
+	assert.ErrorIs(t, m.onCandleClose(context.Background(), s, start), errNoCandleData)
+
+	for _, price := range []float64{100, 102, 98, 99.5} {
+		s.addPrice(&ticker.Price{Last: price})
+	}
+	s.addPrice(&ticker.Price{Bid: 100, Ask: 101})
+	require.NoError(t, m.onCandleClose(context.Background(), s, start))
+	require.NotNil(t, strategy.data)
+	require.Len(t, strategy.data.Candles, 1)
+	assert.Equal(t, kline.Candle{Time: start, Open: 100, High: 102, Low: 98, Close: 100.5}, strategy.data.Candles[0])
+	assert.Equal(t, 1000.0, strategy.data.QuoteFunds)
+
+	d := s.detail()
+	assert.Equal(t, int64(1), d.PNL.Orders)
+	assert.Equal(t, 2.0, d.PNL.Position, "market buy should fill against the simulated book")
+	assert.Equal(t, 101.0, d.PNL.AveragePrice)
+	assert.InDelta(t, 0.202, d.PNL.Fees, 1e-9)
+	assert.Equal(t, -1.0, d.PNL.UnrealisedPNL)
+	placed, err := om.GetOrdersFiltered(&order.Filter{Exchange: base.Name})
+	require.NoError(t, err)
+	require.Len(t, placed, 1)
+	assert.Equal(t, "test", tca.StrategyFromClientOrderID(placed[0].ClientOrderID), "orders should be tagged with the strategy")
+
+	// sells are limited to the free base balance
+	require.NoError(t, (&orderbook.Base{
+		Exchange:    base.Name,
+		Pair:        p,
+		Asset:       asset.Spot,
+		Bids:        orderbook.Items{{Price: 99, Amount: 5}},
+		Asks:        orderbook.Items{{Price: 101, Amount: 5}},
+		LastUpdated: time.Now(),
+	}).Process())
+	strategy.signal = &LiveStrategySignal{Side: order.Sell, Amount: 5}
+	s.addPrice(&ticker.Price{Last: 99})
+	require.NoError(t, m.onCandleClose(context.Background(), s, start.Add(time.Minute)))
+	d = s.detail()
+	assert.Equal(t, 2, d.Candles)
+	assert.Equal(t, int64(2), d.PNL.Orders)
+	assert.Zero(t, d.PNL.Position)
+	assert.Equal(t, -4.0, d.PNL.RealisedPNL)
+	assert.Zero(t, d.PNL.UnrealisedPNL)
+	assert.Empty(t, s.orders, "filled orders should no longer be tracked")
+
+	strategy.signal = &LiveStrategySignal{Side: order.Sell}
+	s.addPrice(&ticker.Price{Last: 99})
+	require.NoError(t, m.onCandleClose(context.Background(), s, start.Add(time.Minute*2)))
+	assert.Equal(t, int64(2), s.detail().PNL.Orders, "no order should be placed without funds")
+	logs, err := (&strategyManager{strategies: []*liveStrategy{s}}).getStrategyLogs(s.id, 1)
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	assert.Contains(t, logs[0].Message, "Insufficient funds")
+}
+
+func TestLiveStrategyPNLApplyFill(t *testing.T) {
+	t.Parallel()
+	var p LiveStrategyPNL
+	p.applyFill(order.Buy, 1, 100)
+	p.applyFill(order.Buy, 1, 110)
+	assert.Equal(t, 2.0, p.Position)
+	assert.Equal(t, 105.0, p.AveragePrice)
+
+	p.applyFill(order.Sell, 1, 120)
+	assert.Equal(t, 1.0, p.Position)
+	assert.Equal(t, 105.0, p.AveragePrice)
+	assert.Equal(t, 15.0, p.RealisedPNL)
+
+	// selling beyond the position reverses it into a short
+	p.applyFill(order.Sell, 3, 100)
+	assert.Equal(t, -2.0, p.Position)
+	assert.Equal(t, 100.0, p.AveragePrice)
+	assert.Equal(t, 10.0, p.RealisedPNL)
+
+	p.applyFill(order.Buy, 2, 90)
+	assert.Zero(t, p.Position)
+	assert.Zero(t, p.AveragePrice)
+	assert.Equal(t, 30.0, p.RealisedPNL)
+}
@@ -0,0 +1,155 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// StrategyManagerName is an exported subsystem name
+const StrategyManagerName = "strategy_manager"
+
+// liveStrategyLogLimit is the number of log entries kept for each strategy
+const liveStrategyLogLimit = 500
+
+var (
+	errLiveStrategyLoaderNotSet = errors.New("live strategy loader not set")
+	errLiveStrategyNotFound     = errors.New("live strategy not found")
+	errLiveStrategyNotRunning   = errors.New("live strategy not running")
+	errInvalidStrategyInterval  = errors.New("strategy interval must be greater than zero")
+	errNoCandleData             = errors.New("no market data received for candle")
+
+	liveStrategyLoaderMtx sync.RWMutex
+	liveStrategyLoader    LiveStrategyLoader
+)
+
+// LiveStrategy decides whether to trade a market each time one of its candles
+// closes. Strategies are implemented outside of the engine so they can be
+// shared with the backtester
+type LiveStrategy interface {
+	Name() string
+	Description() string
+	OnCandles(*LiveStrategyData) (*LiveStrategySignal, error)
+}
+
+// LiveStrategyLoader creates a new instance of a named strategy with its
+// custom settings applied
+type LiveStrategyLoader func(name string, customSettings map[string]interface{}) (LiveStrategy, error)
+
+// LiveStrategyData is the market data and funding a live strategy decides on
+type LiveStrategyData struct {
+	Exchange string
+	Pair     currency.Pair
+	Asset    asset.Item
+	Interval kline.Interval
+	// Candles holds every closed candle, oldest first
+	Candles []kline.Candle
+	// BaseFunds and QuoteFunds are the free balances held on the exchange
+	BaseFunds  float64
+	QuoteFunds float64
+	// Position is the base amount the strategy has bought less the amount it
+	// has sold
+	Position float64
+}
+
+// LiveStrategySignal is the decision of a live strategy
+type LiveStrategySignal struct {
+	// Side is order.Buy, order.Sell or order.DoNothing
+	Side order.Side
+	// Amount is the base amount to trade, the configured order amount is
+	// used when zero
+	Amount float64
+	Reason string
+}
+
+// LiveStrategyDetail is the state of a live strategy
+type LiveStrategyDetail struct {
+	ID          uuid.UUID
+	Strategy    string
+	Description string
+	Exchange    string
+	Pair        currency.Pair
+	Asset       asset.Item
+	Interval    kline.Interval
+	Running     bool
+	Started     time.Time
+	Stopped     time.Time
+	Candles     int
+	PNL         LiveStrategyPNL
+}
+
+// LiveStrategyPNL is the profit and loss of the orders placed by a live
+// strategy, using the average cost of its position
+type LiveStrategyPNL struct {
+	Position      float64
+	AveragePrice  float64
+	LastPrice     float64
+	RealisedPNL   float64
+	UnrealisedPNL float64
+	Fees          float64
+	Orders        int64
+}
+
+// LiveStrategyLog is an event logged by a live strategy
+type LiveStrategyLog struct {
+	Time    time.Time
+	Message string
+}
+
+// iStrategyOrderManager limits exposure of the order manager to the strategy
+// manager
+type iStrategyOrderManager interface {
+	IsRunning() bool
+	Submit(context.Context, *order.Submit) (*OrderSubmitResponse, error)
+	GetByExchangeAndID(string, string) (*order.Detail, error)
+}
+
+// strategyManager runs strategies against live market data, placing their
+// orders through the order manager
+type strategyManager struct {
+	started         int32
+	m               sync.RWMutex
+	exchangeManager iExchangeManager
+	orderManager    iStrategyOrderManager
+	loader          LiveStrategyLoader
+	configured      []config.LiveStrategyConfig
+	strategies      []*liveStrategy
+}
+
+// liveStrategy is a strategy running on a market
+type liveStrategy struct {
+	id       uuid.UUID
+	cfg      config.LiveStrategyConfig
+	asset    asset.Item
+	interval kline.Interval
+	strategy LiveStrategy
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+
+	m       sync.Mutex
+	running bool
+	started time.Time
+	stopped time.Time
+	// forming is the candle built from market data since the last close
+	forming *kline.Candle
+	candles []kline.Candle
+	// orders holds the executed amount and fee already applied to the PNL of
+	// each order which may still fill
+	orders map[string]*strategyOrder
+	pnl    LiveStrategyPNL
+	logs   []LiveStrategyLog
+}
+
+// strategyOrder is the portion of an order applied to a strategy's PNL
+type strategyOrder struct {
+	executed float64
+	fee      float64
+}
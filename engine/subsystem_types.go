@@ -9,6 +9,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
@@ -56,9 +57,23 @@ type iOrderManager interface {
 	IsRunning() bool
 	Exists(*order.Detail) bool
 	Add(*order.Detail) error
+	Submit(context.Context, *order.Submit) (*OrderSubmitResponse, error)
 	Cancel(context.Context, *order.Cancel) error
 	GetByExchangeAndID(string, string) (*order.Detail, error)
 	UpdateExistingOrder(*order.Detail) error
+	RecordFills([]fill.Data) error
+}
+
+// iOrderHistory limits exposure of the order manager to the portfolio manager
+type iOrderHistory interface {
+	IsRunning() bool
+	GetOrdersFiltered(*order.Filter) ([]order.Detail, error)
+}
+
+// iOrderSubmissionReporter observes the outcome of orders submitted via the
+// order manager
+type iOrderSubmissionReporter interface {
+	OrderSubmitted(exchange string, a asset.Item, outcome string)
 }
 
 // iPortfolioManager limits exposure of accessible functions to portfolio manager
@@ -0,0 +1,760 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// Validate checks the synthetic order parameters for its type
+func (s *SyntheticOrder) Validate() error {
+	if s == nil {
+		return errNilOrder
+	}
+	if s.Price < 0 {
+		return fmt.Errorf("%w %v", errInvalidPrice, s.Price)
+	}
+	err := s.childSubmit(s.Amount).Validate()
+	if err != nil {
+		return err
+	}
+	switch s.Type {
+	case order.Stop:
+		if s.TriggerPrice <= 0 {
+			return fmt.Errorf("%w %v", errInvalidTriggerPrice, s.TriggerPrice)
+		}
+	case order.TrailingStop:
+		if s.TrailingOffset <= 0 {
+			return fmt.Errorf("%w %v", errInvalidTrailingOffset, s.TrailingOffset)
+		}
+	case order.OCO:
+		if s.Price <= 0 {
+			return order.ErrPriceMustBeSetIfLimitOrder
+		}
+		// The stop leg must sit on the opposite side of the market to the
+		// resting limit leg
+		if s.TriggerPrice <= 0 ||
+			(s.Side.IsLong() && s.TriggerPrice <= s.Price) ||
+			(!s.Side.IsLong() && s.TriggerPrice >= s.Price) {
+			return fmt.Errorf("%w %v for limit price %v", errInvalidTriggerPrice, s.TriggerPrice, s.Price)
+		}
+	case order.Iceberg:
+		if s.Price <= 0 {
+			return order.ErrPriceMustBeSetIfLimitOrder
+		}
+		if s.SliceAmount <= 0 || s.SliceAmount > s.Amount {
+			return fmt.Errorf("%w %v", errInvalidSliceAmount, s.SliceAmount)
+		}
+	case order.TWAP, order.VWAP:
+		if s.Duration <= 0 || s.Slices <= 0 {
+			return fmt.Errorf("%w duration %v slices %v", errInvalidSchedule, s.Duration, s.Slices)
+		}
+		if s.Type == order.VWAP && (s.ParticipationRate <= 0 || s.ParticipationRate > 1) {
+			return fmt.Errorf("%w %v", errInvalidParticipationRate, s.ParticipationRate)
+		}
+	default:
+		return fmt.Errorf("%w %v", errSyntheticOrderTypeUnsupported, s.Type)
+	}
+	return nil
+}
+
+// childSubmit returns a child order submission for the synthetic order. A
+// limit order is used when a price is set, otherwise a market order
+func (s *SyntheticOrder) childSubmit(amount float64) *order.Submit {
+	submit := &order.Submit{
+		Exchange:  s.Exchange,
+		Pair:      s.Pair,
+		AssetType: s.AssetType,
+		Side:      s.Side,
+		Type:      order.Market,
+		Amount:    amount,
+	}
+	if s.Price > 0 {
+		submit.Type = order.Limit
+		submit.Price = s.Price
+	}
+	return submit
+}
+
+// SubmitSyntheticOrder validates and stores a synthetic order. The order is
+// worked by the order manager as market data is received and is visible via
+// GetOrdersFiltered and cancellable via Cancel
+func (m *OrderManager) SubmitSyntheticOrder(ctx context.Context, s *SyntheticOrder) (*OrderSubmitResponse, error) {
+	if m == nil {
+		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	err := s.Validate()
+	if err != nil {
+		return nil, err
+	}
+	exch, err := m.orderStore.exchangeManager.GetExchangeByName(s.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	err = m.validate(s.childSubmit(s.Amount))
+	if err != nil {
+		return nil, err
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	synth := &syntheticOrder{
+		SyntheticOrder: *s,
+		orderID:        id.String(),
+		internalID:     id,
+		status:         order.Pending,
+		created:        now,
+		lastUpdated:    now,
+	}
+	synth.Exchange = exch.GetName()
+
+	if synth.Type == order.OCO {
+		// The limit leg rests on the exchange while the stop leg is
+		// monitored locally
+		var resp *OrderSubmitResponse
+		resp, err = m.Submit(ctx, synth.childSubmit(synth.Amount))
+		if err != nil {
+			return nil, fmt.Errorf("cannot place OCO limit leg: %w", err)
+		}
+		synth.status = order.Active
+		synth.activeChildID = resp.OrderID
+		synth.placed = synth.Amount
+		synth.complete = true
+		synth.children = append(synth.children, &syntheticChild{orderID: resp.OrderID, amount: synth.Amount})
+	}
+
+	m.syntheticOrders.m.Lock()
+	if m.syntheticOrders.orders == nil {
+		m.syntheticOrders.orders = make(map[string]*syntheticOrder)
+	}
+	m.syntheticOrders.orders[synth.orderID] = synth
+	detail := synth.detail()
+	m.syntheticOrders.m.Unlock()
+
+	msg := fmt.Sprintf("Exchange %s submitted synthetic %v order ID=%v pair=%v side=%v amount=%v.",
+		detail.Exchange,
+		detail.Type,
+		detail.OrderID,
+		detail.Pair,
+		detail.Side,
+		detail.Amount)
+	log.Debugln(log.OrderMgr, msg)
+	m.orderStore.commsManager.PushEvent(base.Event{Type: "order", Message: msg})
+
+	return &OrderSubmitResponse{Detail: &detail, InternalOrderID: detail.InternalOrderID.String()}, nil
+}
+
+// isSyntheticOrder returns whether the order ID belongs to a synthetic order
+func (m *OrderManager) isSyntheticOrder(orderID string) bool {
+	m.syntheticOrders.m.Lock()
+	defer m.syntheticOrders.m.Unlock()
+	_, ok := m.syntheticOrders.orders[orderID]
+	return ok
+}
+
+// cancelSyntheticOrder stops a synthetic order from being worked and cancels
+// any child order resting on the exchange
+func (m *OrderManager) cancelSyntheticOrder(ctx context.Context, cancel *order.Cancel) error {
+	m.syntheticOrders.m.Lock()
+	synth, ok := m.syntheticOrders.orders[cancel.OrderID]
+	if !ok {
+		m.syntheticOrders.m.Unlock()
+		return fmt.Errorf("%w %v", ErrOrderNotFound, cancel.OrderID)
+	}
+	if !synth.isActive() {
+		m.syntheticOrders.m.Unlock()
+		return fmt.Errorf("%w %v %v", errSyntheticOrderInactive, cancel.OrderID, synth.status)
+	}
+	synth.close(order.Cancelled)
+	childCancel := synth.cancelActiveChild()
+	m.syntheticOrders.m.Unlock()
+
+	if childCancel != nil {
+		err := m.Cancel(ctx, childCancel)
+		if err != nil {
+			return fmt.Errorf("synthetic order %v cancelled but child order %v was not: %w", cancel.OrderID, childCancel.OrderID, err)
+		}
+	}
+
+	msg := fmt.Sprintf("Exchange %s synthetic order ID=%v cancelled.", synth.Exchange, cancel.OrderID)
+	log.Debugln(log.OrderMgr, msg)
+	m.orderStore.commsManager.PushEvent(base.Event{Type: "order", Message: msg})
+	return nil
+}
+
+// cancelSyntheticOrdersForExchange cancels all working synthetic orders for
+// an exchange
+func (m *OrderManager) cancelSyntheticOrdersForExchange(ctx context.Context, exch string) {
+	m.syntheticOrders.m.Lock()
+	var ids []string
+	for id, synth := range m.syntheticOrders.orders {
+		if synth.isActive() && strings.EqualFold(synth.Exchange, exch) {
+			ids = append(ids, id)
+		}
+	}
+	m.syntheticOrders.m.Unlock()
+	for i := range ids {
+		err := m.Cancel(ctx, &order.Cancel{Exchange: exch, OrderID: ids[i]})
+		if err != nil {
+			log.Errorln(log.OrderMgr, err)
+		}
+	}
+}
+
+// getSyntheticOrders returns a copy of the synthetic orders matching the
+// filter
+func (m *OrderManager) getSyntheticOrders(f *order.Filter, activeOnly bool) []order.Detail {
+	m.syntheticOrders.m.Lock()
+	defer m.syntheticOrders.m.Unlock()
+	var orders []order.Detail
+	for _, synth := range m.syntheticOrders.orders {
+		if activeOnly && !synth.isActive() {
+			continue
+		}
+		m.updateSyntheticChildren(synth)
+		d := synth.detail()
+		if f != nil && !d.MatchFilter(f) {
+			continue
+		}
+		orders = append(orders, d)
+	}
+	return orders
+}
+
+// runSyntheticOrders works synthetic orders as ticker and orderbook updates
+// are received and as their schedules fall due
+func (m *OrderManager) runSyntheticOrders(shutdown <-chan struct{}) {
+	timer := time.NewTicker(syntheticOrderInterval)
+	defer func() {
+		timer.Stop()
+		m.releaseSyntheticMarketData()
+		m.orderStore.wg.Done()
+	}()
+	for {
+		select {
+		case <-shutdown:
+			return
+		case u := <-m.syntheticOrders.updates:
+			m.processSyntheticOrders(u, time.Now())
+		case <-timer.C:
+			m.subscribeSyntheticMarketData(shutdown)
+			m.processSyntheticOrders(nil, time.Now())
+		}
+	}
+}
+
+// subscribeSyntheticMarketData subscribes to ticker and orderbook updates for
+// each exchange with working synthetic orders. Subscriptions can only occur
+// once an exchange has produced market data, so failures are retried on the
+// next interval
+func (m *OrderManager) subscribeSyntheticMarketData(shutdown <-chan struct{}) {
+	m.syntheticOrders.m.Lock()
+	defer m.syntheticOrders.m.Unlock()
+	for _, synth := range m.syntheticOrders.orders {
+		if !synth.isActive() {
+			continue
+		}
+		exch := strings.ToLower(synth.Exchange)
+		for kind, subscribe := range map[string]func(string) (dispatch.Pipe, error){
+			"ticker":    ticker.SubscribeToExchangeTickers,
+			"orderbook": orderbook.SubscribeToExchangeOrderbooks,
+		} {
+			k := exch + " " + kind
+			if _, ok := m.syntheticOrders.pipes[k]; ok {
+				continue
+			}
+			pipe, err := subscribe(exch)
+			if err != nil {
+				if m.verbose {
+					log.Debugf(log.OrderMgr, "Synthetic orders cannot subscribe to %s %s updates: %v", exch, kind, err)
+				}
+				continue
+			}
+			if m.syntheticOrders.pipes == nil {
+				m.syntheticOrders.pipes = make(map[string]dispatch.Pipe)
+			}
+			m.syntheticOrders.pipes[k] = pipe
+			go m.forwardSyntheticMarketData(k, pipe, shutdown)
+		}
+	}
+}
+
+// forwardSyntheticMarketData converts dispatched market data into updates for
+// the synthetic order routine
+func (m *OrderManager) forwardSyntheticMarketData(k string, pipe dispatch.Pipe, shutdown <-chan struct{}) {
+	for {
+		select {
+		case <-shutdown:
+			return
+		case data, ok := <-pipe.Channel():
+			if !ok {
+				// Allow the subscription to be re-established
+				m.syntheticOrders.m.Lock()
+				delete(m.syntheticOrders.pipes, k)
+				m.syntheticOrders.m.Unlock()
+				return
+			}
+			u := syntheticUpdateFromDispatch(data)
+			if u == nil {
+				continue
+			}
+			select {
+			case m.syntheticOrders.updates <- u:
+			case <-shutdown:
+				return
+			}
+		}
+	}
+}
+
+// releaseSyntheticMarketData releases all market data subscriptions
+func (m *OrderManager) releaseSyntheticMarketData() {
+	m.syntheticOrders.m.Lock()
+	defer m.syntheticOrders.m.Unlock()
+	for k, pipe := range m.syntheticOrders.pipes {
+		err := pipe.Release()
+		if err != nil {
+			log.Errorln(log.DispatchMgr, err)
+		}
+		delete(m.syntheticOrders.pipes, k)
+	}
+}
+
+// syntheticUpdateFromDispatch converts ticker and orderbook data received via
+// dispatch into a market update
+func syntheticUpdateFromDispatch(data any) *syntheticMarketUpdate {
+	switch d := data.(type) {
+	case *ticker.Price:
+		return &syntheticMarketUpdate{
+			exchange: d.ExchangeName,
+			pair:     d.Pair,
+			asset:    d.AssetType,
+			last:     d.Last,
+			bid:      d.Bid,
+			ask:      d.Ask,
+			volume:   d.Volume,
+		}
+	case *orderbook.Depth:
+		pair, err := d.GetPair()
+		if err != nil {
+			return nil
+		}
+		a, err := d.GetAsset()
+		if err != nil {
+			return nil
+		}
+		u := &syntheticMarketUpdate{exchange: d.GetName(), pair: pair, asset: a}
+		// An empty side of the book is not an error for price triggers,
+		// the other side or the ticker will be used instead
+		u.bid, _ = d.GetBestBid()
+		u.ask, _ = d.GetBestAsk()
+		return u
+	}
+	return nil
+}
+
+// processSyntheticOrders works all synthetic orders against a market update
+// and the current time. The update can be nil when only schedules and child
+// orders need to be checked
+func (m *OrderManager) processSyntheticOrders(u *syntheticMarketUpdate, now time.Time) {
+	m.syntheticOrders.m.Lock()
+	var actions []syntheticAction
+	for _, synth := range m.syntheticOrders.orders {
+		if !synth.isActive() {
+			continue
+		}
+		actions = append(actions, m.processSyntheticOrder(synth, u, now)...)
+	}
+	m.syntheticOrders.m.Unlock()
+
+	for i := range actions {
+		m.executeSyntheticAction(&actions[i])
+	}
+}
+
+// processSyntheticOrder updates the state of a synthetic order and returns
+// any child order actions required. It must be called with the store lock
+// held
+func (m *OrderManager) processSyntheticOrder(synth *syntheticOrder, u *syntheticMarketUpdate, now time.Time) []syntheticAction {
+	m.updateSyntheticChildren(synth)
+	if synth.hasUnfilledChild() {
+		// A child was cancelled or rejected outside of the synthetic order,
+		// so stop working it
+		synth.close(order.Cancelled)
+		return nil
+	}
+	active := synth.child(synth.activeChildID)
+	if active != nil && active.finished {
+		synth.activeChildID = ""
+		active = nil
+	}
+	if synth.complete && synth.pending == 0 && synth.childrenFinished() {
+		synth.close(order.Filled)
+		return nil
+	}
+
+	var price float64
+	if u.matches(synth) {
+		price = u.price(synth.Side)
+		if u.volume > 0 {
+			if synth.lastVolume > 0 && u.volume > synth.lastVolume {
+				synth.observedVolume += u.volume - synth.lastVolume
+			}
+			synth.lastVolume = u.volume
+		}
+	}
+
+	switch synth.Type {
+	case order.Stop:
+		if price > 0 && !synth.complete && synth.triggered(price) {
+			return []syntheticAction{synth.place(synth.Amount, now, true)}
+		}
+	case order.TrailingStop:
+		if price <= 0 || synth.complete {
+			return nil
+		}
+		if synth.watermark == 0 ||
+			(synth.Side.IsLong() && price < synth.watermark) ||
+			(!synth.Side.IsLong() && price > synth.watermark) {
+			synth.watermark = price
+			synth.TriggerPrice = synth.watermark - synth.TrailingOffset
+			if synth.Side.IsLong() {
+				synth.TriggerPrice = synth.watermark + synth.TrailingOffset
+			}
+			synth.lastUpdated = now
+		}
+		if synth.triggered(price) {
+			return []syntheticAction{synth.place(synth.Amount, now, true)}
+		}
+	case order.OCO:
+		if price > 0 && active != nil && synth.triggered(price) {
+			cancel := synth.cancelActiveChild()
+			// The stop leg is always sent as a market order for any amount
+			// not already filled by the limit leg
+			synth.placed = active.executed
+			stop := synth.place(synth.Amount-active.executed, now, true)
+			stop.submit.Type = order.Market
+			stop.submit.Price = 0
+			stop.cancel = cancel
+			return []syntheticAction{stop}
+		}
+	case order.Iceberg:
+		if active != nil || synth.complete || synth.pending > 0 {
+			return nil
+		}
+		remaining := synth.Amount - synth.placed
+		amount := math.Min(synth.SliceAmount, remaining)
+		return []syntheticAction{synth.place(amount, now, amount >= remaining)}
+	case order.TWAP, order.VWAP:
+		if synth.slicesPlaced >= synth.Slices {
+			return nil
+		}
+		interval := synth.Duration / time.Duration(synth.Slices)
+		if now.Before(synth.created.Add(interval * time.Duration(synth.slicesPlaced))) {
+			return nil
+		}
+		synth.status = order.Active
+		synth.slicesPlaced++
+		remaining := synth.Amount - synth.placed
+		finalSlice := synth.slicesPlaced == synth.Slices
+		amount := remaining
+		switch {
+		case finalSlice:
+		case synth.Type == order.TWAP:
+			amount = math.Min(remaining, synth.Amount/float64(synth.Slices))
+		default:
+			// VWAP slices participate in the volume traded since the
+			// previous slice, with the final slice completing the order
+			amount = math.Min(remaining, synth.observedVolume*synth.ParticipationRate)
+			synth.observedVolume = 0
+		}
+		if amount <= 0 {
+			synth.lastUpdated = now
+			return nil
+		}
+		return []syntheticAction{synth.place(amount, now, finalSlice)}
+	}
+	return nil
+}
+
+// updateSyntheticChildren updates the executed amount and state of each child
+// order of a synthetic order from the order manager. It must be called with the
+// store lock held
+func (m *OrderManager) updateSyntheticChildren(synth *syntheticOrder) {
+	for _, c := range synth.children {
+		if c.finished {
+			continue
+		}
+		child, err := m.orderStore.getByExchangeAndID(synth.Exchange, c.orderID)
+		if err != nil {
+			continue
+		}
+		c.executed = child.ExecutedAmount
+		if child.Status == order.Filled && c.executed == 0 {
+			c.executed = child.Amount
+		}
+		c.finished = child.Status.IsInactive()
+	}
+}
+
+// executeSyntheticAction sends child order requests to the exchange and
+// records the result against the synthetic order
+func (m *OrderManager) executeSyntheticAction(action *syntheticAction) {
+	if action.cancel != nil {
+		err := m.Cancel(context.TODO(), action.cancel)
+		if err != nil {
+			log.Errorf(log.OrderMgr, "Synthetic order %v cannot cancel child order %v: %v", action.synth.orderID, action.cancel.OrderID, err)
+		}
+		if action.submit != nil && action.synth.Type == order.OCO {
+			if err != nil {
+				// The limit leg may have filled, so the stop leg is held back
+				// until the limit leg is known to be withdrawn
+				m.restoreSyntheticLimitLeg(action)
+				return
+			}
+			if !m.resizeSyntheticStop(action) {
+				return
+			}
+		}
+	}
+	if action.submit == nil {
+		return
+	}
+	resp, err := m.Submit(context.TODO(), action.submit)
+
+	synth := action.synth
+	m.syntheticOrders.m.Lock()
+	synth.pending--
+	if err != nil {
+		if synth.isActive() {
+			synth.close(order.Rejected)
+		}
+		m.syntheticOrders.m.Unlock()
+		msg := fmt.Sprintf("Exchange %s synthetic order ID=%v rejected: %v", synth.Exchange, synth.orderID, err)
+		log.Errorln(log.OrderMgr, msg)
+		m.orderStore.commsManager.PushEvent(base.Event{Type: "order", Message: msg})
+		return
+	}
+	synth.children = append(synth.children, &syntheticChild{orderID: resp.OrderID, amount: action.submit.Amount})
+	var orphan *order.Cancel
+	if synth.Type == order.Iceberg {
+		synth.activeChildID = resp.OrderID
+		if synth.status == order.Cancelled {
+			// Cancelled while the slice was in flight
+			orphan = synth.cancelActiveChild()
+		}
+	}
+	m.syntheticOrders.m.Unlock()
+
+	if m.verbose {
+		log.Debugf(log.OrderMgr, "Synthetic order %v placed child order %v amount %v", synth.orderID, resp.OrderID, action.submit.Amount)
+	}
+	if orphan != nil {
+		err = m.Cancel(context.TODO(), orphan)
+		if err != nil {
+			log.Errorf(log.OrderMgr, "Synthetic order %v cannot cancel child order %v: %v", synth.orderID, orphan.OrderID, err)
+		}
+	}
+}
+
+// restoreSyntheticLimitLeg reinstates the limit leg of an OCO order when it
+// could not be cancelled so a later update can retry the stop leg or finish
+// the order once the limit leg fills
+func (m *OrderManager) restoreSyntheticLimitLeg(action *syntheticAction) {
+	synth := action.synth
+	m.syntheticOrders.m.Lock()
+	defer m.syntheticOrders.m.Unlock()
+	synth.pending--
+	leg := synth.child(action.cancel.OrderID)
+	if leg == nil {
+		return
+	}
+	leg.withdrawn = false
+	synth.activeChildID = leg.orderID
+	synth.placed = leg.amount
+}
+
+// resizeSyntheticStop sizes the stop leg of an OCO order from the executed
+// amount of the cancelled limit leg as reported by the exchange. It returns
+// false when the limit leg has already filled and no stop leg is required
+func (m *OrderManager) resizeSyntheticStop(action *syntheticAction) bool {
+	synth, cancel := action.synth, action.cancel
+	_, err := m.GetOrderInfo(context.TODO(), cancel.Exchange, cancel.OrderID, cancel.Pair, cancel.AssetType)
+	if err != nil {
+		log.Warnf(log.OrderMgr, "Synthetic order %v cannot refresh child order %v, using last known executed amount: %v", synth.orderID, cancel.OrderID, err)
+	}
+	m.syntheticOrders.m.Lock()
+	defer m.syntheticOrders.m.Unlock()
+	m.updateSyntheticChildren(synth)
+	var executed float64
+	if leg := synth.child(cancel.OrderID); leg != nil {
+		executed = leg.executed
+	}
+	remaining := synth.Amount - executed
+	synth.placed = executed
+	if remaining <= 0 {
+		synth.pending--
+		return false
+	}
+	synth.placed += remaining
+	action.submit.Amount = remaining
+	return true
+}
+
+// place returns an action to submit a child order for the amount. The
+// synthetic order remains active until its child orders have filled
+func (s *syntheticOrder) place(amount float64, now time.Time, last bool) syntheticAction {
+	s.status = order.Active
+	s.placed += amount
+	s.pending++
+	s.lastUpdated = now
+	if last {
+		s.complete = true
+	}
+	return syntheticAction{synth: s, submit: s.childSubmit(amount)}
+}
+
+// cancelActiveChild returns a cancel request for the working child order
+func (s *syntheticOrder) cancelActiveChild() *order.Cancel {
+	if s.activeChildID == "" {
+		return nil
+	}
+	cancel := &order.Cancel{
+		Exchange:  s.Exchange,
+		OrderID:   s.activeChildID,
+		Pair:      s.Pair,
+		AssetType: s.AssetType,
+		Side:      s.Side,
+	}
+	if c := s.child(s.activeChildID); c != nil {
+		c.withdrawn = true
+	}
+	s.activeChildID = ""
+	return cancel
+}
+
+// child returns the child order with the ID
+func (s *syntheticOrder) child(orderID string) *syntheticChild {
+	if orderID == "" {
+		return nil
+	}
+	for _, c := range s.children {
+		if c.orderID == orderID {
+			return c
+		}
+	}
+	return nil
+}
+
+// hasUnfilledChild returns whether a child order finished without filling
+// when it was not withdrawn by the synthetic order
+func (s *syntheticOrder) hasUnfilledChild() bool {
+	for _, c := range s.children {
+		if c.finished && !c.withdrawn && c.executed < c.amount {
+			return true
+		}
+	}
+	return false
+}
+
+// childrenFinished returns whether all child orders are no longer active
+func (s *syntheticOrder) childrenFinished() bool {
+	for _, c := range s.children {
+		if !c.finished {
+			return false
+		}
+	}
+	return true
+}
+
+// executed returns the amount executed by all child orders
+func (s *syntheticOrder) executed() float64 {
+	var executed float64
+	for _, c := range s.children {
+		executed += c.executed
+	}
+	return executed
+}
+
+// triggered returns whether the price has crossed the trigger price
+func (s *syntheticOrder) triggered(price float64) bool {
+	if s.Side.IsLong() {
+		return price >= s.TriggerPrice
+	}
+	return price <= s.TriggerPrice
+}
+
+func (s *syntheticOrder) close(status order.Status) {
+	s.status = status
+	s.lastUpdated = time.Now()
+	s.closed = s.lastUpdated
+}
+
+func (s *syntheticOrder) isActive() bool {
+	return s.status == order.Pending || s.status == order.Active
+}
+
+// detail returns the synthetic order as an order detail
+func (s *syntheticOrder) detail() order.Detail {
+	executed := s.executed()
+	remaining := s.Amount - executed
+	if remaining < 0 {
+		remaining = 0
+	}
+	return order.Detail{
+		Exchange:        s.Exchange,
+		OrderID:         s.orderID,
+		InternalOrderID: s.internalID,
+		ClientOrderID:   s.ClientOrderID,
+		Pair:            s.Pair,
+		AssetType:       s.AssetType,
+		Side:            s.Side,
+		Type:            s.Type,
+		Status:          s.status,
+		Price:           s.Price,
+		TriggerPrice:    s.TriggerPrice,
+		Amount:          s.Amount,
+		ExecutedAmount:  executed,
+		RemainingAmount: remaining,
+		Date:            s.created,
+		LastUpdated:     s.lastUpdated,
+		CloseTime:       s.closed,
+	}
+}
+
+// matches returns whether the update is for the synthetic order's market
+func (u *syntheticMarketUpdate) matches(s *syntheticOrder) bool {
+	return u != nil &&
+		strings.EqualFold(u.exchange, s.Exchange) &&
+		u.asset == s.AssetType &&
+		u.pair.Equal(s.Pair)
+}
+
+// price returns the price an order on the side would execute at, falling
+// back to the last traded price
+func (u *syntheticMarketUpdate) price(side order.Side) float64 {
+	if side.IsLong() && u.ask > 0 {
+		return u.ask
+	}
+	if !side.IsLong() && u.bid > 0 {
+		return u.bid
+	}
+	return u.last
+}
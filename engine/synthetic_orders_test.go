@@ -0,0 +1,729 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common/convert"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+// syntheticTestExchange records child orders sent by synthetic orders without
+// any API calls
+type syntheticTestExchange struct {
+	exchange.IBotExchange
+	m         sync.Mutex
+	submitted []*order.Submit
+	cancelled []string
+	cancelErr error
+	executed  map[string]float64
+}
+
+func (f *syntheticTestExchange) SubmitOrder(_ context.Context, s *order.Submit) (*order.SubmitResponse, error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	f.submitted = append(f.submitted, s)
+	return s.DeriveSubmitResponse(strconv.Itoa(len(f.submitted)))
+}
+
+func (f *syntheticTestExchange) CancelOrder(_ context.Context, c *order.Cancel) error {
+	f.m.Lock()
+	defer f.m.Unlock()
+	if f.cancelErr != nil {
+		return f.cancelErr
+	}
+	f.cancelled = append(f.cancelled, c.OrderID)
+	return nil
+}
+
+func (f *syntheticTestExchange) GetOrderInfo(_ context.Context, orderID string, _ currency.Pair, _ asset.Item) (*order.Detail, error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	i, err := strconv.Atoi(orderID)
+	if err != nil || i < 1 || i > len(f.submitted) {
+		return nil, order.ErrOrderNotFound
+	}
+	s := f.submitted[i-1]
+	return &order.Detail{
+		Exchange:        testExchange,
+		OrderID:         orderID,
+		Pair:            s.Pair,
+		AssetType:       s.AssetType,
+		Side:            s.Side,
+		Type:            s.Type,
+		Price:           s.Price,
+		Amount:          s.Amount,
+		ExecutedAmount:  f.executed[orderID],
+		RemainingAmount: s.Amount - f.executed[orderID],
+		Status:          order.Cancelled,
+		LastUpdated:     time.Now(),
+	}, nil
+}
+
+func (f *syntheticTestExchange) setCancelErr(err error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	f.cancelErr = err
+}
+
+func (f *syntheticTestExchange) setExecuted(orderID string, amount float64) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	if f.executed == nil {
+		f.executed = make(map[string]float64)
+	}
+	f.executed[orderID] = amount
+}
+
+func (f *syntheticTestExchange) CanTradePair(currency.Pair, asset.Item) error {
+	return nil
+}
+
+func (f *syntheticTestExchange) getSubmitted() []*order.Submit {
+	f.m.Lock()
+	defer f.m.Unlock()
+	return append([]*order.Submit(nil), f.submitted...)
+}
+
+func (f *syntheticTestExchange) getCancelled() []string {
+	f.m.Lock()
+	defer f.m.Unlock()
+	return append([]string(nil), f.cancelled...)
+}
+
+func syntheticOrdersSetup(t *testing.T) (*OrderManager, *syntheticTestExchange) {
+	t.Helper()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err)
+	exch.SetDefaults()
+	b := exch.GetBase()
+	b.Enabled = true
+	b.CurrencyPairs.Pairs = map[asset.Item]*currency.PairStore{
+		asset.Spot: {
+			AssetEnabled: convert.BoolPtr(true),
+			Enabled:      currency.Pairs{btcusdPair},
+			Available:    currency.Pairs{btcusdPair},
+			ConfigFormat: &currency.PairFormat{Uppercase: true},
+		},
+	}
+	fake := &syntheticTestExchange{IBotExchange: exch}
+	require.NoError(t, em.Add(fake))
+	var wg sync.WaitGroup
+	m, err := SetupOrderManager(em, &CommunicationManager{}, &wg, &config.OrderManager{})
+	require.NoError(t, err)
+	m.started = 1
+	return m, fake
+}
+
+func getSyntheticOrder(t *testing.T, m *OrderManager, id string) order.Detail {
+	t.Helper()
+	orders, err := m.GetOrdersFiltered(&order.Filter{OrderID: id})
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+	return orders[0]
+}
+
+// updateSyntheticChild updates a child order as the order manager would when
+// the exchange reports on it
+func updateSyntheticChild(t *testing.T, m *OrderManager, id string, status order.Status, executed float64) {
+	t.Helper()
+	child, err := m.orderStore.getByExchangeAndID(testExchange, id)
+	require.NoError(t, err)
+	child.Status = status
+	child.ExecutedAmount = executed
+	require.NoError(t, m.orderStore.updateExisting(child))
+}
+
+func syntheticUpdate(bid, ask float64) *syntheticMarketUpdate {
+	return &syntheticMarketUpdate{
+		exchange: testExchange,
+		pair:     btcusdPair,
+		asset:    asset.Spot,
+		last:     (bid + ask) / 2,
+		bid:      bid,
+		ask:      ask,
+	}
+}
+
+func TestSyntheticOrderValidate(t *testing.T) {
+	t.Parallel()
+	var s *SyntheticOrder
+	require.ErrorIs(t, s.Validate(), errNilOrder)
+
+	s = &SyntheticOrder{
+		Exchange:  testExchange,
+		Pair:      btcusdPair,
+		AssetType: asset.Spot,
+		Side:      order.Sell,
+		Amount:    1,
+	}
+	require.ErrorIs(t, s.Validate(), errSyntheticOrderTypeUnsupported)
+
+	s.Price = -1
+	require.ErrorIs(t, s.Validate(), errInvalidPrice)
+	s.Price = 0
+
+	s.Type = order.Stop
+	require.ErrorIs(t, s.Validate(), errInvalidTriggerPrice)
+	s.TriggerPrice = 100
+	require.NoError(t, s.Validate())
+
+	s.Type = order.TrailingStop
+	require.ErrorIs(t, s.Validate(), errInvalidTrailingOffset)
+	s.TrailingOffset = 10
+	require.NoError(t, s.Validate())
+
+	s.Type = order.OCO
+	require.ErrorIs(t, s.Validate(), order.ErrPriceMustBeSetIfLimitOrder)
+	s.Price = 90
+	require.ErrorIs(t, s.Validate(), errInvalidTriggerPrice, "sell stop leg must be below the limit leg")
+	s.Price = 110
+	require.NoError(t, s.Validate())
+
+	s.Type = order.Iceberg
+	require.ErrorIs(t, s.Validate(), errInvalidSliceAmount)
+	s.SliceAmount = 2
+	require.ErrorIs(t, s.Validate(), errInvalidSliceAmount)
+	s.SliceAmount = 0.1
+	require.NoError(t, s.Validate())
+
+	s.Type = order.TWAP
+	require.ErrorIs(t, s.Validate(), errInvalidSchedule)
+	s.Duration = time.Minute
+	s.Slices = 5
+	require.NoError(t, s.Validate())
+
+	s.Type = order.VWAP
+	require.ErrorIs(t, s.Validate(), errInvalidParticipationRate)
+	s.ParticipationRate = 0.1
+	require.NoError(t, s.Validate())
+
+	s.Amount = 0
+	require.ErrorIs(t, s.Validate(), order.ErrAmountIsInvalid)
+}
+
+func TestSubmitSyntheticOrder(t *testing.T) {
+	t.Parallel()
+	var m *OrderManager
+	_, err := m.SubmitSyntheticOrder(context.Background(), nil)
+	require.ErrorIs(t, err, ErrNilSubsystem)
+
+	m, _ = syntheticOrdersSetup(t)
+	m.started = 0
+	_, err = m.SubmitSyntheticOrder(context.Background(), nil)
+	require.ErrorIs(t, err, ErrSubSystemNotStarted)
+	m.started = 1
+
+	_, err = m.SubmitSyntheticOrder(context.Background(), nil)
+	require.ErrorIs(t, err, errNilOrder)
+
+	s := &SyntheticOrder{
+		Exchange:     "bitstamp",
+		Pair:         btcusdPair,
+		AssetType:    asset.Spot,
+		Side:         order.Sell,
+		Type:         order.Stop,
+		Amount:       1,
+		TriggerPrice: 100,
+	}
+	m.cfg.EnforceLimitConfig = true
+	m.cfg.LimitAmount = 0.5
+	_, err = m.SubmitSyntheticOrder(context.Background(), s)
+	require.Error(t, err, "order manager limits must apply to synthetic orders")
+	m.cfg.EnforceLimitConfig = false
+
+	resp, err := m.SubmitSyntheticOrder(context.Background(), s)
+	require.NoError(t, err)
+	assert.Equal(t, testExchange, resp.Exchange, "exchange name should be formatted")
+	assert.Equal(t, order.Pending, resp.Status)
+	assert.NotEmpty(t, resp.InternalOrderID)
+
+	orders, err := m.GetOrdersFiltered(&order.Filter{Exchange: testExchange, Type: order.Stop})
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+	assert.Equal(t, resp.OrderID, orders[0].OrderID)
+
+	orders, err = m.GetOrdersActive(nil)
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+
+	orders, err = m.GetOrdersFiltered(&order.Filter{Type: order.TWAP})
+	require.NoError(t, err)
+	assert.Empty(t, orders)
+}
+
+func TestSyntheticStopOrder(t *testing.T) {
+	t.Parallel()
+	m, exch := syntheticOrdersSetup(t)
+	resp, err := m.SubmitSyntheticOrder(context.Background(), &SyntheticOrder{
+		Exchange:     testExchange,
+		Pair:         btcusdPair,
+		AssetType:    asset.Spot,
+		Side:         order.Sell,
+		Type:         order.Stop,
+		Amount:       1,
+		Price:        99,
+		TriggerPrice: 100,
+	})
+	require.NoError(t, err)
+
+	m.processSyntheticOrders(syntheticUpdate(101, 102), time.Now())
+	assert.Empty(t, exch.getSubmitted(), "stop should not trigger above the trigger price")
+
+	other := syntheticUpdate(50, 51)
+	other.pair = currency.NewPair(currency.LTC, currency.USD)
+	m.processSyntheticOrders(other, time.Now())
+	assert.Empty(t, exch.getSubmitted(), "stop should not trigger on another pair")
+
+	m.processSyntheticOrders(syntheticUpdate(99, 102), time.Now())
+	submitted := exch.getSubmitted()
+	require.Len(t, submitted, 1)
+	assert.Equal(t, order.Limit, submitted[0].Type, "stop with a price should place a limit order")
+	assert.Equal(t, 99.0, submitted[0].Price)
+	assert.Equal(t, order.Sell, submitted[0].Side)
+	assert.Equal(t, 1.0, submitted[0].Amount)
+
+	d := getSyntheticOrder(t, m, resp.OrderID)
+	assert.Equal(t, order.Active, d.Status, "triggered stop should remain active until its child order fills")
+	assert.Zero(t, d.ExecutedAmount)
+
+	m.processSyntheticOrders(syntheticUpdate(98, 99), time.Now())
+	assert.Len(t, exch.getSubmitted(), 1, "triggered stop should not trigger again")
+
+	updateSyntheticChild(t, m, "1", order.PartiallyFilled, 0.4)
+	assert.Equal(t, 0.4, getSyntheticOrder(t, m, resp.OrderID).ExecutedAmount, "executed amount should follow the child order")
+
+	updateSyntheticChild(t, m, "1", order.Filled, 1)
+	m.processSyntheticOrders(nil, time.Now())
+	d = getSyntheticOrder(t, m, resp.OrderID)
+	assert.Equal(t, order.Filled, d.Status)
+	assert.Equal(t, 1.0, d.ExecutedAmount)
+	assert.Zero(t, d.RemainingAmount)
+}
+
+func TestSyntheticOrderChildNotFilled(t *testing.T) {
+	t.Parallel()
+	m, exch := syntheticOrdersSetup(t)
+	s := &SyntheticOrder{
+		Exchange:     testExchange,
+		Pair:         btcusdPair,
+		AssetType:    asset.Spot,
+		Side:         order.Sell,
+		Type:         order.Stop,
+		Amount:       1,
+		TriggerPrice: 100,
+	}
+	resp, err := m.SubmitSyntheticOrder(context.Background(), s)
+	require.NoError(t, err)
+	m.processSyntheticOrders(syntheticUpdate(99, 100), time.Now())
+	require.Len(t, exch.getSubmitted(), 1)
+
+	updateSyntheticChild(t, m, "1", order.Cancelled, 0.25)
+	m.processSyntheticOrders(nil, time.Now())
+	d := getSyntheticOrder(t, m, resp.OrderID)
+	assert.Equal(t, order.Cancelled, d.Status, "a child cancelled on the exchange should not report the order as filled")
+	assert.Equal(t, 0.25, d.ExecutedAmount)
+
+	resp, err = m.SubmitSyntheticOrder(context.Background(), s)
+	require.NoError(t, err)
+	m.processSyntheticOrders(syntheticUpdate(99, 100), time.Now())
+	updateSyntheticChild(t, m, "2", order.Rejected, 0)
+	m.processSyntheticOrders(nil, time.Now())
+	d = getSyntheticOrder(t, m, resp.OrderID)
+	assert.Equal(t, order.Cancelled, d.Status)
+	assert.Zero(t, d.ExecutedAmount)
+}
+
+func TestSyntheticTrailingStopOrder(t *testing.T) {
+	t.Parallel()
+	m, exch := syntheticOrdersSetup(t)
+	resp, err := m.SubmitSyntheticOrder(context.Background(), &SyntheticOrder{
+		Exchange:       testExchange,
+		Pair:           btcusdPair,
+		AssetType:      asset.Spot,
+		Side:           order.Sell,
+		Type:           order.TrailingStop,
+		Amount:         1,
+		TrailingOffset: 10,
+	})
+	require.NoError(t, err)
+
+	m.processSyntheticOrders(syntheticUpdate(100, 101), time.Now())
+	assert.Equal(t, 90.0, getSyntheticOrder(t, m, resp.OrderID).TriggerPrice)
+
+	m.processSyntheticOrders(syntheticUpdate(120, 121), time.Now())
+	assert.Equal(t, 110.0, getSyntheticOrder(t, m, resp.OrderID).TriggerPrice, "stop should trail the best price")
+
+	m.processSyntheticOrders(syntheticUpdate(115, 116), time.Now())
+	assert.Equal(t, 110.0, getSyntheticOrder(t, m, resp.OrderID).TriggerPrice, "stop should not move against the order")
+	assert.Empty(t, exch.getSubmitted())
+
+	m.processSyntheticOrders(syntheticUpdate(109, 110), time.Now())
+	require.Len(t, exch.getSubmitted(), 1)
+	assert.Equal(t, order.Active, getSyntheticOrder(t, m, resp.OrderID).Status)
+
+	m.processSyntheticOrders(syntheticUpdate(100, 101), time.Now())
+	require.Len(t, exch.getSubmitted(), 1, "triggered stop should not trigger again")
+	updateSyntheticChild(t, m, "1", order.Filled, 1)
+	m.processSyntheticOrders(nil, time.Now())
+	assert.Equal(t, order.Filled, getSyntheticOrder(t, m, resp.OrderID).Status)
+}
+
+func TestSyntheticOCOOrder(t *testing.T) {
+	t.Parallel()
+	m, exch := syntheticOrdersSetup(t)
+	s := &SyntheticOrder{
+		Exchange:     testExchange,
+		Pair:         btcusdPair,
+		AssetType:    asset.Spot,
+		Side:         order.Sell,
+		Type:         order.OCO,
+		Amount:       1,
+		Price:        110,
+		TriggerPrice: 90,
+	}
+	resp, err := m.SubmitSyntheticOrder(context.Background(), s)
+	require.NoError(t, err)
+	submitted := exch.getSubmitted()
+	require.Len(t, submitted, 1, "limit leg should be placed on submission")
+	assert.Equal(t, order.Limit, submitted[0].Type)
+	assert.Equal(t, 110.0, submitted[0].Price)
+	assert.Equal(t, order.Active, resp.Status)
+
+	m.processSyntheticOrders(syntheticUpdate(89, 90), time.Now())
+	submitted = exch.getSubmitted()
+	require.Len(t, submitted, 2)
+	assert.Equal(t, order.Market, submitted[1].Type, "stop leg should be a market order")
+	assert.Equal(t, []string{"1"}, exch.getCancelled(), "limit leg should be cancelled")
+	assert.Equal(t, order.Active, getSyntheticOrder(t, m, resp.OrderID).Status, "OCO should remain active until the stop leg fills")
+
+	updateSyntheticChild(t, m, "2", order.Filled, 1)
+	m.processSyntheticOrders(nil, time.Now())
+	d := getSyntheticOrder(t, m, resp.OrderID)
+	assert.Equal(t, order.Filled, d.Status, "withdrawn limit leg should not cancel the OCO")
+	assert.Equal(t, 1.0, d.ExecutedAmount)
+
+	// Limit leg filled before the stop leg is triggered
+	resp, err = m.SubmitSyntheticOrder(context.Background(), s)
+	require.NoError(t, err)
+	updateSyntheticChild(t, m, "3", order.Filled, 1)
+
+	m.processSyntheticOrders(syntheticUpdate(89, 90), time.Now())
+	assert.Len(t, exch.getSubmitted(), 3, "stop leg should not be placed once the limit leg is filled")
+	assert.Equal(t, order.Filled, getSyntheticOrder(t, m, resp.OrderID).Status)
+}
+
+func TestSyntheticOCOOrderCancelFailure(t *testing.T) {
+	t.Parallel()
+	m, exch := syntheticOrdersSetup(t)
+	resp, err := m.SubmitSyntheticOrder(context.Background(), &SyntheticOrder{
+		Exchange:     testExchange,
+		Pair:         btcusdPair,
+		AssetType:    asset.Spot,
+		Side:         order.Sell,
+		Type:         order.OCO,
+		Amount:       1,
+		Price:        110,
+		TriggerPrice: 90,
+	})
+	require.NoError(t, err)
+
+	exch.setCancelErr(errors.New("order already filled"))
+	m.processSyntheticOrders(syntheticUpdate(89, 90), time.Now())
+	assert.Len(t, exch.getSubmitted(), 1, "stop leg should not be placed when the limit leg cannot be cancelled")
+	assert.Equal(t, order.Active, getSyntheticOrder(t, m, resp.OrderID).Status)
+
+	updateSyntheticChild(t, m, "1", order.Filled, 1)
+	m.processSyntheticOrders(syntheticUpdate(89, 90), time.Now())
+	assert.Len(t, exch.getSubmitted(), 1, "stop leg should not be placed once the limit leg is filled")
+	d := getSyntheticOrder(t, m, resp.OrderID)
+	assert.Equal(t, order.Filled, d.Status)
+	assert.Equal(t, 1.0, d.ExecutedAmount)
+}
+
+func TestSyntheticOCOOrderRefreshedStop(t *testing.T) {
+	t.Parallel()
+	m, exch := syntheticOrdersSetup(t)
+	s := &SyntheticOrder{
+		Exchange:     testExchange,
+		Pair:         btcusdPair,
+		AssetType:    asset.Spot,
+		Side:         order.Sell,
+		Type:         order.OCO,
+		Amount:       1,
+		Price:        110,
+		TriggerPrice: 90,
+	}
+	_, err := m.SubmitSyntheticOrder(context.Background(), s)
+	require.NoError(t, err)
+
+	// The limit leg partially filled after the last update was received
+	exch.setExecuted("1", 0.4)
+	m.processSyntheticOrders(syntheticUpdate(89, 90), time.Now())
+	submitted := exch.getSubmitted()
+	require.Len(t, submitted, 2)
+	assert.InDelta(t, 0.6, submitted[1].Amount, 1e-12, "stop leg should cover the amount not filled by the limit leg")
+
+	// The limit leg filled completely before it was cancelled
+	resp, err := m.SubmitSyntheticOrder(context.Background(), s)
+	require.NoError(t, err)
+	exch.setExecuted("3", 1)
+	m.processSyntheticOrders(syntheticUpdate(89, 90), time.Now())
+	assert.Len(t, exch.getSubmitted(), 3, "stop leg should not be placed when the limit leg filled")
+	m.processSyntheticOrders(nil, time.Now())
+	assert.Equal(t, order.Filled, getSyntheticOrder(t, m, resp.OrderID).Status)
+}
+
+func TestSyntheticIcebergOrder(t *testing.T) {
+	t.Parallel()
+	m, exch := syntheticOrdersSetup(t)
+	resp, err := m.SubmitSyntheticOrder(context.Background(), &SyntheticOrder{
+		Exchange:    testExchange,
+		Pair:        btcusdPair,
+		AssetType:   asset.Spot,
+		Side:        order.Buy,
+		Type:        order.Iceberg,
+		Amount:      2.5,
+		Price:       100,
+		SliceAmount: 1,
+	})
+	require.NoError(t, err)
+
+	for i, expected := range []float64{1, 1, 0.5} {
+		m.processSyntheticOrders(nil, time.Now())
+		m.processSyntheticOrders(nil, time.Now())
+		submitted := exch.getSubmitted()
+		require.Len(t, submitted, i+1, "only one slice should be working at a time")
+		assert.Equal(t, expected, submitted[i].Amount)
+		assert.Equal(t, order.Limit, submitted[i].Type)
+
+		updateSyntheticChild(t, m, strconv.Itoa(i+1), order.Filled, expected)
+	}
+	m.processSyntheticOrders(nil, time.Now())
+	d := getSyntheticOrder(t, m, resp.OrderID)
+	assert.Equal(t, order.Filled, d.Status)
+	assert.Equal(t, 2.5, d.ExecutedAmount)
+}
+
+func TestSyntheticTWAPOrder(t *testing.T) {
+	t.Parallel()
+	m, exch := syntheticOrdersSetup(t)
+	resp, err := m.SubmitSyntheticOrder(context.Background(), &SyntheticOrder{
+		Exchange:  testExchange,
+		Pair:      btcusdPair,
+		AssetType: asset.Spot,
+		Side:      order.Buy,
+		Type:      order.TWAP,
+		Amount:    1,
+		Duration:  time.Minute,
+		Slices:    4,
+	})
+	require.NoError(t, err)
+	start := resp.Date
+
+	m.processSyntheticOrders(nil, start)
+	m.processSyntheticOrders(nil, start.Add(time.Second))
+	require.Len(t, exch.getSubmitted(), 1, "second slice should not be placed early")
+	assert.Equal(t, order.Active, getSyntheticOrder(t, m, resp.OrderID).Status)
+
+	m.processSyntheticOrders(nil, start.Add(15*time.Second))
+	m.processSyntheticOrders(nil, start.Add(30*time.Second))
+	m.processSyntheticOrders(nil, start.Add(45*time.Second))
+	submitted := exch.getSubmitted()
+	require.Len(t, submitted, 4)
+	for i := range submitted {
+		assert.Equal(t, 0.25, submitted[i].Amount)
+		assert.Equal(t, order.Market, submitted[i].Type)
+	}
+	assert.Equal(t, order.Active, getSyntheticOrder(t, m, resp.OrderID).Status)
+
+	for i := range submitted {
+		updateSyntheticChild(t, m, strconv.Itoa(i+1), order.Filled, submitted[i].Amount)
+	}
+	m.processSyntheticOrders(nil, start.Add(time.Minute))
+	assert.Len(t, exch.getSubmitted(), 4, "no further slices should be placed")
+	d := getSyntheticOrder(t, m, resp.OrderID)
+	assert.Equal(t, order.Filled, d.Status)
+	assert.Equal(t, 1.0, d.ExecutedAmount)
+}
+
+func TestSyntheticVWAPOrder(t *testing.T) {
+	t.Parallel()
+	m, exch := syntheticOrdersSetup(t)
+	resp, err := m.SubmitSyntheticOrder(context.Background(), &SyntheticOrder{
+		Exchange:          testExchange,
+		Pair:              btcusdPair,
+		AssetType:         asset.Spot,
+		Side:              order.Sell,
+		Type:              order.VWAP,
+		Amount:            10,
+		Duration:          time.Minute,
+		Slices:            3,
+		ParticipationRate: 0.5,
+	})
+	require.NoError(t, err)
+	start := resp.Date
+
+	u := syntheticUpdate(100, 101)
+	u.volume = 1000
+	m.processSyntheticOrders(u, start)
+	assert.Empty(t, exch.getSubmitted(), "no volume has been observed for the first slice")
+
+	u.volume = 1004
+	m.processSyntheticOrders(u, start.Add(time.Second))
+	m.processSyntheticOrders(nil, start.Add(20*time.Second))
+	submitted := exch.getSubmitted()
+	require.Len(t, submitted, 1)
+	assert.Equal(t, 2.0, submitted[0].Amount, "slice should participate in observed volume")
+
+	m.processSyntheticOrders(nil, start.Add(40*time.Second))
+	submitted = exch.getSubmitted()
+	require.Len(t, submitted, 2)
+	assert.Equal(t, 8.0, submitted[1].Amount, "final slice should complete the order")
+
+	updateSyntheticChild(t, m, "1", order.Filled, 2)
+	updateSyntheticChild(t, m, "2", order.Filled, 8)
+	m.processSyntheticOrders(nil, start.Add(time.Minute))
+	assert.Equal(t, order.Filled, getSyntheticOrder(t, m, resp.OrderID).Status)
+}
+
+func TestCancelSyntheticOrder(t *testing.T) {
+	t.Parallel()
+	m, exch := syntheticOrdersSetup(t)
+	resp, err := m.SubmitSyntheticOrder(context.Background(), &SyntheticOrder{
+		Exchange:    testExchange,
+		Pair:        btcusdPair,
+		AssetType:   asset.Spot,
+		Side:        order.Buy,
+		Type:        order.Iceberg,
+		Amount:      2,
+		Price:       100,
+		SliceAmount: 1,
+	})
+	require.NoError(t, err)
+	m.processSyntheticOrders(nil, time.Now())
+	require.Len(t, exch.getSubmitted(), 1)
+
+	cancel := &order.Cancel{Exchange: testExchange, OrderID: resp.OrderID, AssetType: asset.Spot, Pair: btcusdPair}
+	require.NoError(t, m.Cancel(context.Background(), cancel))
+	assert.Equal(t, []string{"1"}, exch.getCancelled(), "working slice should be cancelled")
+	assert.Equal(t, order.Cancelled, getSyntheticOrder(t, m, resp.OrderID).Status)
+
+	orders, err := m.GetOrdersActive(&order.Filter{OrderID: resp.OrderID})
+	require.NoError(t, err)
+	assert.Empty(t, orders)
+
+	require.ErrorIs(t, m.Cancel(context.Background(), cancel), errSyntheticOrderInactive)
+
+	m.processSyntheticOrders(nil, time.Now())
+	assert.Len(t, exch.getSubmitted(), 1, "cancelled order should not place further slices")
+}
+
+func TestSyntheticUpdateFromDispatch(t *testing.T) {
+	t.Parallel()
+	assert.Nil(t, syntheticUpdateFromDispatch("bruh"))
+
+	u := syntheticUpdateFromDispatch(&ticker.Price{
+		ExchangeName: testExchange,
+		Pair:         btcusdPair,
+		AssetType:    asset.Spot,
+		Last:         1337,
+		Bid:          1336,
+		Ask:          1338,
+		Volume:       10,
+	})
+	require.NotNil(t, u)
+	assert.Equal(t, 1336.0, u.price(order.Sell))
+	assert.Equal(t, 1338.0, u.price(order.Buy))
+	assert.Equal(t, 10.0, u.volume)
+
+	pair := currency.NewPair(currency.NewCode("SYNTHETIC"), currency.NewCode("DISPATCH"))
+	err := (&orderbook.Base{
+		Exchange: testExchange,
+		Pair:     pair,
+		Asset:    asset.Spot,
+		Bids:     orderbook.Items{{Price: 99, Amount: 1}},
+		Asks:     orderbook.Items{{Price: 101, Amount: 1}},
+	}).Process()
+	require.NoError(t, err)
+	depth, err := orderbook.GetDepth(testExchange, pair, asset.Spot)
+	require.NoError(t, err)
+
+	u = syntheticUpdateFromDispatch(depth)
+	require.NotNil(t, u)
+	assert.True(t, u.pair.Equal(pair))
+	assert.Equal(t, asset.Spot, u.asset)
+	assert.Equal(t, 99.0, u.price(order.Sell))
+	assert.Equal(t, 101.0, u.price(order.Buy))
+}
+
+func TestSyntheticOrdersMarketData(t *testing.T) {
+	m, exch := syntheticOrdersSetup(t)
+	if !dispatch.IsRunning() {
+		require.NoError(t, dispatch.Start(dispatch.DefaultMaxWorkers, dispatch.DefaultJobsLimit))
+		defer func() { assert.NoError(t, dispatch.Stop()) }()
+	}
+	m.started = 0
+	syntheticOrderInterval = time.Millisecond * 10
+	defer func() { syntheticOrderInterval = time.Second }()
+	require.NoError(t, m.Start())
+
+	pair := currency.NewPair(currency.NewCode("SYNTHETIC"), currency.NewCode("MARKETDATA"))
+	b := exch.GetBase()
+	b.CurrencyPairs.Pairs[asset.Spot].Enabled = b.CurrencyPairs.Pairs[asset.Spot].Enabled.Add(pair)
+	b.CurrencyPairs.Pairs[asset.Spot].Available = b.CurrencyPairs.Pairs[asset.Spot].Available.Add(pair)
+	require.NoError(t, ticker.ProcessTicker(&ticker.Price{
+		ExchangeName: testExchange,
+		Pair:         pair,
+		AssetType:    asset.Spot,
+		Last:         100,
+	}))
+
+	resp, err := m.SubmitSyntheticOrder(context.Background(), &SyntheticOrder{
+		Exchange:     testExchange,
+		Pair:         pair,
+		AssetType:    asset.Spot,
+		Side:         order.Buy,
+		Type:         order.Stop,
+		Amount:       1,
+		TriggerPrice: 110,
+	})
+	require.NoError(t, err)
+
+	assert.Eventually(t, func() bool {
+		m.syntheticOrders.m.Lock()
+		defer m.syntheticOrders.m.Unlock()
+		_, ok := m.syntheticOrders.pipes[strings.ToLower(testExchange)+" ticker"]
+		return ok
+	}, time.Second*5, time.Millisecond*10, "ticker subscription should be established")
+
+	require.NoError(t, ticker.ProcessTicker(&ticker.Price{
+		ExchangeName: testExchange,
+		Pair:         pair,
+		AssetType:    asset.Spot,
+		Last:         111,
+	}))
+	assert.Eventually(t, func() bool {
+		return len(exch.getSubmitted()) == 1
+	}, time.Second*5, time.Millisecond*10, "stop should be triggered by a dispatched ticker")
+	assert.Eventually(t, func() bool {
+		return getSyntheticOrder(t, m, resp.OrderID).Status == order.Filled
+	}, time.Second*5, time.Millisecond*10, "stop should be filled once its market order has filled")
+
+	require.NoError(t, m.Stop())
+}
@@ -0,0 +1,123 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+var (
+	errSyntheticOrderTypeUnsupported = errors.New("synthetic order type unsupported")
+	errInvalidPrice                  = errors.New("invalid price")
+	errInvalidTriggerPrice           = errors.New("invalid trigger price")
+	errInvalidTrailingOffset         = errors.New("invalid trailing offset")
+	errInvalidSliceAmount            = errors.New("invalid slice amount")
+	errInvalidSchedule               = errors.New("invalid schedule")
+	errInvalidParticipationRate      = errors.New("invalid participation rate")
+	errSyntheticOrderInactive        = errors.New("synthetic order is no longer active")
+
+	syntheticOrderInterval = time.Second
+	syntheticUpdateBuffer  = 1024
+)
+
+// SyntheticOrder defines an order type which is emulated by the order manager
+// using market data updates and plain limit or market child orders, allowing
+// it to be used on any exchange regardless of native support
+type SyntheticOrder struct {
+	Exchange      string
+	Pair          currency.Pair
+	AssetType     asset.Item
+	Side          order.Side
+	Type          order.Type
+	Amount        float64
+	ClientOrderID string
+	// Price is the limit price of child orders, market orders are used when
+	// unset. For OCO orders it is the price of the resting limit leg
+	Price float64
+	// TriggerPrice activates stop orders and is the stop leg of OCO orders
+	TriggerPrice float64
+	// TrailingOffset is the distance a trailing stop follows the best price
+	TrailingOffset float64
+	// SliceAmount is the visible amount of each iceberg child order
+	SliceAmount float64
+	// Duration and Slices define the execution schedule of TWAP and VWAP
+	// orders
+	Duration time.Duration
+	Slices   int64
+	// ParticipationRate is the fraction of observed traded volume a VWAP
+	// order will execute per slice
+	ParticipationRate float64
+}
+
+// syntheticOrder holds the working state of a synthetic order
+type syntheticOrder struct {
+	SyntheticOrder
+	orderID     string
+	internalID  uuid.UUID
+	status      order.Status
+	created     time.Time
+	lastUpdated time.Time
+	closed      time.Time
+	// placed is the amount handed off to child orders
+	placed float64
+	// complete is set once every child order required has been placed, the
+	// synthetic order is filled once those child orders have filled
+	complete bool
+	// pending is the number of child orders being submitted
+	pending int
+	// activeChildID is the working child order which can be withdrawn, such
+	// as an iceberg slice or the limit leg of an OCO order
+	activeChildID string
+	children      []*syntheticChild
+	// watermark is the best price seen by a trailing stop
+	watermark      float64
+	slicesPlaced   int64
+	lastVolume     float64
+	observedVolume float64
+}
+
+// syntheticChild is a child order placed by a synthetic order. Its executed
+// amount is updated from the order manager
+type syntheticChild struct {
+	orderID  string
+	amount   float64
+	executed float64
+	finished bool
+	// withdrawn is set when the synthetic order cancelled the child itself, so
+	// its unfilled amount is expected
+	withdrawn bool
+}
+
+// syntheticOrderStore holds all synthetic orders and the market data
+// subscriptions which drive them
+type syntheticOrderStore struct {
+	m       sync.Mutex
+	orders  map[string]*syntheticOrder
+	pipes   map[string]dispatch.Pipe
+	updates chan *syntheticMarketUpdate
+}
+
+// syntheticMarketUpdate is a market data update received via dispatch
+type syntheticMarketUpdate struct {
+	exchange string
+	pair     currency.Pair
+	asset    asset.Item
+	last     float64
+	bid      float64
+	ask      float64
+	volume   float64
+}
+
+// syntheticAction is a child order request resulting from processing a
+// synthetic order, executed once the store lock is released
+type syntheticAction struct {
+	synth  *syntheticOrder
+	submit *order.Submit
+	cancel *order.Cancel
+}
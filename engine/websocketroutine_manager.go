@@ -280,6 +280,9 @@ func (m *WebsocketRoutineManager) websocketDataHandler(exchName string, data int
 			}
 		}
 		m.syncer.PrintOrderbookSummary(base, "websocket", nil)
+		if matcher := m.getMarketDataMatcher(exchName); matcher != nil {
+			return matcher.ProcessOrderbook(d)
+		}
 	case *order.Detail:
 		if !m.orderManager.IsRunning() {
 			return nil
@@ -351,10 +354,16 @@ func (m *WebsocketRoutineManager) websocketDataHandler(exchName string, data int
 		if m.verbose {
 			log.Infof(log.Trade, "%+v", d)
 		}
+		if matcher := m.getMarketDataMatcher(exchName); matcher != nil {
+			return matcher.ProcessTrades(d...)
+		}
 	case []fill.Data:
 		if m.verbose {
 			log.Infof(log.Fill, "%+v", d)
 		}
+		if m.orderManager.IsRunning() {
+			return m.orderManager.RecordFills(d)
+		}
 	default:
 		if m.verbose {
 			log.Warnf(log.WebsocketMgr,
@@ -366,6 +375,23 @@ func (m *WebsocketRoutineManager) websocketDataHandler(exchName string, data int
 	return nil
 }
 
+// getMarketDataMatcher returns the exchange if it simulates order fills
+// against its market data, otherwise nil
+func (m *WebsocketRoutineManager) getMarketDataMatcher(exchName string) marketDataMatcher {
+	if m.exchangeManager == nil {
+		return nil
+	}
+	exch, err := m.exchangeManager.GetExchangeByName(exchName)
+	if err != nil {
+		return nil
+	}
+	matcher, ok := exch.(marketDataMatcher)
+	if !ok {
+		return nil
+	}
+	return matcher
+}
+
 // FormatCurrency is a method that formats and returns a currency pair
 // based on the user currency display preferences
 func (m *WebsocketRoutineManager) FormatCurrency(p currency.Pair) currency.Pair {
@@ -7,11 +7,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/paper"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 )
@@ -357,3 +360,22 @@ func TestSetWebsocketDataHandler(t *testing.T) {
 		t.Fatal("unexpected data handler count")
 	}
 }
+
+func TestGetMarketDataMatcher(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err)
+	exch.SetDefaults()
+	require.NoError(t, em.Add(exch))
+	m := &WebsocketRoutineManager{exchangeManager: em}
+	assert.Nil(t, m.getMarketDataMatcher(testExchange), "live exchanges should not match market data")
+	assert.Nil(t, m.getMarketDataMatcher("unknown"))
+
+	paperExch, err := paper.New(exch, &config.PaperTrading{Enabled: true})
+	require.NoError(t, err)
+	em = NewExchangeManager()
+	require.NoError(t, em.Add(paperExch))
+	m.exchangeManager = em
+	assert.NotNil(t, m.getMarketDataMatcher(testExchange))
+}
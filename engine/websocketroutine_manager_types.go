@@ -5,6 +5,8 @@ import (
 	"sync"
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 )
 
 var (
@@ -37,6 +39,13 @@ type WebsocketRoutineManager struct {
 	mu              sync.RWMutex
 }
 
+// marketDataMatcher defines an exchange which simulates order fills against
+// its own market data, such as a paper trading exchange
+type marketDataMatcher interface {
+	ProcessOrderbook(*orderbook.Depth) error
+	ProcessTrades(...trade.Data) error
+}
+
 // WebsocketDataHandler defines a function signature for a function that handles
 // data coming from websocket connections.
 type WebsocketDataHandler func(service string, incoming interface{}) error
@@ -36,7 +36,7 @@ func withdrawManagerTestHelper(t *testing.T) (*ExchangeManager, *portfolioManage
 	if !errors.Is(err, nil) {
 		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
 	}
-	pm, err := setupPortfolioManager(em, 0, &portfolio.Base{Addresses: []portfolio.Address{}})
+	pm, err := setupPortfolioManager(em, nil, 0, &portfolio.Base{Addresses: []portfolio.Address{}}, currency.USD)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1,12 +1,9 @@
 package binance
 
 import (
-	"context"
-	"fmt"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
-	"golang.org/x/time/rate"
 )
 
 const (
@@ -94,166 +91,96 @@ const (
 	cFuturesOrdersDefaultRate
 	uFuturesMultiAssetMarginRate
 	uFuturesSetMultiAssetMarginRate
+	// endpointLimitCount must remain last, every endpoint limit before it
+	// requires a rate limit in SetRateLimit
+	endpointLimitCount
 )
 
-// RateLimit implements the request.Limiter interface
-type RateLimit struct {
-	SpotRate           *rate.Limiter
-	SpotOrdersRate     *rate.Limiter
-	UFuturesRate       *rate.Limiter
-	UFuturesOrdersRate *rate.Limiter
-	CFuturesRate       *rate.Limiter
-	CFuturesOrdersRate *rate.Limiter
-}
-
-// Limit executes rate limiting functionality for Binance
-func (r *RateLimit) Limit(ctx context.Context, f request.EndpointLimit) error {
-	var limiter *rate.Limiter
-	var tokens int
-	switch f {
-	case spotDefaultRate:
-		limiter, tokens = r.SpotRate, 1
-	case spotOrderbookTickerAllRate,
-		spotSymbolPriceAllRate:
-		limiter, tokens = r.SpotRate, 2
-	case spotHistoricalTradesRate,
-		spotOrderbookDepth500Rate:
-		limiter, tokens = r.SpotRate, 5
-	case spotOrderbookDepth1000Rate,
-		spotAccountInformationRate,
-		spotExchangeInfo:
-		limiter, tokens = r.SpotRate, 10
-	case spotPriceChangeAllRate:
-		limiter, tokens = r.SpotRate, 40
-	case spotOrderbookDepth5000Rate:
-		limiter, tokens = r.SpotRate, 50
-	case spotOrderRate:
-		limiter, tokens = r.SpotOrdersRate, 1
-	case spotOrderQueryRate:
-		limiter, tokens = r.SpotOrdersRate, 2
-	case spotOpenOrdersSpecificRate:
-		limiter, tokens = r.SpotOrdersRate, 3
-	case spotAllOrdersRate:
-		limiter, tokens = r.SpotOrdersRate, 10
-	case spotOpenOrdersAllRate:
-		limiter, tokens = r.SpotOrdersRate, 40
-	case uFuturesDefaultRate,
-		uFuturesKline100Rate:
-		limiter, tokens = r.UFuturesRate, 1
-	case uFuturesOrderbook50Rate,
-		uFuturesKline500Rate,
-		uFuturesOrderbookTickerAllRate:
-		limiter, tokens = r.UFuturesRate, 2
-	case uFuturesOrderbook100Rate,
-		uFuturesKline1000Rate,
-		uFuturesAccountInformationRate:
-		limiter, tokens = r.UFuturesRate, 5
-	case uFuturesOrderbook500Rate,
-		uFuturesKlineMaxRate:
-		limiter, tokens = r.UFuturesRate, 10
-	case uFuturesOrderbook1000Rate,
-		uFuturesHistoricalTradesRate:
-		limiter, tokens = r.UFuturesRate, 20
-	case uFuturesTickerPriceHistoryRate:
-		limiter, tokens = r.UFuturesRate, 40
-	case uFuturesOrdersDefaultRate:
-		limiter, tokens = r.UFuturesOrdersRate, 1
-	case uFuturesBatchOrdersRate,
-		uFuturesGetAllOrdersRate:
-		limiter, tokens = r.UFuturesOrdersRate, 5
-	case uFuturesCountdownCancelRate:
-		limiter, tokens = r.UFuturesOrdersRate, 10
-	case uFuturesCurrencyForceOrdersRate,
-		uFuturesSymbolOrdersRate:
-		limiter, tokens = r.UFuturesOrdersRate, 20
-	case uFuturesIncomeHistoryRate:
-		limiter, tokens = r.UFuturesOrdersRate, 30
-	case uFuturesPairOrdersRate,
-		uFuturesGetAllOpenOrdersRate:
-		limiter, tokens = r.UFuturesOrdersRate, 40
-	case uFuturesAllForceOrdersRate:
-		limiter, tokens = r.UFuturesOrdersRate, 50
-	case cFuturesKline100Rate:
-		limiter, tokens = r.CFuturesRate, 1
-	case cFuturesKline500Rate,
-		cFuturesOrderbookTickerAllRate:
-		limiter, tokens = r.CFuturesRate, 2
-	case cFuturesKline1000Rate,
-		cFuturesAccountInformationRate:
-		limiter, tokens = r.CFuturesRate, 5
-	case cFuturesKlineMaxRate,
-		cFuturesIndexMarkPriceRate:
-		limiter, tokens = r.CFuturesRate, 10
-	case cFuturesHistoricalTradesRate,
-		cFuturesCurrencyForceOrdersRate:
-		limiter, tokens = r.CFuturesRate, 20
-	case cFuturesTickerPriceHistoryRate:
-		limiter, tokens = r.CFuturesRate, 40
-	case cFuturesAllForceOrdersRate:
-		limiter, tokens = r.CFuturesRate, 50
-	case cFuturesOrdersDefaultRate:
-		limiter, tokens = r.CFuturesOrdersRate, 1
-	case cFuturesBatchOrdersRate,
-		cFuturesGetAllOpenOrdersRate:
-		limiter, tokens = r.CFuturesOrdersRate, 5
-	case cFuturesCancelAllOrdersRate:
-		limiter, tokens = r.CFuturesOrdersRate, 10
-	case cFuturesIncomeHistoryRate,
-		cFuturesSymbolOrdersRate:
-		limiter, tokens = r.CFuturesOrdersRate, 20
-	case cFuturesPairOrdersRate:
-		limiter, tokens = r.CFuturesOrdersRate, 40
-	case cFuturesOrderbook50Rate:
-		limiter, tokens = r.CFuturesRate, 2
-	case cFuturesOrderbook100Rate:
-		limiter, tokens = r.CFuturesRate, 5
-	case cFuturesOrderbook500Rate:
-		limiter, tokens = r.CFuturesRate, 10
-	case cFuturesOrderbook1000Rate:
-		limiter, tokens = r.CFuturesRate, 20
-	case cFuturesDefaultRate:
-		limiter, tokens = r.CFuturesRate, 1
-	case uFuturesMultiAssetMarginRate:
-		limiter, tokens = r.UFuturesRate, 30
-	case uFuturesSetMultiAssetMarginRate:
-		limiter, tokens = r.UFuturesRate, 1
-	default:
-		limiter, tokens = r.SpotRate, 1
-	}
-
-	var finalDelay time.Duration
-	var reserves = make([]*rate.Reservation, tokens)
-	for i := 0; i < tokens; i++ {
-		// Consume tokens 1 at a time as this avoids needing burst capacity in the limiter,
-		// which would otherwise allow the rate limit to be exceeded over short periods
-		reserves[i] = limiter.Reserve()
-		finalDelay = reserves[i].Delay()
-	}
-
-	if dl, ok := ctx.Deadline(); ok && dl.Before(time.Now().Add(finalDelay)) {
-		// Cancel all potential reservations to free up rate limiter if deadline
-		// is exceeded.
-		for x := range reserves {
-			reserves[x].Cancel()
-		}
-		return fmt.Errorf("rate limit delay of %s will exceed deadline: %w",
-			finalDelay,
-			context.DeadlineExceeded)
-	}
-
-	time.Sleep(finalDelay)
-	return nil
-}
+// Binance reports the weight and order count used within the interval in
+// response headers
+const (
+	usedWeightHeader     = "X-Mbx-Used-Weight-1m"
+	spotOrderCountHeader = "X-Mbx-Order-Count-10s"
+	futuresOrderCount1m  = "X-Mbx-Order-Count-1m"
+)
 
-// SetRateLimit returns the rate limit for the exchange
-func SetRateLimit() *RateLimit {
-	return &RateLimit{
-		SpotRate:           request.NewRateLimit(spotInterval, spotRequestRate),
-		SpotOrdersRate:     request.NewRateLimit(spotOrderInterval, spotOrderRequestRate),
-		UFuturesRate:       request.NewRateLimit(uFuturesInterval, uFuturesRequestRate),
-		UFuturesOrdersRate: request.NewRateLimit(uFuturesOrderInterval, uFuturesOrderRequestRate),
-		CFuturesRate:       request.NewRateLimit(cFuturesInterval, cFuturesRequestRate),
-		CFuturesOrdersRate: request.NewRateLimit(cFuturesOrderInterval, cFuturesOrderRequestRate),
+// SetRateLimit returns the rate limit for the exchange. Each API's weight
+// and order limits are pooled so that responses only adjust the limits of the
+// API which was requested, as the APIs report their usage in the same headers
+func SetRateLimit() request.RateLimitDefinitions {
+	spot := request.NewRateLimiter("binance:spot", spotInterval, spotRequestRate, request.WithUsedWeightHeader(usedWeightHeader), request.WithPool("binance:spot"))
+	spotOrders := request.NewRateLimiter("binance:spot:orders", spotOrderInterval, spotOrderRequestRate, request.WithUsedWeightHeader(spotOrderCountHeader), request.WithPool("binance:spot"))
+	uFutures := request.NewRateLimiter("binance:ufutures", uFuturesInterval, uFuturesRequestRate, request.WithUsedWeightHeader(usedWeightHeader), request.WithPool("binance:ufutures"))
+	uFuturesOrders := request.NewRateLimiter("binance:ufutures:orders", uFuturesOrderInterval, uFuturesOrderRequestRate, request.WithUsedWeightHeader(futuresOrderCount1m), request.WithPool("binance:ufutures"))
+	cFutures := request.NewRateLimiter("binance:cfutures", cFuturesInterval, cFuturesRequestRate, request.WithUsedWeightHeader(usedWeightHeader), request.WithPool("binance:cfutures"))
+	cFuturesOrders := request.NewRateLimiter("binance:cfutures:orders", cFuturesOrderInterval, cFuturesOrderRequestRate, request.WithUsedWeightHeader(futuresOrderCount1m), request.WithPool("binance:cfutures"))
+
+	return request.RateLimitDefinitions{
+		spotDefaultRate:            request.GetRateLimiterWithWeight(spot, 1),
+		spotOrderbookTickerAllRate: request.GetRateLimiterWithWeight(spot, 2),
+		spotSymbolPriceAllRate:     request.GetRateLimiterWithWeight(spot, 2),
+		spotHistoricalTradesRate:   request.GetRateLimiterWithWeight(spot, 5),
+		spotOrderbookDepth500Rate:  request.GetRateLimiterWithWeight(spot, 5),
+		spotOrderbookDepth1000Rate: request.GetRateLimiterWithWeight(spot, 10),
+		spotAccountInformationRate: request.GetRateLimiterWithWeight(spot, 10),
+		spotExchangeInfo:           request.GetRateLimiterWithWeight(spot, 10),
+		spotPriceChangeAllRate:     request.GetRateLimiterWithWeight(spot, 40),
+		spotOrderbookDepth5000Rate: request.GetRateLimiterWithWeight(spot, 50),
+		spotOrderRate:              request.GetRateLimiterWithWeight(spotOrders, 1),
+		spotOrderQueryRate:         request.GetRateLimiterWithWeight(spotOrders, 2),
+		spotOpenOrdersSpecificRate: request.GetRateLimiterWithWeight(spotOrders, 3),
+		spotAllOrdersRate:          request.GetRateLimiterWithWeight(spotOrders, 10),
+		spotOpenOrdersAllRate:      request.GetRateLimiterWithWeight(spotOrders, 40),
+
+		uFuturesDefaultRate:             request.GetRateLimiterWithWeight(uFutures, 1),
+		uFuturesKline100Rate:            request.GetRateLimiterWithWeight(uFutures, 1),
+		uFuturesSetMultiAssetMarginRate: request.GetRateLimiterWithWeight(uFutures, 1),
+		uFuturesOrderbook50Rate:         request.GetRateLimiterWithWeight(uFutures, 2),
+		uFuturesKline500Rate:            request.GetRateLimiterWithWeight(uFutures, 2),
+		uFuturesOrderbookTickerAllRate:  request.GetRateLimiterWithWeight(uFutures, 2),
+		uFuturesOrderbook100Rate:        request.GetRateLimiterWithWeight(uFutures, 5),
+		uFuturesKline1000Rate:           request.GetRateLimiterWithWeight(uFutures, 5),
+		uFuturesAccountInformationRate:  request.GetRateLimiterWithWeight(uFutures, 5),
+		uFuturesOrderbook500Rate:        request.GetRateLimiterWithWeight(uFutures, 10),
+		uFuturesKlineMaxRate:            request.GetRateLimiterWithWeight(uFutures, 10),
+		uFuturesOrderbook1000Rate:       request.GetRateLimiterWithWeight(uFutures, 20),
+		uFuturesHistoricalTradesRate:    request.GetRateLimiterWithWeight(uFutures, 20),
+		uFuturesMultiAssetMarginRate:    request.GetRateLimiterWithWeight(uFutures, 30),
+		uFuturesTickerPriceHistoryRate:  request.GetRateLimiterWithWeight(uFutures, 40),
+		uFuturesOrdersDefaultRate:       request.GetRateLimiterWithWeight(uFuturesOrders, 1),
+		uFuturesBatchOrdersRate:         request.GetRateLimiterWithWeight(uFuturesOrders, 5),
+		uFuturesGetAllOrdersRate:        request.GetRateLimiterWithWeight(uFuturesOrders, 5),
+		uFuturesCountdownCancelRate:     request.GetRateLimiterWithWeight(uFuturesOrders, 10),
+		uFuturesCurrencyForceOrdersRate: request.GetRateLimiterWithWeight(uFuturesOrders, 20),
+		uFuturesSymbolOrdersRate:        request.GetRateLimiterWithWeight(uFuturesOrders, 20),
+		uFuturesIncomeHistoryRate:       request.GetRateLimiterWithWeight(uFuturesOrders, 30),
+		uFuturesPairOrdersRate:          request.GetRateLimiterWithWeight(uFuturesOrders, 40),
+		uFuturesGetAllOpenOrdersRate:    request.GetRateLimiterWithWeight(uFuturesOrders, 40),
+		uFuturesAllForceOrdersRate:      request.GetRateLimiterWithWeight(uFuturesOrders, 50),
+
+		cFuturesDefaultRate:             request.GetRateLimiterWithWeight(cFutures, 1),
+		cFuturesKline100Rate:            request.GetRateLimiterWithWeight(cFutures, 1),
+		cFuturesKline500Rate:            request.GetRateLimiterWithWeight(cFutures, 2),
+		cFuturesOrderbookTickerAllRate:  request.GetRateLimiterWithWeight(cFutures, 2),
+		cFuturesOrderbook50Rate:         request.GetRateLimiterWithWeight(cFutures, 2),
+		cFuturesKline1000Rate:           request.GetRateLimiterWithWeight(cFutures, 5),
+		cFuturesAccountInformationRate:  request.GetRateLimiterWithWeight(cFutures, 5),
+		cFuturesOrderbook100Rate:        request.GetRateLimiterWithWeight(cFutures, 5),
+		cFuturesKlineMaxRate:            request.GetRateLimiterWithWeight(cFutures, 10),
+		cFuturesIndexMarkPriceRate:      request.GetRateLimiterWithWeight(cFutures, 10),
+		cFuturesOrderbook500Rate:        request.GetRateLimiterWithWeight(cFutures, 10),
+		cFuturesHistoricalTradesRate:    request.GetRateLimiterWithWeight(cFutures, 20),
+		cFuturesCurrencyForceOrdersRate: request.GetRateLimiterWithWeight(cFutures, 20),
+		cFuturesOrderbook1000Rate:       request.GetRateLimiterWithWeight(cFutures, 20),
+		cFuturesTickerPriceHistoryRate:  request.GetRateLimiterWithWeight(cFutures, 40),
+		cFuturesAllForceOrdersRate:      request.GetRateLimiterWithWeight(cFutures, 50),
+		cFuturesOrdersDefaultRate:       request.GetRateLimiterWithWeight(cFuturesOrders, 1),
+		cFuturesBatchOrdersRate:         request.GetRateLimiterWithWeight(cFuturesOrders, 5),
+		cFuturesGetAllOpenOrdersRate:    request.GetRateLimiterWithWeight(cFuturesOrders, 5),
+		cFuturesCancelAllOrdersRate:     request.GetRateLimiterWithWeight(cFuturesOrders, 10),
+		cFuturesIncomeHistoryRate:       request.GetRateLimiterWithWeight(cFuturesOrders, 20),
+		cFuturesSymbolOrdersRate:        request.GetRateLimiterWithWeight(cFuturesOrders, 20),
+		cFuturesPairOrdersRate:          request.GetRateLimiterWithWeight(cFuturesOrders, 40),
 	}
 }
 
@@ -3,6 +3,7 @@ package binance
 import (
 	"context"
 	"errors"
+	"net/http"
 	"testing"
 	"time"
 
@@ -76,3 +77,31 @@ func TestRateLimit_LimitStatic(t *testing.T) {
 		})
 	}
 }
+
+func TestSetRateLimitDefinesEveryEndpoint(t *testing.T) {
+	t.Parallel()
+	l := SetRateLimit()
+	for e := spotDefaultRate; e < endpointLimitCount; e++ {
+		if d, ok := l[e]; !ok || d == nil || d.RateLimiter == nil || d.Weight < 1 {
+			t.Errorf("endpoint %d does not have a weighted rate limit", e)
+		}
+	}
+}
+
+func TestSetRateLimitPoolsResponses(t *testing.T) {
+	t.Parallel()
+	l := SetRateLimit()
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set(usedWeightHeader, "3000")
+	resp.Header.Set(futuresOrderCount1m, "1000")
+	l.UpdateFromResponse(cFuturesDefaultRate, resp)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := l.Limit(ctx, spotDefaultRate); err != nil {
+		t.Errorf("coin margined futures usage should not limit spot requests: %v", err)
+	}
+	if err := l.Limit(ctx, uFuturesOrdersDefaultRate); err != nil {
+		t.Errorf("coin margined futures usage should not limit USDT margined futures orders: %v", err)
+	}
+}
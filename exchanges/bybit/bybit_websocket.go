@@ -118,7 +118,7 @@ func (by *Bybit) WsAuth(ctx context.Context) error {
 		Operation: "auth",
 		Args:      []interface{}{creds.Key, intNonce, sign},
 	}
-	resp, err := by.Websocket.AuthConn.SendMessageReturnResponse(req.RequestID, req)
+	resp, err := by.Websocket.AuthConn.SendMessageReturnResponseContext(ctx, req.RequestID, req)
 	if err != nil {
 		return err
 	}
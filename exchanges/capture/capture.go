@@ -0,0 +1,296 @@
+package capture
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+// NewWriter returns a writer which stores capture files in the directory
+func NewWriter(dir string) (*Writer, error) {
+	if dir == "" {
+		return nil, errDirectoryRequired
+	}
+	if err := os.MkdirAll(dir, file.DefaultPermissionOctal); err != nil {
+		return nil, err
+	}
+	return &Writer{dir: dir, files: make(map[Key]*captureFile)}, nil
+}
+
+// NeedsSnapshot returns whether the file for the market and time does not yet
+// start with a snapshot, in which case the next book record should be one so
+// that each file can be read on its own
+func (w *Writer) NeedsSnapshot(k Key, t time.Time) bool {
+	w.m.Lock()
+	defer w.m.Unlock()
+	cf, ok := w.files[k]
+	return !ok || !cf.hasSnapshot || dayOf(t).After(cf.day)
+}
+
+// Write appends the record to the market's file for the record's day. Records
+// older than the open file's day are written to the open file
+func (w *Writer) Write(k Key, r *Record) error {
+	if r == nil {
+		return errNilRecord
+	}
+	if k.Exchange == "" || !k.Asset.IsValid() || k.Pair.IsEmpty() {
+		return errInvalidKey
+	}
+	w.m.Lock()
+	defer w.m.Unlock()
+	if w.files == nil {
+		return errWriterClosed
+	}
+	cf, ok := w.files[k]
+	if ok && dayOf(r.Time).After(cf.day) {
+		if err := cf.close(); err != nil {
+			return err
+		}
+		delete(w.files, k)
+		ok = false
+	}
+	if !ok {
+		var err error
+		if cf, err = openCaptureFile(filePath(w.dir, k, r.Time), dayOf(r.Time)); err != nil {
+			return err
+		}
+		w.files[k] = cf
+	}
+	if err := cf.enc.Encode(r); err != nil {
+		return err
+	}
+	cf.dirty = true
+	if r.Kind == KindSnapshot {
+		cf.hasSnapshot = true
+	}
+	return nil
+}
+
+// Flush completes the gzip member of every file written to since the last
+// flush so that its records can be read
+func (w *Writer) Flush() error {
+	w.m.Lock()
+	defer w.m.Unlock()
+	var errs error
+	for k, cf := range w.files {
+		if !cf.dirty {
+			continue
+		}
+		if err := cf.gz.Close(); err != nil {
+			errs = common.AppendError(errs, fmt.Errorf("%s %s %s: %w", k.Exchange, k.Asset, k.Pair, err))
+			continue
+		}
+		cf.gz.Reset(cf.f)
+		cf.dirty = false
+	}
+	return errs
+}
+
+// Close flushes and closes every file. The writer cannot be used afterwards
+func (w *Writer) Close() error {
+	w.m.Lock()
+	defer w.m.Unlock()
+	if w.files == nil {
+		return errWriterClosed
+	}
+	var errs error
+	for k, cf := range w.files {
+		if err := cf.close(); err != nil {
+			errs = common.AppendError(errs, fmt.Errorf("%s %s %s: %w", k.Exchange, k.Asset, k.Pair, err))
+		}
+	}
+	w.files = nil
+	return errs
+}
+
+func (cf *captureFile) close() error {
+	var err error
+	if cf.dirty {
+		err = cf.gz.Close()
+	}
+	return common.AppendError(err, cf.f.Close())
+}
+
+// openCaptureFile opens a file for appending. Any incomplete gzip member left
+// by a process which did not close the file is removed first, as readers
+// cannot read past it
+func openCaptureFile(path string, day time.Time) (*captureFile, error) {
+	if err := os.MkdirAll(filepath.Dir(path), file.DefaultPermissionOctal); err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, file.DefaultPermissionOctal)
+	if err != nil {
+		return nil, err
+	}
+	valid, err := completeMembersLength(f)
+	if err == nil {
+		err = f.Truncate(valid)
+	}
+	if err == nil {
+		_, err = f.Seek(valid, io.SeekStart)
+	}
+	if err != nil {
+		return nil, common.AppendError(err, f.Close())
+	}
+	gz := gzip.NewWriter(f)
+	// Files with existing members are assumed to start with a snapshot
+	return &captureFile{day: day, f: f, gz: gz, enc: json.NewEncoder(gz), hasSnapshot: valid > 0}, nil
+}
+
+// completeMembersLength returns the length of the complete gzip members at the
+// start of the reader
+func completeMembersLength(r io.Reader) (int64, error) {
+	cr := &countingReader{r: r}
+	br := bufio.NewReader(cr)
+	zr := new(gzip.Reader)
+	var valid int64
+	for {
+		if _, err := br.Peek(1); err != nil {
+			if errors.Is(err, io.EOF) {
+				return valid, nil
+			}
+			return 0, err
+		}
+		if err := zr.Reset(br); err != nil {
+			return valid, nil //nolint:nilerr // An invalid header ends the complete members
+		}
+		zr.Multistream(false)
+		if _, err := io.Copy(io.Discard, zr); err != nil {
+			return valid, nil //nolint:nilerr // A truncated member ends the complete members
+		}
+		valid = cr.n - int64(br.Buffered())
+	}
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+func dayOf(t time.Time) time.Time {
+	return t.UTC().Truncate(time.Hour * 24)
+}
+
+// filePath returns the path of a market's file for the day of the time
+func filePath(dir string, k Key, t time.Time) string {
+	return filepath.Join(dir,
+		strings.ToLower(k.Exchange),
+		k.Asset.String(),
+		k.Pair.Format(currency.PairFormat{Delimiter: currency.DashDelimiter, Uppercase: true}).String(),
+		dayOf(t).Format(dayLayout)+fileExtension)
+}
+
+// SnapshotRecord returns a snapshot record of the book with duplicate price
+// levels aggregated
+func SnapshotRecord(b *orderbook.Base) *Record {
+	return &Record{
+		Kind:     KindSnapshot,
+		Time:     b.LastUpdated,
+		UpdateID: b.LastUpdateID,
+		Bids:     aggregate(b.Bids, true),
+		Asks:     aggregate(b.Asks, false),
+	}
+}
+
+// UpdateRecord returns a record of the price levels which differ between the
+// previous and current book, or nil when they are the same
+func UpdateRecord(previous, current *orderbook.Base) *Record {
+	bids := diff(aggregate(previous.Bids, true), aggregate(current.Bids, true), true)
+	asks := diff(aggregate(previous.Asks, false), aggregate(current.Asks, false), false)
+	if len(bids) == 0 && len(asks) == 0 {
+		return nil
+	}
+	return &Record{
+		Kind:     KindUpdate,
+		Time:     current.LastUpdated,
+		UpdateID: current.LastUpdateID,
+		Bids:     bids,
+		Asks:     asks,
+	}
+}
+
+// TradeRecord returns a record of the trade
+func TradeRecord(t *trade.Data) *Record {
+	return &Record{
+		Kind:    KindTrade,
+		Time:    t.Timestamp,
+		TradeID: t.TID,
+		Side:    t.Side.String(),
+		Price:   t.Price,
+		Amount:  t.Amount,
+	}
+}
+
+// aggregate sums the amounts of duplicate prices and returns the levels sorted
+// from the best price
+func aggregate(items orderbook.Items, bids bool) []Level {
+	levels := make([]Level, 0, len(items))
+	index := make(map[float64]int, len(items))
+	for i := range items {
+		if j, ok := index[items[i].Price]; ok {
+			levels[j].Amount += items[i].Amount
+			continue
+		}
+		index[items[i].Price] = len(levels)
+		levels = append(levels, Level{Price: items[i].Price, Amount: items[i].Amount})
+	}
+	sort.Slice(levels, func(i, j int) bool {
+		if bids {
+			return levels[i].Price > levels[j].Price
+		}
+		return levels[i].Price < levels[j].Price
+	})
+	return levels
+}
+
+// diff returns the levels of current which differ from previous and zero
+// amount levels for prices no longer present. Both must be sorted from the
+// best price
+func diff(previous, current []Level, bids bool) []Level {
+	var changes []Level
+	i, j := 0, 0
+	for i < len(previous) || j < len(current) {
+		switch {
+		case j == len(current) || (i < len(previous) && better(previous[i].Price, current[j].Price, bids)):
+			changes = append(changes, Level{Price: previous[i].Price})
+			i++
+		case i == len(previous) || better(current[j].Price, previous[i].Price, bids):
+			changes = append(changes, current[j])
+			j++
+		default:
+			if previous[i].Amount != current[j].Amount {
+				changes = append(changes, current[j])
+			}
+			i++
+			j++
+		}
+	}
+	return changes
+}
+
+func better(a, b float64, bids bool) bool {
+	if bids {
+		return a > b
+	}
+	return a < b
+}
@@ -0,0 +1,170 @@
+package capture
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+var (
+	testKey   = Key{Exchange: "Binance", Asset: asset.Spot, Pair: currency.NewPair(currency.BTC, currency.USDT)}
+	testStart = time.Date(2024, 3, 1, 23, 59, 0, 0, time.UTC)
+)
+
+func testBook(t time.Time, id int64, bids, asks orderbook.Items) *orderbook.Base {
+	return &orderbook.Base{Bids: bids, Asks: asks, LastUpdated: t, LastUpdateID: id}
+}
+
+func TestAggregateAndDiff(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, []Level{{Price: 101, Amount: 1}, {Price: 100, Amount: 3}},
+		aggregate(orderbook.Items{{Price: 100, Amount: 1}, {Price: 101, Amount: 1}, {Price: 100, Amount: 2}}, true),
+		"duplicate prices should be summed and bids sorted descending")
+
+	previous := testBook(testStart, 1, orderbook.Items{{Price: 100, Amount: 1}, {Price: 99, Amount: 1}}, orderbook.Items{{Price: 101, Amount: 1}, {Price: 102, Amount: 1}})
+	assert.Nil(t, UpdateRecord(previous, previous), "unchanged books should not produce an update")
+
+	current := testBook(testStart.Add(time.Second), 2, orderbook.Items{{Price: 100, Amount: 2}, {Price: 98, Amount: 1}}, orderbook.Items{{Price: 100.5, Amount: 1}, {Price: 101, Amount: 1}, {Price: 102, Amount: 1}})
+	u := UpdateRecord(previous, current)
+	require.NotNil(t, u)
+	assert.Equal(t, KindUpdate, u.Kind)
+	assert.Equal(t, int64(2), u.UpdateID)
+	assert.Equal(t, []Level{{Price: 100, Amount: 2}, {Price: 99}, {Price: 98, Amount: 1}}, u.Bids)
+	assert.Equal(t, []Level{{Price: 100.5, Amount: 1}}, u.Asks)
+}
+
+func TestWriterAndReader(t *testing.T) {
+	t.Parallel()
+	_, err := NewWriter("")
+	assert.ErrorIs(t, err, errDirectoryRequired)
+
+	dir := t.TempDir()
+	w, err := NewWriter(dir)
+	require.NoError(t, err)
+	assert.ErrorIs(t, w.Write(testKey, nil), errNilRecord)
+	assert.ErrorIs(t, w.Write(Key{Exchange: "Binance"}, &Record{}), errInvalidKey)
+	assert.True(t, w.NeedsSnapshot(testKey, testStart))
+
+	book1 := testBook(testStart, 1, orderbook.Items{{Price: 100, Amount: 1}}, orderbook.Items{{Price: 101, Amount: 1}})
+	require.NoError(t, w.Write(testKey, SnapshotRecord(book1)))
+	assert.False(t, w.NeedsSnapshot(testKey, testStart.Add(time.Second)))
+	book2 := testBook(testStart.Add(time.Second*10), 2, orderbook.Items{{Price: 100, Amount: 5}}, orderbook.Items{{Price: 101, Amount: 1}})
+	require.NoError(t, w.Write(testKey, UpdateRecord(book1, book2)))
+	require.NoError(t, w.Write(testKey, TradeRecord(&trade.Data{TID: "1", Side: order.Buy, Price: 101, Amount: 0.5, Timestamp: testStart.Add(time.Second * 20)})))
+	require.NoError(t, w.Flush())
+
+	nextDay := testStart.Add(time.Minute * 2)
+	assert.True(t, w.NeedsSnapshot(testKey, nextDay), "a new day's file should start with a snapshot")
+	require.NoError(t, w.Write(testKey, TradeRecord(&trade.Data{TID: "2", Side: order.Sell, Price: 100, Amount: 1, Timestamp: nextDay})))
+	assert.True(t, w.NeedsSnapshot(testKey, nextDay), "trades should not satisfy the snapshot requirement")
+	book3 := testBook(nextDay, 3, orderbook.Items{{Price: 99, Amount: 1}}, orderbook.Items{{Price: 101, Amount: 1}})
+	require.NoError(t, w.Write(testKey, SnapshotRecord(book3)))
+	require.NoError(t, w.Close())
+	assert.ErrorIs(t, w.Write(testKey, SnapshotRecord(book3)), errWriterClosed)
+	assert.ErrorIs(t, w.Close(), errWriterClosed)
+
+	_, err = os.Stat(filepath.Join(dir, "binance", "spot", "BTC-USDT", "2024-03-01.jsonl.gz"))
+	require.NoError(t, err)
+	_, err = os.Stat(filepath.Join(dir, "binance", "spot", "BTC-USDT", "2024-03-02.jsonl.gz"))
+	require.NoError(t, err)
+
+	r := NewReader(dir)
+	_, err = r.Depth(testKey, testStart.Add(-time.Second))
+	assert.ErrorIs(t, err, ErrNoSnapshot)
+
+	d, err := r.Depth(testKey, testStart.Add(time.Second*5))
+	require.NoError(t, err)
+	b, err := d.Retrieve()
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, b.Bids[0].Amount, "updates after the time should not be applied")
+
+	d, err = r.Depth(testKey, testStart.Add(time.Second*30))
+	require.NoError(t, err)
+	b, err = d.Retrieve()
+	require.NoError(t, err)
+	assert.Equal(t, 5.0, b.Bids[0].Amount)
+	assert.Equal(t, int64(2), b.LastUpdateID)
+	assert.Equal(t, testKey.Exchange, b.Exchange)
+
+	d, err = r.Depth(testKey, nextDay.Add(-time.Second))
+	require.NoError(t, err, "books should be reconstructed from the previous day before the day's first snapshot")
+	b, err = d.Retrieve()
+	require.NoError(t, err)
+	assert.Equal(t, 100.0, b.Bids[0].Price)
+
+	d, err = r.Depth(testKey, nextDay.Add(time.Hour))
+	require.NoError(t, err)
+	b, err = d.Retrieve()
+	require.NoError(t, err)
+	assert.Equal(t, 99.0, b.Bids[0].Price)
+
+	trades, err := r.Trades(testKey, testStart, nextDay)
+	require.NoError(t, err)
+	require.Len(t, trades, 2)
+	assert.Equal(t, order.Buy, trades[0].Side)
+	assert.Equal(t, order.Sell, trades[1].Side)
+	assert.Equal(t, testKey.Pair, trades[1].CurrencyPair)
+
+	_, err = r.Trades(testKey, nextDay, testStart)
+	assert.ErrorIs(t, err, errInvalidTimeRange)
+	trades, err = r.Trades(Key{Exchange: "Kraken", Asset: asset.Spot, Pair: testKey.Pair}, testStart, nextDay)
+	assert.NoError(t, err, "markets without captures should not error")
+	assert.Empty(t, trades)
+}
+
+func TestWriterRepairsIncompleteFile(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	w, err := NewWriter(dir)
+	require.NoError(t, err)
+	book := testBook(testStart, 1, orderbook.Items{{Price: 100, Amount: 1}}, nil)
+	require.NoError(t, w.Write(testKey, SnapshotRecord(book)))
+	require.NoError(t, w.Flush())
+	require.NoError(t, w.Write(testKey, TradeRecord(&trade.Data{TID: "lost", Timestamp: testStart.Add(time.Second)})))
+	// Simulate the process exiting without closing the gzip member
+	cf := w.files[testKey]
+	require.NoError(t, cf.gz.Flush())
+	require.NoError(t, cf.f.Close())
+
+	path := filepath.Join(dir, "binance", "spot", "BTC-USDT", "2024-03-01.jsonl.gz")
+	var kinds []RecordKind
+	require.NoError(t, ReadFile(path, func(r *Record) error {
+		kinds = append(kinds, r.Kind)
+		return nil
+	}), "incomplete files should be readable")
+	assert.Equal(t, []RecordKind{KindSnapshot, KindTrade}, kinds)
+
+	w, err = NewWriter(dir)
+	require.NoError(t, err)
+	require.NoError(t, w.Write(testKey, TradeRecord(&trade.Data{TID: "kept", Timestamp: testStart.Add(time.Second * 2)})))
+	assert.False(t, w.NeedsSnapshot(testKey, testStart), "existing files should be assumed to start with a snapshot")
+	require.NoError(t, w.Close())
+
+	var ids []string
+	require.NoError(t, ReadFile(path, func(r *Record) error {
+		ids = append(ids, r.TradeID)
+		return nil
+	}))
+	assert.Equal(t, []string{"", "kept"}, ids, "the incomplete member should be removed before appending")
+}
+
+func TestApply(t *testing.T) {
+	t.Parallel()
+	d := NewDepth(testKey)
+	assert.ErrorIs(t, Apply(d, &Record{Kind: KindTrade}), errNotBookRecord)
+	require.NoError(t, Apply(d, &Record{Kind: KindSnapshot, Time: testStart, Bids: []Level{{Price: 100, Amount: 1}}, Asks: []Level{{Price: 101, Amount: 1}}}))
+	require.NoError(t, Apply(d, &Record{Kind: KindUpdate, Time: testStart.Add(time.Second), Bids: []Level{{Price: 100}, {Price: 99, Amount: 2}}}))
+	b, err := d.Retrieve()
+	require.NoError(t, err)
+	assert.Equal(t, orderbook.Items{{Price: 99, Amount: 2}}, b.Bids)
+	assert.Equal(t, orderbook.Items{{Price: 101, Amount: 1}}, b.Asks)
+}
@@ -0,0 +1,95 @@
+package capture
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+const (
+	fileExtension = ".jsonl.gz"
+	dayLayout     = "2006-01-02"
+)
+
+// Record kinds
+const (
+	// KindSnapshot replaces both sides of the book
+	KindSnapshot RecordKind = "snapshot"
+	// KindUpdate sets the amount of each price level, removing levels with a
+	// zero amount
+	KindUpdate RecordKind = "update"
+	// KindTrade is a public trade
+	KindTrade RecordKind = "trade"
+)
+
+var (
+	// ErrNoSnapshot is returned when a book cannot be reconstructed as there
+	// is no snapshot captured before the requested time
+	ErrNoSnapshot = errors.New("no orderbook snapshot captured before time")
+
+	errDirectoryRequired = errors.New("capture directory required")
+	errWriterClosed      = errors.New("capture writer closed")
+	errInvalidKey        = errors.New("capture key requires exchange, asset and pair")
+	errNilRecord         = errors.New("capture record is nil")
+	errNotBookRecord     = errors.New("record is not an orderbook snapshot or update")
+	errInvalidTimeRange  = errors.New("end time before start time")
+)
+
+// RecordKind identifies the data held by a record
+type RecordKind string
+
+// Key identifies the market captured to a series of files
+type Key struct {
+	Exchange string
+	Asset    asset.Item
+	Pair     currency.Pair
+}
+
+// Level is an aggregated orderbook price level
+type Level struct {
+	Price  float64 `json:"p"`
+	Amount float64 `json:"a"`
+}
+
+// Record is a single line of a capture file. Time is the exchange's time of
+// the event when known
+type Record struct {
+	Kind     RecordKind `json:"kind"`
+	Time     time.Time  `json:"time"`
+	UpdateID int64      `json:"updateID,omitempty"`
+	Bids     []Level    `json:"bids,omitempty"`
+	Asks     []Level    `json:"asks,omitempty"`
+	TradeID  string     `json:"tradeID,omitempty"`
+	Side     string     `json:"side,omitempty"`
+	Price    float64    `json:"price,omitempty"`
+	Amount   float64    `json:"amount,omitempty"`
+}
+
+// Writer appends records to gzip compressed JSON lines files, one per market
+// per UTC day. Each flush completes a gzip member so that at most the data
+// since the last flush is lost if the process exits unexpectedly
+type Writer struct {
+	dir   string
+	m     sync.Mutex
+	files map[Key]*captureFile
+}
+
+type captureFile struct {
+	day         time.Time
+	f           *os.File
+	gz          *gzip.Writer
+	enc         *json.Encoder
+	dirty       bool
+	hasSnapshot bool
+}
+
+// Reader reads capture files written by a Writer
+type Reader struct {
+	dir string
+}
@@ -0,0 +1,171 @@
+package capture
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+// NewReader returns a reader of capture files stored in the directory
+func NewReader(dir string) *Reader {
+	return &Reader{dir: dir}
+}
+
+// Read calls fn with each record of the market timed between start and end
+// inclusive, in the order written
+func (r *Reader) Read(k Key, start, end time.Time, fn func(*Record) error) error {
+	if end.Before(start) {
+		return errInvalidTimeRange
+	}
+	for day := dayOf(start); !day.After(end); day = day.AddDate(0, 0, 1) {
+		err := ReadFile(filePath(r.dir, k, day), func(rec *Record) error {
+			if rec.Time.Before(start) || rec.Time.After(end) {
+				return nil
+			}
+			return fn(rec)
+		})
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	return nil
+}
+
+// Depth reconstructs the market's book as it was at the time from the latest
+// snapshot before it and the updates since
+func (r *Reader) Depth(k Key, at time.Time) (*orderbook.Depth, error) {
+	// Books are reconstructed from the previous day when no snapshot was
+	// captured yet on the day
+	for _, day := range []time.Time{dayOf(at), dayOf(at).AddDate(0, 0, -1)} {
+		var d *orderbook.Depth
+		err := ReadFile(filePath(r.dir, k, day), func(rec *Record) error {
+			if rec.Time.After(at) || rec.Kind == KindTrade {
+				return nil
+			}
+			if rec.Kind == KindSnapshot {
+				d = NewDepth(k)
+			} else if d == nil {
+				return nil
+			}
+			return Apply(d, rec)
+		})
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+		if d != nil {
+			return d, nil
+		}
+	}
+	return nil, fmt.Errorf("%s %s %s %s: %w", k.Exchange, k.Asset, k.Pair, at, ErrNoSnapshot)
+}
+
+// Trades returns the market's trades timed between start and end inclusive
+func (r *Reader) Trades(k Key, start, end time.Time) ([]trade.Data, error) {
+	var trades []trade.Data
+	err := r.Read(k, start, end, func(rec *Record) error {
+		if rec.Kind == KindTrade {
+			trades = append(trades, rec.Trade(k))
+		}
+		return nil
+	})
+	return trades, err
+}
+
+// ReadFile calls fn with each record of a capture file. A file which ends with
+// an incomplete gzip member, such as one still being written, is read up to
+// the last complete record
+func ReadFile(path string, fn func(*Record) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	zr, err := gzip.NewReader(bufio.NewReader(f))
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	defer zr.Close()
+	s := bufio.NewScanner(zr)
+	s.Buffer(make([]byte, 0, 64*1024), 256*1024*1024)
+	for s.Scan() {
+		var rec Record
+		if err = json.Unmarshal(s.Bytes(), &rec); err != nil {
+			// A partial line is only possible at the end of a truncated file
+			break
+		}
+		if err = fn(&rec); err != nil {
+			return err
+		}
+	}
+	if err = s.Err(); err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+// NewDepth returns an empty depth for the market which does not publish to
+// orderbook subscribers
+func NewDepth(k Key) *orderbook.Depth {
+	d := orderbook.NewDepth(uuid.Nil)
+	d.AssignOptions(&orderbook.Base{Exchange: k.Exchange, Asset: k.Asset, Pair: k.Pair})
+	return d
+}
+
+// Apply applies a snapshot or update record to the depth
+func Apply(d *orderbook.Depth, rec *Record) error {
+	switch rec.Kind {
+	case KindSnapshot:
+		return d.LoadSnapshot(items(rec.Bids), items(rec.Asks), rec.UpdateID, rec.Time, false)
+	case KindUpdate:
+		return d.UpdateBidAskByPrice(&orderbook.Update{
+			UpdateID:   rec.UpdateID,
+			UpdateTime: rec.Time,
+			Bids:       items(rec.Bids),
+			Asks:       items(rec.Asks),
+		})
+	default:
+		return fmt.Errorf("%w: %s", errNotBookRecord, rec.Kind)
+	}
+}
+
+// Trade returns the trade held by a trade record
+func (rec *Record) Trade(k Key) trade.Data {
+	side, err := order.StringToOrderSide(rec.Side)
+	if err != nil {
+		side = order.UnknownSide
+	}
+	return trade.Data{
+		TID:          rec.TradeID,
+		Exchange:     k.Exchange,
+		CurrencyPair: k.Pair,
+		AssetType:    k.Asset,
+		Side:         side,
+		Price:        rec.Price,
+		Amount:       rec.Amount,
+		Timestamp:    rec.Time,
+	}
+}
+
+func items(levels []Level) orderbook.Items {
+	if len(levels) == 0 {
+		return nil
+	}
+	i := make(orderbook.Items, len(levels))
+	for x := range levels {
+		i[x] = orderbook.Item{Price: levels[x].Price, Amount: levels[x].Amount}
+	}
+	return i
+}
@@ -689,7 +689,7 @@ func (c *COINUT) wsAuthenticate(ctx context.Context) error {
 	}
 	r.Hmac = crypto.HexEncodeToString(hmac)
 
-	resp, err := c.Websocket.Conn.SendMessageReturnResponse(r.Nonce, r)
+	resp, err := c.Websocket.Conn.SendMessageReturnResponseContext(ctx, r.Nonce, r)
 	if err != nil {
 		return err
 	}
@@ -357,6 +357,9 @@ const (
 	OptimalLimitIOC
 	OCO             // One-cancels-the-other order
 	ConditionalStop // One-way stop order
+	Iceberg         // Order split into smaller visible slices
+	TWAP            // Time weighted average price order
+	VWAP            // Volume weighted average price order
 )
 
 // Side enforces a standard for order sides across the code base
@@ -696,6 +696,12 @@ func (t Type) String() string {
 		return "OPTIMAL_LIMIT_IOC"
 	case OCO:
 		return "OCO"
+	case Iceberg:
+		return "ICEBERG"
+	case TWAP:
+		return "TWAP"
+	case VWAP:
+		return "VWAP"
 	default:
 		return "UNKNOWN"
 	}
@@ -1115,6 +1121,12 @@ func StringToOrderType(oType string) (Type, error) {
 		return OCO, nil
 	case ConditionalStop.String():
 		return ConditionalStop, nil
+	case Iceberg.String():
+		return Iceberg, nil
+	case TWAP.String():
+		return TWAP, nil
+	case VWAP.String():
+		return VWAP, nil
 	default:
 		return UnknownType, fmt.Errorf("'%v' %w", oType, errUnrecognisedOrderType)
 	}
@@ -0,0 +1,326 @@
+package tca
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// StrategyClientOrderID returns a unique client order ID which tags an order
+// as submitted by a strategy. The ID is alphanumeric so it is accepted by
+// exchanges which restrict client order IDs and is made of a two digit
+// strategy name length, the name and a random hex suffix, e.g.
+// "08momentum3f2a9c1b7e4d". Reports attribute orders to strategies using
+// StrategyFromClientOrderID
+func StrategyClientOrderID(strategy string) (string, error) {
+	if !isValidStrategyName(strategy) {
+		return "", fmt.Errorf("%w %q", errInvalidStrategyName, strategy)
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%0*d%s%s", strategyLengthDigits, len(strategy), strategy, hex.EncodeToString(id.Bytes())[:strategySuffixLength]), nil
+}
+
+// StrategyFromClientOrderID returns the strategy which submitted an order
+// using a client order ID built by StrategyClientOrderID. An empty string is
+// returned for orders which are not tagged
+func StrategyFromClientOrderID(clientOrderID string) string {
+	if len(clientOrderID) <= strategyLengthDigits+strategySuffixLength {
+		return ""
+	}
+	length, err := strconv.Atoi(clientOrderID[:strategyLengthDigits])
+	if err != nil || length <= 0 || len(clientOrderID) != strategyLengthDigits+length+strategySuffixLength {
+		return ""
+	}
+	if _, err = hex.DecodeString(clientOrderID[strategyLengthDigits+length:]); err != nil {
+		return ""
+	}
+	strategy := clientOrderID[strategyLengthDigits : strategyLengthDigits+length]
+	if !isValidStrategyName(strategy) {
+		return ""
+	}
+	return strategy
+}
+
+// isValidStrategyName returns whether a strategy name can be encoded in a
+// client order ID
+func isValidStrategyName(strategy string) bool {
+	if strategy == "" || len(strategy) > maxStrategyNameLength {
+		return false
+	}
+	for _, r := range strategy {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// Analyse calculates the execution quality of an order. When the order's
+// trades account for its executed amount they are used to derive the executed
+// amount, price and fees, otherwise the order's own execution details are
+// used. Candles must be sorted by time and are used to source arrival, VWAP
+// and closing prices
+func Analyse(d *order.Detail, strategy string, candles []kline.Candle) (*Result, error) {
+	if d == nil {
+		return nil, errNilOrder
+	}
+	r := &Result{
+		Exchange:             d.Exchange,
+		Pair:                 d.Pair,
+		Asset:                d.AssetType,
+		Strategy:             strategy,
+		OrderID:              d.OrderID,
+		Side:                 d.Side,
+		Type:                 d.Type,
+		Status:               d.Status,
+		Date:                 d.Date,
+		Amount:               d.Amount,
+		ExecutedAmount:       d.ExecutedAmount,
+		AverageExecutedPrice: d.AverageExecutedPrice,
+	}
+	end := d.LastUpdated
+	var tradeFee float64
+	if len(d.Trades) > 0 {
+		var executed, cost, fee float64
+		for i := range d.Trades {
+			executed += d.Trades[i].Amount
+			cost += d.Trades[i].Price * d.Trades[i].Amount
+			feeAsset := d.FeeAsset
+			if d.Trades[i].FeeAsset != "" {
+				feeAsset = currency.NewCode(d.Trades[i].FeeAsset)
+			}
+			fee += quoteFee(d.Trades[i].Fee, feeAsset, d.Pair, d.Trades[i].Price)
+			if d.Trades[i].Timestamp.After(end) {
+				end = d.Trades[i].Timestamp
+			}
+		}
+		if executed > 0 && executed >= d.ExecutedAmount {
+			r.ExecutedAmount = executed
+			r.AverageExecutedPrice = cost / executed
+			tradeFee = fee
+		}
+	}
+	if r.AverageExecutedPrice == 0 && r.ExecutedAmount > 0 && d.Cost > 0 {
+		r.AverageExecutedPrice = d.Cost / r.ExecutedAmount
+	}
+	if r.ExecutedAmount > 0 && r.AverageExecutedPrice <= 0 {
+		return nil, fmt.Errorf("%s %s %w", d.Exchange, d.OrderID, errMissingExecutePrice)
+	}
+	r.Fee = tradeFee
+	if r.Fee == 0 {
+		r.Fee = quoteFee(d.Fee, d.FeeAsset, d.Pair, r.AverageExecutedPrice)
+	}
+	if end.Before(d.Date) {
+		end = d.Date
+	}
+	if r.Amount < r.ExecutedAmount {
+		r.Amount = r.ExecutedAmount
+	}
+	r.Notional = r.ExecutedAmount * r.AverageExecutedPrice
+	if r.Amount > 0 {
+		r.FillRate = r.ExecutedAmount / r.Amount
+	}
+	if r.Notional > 0 {
+		r.FeeDrag = r.Fee / r.Notional * basisPoints
+	}
+
+	arrival, vwap, closing := marketPrices(candles, d.Date, end)
+	if arrival <= 0 {
+		return r, nil
+	}
+	r.HasMarketData = true
+	r.ArrivalPrice = arrival
+	r.MarketVWAP = vwap
+	r.ClosingPrice = closing
+	r.ArrivalNotional = arrival * r.Amount
+
+	direction := 1.0
+	if d.Side.IsShort() {
+		direction = -1
+	}
+	if r.ExecutedAmount > 0 {
+		r.ArrivalSlippage = direction * (r.AverageExecutedPrice - arrival) / arrival * basisPoints
+		if vwap > 0 {
+			r.VWAPDeviation = direction * (r.AverageExecutedPrice - vwap) / vwap * basisPoints
+		}
+	}
+	// Implementation shortfall is the cost of executing against the arrival
+	// price plus fees plus the opportunity cost of the unfilled remainder
+	r.ImplementationShortfall = direction*(r.AverageExecutedPrice-arrival)*r.ExecutedAmount +
+		r.Fee +
+		direction*(closing-arrival)*(r.Amount-r.ExecutedAmount)
+	if r.ArrivalNotional > 0 {
+		r.ImplementationShortfallBps = r.ImplementationShortfall / r.ArrivalNotional * basisPoints
+	}
+	return r, nil
+}
+
+// quoteFee returns a fee in the quote currency of the pair. Fees charged in
+// the base currency are converted at the execution price, fees without an
+// asset or in any other asset are treated as quote
+func quoteFee(fee float64, feeAsset currency.Code, pair currency.Pair, price float64) float64 {
+	if !feeAsset.IsEmpty() && feeAsset.Equal(pair.Base) {
+		return fee * price
+	}
+	return fee
+}
+
+// marketPrices returns the arrival price, the market VWAP and closing price
+// between start and end. Zero values are returned when no candle covers start
+func marketPrices(candles []kline.Candle, start, end time.Time) (arrival, vwap, closing float64) {
+	first := -1
+	for i := range candles {
+		if candles[i].Time.After(start) {
+			break
+		}
+		first = i
+	}
+	if first == -1 {
+		return 0, 0, 0
+	}
+	arrival = candles[first].Open
+	closing = candles[first].Close
+	var volume, weighted float64
+	for i := first; i < len(candles) && !candles[i].Time.After(end); i++ {
+		typical := (candles[i].High + candles[i].Low + candles[i].Close) / 3
+		weighted += typical * candles[i].Volume
+		volume += candles[i].Volume
+		closing = candles[i].Close
+	}
+	if volume > 0 {
+		vwap = weighted / volume
+	}
+	return arrival, vwap, closing
+}
+
+// Aggregate summarises results by the supplied grouping. Results are always
+// grouped by the quote currency of their pair and when period is greater
+// than zero they are also grouped by the UTC period their order was placed
+// in. Summaries are sorted by period, exchange, quote, pair and strategy
+func Aggregate(results []Result, groupBy GroupBy, period time.Duration) []Summary {
+	groups := make(map[groupKey]*accumulator)
+	for i := range results {
+		r := &results[i]
+		k := groupKey{quote: r.Pair.Quote.Upper().String()}
+		s := Summary{Quote: r.Pair.Quote}
+		if groupBy&GroupByExchange != 0 {
+			k.exchange = strings.ToLower(r.Exchange)
+			s.Exchange = r.Exchange
+		}
+		if groupBy&GroupByPair != 0 {
+			k.pair = r.Pair.Upper().String()
+			k.asset = r.Asset
+			s.Pair = r.Pair
+			s.Asset = r.Asset
+		}
+		if groupBy&GroupByStrategy != 0 {
+			k.strategy = r.Strategy
+			s.Strategy = r.Strategy
+		}
+		if period > 0 {
+			s.PeriodStart = r.Date.UTC().Truncate(period)
+			k.period = s.PeriodStart.Unix()
+		}
+		acc, ok := groups[k]
+		if !ok {
+			acc = &accumulator{summary: s}
+			groups[k] = acc
+		}
+		acc.add(r)
+	}
+
+	summaries := make([]Summary, 0, len(groups))
+	for _, acc := range groups {
+		summaries = append(summaries, acc.finalise())
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		if !summaries[i].PeriodStart.Equal(summaries[j].PeriodStart) {
+			return summaries[i].PeriodStart.Before(summaries[j].PeriodStart)
+		}
+		if summaries[i].Exchange != summaries[j].Exchange {
+			return summaries[i].Exchange < summaries[j].Exchange
+		}
+		if qi, qj := summaries[i].Quote.Upper().String(), summaries[j].Quote.Upper().String(); qi != qj {
+			return qi < qj
+		}
+		if pi, pj := summaries[i].Pair.String(), summaries[j].Pair.String(); pi != pj {
+			return pi < pj
+		}
+		if summaries[i].Asset != summaries[j].Asset {
+			return summaries[i].Asset < summaries[j].Asset
+		}
+		return summaries[i].Strategy < summaries[j].Strategy
+	})
+	return summaries
+}
+
+// GroupByFromStrings converts grouping names to a GroupBy value
+func GroupByFromStrings(names ...string) (GroupBy, error) {
+	var g GroupBy
+	for i := range names {
+		switch strings.ToLower(names[i]) {
+		case "exchange":
+			g |= GroupByExchange
+		case "pair":
+			g |= GroupByPair
+		case "strategy":
+			g |= GroupByStrategy
+		default:
+			return 0, fmt.Errorf("%w %q", errUnknownGroupBy, names[i])
+		}
+	}
+	return g, nil
+}
+
+func (a *accumulator) add(r *Result) {
+	a.summary.Orders++
+	a.summary.Amount += r.Amount
+	a.summary.ExecutedAmount += r.ExecutedAmount
+	a.summary.Notional += r.Notional
+	a.summary.Fee += r.Fee
+	if !r.HasMarketData {
+		return
+	}
+	a.arrivalNotional += r.ArrivalNotional
+	a.summary.ImplementationShortfall += r.ImplementationShortfall
+	if r.Notional == 0 {
+		return
+	}
+	a.slippageNotional += r.Notional
+	a.slippageWeighted += r.ArrivalSlippage * r.Notional
+	if r.MarketVWAP > 0 {
+		a.vwapNotional += r.Notional
+		a.vwapWeighted += r.VWAPDeviation * r.Notional
+	}
+}
+
+func (a *accumulator) finalise() Summary {
+	s := a.summary
+	if a.slippageNotional > 0 {
+		s.ArrivalSlippage = a.slippageWeighted / a.slippageNotional
+	}
+	if a.vwapNotional > 0 {
+		s.VWAPDeviation = a.vwapWeighted / a.vwapNotional
+	}
+	if a.arrivalNotional > 0 {
+		s.ImplementationShortfallBps = s.ImplementationShortfall / a.arrivalNotional * basisPoints
+	}
+	if s.Notional > 0 {
+		s.FeeDrag = s.Fee / s.Notional * basisPoints
+	}
+	if s.Amount > 0 {
+		s.FillRate = s.ExecutedAmount / s.Amount
+	}
+	return s
+}
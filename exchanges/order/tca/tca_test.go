@@ -0,0 +1,219 @@
+package tca
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+var (
+	tcaStart = time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	tcaPair  = currency.NewPair(currency.BTC, currency.USD)
+)
+
+func tcaCandles() []kline.Candle {
+	return []kline.Candle{
+		{Time: tcaStart, Open: 100, High: 102, Low: 98, Close: 101, Volume: 10},
+		{Time: tcaStart.Add(time.Minute), Open: 101, High: 103, Low: 100, Close: 102, Volume: 30},
+		{Time: tcaStart.Add(2 * time.Minute), Open: 102, High: 110, Low: 102, Close: 108, Volume: 50},
+	}
+}
+
+func tcaBuy() *order.Detail {
+	return &order.Detail{
+		Exchange:    "test",
+		OrderID:     "buy",
+		Pair:        tcaPair,
+		AssetType:   asset.Spot,
+		Side:        order.Buy,
+		Type:        order.Limit,
+		Status:      order.Cancelled,
+		Amount:      2,
+		Date:        tcaStart.Add(30 * time.Second),
+		LastUpdated: tcaStart.Add(40 * time.Second),
+		Trades: []order.TradeHistory{
+			{Price: 101, Amount: 1, Fee: 0.1, Timestamp: tcaStart.Add(40 * time.Second)},
+			{Price: 102, Amount: 0.5, Fee: 0.05, Timestamp: tcaStart.Add(70 * time.Second)},
+		},
+	}
+}
+
+func tcaSell() *order.Detail {
+	return &order.Detail{
+		Exchange:             "other",
+		OrderID:              "sell",
+		Pair:                 tcaPair,
+		AssetType:            asset.Spot,
+		Side:                 order.Sell,
+		Type:                 order.Market,
+		Status:               order.Filled,
+		Amount:               1,
+		ExecutedAmount:       1,
+		AverageExecutedPrice: 99,
+		Date:                 tcaStart.Add(10 * time.Second),
+		LastUpdated:          tcaStart.Add(20 * time.Second),
+	}
+}
+
+func TestAnalyse(t *testing.T) {
+	t.Parallel()
+	_, err := Analyse(nil, "", nil)
+	assert.ErrorIs(t, err, errNilOrder)
+
+	r, err := Analyse(tcaBuy(), "a", tcaCandles())
+	require.NoError(t, err)
+	assert.True(t, r.HasMarketData)
+	assert.Equal(t, 1.5, r.ExecutedAmount)
+	assert.InDelta(t, 152.0/1.5, r.AverageExecutedPrice, 1e-9)
+	assert.InDelta(t, 0.15, r.Fee, 1e-9)
+	assert.Equal(t, 100.0, r.ArrivalPrice)
+	assert.InDelta(t, 4053.333333333/40, r.MarketVWAP, 1e-6, "VWAP should cover candles up to the last trade")
+	assert.Equal(t, 102.0, r.ClosingPrice)
+	assert.InDelta(t, 133.333333333, r.ArrivalSlippage, 1e-6)
+	assert.InDelta(t, 0, r.VWAPDeviation, 1e-6)
+	assert.InDelta(t, 3.15, r.ImplementationShortfall, 1e-9)
+	assert.InDelta(t, 157.5, r.ImplementationShortfallBps, 1e-9)
+	assert.InDelta(t, 0.15/152*basisPoints, r.FeeDrag, 1e-9)
+	assert.Equal(t, 0.75, r.FillRate)
+
+	r, err = Analyse(tcaSell(), "b", tcaCandles())
+	require.NoError(t, err)
+	assert.InDelta(t, 100, r.ArrivalSlippage, 1e-9, "selling below arrival should be a cost")
+	assert.InDelta(t, 1, r.ImplementationShortfall, 1e-9)
+	assert.Equal(t, 1.0, r.FillRate)
+
+	r, err = Analyse(tcaSell(), "", nil)
+	require.NoError(t, err)
+	assert.False(t, r.HasMarketData)
+	assert.Zero(t, r.ArrivalSlippage)
+	assert.Equal(t, 99.0, r.Notional)
+
+	d := tcaSell()
+	d.AverageExecutedPrice = 0
+	_, err = Analyse(d, "", nil)
+	assert.ErrorIs(t, err, errMissingExecutePrice)
+
+	d.Cost = 98
+	r, err = Analyse(d, "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, 98.0, r.AverageExecutedPrice, "average price should fall back to cost")
+}
+
+func TestAnalyseFeeAsset(t *testing.T) {
+	t.Parallel()
+	d := tcaBuy()
+	d.Trades[0].FeeAsset = currency.BTC.String()
+	d.Trades[0].Fee = 0.001
+	r, err := Analyse(d, "", nil)
+	require.NoError(t, err)
+	assert.InDelta(t, 0.001*101+0.05, r.Fee, 1e-9, "base fees should be converted at the trade price")
+	assert.InDelta(t, (0.001*101+0.05)/152*basisPoints, r.FeeDrag, 1e-9)
+
+	d = tcaSell()
+	d.Fee = 0.002
+	d.FeeAsset = currency.BTC
+	r, err = Analyse(d, "", nil)
+	require.NoError(t, err)
+	assert.InDelta(t, 0.002*99, r.Fee, 1e-9, "base order fees should be converted at the average price")
+
+	d.FeeAsset = currency.USD
+	r, err = Analyse(d, "", nil)
+	require.NoError(t, err)
+	assert.InDelta(t, 0.002, r.Fee, 1e-9)
+}
+
+func TestAggregate(t *testing.T) {
+	t.Parallel()
+	buy, err := Analyse(tcaBuy(), "a", tcaCandles())
+	require.NoError(t, err)
+	sell, err := Analyse(tcaSell(), "b", tcaCandles())
+	require.NoError(t, err)
+	unfilled := tcaSell()
+	unfilled.OrderID = "unfilled"
+	unfilled.ExecutedAmount = 0
+	unfilled.Status = order.Cancelled
+	unfilled.Date = tcaStart.Add(25 * time.Hour)
+	late, err := Analyse(unfilled, "b", nil)
+	require.NoError(t, err)
+	results := []Result{*buy, *sell, *late}
+
+	s := Aggregate(results, 0, 0)
+	require.Len(t, s, 1)
+	assert.Equal(t, 3, s[0].Orders)
+	assert.Empty(t, s[0].Exchange)
+	assert.InDelta(t, 251, s[0].Notional, 1e-9)
+	assert.InDelta(t, 2.5/4, s[0].FillRate, 1e-9)
+	assert.InDelta(t, (133.333333333*152+100*99)/251, s[0].ArrivalSlippage, 1e-6)
+	assert.InDelta(t, 4.15/300*basisPoints, s[0].ImplementationShortfallBps, 1e-9)
+
+	s = Aggregate(results, GroupByExchange|GroupByStrategy, 0)
+	require.Len(t, s, 2)
+	assert.Equal(t, "other", s[0].Exchange)
+	assert.Equal(t, "b", s[0].Strategy)
+	assert.Equal(t, 2, s[0].Orders)
+	assert.Equal(t, "test", s[1].Exchange)
+	assert.True(t, s[1].Pair.IsEmpty(), "pair should not be set when not grouped")
+
+	s = Aggregate(results, GroupByPair, 24*time.Hour)
+	require.Len(t, s, 2)
+	assert.Equal(t, tcaStart.Truncate(24*time.Hour), s[0].PeriodStart)
+	assert.Equal(t, 2, s[0].Orders)
+	assert.True(t, s[1].Pair.Equal(tcaPair))
+	assert.Equal(t, asset.Spot, s[1].Asset)
+
+	usdt := *sell
+	usdt.OrderID = "usdt"
+	usdt.Pair = currency.NewPair(currency.BTC, currency.USDT)
+	s = Aggregate([]Result{*buy, *sell, usdt}, GroupByStrategy, 0)
+	require.Len(t, s, 3, "results should not be aggregated across quote currencies")
+	assert.Equal(t, currency.USD, s[0].Quote)
+	assert.Equal(t, "a", s[0].Strategy)
+	assert.Equal(t, currency.USD, s[1].Quote)
+	assert.Equal(t, currency.USDT, s[2].Quote)
+	assert.InDelta(t, 99, s[2].Notional, 1e-9)
+}
+
+func TestGroupByFromStrings(t *testing.T) {
+	t.Parallel()
+	g, err := GroupByFromStrings("Exchange", "strategy")
+	require.NoError(t, err)
+	assert.Equal(t, GroupByExchange|GroupByStrategy, g)
+
+	_, err = GroupByFromStrings("side")
+	assert.ErrorIs(t, err, errUnknownGroupBy)
+}
+
+func TestStrategyClientOrderID(t *testing.T) {
+	t.Parallel()
+	_, err := StrategyClientOrderID("")
+	assert.ErrorIs(t, err, errInvalidStrategyName)
+	_, err = StrategyClientOrderID("bad:name")
+	assert.ErrorIs(t, err, errInvalidStrategyName)
+	_, err = StrategyClientOrderID("averyveryverylongname")
+	assert.ErrorIs(t, err, errInvalidStrategyName)
+
+	id, err := StrategyClientOrderID("momentum")
+	require.NoError(t, err)
+	assert.Len(t, id, strategyLengthDigits+len("momentum")+strategySuffixLength)
+	assert.Regexp(t, "^08momentum[0-9a-f]{12}$", id, "client order IDs should be alphanumeric")
+	assert.Equal(t, "momentum", StrategyFromClientOrderID(id))
+
+	id, err = StrategyClientOrderID("averyverylongname1")
+	require.NoError(t, err)
+	assert.Len(t, id, 32)
+	assert.Equal(t, "averyverylongname1", StrategyFromClientOrderID(id))
+
+	again, err := StrategyClientOrderID("momentum")
+	require.NoError(t, err)
+	assert.NotEqual(t, id, again, "client order IDs should be unique")
+
+	assert.Empty(t, StrategyFromClientOrderID("1337"), "untagged client order IDs should not be attributed")
+	assert.Empty(t, StrategyFromClientOrderID("02ab3f2a9c1b7e4z"), "suffix should be hex")
+	assert.Empty(t, StrategyFromClientOrderID("08momentum3f2a9c1b7e4"), "length should match the prefix")
+}
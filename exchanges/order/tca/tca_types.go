@@ -0,0 +1,131 @@
+package tca
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// basisPoints converts a ratio to basis points
+const basisPoints = 10000
+
+const (
+	// strategyLengthDigits is the number of digits which prefix a client
+	// order ID built by StrategyClientOrderID with its strategy name length
+	strategyLengthDigits = 2
+	// strategySuffixLength is the number of random hex characters which make
+	// a strategy's client order IDs unique
+	strategySuffixLength = 12
+	// maxStrategyNameLength keeps tagged client order IDs within the 32
+	// character limit common to exchanges
+	maxStrategyNameLength = 32 - strategyLengthDigits - strategySuffixLength
+)
+
+// GroupBy defines which order attributes results are aggregated by. Time is
+// grouped separately by the period passed to Aggregate
+type GroupBy uint8
+
+// GroupBy values which can be combined
+const (
+	GroupByExchange GroupBy = 1 << iota
+	GroupByPair
+	GroupByStrategy
+)
+
+var (
+	errNilOrder            = errors.New("order is nil")
+	errMissingExecutePrice = errors.New("executed order has no execution price")
+	errUnknownGroupBy      = errors.New("unknown group by")
+	errInvalidStrategyName = errors.New("invalid strategy name")
+)
+
+// Result holds the execution quality of an individual order. Slippage,
+// deviation and fee drag are in basis points. Costs are positive when
+// execution was worse than the benchmark and negative when it was better
+type Result struct {
+	Exchange             string
+	Pair                 currency.Pair
+	Asset                asset.Item
+	Strategy             string
+	OrderID              string
+	Side                 order.Side
+	Type                 order.Type
+	Status               order.Status
+	Date                 time.Time
+	Amount               float64
+	ExecutedAmount       float64
+	AverageExecutedPrice float64
+	// Notional is the quote value of the executed amount
+	Notional float64
+	Fee      float64
+	// HasMarketData is set when candles covering the order were available.
+	// Arrival, VWAP and shortfall metrics are zero without them
+	HasMarketData bool
+	// ArrivalPrice is the open of the candle the order was placed in
+	ArrivalPrice float64
+	// MarketVWAP is the market volume weighted average price from the
+	// candle the order was placed in to the candle of its last fill
+	MarketVWAP float64
+	// ClosingPrice is the close of the candle of the order's last update
+	// and is used to price the unfilled remainder
+	ClosingPrice float64
+	// ArrivalNotional is the quote value of the full order amount at the
+	// arrival price
+	ArrivalNotional         float64
+	ArrivalSlippage         float64
+	ImplementationShortfall float64
+	// ImplementationShortfallBps is the shortfall relative to the arrival
+	// notional
+	ImplementationShortfallBps float64
+	VWAPDeviation              float64
+	FeeDrag                    float64
+	FillRate                   float64
+}
+
+// Summary holds aggregated execution quality for a group of orders. Fields
+// which are not grouped on are left empty. Orders are always grouped by quote
+// currency so monetary totals are never summed across currencies. Slippage
+// and deviation values are weighted by executed notional
+type Summary struct {
+	Exchange string
+	Pair     currency.Pair
+	Asset    asset.Item
+	Strategy string
+	// Quote is the currency of the summary's notional, fee and shortfall
+	Quote                      currency.Code
+	PeriodStart                time.Time
+	Orders                     int
+	Amount                     float64
+	ExecutedAmount             float64
+	Notional                   float64
+	Fee                        float64
+	ArrivalSlippage            float64
+	ImplementationShortfall    float64
+	ImplementationShortfallBps float64
+	VWAPDeviation              float64
+	FeeDrag                    float64
+	FillRate                   float64
+}
+
+// groupKey identifies a summary
+type groupKey struct {
+	exchange string
+	pair     string
+	quote    string
+	asset    asset.Item
+	strategy string
+	period   int64
+}
+
+// accumulator tracks the running totals of a summary
+type accumulator struct {
+	summary          Summary
+	slippageNotional float64
+	slippageWeighted float64
+	vwapNotional     float64
+	vwapWeighted     float64
+	arrivalNotional  float64
+}
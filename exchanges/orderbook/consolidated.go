@@ -0,0 +1,328 @@
+package orderbook
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// ConsolidatedExchange is the exchange name of consolidated orderbook depth
+const ConsolidatedExchange = "consolidated"
+
+var (
+	errNoConsolidatedBooks      = errors.New("no orderbooks to consolidate")
+	errConsolidatedStarted      = errors.New("consolidated orderbook already started")
+	errConsolidatedNotStarted   = errors.New("consolidated orderbook not started")
+	errConsolidatedQuoteInvalid = errors.New("invalid quote conversion rate")
+)
+
+// QuoteRate returns the rate which converts a price in one quote currency to
+// another
+type QuoteRate func(from, to currency.Code) (float64, error)
+
+// Consolidated merges the depth of a pair across exchanges into one ladder
+// with each level tagged by its exchange. The embedded depth is rebuilt from
+// the exchange books on every update, so it can be queried with the same
+// movement calculations as an exchange's depth
+type Consolidated struct {
+	*Depth
+	exchanges []string
+	rate      QuoteRate
+
+	// update serialises rebuilds, which are triggered concurrently by the
+	// listener of each exchange, so an older rebuild cannot overwrite a newer
+	// one
+	update    sync.Mutex
+	m         sync.Mutex
+	bidVenues []venue
+	askVenues []venue
+	pipes     []dispatch.Pipe
+	shutdown  chan struct{}
+	wg        sync.WaitGroup
+}
+
+// venue is the exchange and pair an orderbook level came from
+type venue struct {
+	exchange string
+	pair     currency.Pair
+}
+
+// venueItem is an orderbook level tagged by its venue
+type venueItem struct {
+	Item
+	venue
+}
+
+// ConsolidatedItem is a level of a consolidated orderbook. Pair is the pair
+// of the exchange's orderbook, which differs from the consolidated pair when
+// its price has been converted from another quote currency
+type ConsolidatedItem struct {
+	Item
+	Exchange string
+	Pair     currency.Pair
+}
+
+// ConsolidatedBase is a copy of a consolidated orderbook
+type ConsolidatedBase struct {
+	Pair        currency.Pair
+	Asset       asset.Item
+	Bids        []ConsolidatedItem
+	Asks        []ConsolidatedItem
+	LastUpdated time.Time
+}
+
+// NewConsolidated returns a consolidated orderbook of a pair across the
+// exchanges, or across all exchanges when none are supplied. Orderbooks with
+// the same base but a different quote currency are included when a quote rate
+// is supplied, with their prices converted to the pair's quote currency
+func NewConsolidated(exchanges []string, p currency.Pair, a asset.Item, rate QuoteRate) (*Consolidated, error) {
+	if p.IsEmpty() {
+		return nil, errPairNotSet
+	}
+	if !a.IsValid() {
+		return nil, fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	id, err := service.Mux.GetID()
+	if err != nil {
+		return nil, err
+	}
+	c := &Consolidated{
+		Depth:     NewDepth(id),
+		exchanges: make([]string, len(exchanges)),
+		rate:      rate,
+	}
+	for i := range exchanges {
+		c.exchanges[i] = strings.ToLower(exchanges[i])
+	}
+	c.Depth.exchange = ConsolidatedExchange
+	c.Depth.pair = p
+	c.Depth.asset = a
+	c.Depth.priceDuplication = true
+	return c, nil
+}
+
+// Update rebuilds the consolidated orderbook from the current exchange
+// orderbooks and publishes it to subscribers
+func (c *Consolidated) Update() error {
+	c.update.Lock()
+	defer c.update.Unlock()
+	var bids, asks []venueItem
+	var lastUpdated time.Time
+	for _, d := range c.books() {
+		b, err := d.Retrieve()
+		if err != nil {
+			// Invalid books are left out until they are valid again
+			continue
+		}
+		rate := 1.0
+		if !b.Pair.Quote.Equal(c.Depth.pair.Quote) {
+			rate, err = c.rate(b.Pair.Quote, c.Depth.pair.Quote)
+			if err != nil {
+				log.Debugf(log.ExchangeSys, "Consolidated orderbook cannot convert %s %s: %v", b.Exchange, b.Pair, err)
+				continue
+			}
+			if rate <= 0 {
+				log.Debugf(log.ExchangeSys, "Consolidated orderbook cannot convert %s %s: %v %v", b.Exchange, b.Pair, errConsolidatedQuoteInvalid, rate)
+				continue
+			}
+		}
+		v := venue{exchange: b.Exchange, pair: b.Pair}
+		bids = appendVenueItems(bids, b.Bids, v, rate)
+		asks = appendVenueItems(asks, b.Asks, v, rate)
+		if b.LastUpdated.After(lastUpdated) {
+			lastUpdated = b.LastUpdated
+		}
+	}
+	if lastUpdated.IsZero() {
+		lastUpdated = time.Now()
+	}
+	sort.SliceStable(bids, func(i, j int) bool {
+		if bids[i].Price == bids[j].Price {
+			return bids[i].exchange < bids[j].exchange
+		}
+		return bids[i].Price > bids[j].Price
+	})
+	sort.SliceStable(asks, func(i, j int) bool {
+		if asks[i].Price == asks[j].Price {
+			return asks[i].exchange < asks[j].exchange
+		}
+		return asks[i].Price < asks[j].Price
+	})
+
+	bidItems, bidVenues := splitVenueItems(bids)
+	askItems, askVenues := splitVenueItems(asks)
+	c.m.Lock()
+	err := c.Depth.LoadSnapshot(bidItems, askItems, 0, lastUpdated, false)
+	c.bidVenues, c.askVenues = bidVenues, askVenues
+	c.m.Unlock()
+	if err != nil {
+		return err
+	}
+	if len(bids) == 0 && len(asks) == 0 {
+		return fmt.Errorf("%w for %s %s", errNoConsolidatedBooks, c.Depth.pair, c.Depth.asset)
+	}
+	return c.Depth.mux.Publish(c, c.Depth._ID)
+}
+
+// Retrieve returns a copy of the consolidated orderbook with each level tagged
+// by its exchange
+func (c *Consolidated) Retrieve() (*ConsolidatedBase, error) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	b, err := c.Depth.Retrieve()
+	if err != nil {
+		return nil, err
+	}
+	resp := &ConsolidatedBase{
+		Pair:        b.Pair,
+		Asset:       b.Asset,
+		Bids:        make([]ConsolidatedItem, len(b.Bids)),
+		Asks:        make([]ConsolidatedItem, len(b.Asks)),
+		LastUpdated: b.LastUpdated,
+	}
+	for i := range b.Bids {
+		resp.Bids[i] = ConsolidatedItem{Item: b.Bids[i], Exchange: c.bidVenues[i].exchange, Pair: c.bidVenues[i].pair}
+	}
+	for i := range b.Asks {
+		resp.Asks[i] = ConsolidatedItem{Item: b.Asks[i], Exchange: c.askVenues[i].exchange, Pair: c.askVenues[i].pair}
+	}
+	return resp, nil
+}
+
+// Start subscribes to the orderbook updates of the exchanges and rebuilds the
+// consolidated orderbook whenever a merged orderbook changes
+func (c *Consolidated) Start() error {
+	c.m.Lock()
+	defer c.m.Unlock()
+	if c.shutdown != nil {
+		return errConsolidatedStarted
+	}
+	exchanges := c.exchanges
+	if len(exchanges) == 0 {
+		service.mu.Lock()
+		for name := range service.books {
+			exchanges = append(exchanges, name)
+		}
+		service.mu.Unlock()
+	}
+	for i := range exchanges {
+		pipe, err := SubscribeToExchangeOrderbooks(exchanges[i])
+		if err != nil {
+			log.Debugf(log.ExchangeSys, "Consolidated orderbook cannot subscribe to %s: %v", exchanges[i], err)
+			continue
+		}
+		c.pipes = append(c.pipes, pipe)
+	}
+	if len(c.pipes) == 0 {
+		return fmt.Errorf("%w for %s %s", errNoConsolidatedBooks, c.Depth.pair, c.Depth.asset)
+	}
+	c.shutdown = make(chan struct{})
+	for i := range c.pipes {
+		c.wg.Add(1)
+		go c.listen(c.pipes[i], c.shutdown)
+	}
+	return nil
+}
+
+// Stop unsubscribes from the exchanges' orderbook updates
+func (c *Consolidated) Stop() error {
+	c.m.Lock()
+	if c.shutdown == nil {
+		c.m.Unlock()
+		return errConsolidatedNotStarted
+	}
+	close(c.shutdown)
+	c.shutdown = nil
+	pipes := c.pipes
+	c.pipes = nil
+	c.m.Unlock()
+	c.wg.Wait()
+	var errs error
+	for i := range pipes {
+		errs = common.AppendError(errs, pipes[i].Release())
+	}
+	return errs
+}
+
+// Subscribe returns a pipe which receives the consolidated orderbook after
+// every update
+func (c *Consolidated) Subscribe() (dispatch.Pipe, error) {
+	return c.Depth.mux.Subscribe(c.Depth._ID)
+}
+
+// listen rebuilds the consolidated orderbook on updates of merged orderbooks
+func (c *Consolidated) listen(pipe dispatch.Pipe, shutdown <-chan struct{}) {
+	defer c.wg.Done()
+	for {
+		select {
+		case <-shutdown:
+			return
+		case data, ok := <-pipe.Channel():
+			if !ok {
+				return
+			}
+			d, ok := data.(*Depth)
+			if !ok || !c.merges(d) {
+				continue
+			}
+			if err := c.Update(); err != nil {
+				log.Debugf(log.ExchangeSys, "Consolidated orderbook update: %v", err)
+			}
+		}
+	}
+}
+
+// books returns the exchange orderbooks which are merged
+func (c *Consolidated) books() []*Depth {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	var books []*Depth
+	for name, exch := range service.books {
+		if len(c.exchanges) > 0 && !common.StringDataCompare(c.exchanges, name) {
+			continue
+		}
+		for _, d := range exch.m {
+			if c.merges(d) {
+				books = append(books, d)
+			}
+		}
+	}
+	return books
+}
+
+// merges returns whether an exchange orderbook is merged
+func (c *Consolidated) merges(d *Depth) bool {
+	d.m.Lock()
+	defer d.m.Unlock()
+	return d.asset == c.Depth.asset &&
+		d.pair.Base.Equal(c.Depth.pair.Base) &&
+		(d.pair.Quote.Equal(c.Depth.pair.Quote) || c.rate != nil)
+}
+
+func appendVenueItems(dst []venueItem, items Items, v venue, rate float64) []venueItem {
+	for i := range items {
+		item := items[i]
+		item.Price *= rate
+		dst = append(dst, venueItem{Item: item, venue: v})
+	}
+	return dst
+}
+
+func splitVenueItems(v []venueItem) (Items, []venue) {
+	items := make(Items, len(v))
+	venues := make([]venue, len(v))
+	for i := range v {
+		items[i] = v[i].Item
+		venues[i] = v[i].venue
+	}
+	return items, venues
+}
@@ -0,0 +1,172 @@
+package orderbook
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+var errConsolidatedTestRate = errors.New("no rate")
+
+func TestNewConsolidated(t *testing.T) {
+	t.Parallel()
+	_, err := NewConsolidated(nil, currency.EMPTYPAIR, asset.Spot, nil)
+	assert.ErrorIs(t, err, errPairNotSet)
+	_, err = NewConsolidated(nil, currency.NewPair(currency.BTC, currency.USD), asset.Empty, nil)
+	assert.ErrorIs(t, err, asset.ErrNotSupported)
+	c, err := NewConsolidated([]string{"Bitstamp"}, currency.NewPair(currency.BTC, currency.USD), asset.Spot, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"bitstamp"}, c.exchanges)
+	assert.Equal(t, ConsolidatedExchange, c.Depth.exchange)
+}
+
+func TestConsolidatedUpdate(t *testing.T) {
+	t.Parallel()
+	base := currency.NewCode("CONSOLIDATEDUPDATE")
+	usd := currency.NewPair(base, currency.USD)
+	eur := currency.NewPair(base, currency.EUR)
+	gbp := currency.NewPair(base, currency.GBP)
+	for _, b := range []*Base{
+		{Exchange: "ConsolidatedOne", Pair: usd, Asset: asset.Spot, Bids: Items{{Price: 99, Amount: 1}}, Asks: Items{{Price: 101, Amount: 1}, {Price: 103, Amount: 2}}},
+		{Exchange: "ConsolidatedTwo", Pair: usd, Asset: asset.Spot, Bids: Items{{Price: 99, Amount: 2}, {Price: 98, Amount: 1}}, Asks: Items{{Price: 102, Amount: 1}}},
+		{Exchange: "ConsolidatedThree", Pair: eur, Asset: asset.Spot, Bids: Items{{Price: 50, Amount: 1}}, Asks: Items{{Price: 50.25, Amount: 1}}},
+		{Exchange: "ConsolidatedThree", Pair: gbp, Asset: asset.Spot, Bids: Items{{Price: 1, Amount: 1}}},
+		{Exchange: "ConsolidatedThree", Pair: usd, Asset: asset.Futures, Bids: Items{{Price: 1000, Amount: 1}}},
+	} {
+		require.NoError(t, b.Process())
+	}
+
+	c, err := NewConsolidated(nil, usd, asset.Spot, nil)
+	require.NoError(t, err)
+	require.NoError(t, c.Update())
+	ob, err := c.Retrieve()
+	require.NoError(t, err)
+	assert.Equal(t, []ConsolidatedItem{
+		{Item: Item{Price: 99, Amount: 1}, Exchange: "ConsolidatedOne", Pair: usd},
+		{Item: Item{Price: 99, Amount: 2}, Exchange: "ConsolidatedTwo", Pair: usd},
+		{Item: Item{Price: 98, Amount: 1}, Exchange: "ConsolidatedTwo", Pair: usd},
+	}, ob.Bids)
+	require.Len(t, ob.Asks, 3)
+	assert.Equal(t, "ConsolidatedTwo", ob.Asks[1].Exchange)
+
+	// the embedded depth provides the movement calculations across venues
+	mov, err := c.LiftTheAsksFromBest(2, true)
+	require.NoError(t, err)
+	assert.Equal(t, 101.5, mov.AverageOrderCost)
+	mov, err = c.HitTheBidsFromBest(3, false)
+	require.NoError(t, err)
+	assert.Equal(t, float64(99), mov.AverageOrderCost)
+
+	c, err = NewConsolidated([]string{"ConsolidatedOne", "ConsolidatedThree"}, usd, asset.Spot, func(from, _ currency.Code) (float64, error) {
+		if from.Equal(currency.EUR) {
+			return 2, nil
+		}
+		return 0, errConsolidatedTestRate
+	})
+	require.NoError(t, err)
+	require.NoError(t, c.Update())
+	ob, err = c.Retrieve()
+	require.NoError(t, err)
+	require.Len(t, ob.Bids, 2)
+	assert.Equal(t, ConsolidatedItem{Item: Item{Price: 100, Amount: 1}, Exchange: "ConsolidatedThree", Pair: eur}, ob.Bids[0])
+	require.Len(t, ob.Asks, 3)
+	assert.Equal(t, 100.5, ob.Asks[0].Price)
+	assert.Equal(t, usd, ob.Pair)
+
+	c, err = NewConsolidated([]string{"ConsolidatedOne"}, currency.NewPair(currency.NewCode("CONSOLIDATEDNONE"), currency.USD), asset.Spot, nil)
+	require.NoError(t, err)
+	assert.ErrorIs(t, c.Update(), errNoConsolidatedBooks)
+}
+
+func TestConsolidatedUpdateConcurrent(t *testing.T) {
+	t.Parallel()
+	base := currency.NewCode("CONSOLIDATEDCONCURRENT")
+	usd := currency.NewPair(base, currency.USD)
+	eur := currency.NewPair(base, currency.EUR)
+	for _, exch := range []string{"ConsolidatedConcurrentOne", "ConsolidatedConcurrentTwo"} {
+		require.NoError(t, (&Base{Exchange: exch, Pair: eur, Asset: asset.Spot, Bids: Items{{Price: 40, Amount: 1}}}).Process())
+	}
+
+	entered, release := make(chan struct{}), make(chan struct{})
+	var calls atomic.Int32
+	c, err := NewConsolidated([]string{"ConsolidatedConcurrentOne", "ConsolidatedConcurrentTwo"}, usd, asset.Spot, func(currency.Code, currency.Code) (float64, error) {
+		if calls.Add(1) == 1 {
+			close(entered)
+			<-release
+		}
+		return 2, nil
+	})
+	require.NoError(t, err)
+
+	// the first update stalls converting the prices of the first book while
+	// newer books arrive and trigger a second update
+	first := make(chan struct{})
+	go func() {
+		defer close(first)
+		assert.NoError(t, c.Update())
+	}()
+	<-entered
+	for _, exch := range []string{"ConsolidatedConcurrentOne", "ConsolidatedConcurrentTwo"} {
+		require.NoError(t, (&Base{Exchange: exch, Pair: eur, Asset: asset.Spot, Bids: Items{{Price: 45, Amount: 1}}}).Process())
+	}
+	second := make(chan struct{})
+	go func() {
+		defer close(second)
+		assert.NoError(t, c.Update())
+	}()
+	select {
+	case <-second:
+	case <-time.After(100 * time.Millisecond):
+	}
+	close(release)
+	<-first
+	<-second
+
+	ob, err := c.Retrieve()
+	require.NoError(t, err)
+	require.Len(t, ob.Bids, 2)
+	assert.Equal(t, 90.0, ob.Bids[0].Price, "an older update should not overwrite a newer one")
+	assert.Equal(t, 90.0, ob.Bids[1].Price, "an older update should not overwrite a newer one")
+}
+
+func TestConsolidatedStart(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.NewCode("CONSOLIDATEDSTART"), currency.USD)
+	b := &Base{Exchange: "ConsolidatedStart", Pair: p, Asset: asset.Spot, Bids: Items{{Price: 10, Amount: 1}}}
+	require.NoError(t, b.Process())
+
+	c, err := NewConsolidated([]string{"ConsolidatedStartMissing"}, p, asset.Spot, nil)
+	require.NoError(t, err)
+	assert.ErrorIs(t, c.Start(), errNoConsolidatedBooks)
+	assert.ErrorIs(t, c.Stop(), errConsolidatedNotStarted)
+
+	c, err = NewConsolidated([]string{b.Exchange}, p, asset.Spot, nil)
+	require.NoError(t, err)
+	pipe, err := c.Subscribe()
+	require.NoError(t, err)
+	require.NoError(t, c.Start())
+	assert.ErrorIs(t, c.Start(), errConsolidatedStarted)
+
+	b.Bids = Items{{Price: 11, Amount: 1}}
+	require.NoError(t, b.Process())
+	select {
+	case data := <-pipe.Channel():
+		consolidated, ok := data.(*Consolidated)
+		require.True(t, ok)
+		var ob *ConsolidatedBase
+		ob, err = consolidated.Retrieve()
+		require.NoError(t, err)
+		require.Len(t, ob.Bids, 1)
+		assert.Equal(t, 11.0, ob.Bids[0].Price)
+	case <-time.After(time.Second * 5):
+		t.Fatal("consolidated orderbook update not received")
+	}
+	require.NoError(t, c.Stop())
+	require.NoError(t, pipe.Release())
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/dispatch"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/alert"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
@@ -326,6 +327,16 @@ func (d *Depth) LastUpdateID() (int64, error) {
 	return d.lastUpdateID, nil
 }
 
+// LastUpdated returns the time of the last update to the depth
+func (d *Depth) LastUpdated() (time.Time, error) {
+	d.m.Lock()
+	defer d.m.Unlock()
+	if d.validationError != nil {
+		return time.Time{}, d.validationError
+	}
+	return d.lastUpdated, nil
+}
+
 // IsFundingRate returns if the depth is a funding rate
 func (d *Depth) IsFundingRate() bool {
 	d.m.Lock()
@@ -789,3 +800,13 @@ func (d *Depth) GetPair() (currency.Pair, error) {
 	}
 	return d.pair, nil
 }
+
+// GetAsset returns the asset associated with the depth
+func (d *Depth) GetAsset() (asset.Item, error) {
+	d.m.Lock()
+	defer d.m.Unlock()
+	if !d.asset.IsValid() {
+		return asset.Empty, asset.ErrNotSupported
+	}
+	return d.asset, nil
+}
@@ -484,6 +484,22 @@ func TestLastUpdateID(t *testing.T) {
 	assert.EqualValues(t, 1337, id, "LastUpdateID should return correct value")
 }
 
+func TestLastUpdated(t *testing.T) {
+	t.Parallel()
+	d := Depth{}
+	err := d.Invalidate(nil)
+	assert.ErrorIs(t, err, ErrOrderbookInvalid, "Invalidate should error correctly")
+
+	_, err = d.LastUpdated()
+	assert.ErrorIs(t, err, ErrOrderbookInvalid, "LastUpdated should error correctly")
+
+	d.validationError = nil
+	d.lastUpdated = time.Unix(1337, 0)
+	tn, err := d.LastUpdated()
+	assert.NoError(t, err, "LastUpdated should not error")
+	assert.Equal(t, time.Unix(1337, 0), tn, "LastUpdated should return correct value")
+}
+
 func TestIsFundingRate(t *testing.T) {
 	t.Parallel()
 	d := Depth{}
@@ -691,6 +707,19 @@ func TestGetPair(t *testing.T) {
 	assert.Equal(t, expected, pair, "GetPair should return correct pair")
 }
 
+func TestGetAsset(t *testing.T) {
+	t.Parallel()
+	depth := NewDepth(id)
+
+	_, err := depth.GetAsset()
+	assert.ErrorIs(t, err, asset.ErrNotSupported, "GetAsset should error correctly")
+
+	depth.asset = asset.Spot
+	a, err := depth.GetAsset()
+	assert.NoError(t, err, "GetAsset should not error")
+	assert.Equal(t, asset.Spot, a, "GetAsset should return correct asset")
+}
+
 func getInvalidDepth() *Depth {
 	depth := NewDepth(id)
 	_ = depth.Invalidate(errors.New("invalid reasoning"))
@@ -0,0 +1,497 @@
+package simulator
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+// New returns a simulator seeded with a snapshot of the supplied depth
+func New(d *orderbook.Depth, fees Fees) (*Simulator, error) {
+	s := &Simulator{
+		fees:   fees,
+		orders: make(map[string]*Order),
+	}
+	if _, err := s.LoadDepth(d); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// LoadDepth replaces the simulated book with a snapshot of the supplied depth.
+// Any resting orders crossed by the new book are filled as makers
+func (s *Simulator) LoadDepth(d *orderbook.Depth) ([]Fill, error) {
+	if d == nil {
+		return nil, errNilDepth
+	}
+	b, err := d.Retrieve()
+	if err != nil {
+		return nil, err
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.exchange == "" {
+		s.exchange, s.pair, s.asset = b.Exchange, b.Pair, b.Asset
+	} else if err = s.checkInstrument(b.Pair, b.Asset); err != nil {
+		return nil, err
+	}
+	s.bids = copyLevels(b.Bids)
+	s.asks = copyLevels(b.Asks)
+	s.setTime(b.LastUpdated)
+	s.refreshQueues()
+	return s.matchCrossed(), nil
+}
+
+// ProcessUpdate applies price level changes to the simulated book. Any resting
+// orders crossed by the updated book are filled as makers
+func (s *Simulator) ProcessUpdate(u *orderbook.Update) ([]Fill, error) {
+	if u == nil {
+		return nil, errNilUpdate
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	if err := s.checkInstrument(u.Pair, u.Asset); err != nil {
+		return nil, err
+	}
+	s.bids = applyLevels(s.bids, u.Bids, u.Action, true)
+	s.asks = applyLevels(s.asks, u.Asks, u.Action, false)
+	s.setTime(u.UpdateTime)
+	s.refreshQueues()
+	return s.matchCrossed(), nil
+}
+
+// ProcessTrade matches resting orders against a public trade. Orders priced
+// through the trade price fill first, followed by orders at the trade price
+// once the amount queued ahead of them has traded
+func (s *Simulator) ProcessTrade(t *trade.Data) ([]Fill, error) {
+	if t == nil {
+		return nil, errNilTrade
+	}
+	if t.Price <= 0 || t.Amount <= 0 {
+		return nil, errInvalidTrade
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	if err := s.checkInstrument(t.CurrencyPair, t.AssetType); err != nil {
+		return nil, err
+	}
+	s.setTime(t.Timestamp)
+
+	buyer := t.Side.IsLong()
+	if !buyer && !t.Side.IsShort() {
+		// Infer the aggressor when the exchange does not supply one
+		buyer = len(s.asks) > 0 && t.Price >= s.asks[0].Price ||
+			len(s.bids) > 0 && t.Price > s.bids[0].Price
+	}
+	resting := s.restingBids
+	if buyer {
+		resting = s.restingAsks
+	}
+
+	var fills []Fill
+	volume := t.Amount
+	var ownAhead float64
+	for _, o := range append([]*Order(nil), resting...) {
+		if volume <= 0 {
+			break
+		}
+		if buyer && o.Price > t.Price || !buyer && o.Price < t.Price {
+			break
+		}
+		var amount float64
+		if o.Price != t.Price {
+			// The trade cleared this price level entirely
+			o.QueueAhead = 0
+			amount = min(volume, o.RemainingAmount)
+			volume -= amount
+		} else {
+			amount = min(volume-o.QueueAhead-ownAhead, o.RemainingAmount)
+			ownAhead += o.RemainingAmount
+			o.QueueAhead = max(o.QueueAhead-volume, 0)
+		}
+		if amount > 0 {
+			fills = append(fills, s.fill(o, o.Price, amount, true))
+		}
+	}
+	return fills, nil
+}
+
+// SubmitOrder submits a limit or market order to the simulator. Any amount
+// which can be matched immediately takes liquidity from the simulated book,
+// with the remainder of limit orders resting on the book
+func (s *Simulator) SubmitOrder(submit *order.Submit) (*Order, error) {
+	if submit == nil {
+		return nil, order.ErrSubmissionIsNil
+	}
+	if !submit.Side.IsLong() && !submit.Side.IsShort() {
+		return nil, fmt.Errorf("%w %v", order.ErrSideIsInvalid, submit.Side)
+	}
+	if submit.Type != order.Limit && submit.Type != order.Market {
+		return nil, fmt.Errorf("%w %v", order.ErrUnsupportedOrderType, submit.Type)
+	}
+	if submit.Amount <= 0 {
+		return nil, order.ErrAmountIsInvalid
+	}
+	if submit.Type == order.Limit && submit.Price <= 0 {
+		return nil, order.ErrPriceMustBeSetIfLimitOrder
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	if err := s.checkInstrument(submit.Pair, submit.AssetType); err != nil {
+		return nil, err
+	}
+
+	o := &Order{
+		ClientOrderID:   submit.ClientOrderID,
+		Side:            submit.Side,
+		Type:            submit.Type,
+		Price:           submit.Price,
+		Amount:          submit.Amount,
+		RemainingAmount: submit.Amount,
+		Status:          order.New,
+		Created:         s.timestamp(),
+	}
+	o.LastUpdated = o.Created
+	if o.Type == order.Market {
+		o.Price = 0
+	}
+
+	available := s.available(o)
+	switch {
+	case submit.PostOnly && available > 0:
+		return nil, ErrPostOnlyWouldTake
+	case submit.FillOrKill && available < o.Amount-dust:
+		return nil, ErrFillOrKillUnfilled
+	case o.Type == order.Market && available == 0:
+		return nil, ErrNoLiquidity
+	}
+
+	s.sequence++
+	o.sequence = s.sequence
+	o.ID = strconv.FormatInt(s.sequence, 10)
+	s.orders[o.ID] = o
+
+	s.take(o)
+	if o.RemainingAmount > 0 {
+		if o.Type == order.Market || submit.ImmediateOrCancel {
+			s.cancel(o)
+		} else {
+			o.QueueAhead = levelAmount(s.levels(o.Side.IsLong()), o.Price)
+			s.rest(o)
+		}
+	}
+	return o.copy(), nil
+}
+
+// CancelOrder cancels an active simulated order
+func (s *Simulator) CancelOrder(id string) (*Order, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	o, ok := s.orders[id]
+	if !ok {
+		return nil, fmt.Errorf("%w %s", ErrOrderNotFound, id)
+	}
+	if o.RemainingAmount == 0 || o.Status == order.Cancelled || o.Status == order.PartiallyFilledCancelled {
+		return nil, fmt.Errorf("%w %s %s", errOrderNotActive, id, o.Status)
+	}
+	s.cancel(o)
+	return o.copy(), nil
+}
+
+// GetOrder returns a copy of a simulated order by ID
+func (s *Simulator) GetOrder(id string) (*Order, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	o, ok := s.orders[id]
+	if !ok {
+		return nil, fmt.Errorf("%w %s", ErrOrderNotFound, id)
+	}
+	return o.copy(), nil
+}
+
+// GetActiveOrders returns copies of all simulated orders resting on the book,
+// bids then asks in price-time priority
+func (s *Simulator) GetActiveOrders() []Order {
+	s.m.Lock()
+	defer s.m.Unlock()
+	orders := make([]Order, 0, len(s.restingBids)+len(s.restingAsks))
+	for _, o := range s.restingBids {
+		orders = append(orders, *o.copy())
+	}
+	for _, o := range s.restingAsks {
+		orders = append(orders, *o.copy())
+	}
+	return orders
+}
+
+// GetBook returns a copy of the simulated book
+func (s *Simulator) GetBook() (bids, asks []orderbook.Item) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	return copyLevels(s.bids), copyLevels(s.asks)
+}
+
+// checkInstrument ensures incoming data relates to the simulated pair and
+// asset, empty values are not checked
+func (s *Simulator) checkInstrument(p currency.Pair, a asset.Item) error {
+	if !p.IsEmpty() && !s.pair.IsEmpty() && !p.Equal(s.pair) {
+		return fmt.Errorf("%w %v %v", errPairMismatch, p, s.pair)
+	}
+	if a != asset.Empty && s.asset != asset.Empty && a != s.asset {
+		return fmt.Errorf("%w %v %v", errAssetMismatch, a, s.asset)
+	}
+	return nil
+}
+
+// take matches an order against the opposing side of the simulated book up to
+// its limit price
+func (s *Simulator) take(o *Order) {
+	book := s.levels(!o.Side.IsLong())
+	for len(book) > 0 && o.RemainingAmount > 0 {
+		if !s.crosses(o, book[0].Price) {
+			break
+		}
+		amount := min(book[0].Amount, o.RemainingAmount)
+		s.fill(o, book[0].Price, amount, false)
+		book[0].Amount -= amount
+		if book[0].Amount <= dust {
+			book = book[1:]
+		}
+	}
+	s.setLevels(!o.Side.IsLong(), book)
+}
+
+// available returns the amount an order can match immediately
+func (s *Simulator) available(o *Order) float64 {
+	var amount float64
+	for _, level := range s.levels(!o.Side.IsLong()) {
+		if !s.crosses(o, level.Price) {
+			break
+		}
+		amount += level.Amount
+	}
+	return amount
+}
+
+// crosses returns whether an order can match at the supplied price
+func (s *Simulator) crosses(o *Order, price float64) bool {
+	switch {
+	case o.Type == order.Market:
+		return true
+	case o.Side.IsLong():
+		return price <= o.Price
+	default:
+		return price >= o.Price
+	}
+}
+
+// matchCrossed fills resting orders which the simulated book now crosses. An
+// exchange book cannot cross itself, so any opposing liquidity at or through
+// a resting order's price must have traded with it first
+func (s *Simulator) matchCrossed() []Fill {
+	var fills []Fill
+	for _, buyer := range []bool{true, false} {
+		resting := s.restingAsks
+		if buyer {
+			resting = s.restingBids
+		}
+		for _, o := range append([]*Order(nil), resting...) {
+			book := s.levels(!buyer)
+			for len(book) > 0 && o.RemainingAmount > 0 && s.crosses(o, book[0].Price) {
+				amount := min(book[0].Amount, o.RemainingAmount)
+				fills = append(fills, s.fill(o, o.Price, amount, true))
+				book[0].Amount -= amount
+				if book[0].Amount <= dust {
+					book = book[1:]
+				}
+			}
+			s.setLevels(!buyer, book)
+			o.QueueAhead = min(o.QueueAhead, levelAmount(s.levels(buyer), o.Price))
+		}
+	}
+	return fills
+}
+
+// refreshQueues caps the estimated queue position of resting orders to the
+// amount now visible at their price level. Amount removed from a level other
+// than by trading is assumed to have been ahead of the order
+func (s *Simulator) refreshQueues() {
+	for _, o := range s.restingBids {
+		o.QueueAhead = min(o.QueueAhead, levelAmount(s.bids, o.Price))
+	}
+	for _, o := range s.restingAsks {
+		o.QueueAhead = min(o.QueueAhead, levelAmount(s.asks, o.Price))
+	}
+}
+
+// fill executes an amount of an order at the supplied price and applies fees
+func (s *Simulator) fill(o *Order, price, amount float64, maker bool) Fill {
+	rate := s.fees.Taker
+	if maker {
+		rate = s.fees.Maker
+	}
+	f := Fill{
+		OrderID:   o.ID,
+		Side:      o.Side,
+		Price:     price,
+		Amount:    amount,
+		Fee:       price * amount * rate,
+		Maker:     maker,
+		Timestamp: s.timestamp(),
+	}
+	o.AverageExecutedPrice = (o.AverageExecutedPrice*o.ExecutedAmount + price*amount) / (o.ExecutedAmount + amount)
+	o.ExecutedAmount += amount
+	o.RemainingAmount -= amount
+	o.Fee += f.Fee
+	o.LastUpdated = f.Timestamp
+	o.Fills = append(o.Fills, f)
+	if o.RemainingAmount <= dust {
+		o.RemainingAmount = 0
+		o.Status = order.Filled
+		s.unrest(o)
+	} else {
+		o.Status = order.PartiallyFilled
+	}
+	return f
+}
+
+// cancel removes an order from the book
+func (s *Simulator) cancel(o *Order) {
+	s.unrest(o)
+	o.Status = order.Cancelled
+	if o.ExecutedAmount > 0 {
+		o.Status = order.PartiallyFilledCancelled
+	}
+	o.LastUpdated = s.timestamp()
+}
+
+// rest inserts an order into the resting orders by price-time priority
+func (s *Simulator) rest(o *Order) {
+	resting := &s.restingAsks
+	if o.Side.IsLong() {
+		resting = &s.restingBids
+	}
+	i := sort.Search(len(*resting), func(i int) bool {
+		r := (*resting)[i]
+		if r.Price == o.Price {
+			return r.sequence > o.sequence
+		}
+		if o.Side.IsLong() {
+			return r.Price < o.Price
+		}
+		return r.Price > o.Price
+	})
+	*resting = append(*resting, nil)
+	copy((*resting)[i+1:], (*resting)[i:])
+	(*resting)[i] = o
+}
+
+// unrest removes an order from the resting orders
+func (s *Simulator) unrest(o *Order) {
+	resting := &s.restingAsks
+	if o.Side.IsLong() {
+		resting = &s.restingBids
+	}
+	for i := range *resting {
+		if (*resting)[i] == o {
+			*resting = append((*resting)[:i], (*resting)[i+1:]...)
+			return
+		}
+	}
+}
+
+// levels returns the bid or ask side of the simulated book
+func (s *Simulator) levels(bids bool) []orderbook.Item {
+	if bids {
+		return s.bids
+	}
+	return s.asks
+}
+
+// setLevels sets the bid or ask side of the simulated book
+func (s *Simulator) setLevels(bids bool, levels []orderbook.Item) {
+	if bids {
+		s.bids = levels
+	} else {
+		s.asks = levels
+	}
+}
+
+// setTime advances the simulator clock
+func (s *Simulator) setTime(t time.Time) {
+	if t.After(s.now) {
+		s.now = t
+	}
+}
+
+// timestamp returns the time of the latest market data processed, or the
+// current time if none has been
+func (s *Simulator) timestamp() time.Time {
+	if s.now.IsZero() {
+		return time.Now()
+	}
+	return s.now
+}
+
+// copy returns a copy of the order
+func (o *Order) copy() *Order {
+	c := *o
+	c.Fills = append([]Fill(nil), o.Fills...)
+	return &c
+}
+
+// copyLevels returns a copy of the book levels excluding any without amount
+func copyLevels(items []orderbook.Item) []orderbook.Item {
+	levels := make([]orderbook.Item, 0, len(items))
+	for i := range items {
+		if items[i].Amount > 0 {
+			levels = append(levels, items[i])
+		}
+	}
+	return levels
+}
+
+// levelAmount returns the amount at a price level
+func levelAmount(levels []orderbook.Item, price float64) float64 {
+	for i := range levels {
+		if levels[i].Price == price {
+			return levels[i].Amount
+		}
+	}
+	return 0
+}
+
+// applyLevels applies price level updates to one side of the book, keeping it
+// ordered best price first. A zero amount or delete action removes a level
+func applyLevels(levels, updates []orderbook.Item, action orderbook.Action, bids bool) []orderbook.Item {
+	for _, u := range updates {
+		i := sort.Search(len(levels), func(i int) bool {
+			if bids {
+				return levels[i].Price <= u.Price
+			}
+			return levels[i].Price >= u.Price
+		})
+		exists := i < len(levels) && levels[i].Price == u.Price
+		switch {
+		case action == orderbook.Delete || u.Amount <= 0:
+			if exists {
+				levels = append(levels[:i], levels[i+1:]...)
+			}
+		case exists:
+			levels[i].Amount = u.Amount
+		default:
+			levels = append(levels, orderbook.Item{})
+			copy(levels[i+1:], levels[i:])
+			levels[i] = u
+		}
+	}
+	return levels
+}
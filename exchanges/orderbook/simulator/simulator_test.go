@@ -2,14 +2,72 @@ package simulator
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/common/convert"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/bitstamp"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 )
 
+var (
+	simPair  = currency.NewPair(currency.BTC, currency.USD)
+	simFees  = Fees{Maker: 0.001, Taker: 0.002}
+	simStart = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func simDepth(t *testing.T, bids, asks []orderbook.Item) *orderbook.Depth {
+	t.Helper()
+	id, err := uuid.NewV4()
+	require.NoError(t, err)
+	d := orderbook.NewDepth(id)
+	d.AssignOptions(&orderbook.Base{Exchange: "simulator", Pair: simPair, Asset: asset.Spot})
+	require.NoError(t, d.LoadSnapshot(bids, asks, 0, simStart, true))
+	return d
+}
+
+func newSimulator(t *testing.T) *Simulator {
+	t.Helper()
+	s, err := New(simDepth(t,
+		[]orderbook.Item{{Price: 99, Amount: 2}, {Price: 98, Amount: 3}},
+		[]orderbook.Item{{Price: 101, Amount: 1}, {Price: 102, Amount: 2}},
+	), simFees)
+	require.NoError(t, err)
+	return s
+}
+
+func simSubmit(side order.Side, oType order.Type, price, amount float64) *order.Submit {
+	return &order.Submit{
+		Exchange:  "simulator",
+		Pair:      simPair,
+		AssetType: asset.Spot,
+		Side:      side,
+		Type:      oType,
+		Price:     price,
+		Amount:    amount,
+	}
+}
+
+func simTrade(side order.Side, price, amount float64) *trade.Data {
+	return &trade.Data{
+		Exchange:     "simulator",
+		CurrencyPair: simPair,
+		AssetType:    asset.Spot,
+		Side:         side,
+		Price:        price,
+		Amount:       amount,
+		Timestamp:    simStart.Add(time.Minute),
+	}
+}
+
 func TestSimulate(t *testing.T) {
 	b := bitstamp.Bitstamp{}
 	b.SetDefaults()
@@ -39,3 +97,284 @@ func TestSimulate(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+	_, err := New(nil, simFees)
+	assert.ErrorIs(t, err, errNilDepth)
+
+	d := simDepth(t, nil, nil)
+	invalid := errors.New("invalid")
+	assert.ErrorIs(t, d.Invalidate(invalid), invalid)
+	_, err = New(d, simFees)
+	assert.ErrorIs(t, err, invalid)
+
+	s := newSimulator(t)
+	bids, asks := s.GetBook()
+	assert.Len(t, bids, 2)
+	assert.Len(t, asks, 2)
+	assert.True(t, s.pair.Equal(simPair))
+	assert.Equal(t, asset.Spot, s.asset)
+}
+
+func TestSubmitOrder(t *testing.T) {
+	t.Parallel()
+	s := newSimulator(t)
+	_, err := s.SubmitOrder(nil)
+	assert.ErrorIs(t, err, order.ErrSubmissionIsNil)
+	_, err = s.SubmitOrder(simSubmit(order.UnknownSide, order.Market, 0, 1))
+	assert.ErrorIs(t, err, order.ErrSideIsInvalid)
+	_, err = s.SubmitOrder(simSubmit(order.Buy, order.Stop, 0, 1))
+	assert.ErrorIs(t, err, order.ErrUnsupportedOrderType)
+	_, err = s.SubmitOrder(simSubmit(order.Buy, order.Market, 0, 0))
+	assert.ErrorIs(t, err, order.ErrAmountIsInvalid)
+	_, err = s.SubmitOrder(simSubmit(order.Buy, order.Limit, 0, 1))
+	assert.ErrorIs(t, err, order.ErrPriceMustBeSetIfLimitOrder)
+	mismatch := simSubmit(order.Buy, order.Market, 0, 1)
+	mismatch.Pair = currency.NewPair(currency.ETH, currency.USD)
+	_, err = s.SubmitOrder(mismatch)
+	assert.ErrorIs(t, err, errPairMismatch)
+	mismatch.Pair = simPair
+	mismatch.AssetType = asset.Futures
+	_, err = s.SubmitOrder(mismatch)
+	assert.ErrorIs(t, err, errAssetMismatch)
+
+	o, err := s.SubmitOrder(simSubmit(order.Buy, order.Market, 0, 2))
+	require.NoError(t, err)
+	assert.Equal(t, order.Filled, o.Status)
+	assert.Equal(t, 2.0, o.ExecutedAmount)
+	assert.Zero(t, o.RemainingAmount)
+	assert.Equal(t, 101.5, o.AverageExecutedPrice)
+	assert.InDelta(t, (101+102)*simFees.Taker, o.Fee, 1e-9)
+	assert.Equal(t, simStart, o.Created)
+	require.Len(t, o.Fills, 2)
+	assert.False(t, o.Fills[0].Maker)
+	assert.Equal(t, 101.0, o.Fills[0].Price)
+	_, asks := s.GetBook()
+	assert.Equal(t, []orderbook.Item{{Price: 102, Amount: 1}}, asks, "taken liquidity should be removed from the book")
+
+	o, err = s.SubmitOrder(simSubmit(order.Sell, order.Market, 0, 10))
+	require.NoError(t, err)
+	assert.Equal(t, order.PartiallyFilledCancelled, o.Status)
+	assert.Equal(t, 5.0, o.ExecutedAmount)
+	assert.Equal(t, 5.0, o.RemainingAmount)
+
+	_, err = s.SubmitOrder(simSubmit(order.Sell, order.Market, 0, 1))
+	assert.ErrorIs(t, err, ErrNoLiquidity)
+}
+
+func TestSubmitOrderLimit(t *testing.T) {
+	t.Parallel()
+	s := newSimulator(t)
+	o, err := s.SubmitOrder(simSubmit(order.Buy, order.Limit, 101.5, 3))
+	require.NoError(t, err)
+	assert.Equal(t, order.PartiallyFilled, o.Status)
+	assert.Equal(t, 1.0, o.ExecutedAmount)
+	assert.Equal(t, 2.0, o.RemainingAmount)
+	assert.Zero(t, o.QueueAhead, "a new price level has nothing queued ahead")
+
+	o, err = s.SubmitOrder(simSubmit(order.Sell, order.Limit, 102, 1))
+	require.NoError(t, err)
+	assert.Equal(t, order.New, o.Status)
+	assert.Equal(t, 2.0, o.QueueAhead)
+
+	active := s.GetActiveOrders()
+	require.Len(t, active, 2)
+	assert.Equal(t, order.Buy, active[0].Side)
+	assert.Equal(t, order.Sell, active[1].Side)
+
+	postOnly := simSubmit(order.Buy, order.Limit, 102, 1)
+	postOnly.PostOnly = true
+	_, err = s.SubmitOrder(postOnly)
+	assert.ErrorIs(t, err, ErrPostOnlyWouldTake)
+
+	fok := simSubmit(order.Buy, order.Limit, 102, 5)
+	fok.FillOrKill = true
+	_, err = s.SubmitOrder(fok)
+	assert.ErrorIs(t, err, ErrFillOrKillUnfilled)
+	fok.Amount = 1
+	o, err = s.SubmitOrder(fok)
+	require.NoError(t, err)
+	assert.Equal(t, order.Filled, o.Status)
+
+	ioc := simSubmit(order.Sell, order.Limit, 98.5, 5)
+	ioc.ImmediateOrCancel = true
+	o, err = s.SubmitOrder(ioc)
+	require.NoError(t, err)
+	assert.Equal(t, order.PartiallyFilledCancelled, o.Status)
+	assert.Equal(t, 2.0, o.ExecutedAmount)
+	assert.Len(t, s.GetActiveOrders(), 2)
+}
+
+func TestProcessTrade(t *testing.T) {
+	t.Parallel()
+	s := newSimulator(t)
+	_, err := s.ProcessTrade(nil)
+	assert.ErrorIs(t, err, errNilTrade)
+	_, err = s.ProcessTrade(simTrade(order.Sell, 0, 1))
+	assert.ErrorIs(t, err, errInvalidTrade)
+	mismatch := simTrade(order.Sell, 99, 1)
+	mismatch.CurrencyPair = currency.NewPair(currency.ETH, currency.USD)
+	_, err = s.ProcessTrade(mismatch)
+	assert.ErrorIs(t, err, errPairMismatch)
+
+	o, err := s.SubmitOrder(simSubmit(order.Buy, order.Limit, 99, 1))
+	require.NoError(t, err)
+	assert.Equal(t, 2.0, o.QueueAhead)
+
+	fills, err := s.ProcessTrade(simTrade(order.Buy, 101, 5))
+	require.NoError(t, err)
+	assert.Empty(t, fills, "buy trades should not match resting bids")
+
+	fills, err = s.ProcessTrade(simTrade(order.Sell, 99, 1.5))
+	require.NoError(t, err)
+	assert.Empty(t, fills)
+	o, err = s.GetOrder(o.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 0.5, o.QueueAhead)
+
+	fills, err = s.ProcessTrade(simTrade(order.Sell, 99, 1))
+	require.NoError(t, err)
+	require.Len(t, fills, 1)
+	assert.Equal(t, 0.5, fills[0].Amount)
+	assert.Equal(t, 99.0, fills[0].Price)
+	assert.True(t, fills[0].Maker)
+	assert.InDelta(t, 99*0.5*simFees.Maker, fills[0].Fee, 1e-9)
+	assert.Equal(t, simStart.Add(time.Minute), fills[0].Timestamp)
+
+	// A trade through the order's price fills it regardless of queue
+	fills, err = s.ProcessTrade(simTrade(order.UnknownSide, 98, 5))
+	require.NoError(t, err)
+	require.Len(t, fills, 1)
+	assert.Equal(t, 0.5, fills[0].Amount)
+	o, err = s.GetOrder(o.ID)
+	require.NoError(t, err)
+	assert.Equal(t, order.Filled, o.Status)
+	assert.Empty(t, s.GetActiveOrders())
+}
+
+func TestProcessTradePriceTimePriority(t *testing.T) {
+	t.Parallel()
+	s := newSimulator(t)
+	first, err := s.SubmitOrder(simSubmit(order.Sell, order.Limit, 101, 1))
+	require.NoError(t, err)
+	second, err := s.SubmitOrder(simSubmit(order.Sell, order.Limit, 101, 1))
+	require.NoError(t, err)
+	better, err := s.SubmitOrder(simSubmit(order.Sell, order.Limit, 100.5, 1))
+	require.NoError(t, err)
+
+	active := s.GetActiveOrders()
+	require.Len(t, active, 3)
+	assert.Equal(t, better.ID, active[0].ID)
+	assert.Equal(t, first.ID, active[1].ID)
+	assert.Equal(t, second.ID, active[2].ID)
+
+	fills, err := s.ProcessTrade(simTrade(order.Buy, 101, 3))
+	require.NoError(t, err)
+	require.Len(t, fills, 2)
+	assert.Equal(t, better.ID, fills[0].OrderID)
+	assert.Equal(t, 1.0, fills[0].Amount)
+	assert.Equal(t, first.ID, fills[1].OrderID)
+	assert.Equal(t, 1.0, fills[1].Amount)
+
+	fills, err = s.ProcessTrade(simTrade(order.Buy, 101, 1))
+	require.NoError(t, err)
+	require.Len(t, fills, 1)
+	assert.Equal(t, second.ID, fills[0].OrderID)
+}
+
+func TestProcessUpdate(t *testing.T) {
+	t.Parallel()
+	s := newSimulator(t)
+	_, err := s.ProcessUpdate(nil)
+	assert.ErrorIs(t, err, errNilUpdate)
+	_, err = s.ProcessUpdate(&orderbook.Update{Asset: asset.Futures})
+	assert.ErrorIs(t, err, errAssetMismatch)
+
+	o, err := s.SubmitOrder(simSubmit(order.Buy, order.Limit, 99, 1))
+	require.NoError(t, err)
+
+	fills, err := s.ProcessUpdate(&orderbook.Update{
+		Action: orderbook.UpdateInsert,
+		Bids:   []orderbook.Item{{Price: 99, Amount: 0.5}, {Price: 98.5, Amount: 1}},
+		Asks:   []orderbook.Item{{Price: 103, Amount: 1}, {Price: 100, Amount: 1}},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, fills)
+	bids, asks := s.GetBook()
+	assert.Equal(t, []orderbook.Item{{Price: 99, Amount: 0.5}, {Price: 98.5, Amount: 1}, {Price: 98, Amount: 3}}, bids)
+	assert.Equal(t, []orderbook.Item{{Price: 100, Amount: 1}, {Price: 101, Amount: 1}, {Price: 102, Amount: 2}, {Price: 103, Amount: 1}}, asks)
+	o, err = s.GetOrder(o.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 0.5, o.QueueAhead, "queue should be capped to the visible level amount")
+
+	fills, err = s.ProcessUpdate(&orderbook.Update{
+		Action: orderbook.Delete,
+		Asks:   []orderbook.Item{{Price: 103}},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, fills)
+	_, asks = s.GetBook()
+	assert.Len(t, asks, 3)
+
+	fills, err = s.ProcessUpdate(&orderbook.Update{
+		Action: orderbook.UpdateInsert,
+		Asks:   []orderbook.Item{{Price: 99, Amount: 0.4}},
+	})
+	require.NoError(t, err)
+	require.Len(t, fills, 1)
+	assert.Equal(t, 0.4, fills[0].Amount)
+	assert.Equal(t, 99.0, fills[0].Price)
+	assert.True(t, fills[0].Maker)
+	_, asks = s.GetBook()
+	assert.Equal(t, 100.0, asks[0].Price, "crossed liquidity should be consumed")
+}
+
+func TestLoadDepth(t *testing.T) {
+	t.Parallel()
+	s := newSimulator(t)
+	_, err := s.LoadDepth(nil)
+	assert.ErrorIs(t, err, errNilDepth)
+
+	o, err := s.SubmitOrder(simSubmit(order.Sell, order.Limit, 101, 2))
+	require.NoError(t, err)
+	assert.Equal(t, order.New, o.Status)
+	assert.Equal(t, 1.0, o.QueueAhead)
+
+	fills, err := s.LoadDepth(simDepth(t,
+		[]orderbook.Item{{Price: 101.5, Amount: 1.5}, {Price: 100, Amount: 1}},
+		[]orderbook.Item{{Price: 102, Amount: 1}},
+	))
+	require.NoError(t, err)
+	require.Len(t, fills, 1)
+	assert.Equal(t, 1.5, fills[0].Amount)
+	assert.Equal(t, 101.0, fills[0].Price)
+	o, err = s.GetOrder(o.ID)
+	require.NoError(t, err)
+	assert.Equal(t, order.PartiallyFilled, o.Status)
+	assert.Zero(t, o.QueueAhead)
+}
+
+func TestCancelOrder(t *testing.T) {
+	t.Parallel()
+	s := newSimulator(t)
+	_, err := s.CancelOrder("1")
+	assert.ErrorIs(t, err, ErrOrderNotFound)
+	_, err = s.GetOrder("1")
+	assert.ErrorIs(t, err, ErrOrderNotFound)
+
+	o, err := s.SubmitOrder(simSubmit(order.Buy, order.Limit, 101, 2))
+	require.NoError(t, err)
+	o, err = s.CancelOrder(o.ID)
+	require.NoError(t, err)
+	assert.Equal(t, order.PartiallyFilledCancelled, o.Status)
+	assert.Empty(t, s.GetActiveOrders())
+	_, err = s.CancelOrder(o.ID)
+	assert.ErrorIs(t, err, errOrderNotActive)
+
+	o, err = s.SubmitOrder(simSubmit(order.Buy, order.Limit, 90, 1))
+	require.NoError(t, err)
+	o, err = s.CancelOrder(o.ID)
+	require.NoError(t, err)
+	assert.Equal(t, order.Cancelled, o.Status)
+}
@@ -0,0 +1,102 @@
+package simulator
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// dust is the remaining amount below which an order is considered filled, to
+// account for floating point error
+const dust = 1e-12
+
+var (
+	// ErrOrderNotFound is returned when an order is not held by the simulator
+	ErrOrderNotFound = errors.New("simulated order not found")
+	// ErrNoLiquidity is returned when a market order cannot be matched
+	// against any liquidity
+	ErrNoLiquidity = errors.New("no liquidity available")
+	// ErrPostOnlyWouldTake is returned when a post only order would match
+	// immediately against the book
+	ErrPostOnlyWouldTake = errors.New("post only order would take liquidity")
+	// ErrFillOrKillUnfilled is returned when a fill or kill order cannot be
+	// completely filled immediately
+	ErrFillOrKillUnfilled = errors.New("fill or kill order cannot be completely filled")
+
+	errNilDepth       = errors.New("orderbook depth is nil")
+	errNilUpdate      = errors.New("orderbook update is nil")
+	errNilTrade       = errors.New("trade is nil")
+	errInvalidTrade   = errors.New("trade price and amount must be greater than zero")
+	errPairMismatch   = errors.New("pair does not match simulator")
+	errAssetMismatch  = errors.New("asset does not match simulator")
+	errOrderNotActive = errors.New("simulated order is not active")
+)
+
+// Fees defines the maker and taker fee rates applied to fills as a fraction of
+// the fill's quote value e.g. 0.001 for 0.1%
+type Fees struct {
+	Maker float64
+	Taker float64
+}
+
+// Simulator matches simulated orders against an orderbook snapshot and the
+// trades and updates which follow it. Orders taking liquidity walk the book,
+// while resting orders are filled with price-time priority using an estimate
+// of their queue position. Simulated orders do not match against each other
+type Simulator struct {
+	m        sync.Mutex
+	exchange string
+	pair     currency.Pair
+	asset    asset.Item
+	fees     Fees
+	// bids and asks are the simulated book, ordered best price first
+	bids []orderbook.Item
+	asks []orderbook.Item
+	// restingBids and restingAsks are the active simulated limit orders,
+	// ordered by price-time priority
+	restingBids []*Order
+	restingAsks []*Order
+	orders      map[string]*Order
+	sequence    int64
+	now         time.Time
+}
+
+// Order defines a simulated order and its execution state
+type Order struct {
+	ID                   string
+	ClientOrderID        string
+	Side                 order.Side
+	Type                 order.Type
+	Price                float64
+	Amount               float64
+	ExecutedAmount       float64
+	RemainingAmount      float64
+	AverageExecutedPrice float64
+	Fee                  float64
+	// QueueAhead is the estimated amount resting ahead of the order at its
+	// price level which must trade before the order fills
+	QueueAhead  float64
+	Status      order.Status
+	Created     time.Time
+	LastUpdated time.Time
+	Fills       []Fill
+	sequence    int64
+}
+
+// Fill defines an execution against a simulated order
+type Fill struct {
+	OrderID string
+	Side    order.Side
+	Price   float64
+	Amount  float64
+	Fee     float64
+	// Maker is true when the order was resting on the book, otherwise the
+	// order took liquidity
+	Maker     bool
+	Timestamp time.Time
+}
@@ -0,0 +1,640 @@
+package paper
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/collateral"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook/simulator"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+// New wraps an exchange which has already been set up for paper trading. The
+// wrapped exchange has its authenticated support disabled so that it can never
+// interact with the real account
+func New(exch exchange.IBotExchange, cfg *config.PaperTrading) (*Exchange, error) {
+	if exch == nil {
+		return nil, errNilExchange
+	}
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	if _, ok := exch.(*Exchange); ok {
+		return nil, fmt.Errorf("%s %w", exch.GetName(), errAlreadyPaperTrading)
+	}
+	balances := make(map[*currency.Item]*balance, len(cfg.Balances))
+	for code, amount := range cfg.Balances {
+		if amount < 0 {
+			return nil, fmt.Errorf("%s %w: %v", code, errInvalidBalance, amount)
+		}
+		balances[currency.NewCode(code).Item] = &balance{total: amount}
+	}
+
+	b := exch.GetBase()
+	b.API.AuthenticatedSupport = false
+	b.API.AuthenticatedWebsocketSupport = false
+
+	return &Exchange{
+		IBotExchange: exch,
+		fees:         simulator.Fees{Maker: cfg.MakerFee, Taker: cfg.TakerFee},
+		balances:     balances,
+		simulators:   make(map[key.PairAsset]*simulator.Simulator),
+		orders:       make(map[string]*paperOrder),
+		simOrders:    make(map[simOrderKey]*paperOrder),
+	}, nil
+}
+
+// UpdateOrderbook updates the orderbook from the wrapped exchange and matches
+// any resting orders against it
+func (e *Exchange) UpdateOrderbook(ctx context.Context, p currency.Pair, a asset.Item) (*orderbook.Base, error) {
+	b, err := e.IBotExchange.UpdateOrderbook(ctx, p, a)
+	if err != nil {
+		return nil, err
+	}
+	d, err := orderbook.GetDepth(e.GetName(), p, a)
+	if err != nil {
+		return nil, err
+	}
+	return b, e.ProcessOrderbook(d)
+}
+
+// ProcessOrderbook matches resting orders against an orderbook update
+func (e *Exchange) ProcessOrderbook(d *orderbook.Depth) error {
+	if d == nil {
+		return nil
+	}
+	p, err := d.GetPair()
+	if err != nil {
+		return err
+	}
+	a, err := d.GetAsset()
+	if err != nil {
+		return err
+	}
+	e.m.Lock()
+	defer e.m.Unlock()
+	sim, ok := e.simulators[key.PairAsset{Base: p.Base.Item, Quote: p.Quote.Item, Asset: a}]
+	if !ok {
+		return nil
+	}
+	fills, err := sim.LoadDepth(d)
+	if err != nil {
+		return err
+	}
+	e.publish(e.applyFills(sim, fills))
+	return nil
+}
+
+// ProcessTrades matches resting orders against public trades
+func (e *Exchange) ProcessTrades(trades ...trade.Data) error {
+	e.m.Lock()
+	defer e.m.Unlock()
+	var updated []*order.Detail
+	for i := range trades {
+		sim, ok := e.simulators[key.PairAsset{
+			Base:  trades[i].CurrencyPair.Base.Item,
+			Quote: trades[i].CurrencyPair.Quote.Item,
+			Asset: trades[i].AssetType,
+		}]
+		if !ok {
+			continue
+		}
+		fills, err := sim.ProcessTrade(&trades[i])
+		if err != nil {
+			return err
+		}
+		updated = append(updated, e.applyFills(sim, fills)...)
+	}
+	e.publish(updated)
+	return nil
+}
+
+// SubmitOrder submits an order to the simulated account, matching it against
+// the live orderbook
+func (e *Exchange) SubmitOrder(ctx context.Context, s *order.Submit) (*order.SubmitResponse, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	if s.AssetType != asset.Spot {
+		return nil, fmt.Errorf("%s paper trading %w: %v", e.GetName(), asset.ErrNotSupported, s.AssetType)
+	}
+	d, err := e.getDepth(ctx, s.Pair, s.AssetType)
+	if err != nil {
+		return nil, err
+	}
+
+	e.m.Lock()
+	defer e.m.Unlock()
+	k := key.PairAsset{Base: s.Pair.Base.Item, Quote: s.Pair.Quote.Item, Asset: s.AssetType}
+	sim, ok := e.simulators[k]
+	if ok {
+		var fills []simulator.Fill
+		fills, err = sim.LoadDepth(d)
+		if err != nil {
+			return nil, err
+		}
+		e.publish(e.applyFills(sim, fills))
+	} else {
+		sim, err = simulator.New(d, e.fees)
+		if err != nil {
+			return nil, err
+		}
+		e.simulators[k] = sim
+	}
+
+	submit := *s
+	bids, asks := sim.GetBook()
+	if submit.Amount == 0 {
+		// Quote amount orders are converted to the base amount the current
+		// book can fill
+		book := bids
+		if submit.Side.IsLong() {
+			book = asks
+		}
+		submit.Amount = amountForQuote(book, submit.QuoteAmount)
+	}
+
+	var heldCurrency currency.Code
+	var held float64
+	if submit.Side.IsLong() {
+		heldCurrency = submit.Pair.Quote
+		price := submit.Price
+		if submit.Type == order.Market {
+			price = worstPrice(asks, submit.Amount)
+		}
+		held = submit.Amount * price * (1 + max(e.fees.Maker, e.fees.Taker))
+	} else {
+		heldCurrency = submit.Pair.Base
+		held = submit.Amount
+	}
+	if free := e.free(heldCurrency); free < held {
+		return nil, fmt.Errorf("%s %w: %v %s required, %v available", e.GetName(), ErrInsufficientFunds, held, heldCurrency, free)
+	}
+
+	result, err := sim.SubmitOrder(&submit)
+	if err != nil {
+		return nil, err
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+
+	po := &paperOrder{
+		detail: order.Detail{
+			ImmediateOrCancel: submit.ImmediateOrCancel,
+			FillOrKill:        submit.FillOrKill,
+			PostOnly:          submit.PostOnly,
+			Price:             submit.Price,
+			Amount:            submit.Amount,
+			RemainingAmount:   submit.Amount,
+			Exchange:          e.GetName(),
+			OrderID:           id.String(),
+			ClientOrderID:     submit.ClientOrderID,
+			ClientID:          submit.ClientID,
+			Type:              submit.Type,
+			Side:              submit.Side,
+			Status:            order.New,
+			AssetType:         submit.AssetType,
+			Date:              result.Created,
+			LastUpdated:       result.Created,
+			Pair:              submit.Pair,
+		},
+		sim:          sim,
+		simID:        result.ID,
+		heldCurrency: heldCurrency,
+	}
+	e.orders[po.detail.OrderID] = po
+	e.simOrders[simOrderKey{sim: sim, id: result.ID}] = po
+	for i := range result.Fills {
+		e.applyFill(po, &result.Fills[i])
+	}
+	po.detail.Status = result.Status
+	if result.RemainingAmount > 0 && po.detail.IsActive() {
+		po.held = result.RemainingAmount
+		if po.detail.Side.IsLong() {
+			po.held *= submit.Price * (1 + e.fees.Maker)
+		}
+		e.getBalance(heldCurrency).hold += po.held
+	}
+
+	resp, err := submit.DeriveSubmitResponse(po.detail.OrderID)
+	if err != nil {
+		return nil, err
+	}
+	resp.Amount = po.detail.Amount
+	resp.Status = po.detail.Status
+	resp.AverageExecutedPrice = po.detail.AverageExecutedPrice
+	resp.Trades = append([]order.TradeHistory(nil), po.detail.Trades...)
+	resp.Fee = po.detail.Fee
+	resp.FeeAsset = submit.Pair.Quote
+	resp.Cost = po.detail.Cost
+	resp.Date = po.detail.Date
+	resp.LastUpdated = po.detail.LastUpdated
+	return resp, nil
+}
+
+// ModifyOrder is not supported when paper trading, orders must be cancelled
+// and resubmitted
+func (e *Exchange) ModifyOrder(context.Context, *order.Modify) (*order.ModifyResponse, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// CancelOrder cancels a simulated order
+func (e *Exchange) CancelOrder(_ context.Context, c *order.Cancel) error {
+	if err := c.Validate(c.StandardCancel()); err != nil {
+		return err
+	}
+	e.m.Lock()
+	defer e.m.Unlock()
+	return e.cancel(c.OrderID)
+}
+
+// CancelBatchOrders cancels a batch of simulated orders
+func (e *Exchange) CancelBatchOrders(_ context.Context, o []order.Cancel) (*order.CancelBatchResponse, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	resp := &order.CancelBatchResponse{Status: make(map[string]string, len(o))}
+	for i := range o {
+		if err := e.cancel(o[i].OrderID); err != nil {
+			resp.Status[o[i].OrderID] = err.Error()
+			continue
+		}
+		resp.Status[o[i].OrderID] = order.Cancelled.String()
+	}
+	return resp, nil
+}
+
+// CancelAllOrders cancels all active simulated orders, optionally filtered by
+// pair and asset
+func (e *Exchange) CancelAllOrders(_ context.Context, c *order.Cancel) (order.CancelAllResponse, error) {
+	if err := c.Validate(); err != nil {
+		return order.CancelAllResponse{}, err
+	}
+	e.m.Lock()
+	defer e.m.Unlock()
+	resp := order.CancelAllResponse{Status: make(map[string]string)}
+	for id, po := range e.orders {
+		if !po.detail.IsActive() ||
+			!c.Pair.IsEmpty() && !po.detail.Pair.Equal(c.Pair) ||
+			c.AssetType != asset.Empty && po.detail.AssetType != c.AssetType {
+			continue
+		}
+		if err := e.cancel(id); err != nil {
+			resp.Status[id] = err.Error()
+			continue
+		}
+		resp.Status[id] = order.Cancelled.String()
+		resp.Count++
+	}
+	return resp, nil
+}
+
+// GetOrderInfo returns a simulated order
+func (e *Exchange) GetOrderInfo(_ context.Context, orderID string, _ currency.Pair, _ asset.Item) (*order.Detail, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	po, ok := e.orders[orderID]
+	if !ok {
+		return nil, fmt.Errorf("%s %w %s", e.GetName(), order.ErrOrderNotFound, orderID)
+	}
+	return po.detail.CopyToPointer(), nil
+}
+
+// GetActiveOrders returns the active simulated orders
+func (e *Exchange) GetActiveOrders(_ context.Context, req *order.MultiOrderRequest) (order.FilteredOrders, error) {
+	return e.getOrders(req, true)
+}
+
+// GetOrderHistory returns the simulated orders which are no longer active
+func (e *Exchange) GetOrderHistory(_ context.Context, req *order.MultiOrderRequest) (order.FilteredOrders, error) {
+	return e.getOrders(req, false)
+}
+
+// UpdateAccountInfo returns the simulated account holdings and stores them in
+// the account service
+func (e *Exchange) UpdateAccountInfo(ctx context.Context, a asset.Item) (account.Holdings, error) {
+	h, err := e.FetchAccountInfo(ctx, a)
+	if err != nil {
+		return h, err
+	}
+	return h, account.Process(&h, &credentials)
+}
+
+// FetchAccountInfo returns the simulated account holdings
+func (e *Exchange) FetchAccountInfo(_ context.Context, a asset.Item) (account.Holdings, error) {
+	if a != asset.Spot {
+		return account.Holdings{}, fmt.Errorf("%s paper trading %w: %v", e.GetName(), asset.ErrNotSupported, a)
+	}
+	e.m.Lock()
+	defer e.m.Unlock()
+	balances := make([]account.Balance, 0, len(e.balances))
+	for item, b := range e.balances {
+		balances = append(balances, account.Balance{
+			Currency:               currency.Code{Item: item, UpperCase: true},
+			Total:                  b.total,
+			Hold:                   b.hold,
+			Free:                   b.total - b.hold,
+			AvailableWithoutBorrow: b.total - b.hold,
+		})
+	}
+	sort.Slice(balances, func(i, j int) bool {
+		return balances[i].Currency.String() < balances[j].Currency.String()
+	})
+	return account.Holdings{
+		Exchange: e.GetName(),
+		Accounts: []account.SubAccount{{
+			ID:         paperAccountID,
+			AssetType:  asset.Spot,
+			Currencies: balances,
+		}},
+	}, nil
+}
+
+// HasAssetTypeAccountSegregation returns false as the simulated account only
+// holds spot balances
+func (e *Exchange) HasAssetTypeAccountSegregation() bool {
+	return false
+}
+
+// GetCredentials returns the simulated account's placeholder credentials
+func (e *Exchange) GetCredentials(context.Context) (*account.Credentials, error) {
+	creds := credentials
+	return &creds, nil
+}
+
+// GetDefaultCredentials returns the simulated account's placeholder
+// credentials
+func (e *Exchange) GetDefaultCredentials() *account.Credentials {
+	creds := credentials
+	return &creds
+}
+
+// ValidateAPICredentials always succeeds as the simulated account requires no
+// credentials
+func (e *Exchange) ValidateAPICredentials(context.Context, asset.Item) error {
+	return nil
+}
+
+// VerifyAPICredentials always succeeds as the simulated account requires no
+// credentials
+func (e *Exchange) VerifyAPICredentials(*account.Credentials) error {
+	return nil
+}
+
+// IsRESTAuthenticationSupported returns true so that account and order
+// management subsystems interact with the simulated account
+func (e *Exchange) IsRESTAuthenticationSupported() bool {
+	return true
+}
+
+// IsWebsocketAuthenticationSupported returns false as the simulated account
+// has no authenticated websocket
+func (e *Exchange) IsWebsocketAuthenticationSupported() bool {
+	return false
+}
+
+// AuthenticateWebsocket is not supported when paper trading
+func (e *Exchange) AuthenticateWebsocket(context.Context) error {
+	return common.ErrFunctionNotSupported
+}
+
+// WithdrawCryptocurrencyFunds is not supported when paper trading
+func (e *Exchange) WithdrawCryptocurrencyFunds(context.Context, *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// WithdrawFiatFunds is not supported when paper trading
+func (e *Exchange) WithdrawFiatFunds(context.Context, *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// WithdrawFiatFundsToInternationalBank is not supported when paper trading
+func (e *Exchange) WithdrawFiatFundsToInternationalBank(context.Context, *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// SetCollateralMode is not supported when paper trading
+func (e *Exchange) SetCollateralMode(context.Context, asset.Item, collateral.Mode) error {
+	return common.ErrFunctionNotSupported
+}
+
+// SetLeverage is not supported when paper trading
+func (e *Exchange) SetLeverage(context.Context, asset.Item, currency.Pair, margin.Type, float64, order.Side) error {
+	return common.ErrFunctionNotSupported
+}
+
+// SetMarginType is not supported when paper trading
+func (e *Exchange) SetMarginType(context.Context, asset.Item, currency.Pair, margin.Type) error {
+	return common.ErrFunctionNotSupported
+}
+
+// ChangePositionMargin is not supported when paper trading
+func (e *Exchange) ChangePositionMargin(context.Context, *margin.PositionChangeRequest) (*margin.PositionChangeResponse, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// getDepth returns the live orderbook depth, fetching it from the wrapped
+// exchange if it has not been synced
+func (e *Exchange) getDepth(ctx context.Context, p currency.Pair, a asset.Item) (*orderbook.Depth, error) {
+	d, err := orderbook.GetDepth(e.GetName(), p, a)
+	if err == nil && d.IsValid() {
+		return d, nil
+	}
+	if _, err = e.IBotExchange.UpdateOrderbook(ctx, p, a); err != nil {
+		return nil, err
+	}
+	return orderbook.GetDepth(e.GetName(), p, a)
+}
+
+// getOrders returns simulated orders matching the request
+func (e *Exchange) getOrders(req *order.MultiOrderRequest, active bool) (order.FilteredOrders, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	e.m.Lock()
+	orders := make([]order.Detail, 0, len(e.orders))
+	for _, po := range e.orders {
+		if po.detail.IsActive() != active || po.detail.AssetType != req.AssetType {
+			continue
+		}
+		orders = append(orders, po.detail.Copy())
+	}
+	e.m.Unlock()
+	sort.Slice(orders, func(i, j int) bool {
+		return orders[i].Date.Before(orders[j].Date)
+	})
+	return req.Filter(e.GetName(), orders), nil
+}
+
+// cancel cancels a simulated order and releases its reserved funds
+func (e *Exchange) cancel(id string) error {
+	po, ok := e.orders[id]
+	if !ok {
+		return fmt.Errorf("%s %w %s", e.GetName(), order.ErrOrderNotFound, id)
+	}
+	result, err := po.sim.CancelOrder(po.simID)
+	if err != nil {
+		return err
+	}
+	po.detail.Status = result.Status
+	po.detail.RemainingAmount = result.RemainingAmount
+	po.detail.LastUpdated = result.LastUpdated
+	po.detail.CloseTime = result.LastUpdated
+	e.release(po, po.held)
+	return nil
+}
+
+// applyFills applies simulator fills to their orders and returns copies of the
+// updated orders
+func (e *Exchange) applyFills(sim *simulator.Simulator, fills []simulator.Fill) []*order.Detail {
+	updated := make([]*order.Detail, 0, len(fills))
+	for i := range fills {
+		po, ok := e.simOrders[simOrderKey{sim: sim, id: fills[i].OrderID}]
+		if !ok {
+			continue
+		}
+		e.applyFill(po, &fills[i])
+		updated = append(updated, po.detail.CopyToPointer())
+	}
+	return updated
+}
+
+// applyFill updates an order and the simulated balances with a fill
+func (e *Exchange) applyFill(po *paperOrder, f *simulator.Fill) {
+	if po.held > 0 && po.detail.RemainingAmount > 0 {
+		e.release(po, po.held*f.Amount/po.detail.RemainingAmount)
+	}
+	base := e.getBalance(po.detail.Pair.Base)
+	quote := e.getBalance(po.detail.Pair.Quote)
+	value := f.Price * f.Amount
+	if po.detail.Side.IsLong() {
+		base.total += f.Amount
+		quote.total -= value + f.Fee
+	} else {
+		base.total -= f.Amount
+		quote.total += value - f.Fee
+	}
+
+	d := &po.detail
+	d.AverageExecutedPrice = (d.AverageExecutedPrice*d.ExecutedAmount + value) / (d.ExecutedAmount + f.Amount)
+	d.ExecutedAmount += f.Amount
+	d.RemainingAmount = max(d.Amount-d.ExecutedAmount, 0)
+	d.Cost += value
+	d.Fee += f.Fee
+	d.FeeAsset = po.detail.Pair.Quote
+	d.LastUpdated = f.Timestamp
+	d.Trades = append(d.Trades, order.TradeHistory{
+		Price:     f.Price,
+		Amount:    f.Amount,
+		Fee:       f.Fee,
+		Exchange:  d.Exchange,
+		TID:       d.OrderID + "-" + fmt.Sprint(len(d.Trades)+1),
+		Type:      d.Type,
+		Side:      d.Side,
+		Timestamp: f.Timestamp,
+		IsMaker:   f.Maker,
+		FeeAsset:  d.Pair.Quote.String(),
+		Total:     value,
+	})
+	if d.RemainingAmount <= 0 || d.Amount-d.ExecutedAmount < 1e-12 {
+		d.RemainingAmount = 0
+		d.Status = order.Filled
+		d.CloseTime = f.Timestamp
+		e.release(po, po.held)
+	} else {
+		d.Status = order.PartiallyFilled
+	}
+}
+
+// release returns reserved funds to the free balance
+func (e *Exchange) release(po *paperOrder, amount float64) {
+	amount = min(amount, po.held)
+	if amount <= 0 {
+		return
+	}
+	po.held -= amount
+	b := e.getBalance(po.heldCurrency)
+	b.hold = max(b.hold-amount, 0)
+}
+
+// free returns the unreserved balance of a currency
+func (e *Exchange) free(c currency.Code) float64 {
+	b, ok := e.balances[c.Item]
+	if !ok {
+		return 0
+	}
+	return b.total - b.hold
+}
+
+// getBalance returns the balance of a currency, creating it if required
+func (e *Exchange) getBalance(c currency.Code) *balance {
+	b, ok := e.balances[c.Item]
+	if !ok {
+		b = &balance{}
+		e.balances[c.Item] = b
+	}
+	return b
+}
+
+// publish sends updated orders to the websocket data handler so they are
+// processed exactly as exchange order updates would be
+func (e *Exchange) publish(orders []*order.Detail) {
+	if len(orders) == 0 {
+		return
+	}
+	ws, err := e.IBotExchange.GetWebsocket()
+	if err != nil || ws == nil || !ws.IsConnected() {
+		return
+	}
+	for i := range orders {
+		select {
+		case ws.DataHandler <- orders[i]:
+		default:
+			log.Warnf(log.ExchangeSys, "%s paper trading websocket data handler full, order %s update dropped", e.GetName(), orders[i].OrderID)
+		}
+	}
+}
+
+// worstPrice returns the price of the deepest level required to fill an
+// amount, or the last level if the book cannot fill it
+func worstPrice(levels []orderbook.Item, amount float64) float64 {
+	var price float64
+	for i := range levels {
+		price = levels[i].Price
+		amount -= levels[i].Amount
+		if amount <= 0 {
+			break
+		}
+	}
+	return price
+}
+
+// amountForQuote returns the base amount which can be traded for a quote
+// amount against the book
+func amountForQuote(levels []orderbook.Item, quote float64) float64 {
+	var amount float64
+	for i := range levels {
+		value := levels[i].Price * levels[i].Amount
+		if value >= quote {
+			return amount + quote/levels[i].Price
+		}
+		amount += levels[i].Amount
+		quote -= value
+	}
+	return amount
+}
@@ -0,0 +1,256 @@
+package paper
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+type fakeExchange struct {
+	exchange.IBotExchange
+	base *exchange.Base
+}
+
+func (f *fakeExchange) GetName() string         { return f.base.Name }
+func (f *fakeExchange) GetBase() *exchange.Base { return f.base }
+func (f *fakeExchange) GetWebsocket() (*stream.Websocket, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+func (f *fakeExchange) UpdateOrderbook(_ context.Context, p currency.Pair, a asset.Item) (*orderbook.Base, error) {
+	return orderbook.Get(f.base.Name, p, a)
+}
+
+func paperSetup(t *testing.T, name string) (*Exchange, currency.Pair) {
+	t.Helper()
+	p := currency.NewPair(currency.BTC, currency.USD)
+	err := (&orderbook.Base{
+		Exchange:    name,
+		Pair:        p,
+		Asset:       asset.Spot,
+		Bids:        orderbook.Items{{Price: 99, Amount: 1}, {Price: 98, Amount: 2}},
+		Asks:        orderbook.Items{{Price: 101, Amount: 1}, {Price: 102, Amount: 2}},
+		LastUpdated: time.Now(),
+	}).Process()
+	require.NoError(t, err)
+
+	base := &exchange.Base{Name: name}
+	base.API.AuthenticatedSupport = true
+	base.API.AuthenticatedWebsocketSupport = true
+	e, err := New(&fakeExchange{base: base}, &config.PaperTrading{
+		Enabled:  true,
+		Balances: map[string]float64{"USD": 1000, "BTC": 1},
+		TakerFee: 0.001,
+	})
+	require.NoError(t, err)
+	return e, p
+}
+
+func paperSubmit(e *Exchange, p currency.Pair, side order.Side, orderType order.Type, amount, price float64) *order.Submit {
+	return &order.Submit{
+		Exchange:  e.GetName(),
+		Pair:      p,
+		AssetType: asset.Spot,
+		Side:      side,
+		Type:      orderType,
+		Amount:    amount,
+		Price:     price,
+	}
+}
+
+func getBalance(t *testing.T, e *Exchange, c currency.Code) account.Balance {
+	t.Helper()
+	h, err := e.FetchAccountInfo(context.Background(), asset.Spot)
+	require.NoError(t, err)
+	require.Len(t, h.Accounts, 1)
+	for _, b := range h.Accounts[0].Currencies {
+		if b.Currency.Equal(c) {
+			return b
+		}
+	}
+	return account.Balance{Currency: c}
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+	_, err := New(nil, &config.PaperTrading{})
+	assert.ErrorIs(t, err, errNilExchange)
+
+	base := &exchange.Base{Name: "papernew"}
+	base.API.AuthenticatedSupport = true
+	_, err = New(&fakeExchange{base: base}, nil)
+	assert.ErrorIs(t, err, errNilConfig)
+
+	_, err = New(&fakeExchange{base: base}, &config.PaperTrading{Balances: map[string]float64{"BTC": -1}})
+	assert.ErrorIs(t, err, errInvalidBalance)
+
+	e, err := New(&fakeExchange{base: base}, &config.PaperTrading{})
+	require.NoError(t, err)
+	assert.False(t, base.API.AuthenticatedSupport, "wrapped exchange authenticated support should be disabled")
+	assert.True(t, e.IsRESTAuthenticationSupported())
+	assert.False(t, e.IsWebsocketAuthenticationSupported())
+
+	_, err = New(e, &config.PaperTrading{})
+	assert.ErrorIs(t, err, errAlreadyPaperTrading)
+}
+
+func TestSubmitOrder(t *testing.T) {
+	t.Parallel()
+	e, p := paperSetup(t, "papersubmit")
+	ctx := context.Background()
+
+	resp, err := e.SubmitOrder(ctx, paperSubmit(e, p, order.Buy, order.Market, 1.5, 0))
+	require.NoError(t, err)
+	assert.Equal(t, order.Filled, resp.Status)
+	assert.NotEmpty(t, resp.OrderID)
+	require.Len(t, resp.Trades, 2)
+	assert.False(t, resp.Trades[0].IsMaker)
+	assert.InDelta(t, 152.0/1.5, resp.AverageExecutedPrice, 1e-9)
+	assert.InDelta(t, 0.152, resp.Fee, 1e-9)
+	assert.InDelta(t, 2.5, getBalance(t, e, currency.BTC).Total, 1e-9)
+	assert.InDelta(t, 1000-152.152, getBalance(t, e, currency.USD).Total, 1e-9)
+
+	_, err = e.SubmitOrder(ctx, paperSubmit(e, p, order.Sell, order.Market, 3, 0))
+	assert.ErrorIs(t, err, ErrInsufficientFunds)
+
+	s := paperSubmit(e, p, order.Buy, order.Market, 0, 0)
+	s.QuoteAmount = 50.5
+	resp, err = e.SubmitOrder(ctx, s)
+	require.NoError(t, err)
+	assert.InDelta(t, 0.5, resp.Amount, 1e-9, "quote amount should convert at the best ask")
+
+	s = paperSubmit(e, p, order.Buy, order.Limit, 1, 100)
+	s.AssetType = asset.Futures
+	_, err = e.SubmitOrder(ctx, s)
+	assert.ErrorIs(t, err, asset.ErrNotSupported)
+
+	_, err = e.ModifyOrder(ctx, &order.Modify{})
+	assert.ErrorIs(t, err, common.ErrFunctionNotSupported)
+	_, err = e.WithdrawCryptocurrencyFunds(ctx, nil)
+	assert.ErrorIs(t, err, common.ErrFunctionNotSupported)
+}
+
+func TestRestingOrder(t *testing.T) {
+	t.Parallel()
+	e, p := paperSetup(t, "paperresting")
+	ctx := context.Background()
+
+	resp, err := e.SubmitOrder(ctx, paperSubmit(e, p, order.Buy, order.Limit, 2, 99.5))
+	require.NoError(t, err)
+	assert.Equal(t, order.New, resp.Status)
+	usd := getBalance(t, e, currency.USD)
+	assert.InDelta(t, 199.0, usd.Hold, 1e-9)
+	assert.InDelta(t, 801.0, usd.Free, 1e-9)
+
+	active, err := e.GetActiveOrders(ctx, &order.MultiOrderRequest{AssetType: asset.Spot, Side: order.AnySide, Type: order.AnyType})
+	require.NoError(t, err)
+	require.Len(t, active, 1)
+	assert.Equal(t, resp.OrderID, active[0].OrderID)
+
+	err = e.ProcessTrades(trade.Data{
+		CurrencyPair: p,
+		AssetType:    asset.Spot,
+		Side:         order.Sell,
+		Price:        99.5,
+		Amount:       1,
+		Timestamp:    time.Now(),
+	})
+	require.NoError(t, err)
+	d, err := e.GetOrderInfo(ctx, resp.OrderID, p, asset.Spot)
+	require.NoError(t, err)
+	assert.Equal(t, order.PartiallyFilled, d.Status)
+	assert.Equal(t, 1.0, d.ExecutedAmount)
+	require.Len(t, d.Trades, 1)
+	assert.True(t, d.Trades[0].IsMaker)
+	usd = getBalance(t, e, currency.USD)
+	assert.InDelta(t, 99.5, usd.Hold, 1e-9)
+	assert.InDelta(t, 900.5, usd.Total, 1e-9)
+	assert.InDelta(t, 2.0, getBalance(t, e, currency.BTC).Total, 1e-9)
+
+	require.NoError(t, e.CancelOrder(ctx, &order.Cancel{OrderID: resp.OrderID}))
+	usd = getBalance(t, e, currency.USD)
+	assert.Zero(t, usd.Hold)
+	assert.InDelta(t, 900.5, usd.Free, 1e-9)
+	assert.ErrorIs(t, e.CancelOrder(ctx, &order.Cancel{OrderID: "bad"}), order.ErrOrderNotFound)
+
+	history, err := e.GetOrderHistory(ctx, &order.MultiOrderRequest{AssetType: asset.Spot, Side: order.AnySide, Type: order.AnyType})
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, order.PartiallyFilledCancelled, history[0].Status)
+}
+
+func TestProcessOrderbook(t *testing.T) {
+	t.Parallel()
+	e, p := paperSetup(t, "paperorderbook")
+	ctx := context.Background()
+
+	resp, err := e.SubmitOrder(ctx, paperSubmit(e, p, order.Sell, order.Limit, 1, 100))
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, getBalance(t, e, currency.BTC).Hold)
+
+	err = (&orderbook.Base{
+		Exchange:    "paperorderbook",
+		Pair:        p,
+		Asset:       asset.Spot,
+		Bids:        orderbook.Items{{Price: 100.5, Amount: 3}},
+		Asks:        orderbook.Items{{Price: 101, Amount: 1}},
+		LastUpdated: time.Now(),
+	}).Process()
+	require.NoError(t, err)
+	d, err := orderbook.GetDepth("paperorderbook", p, asset.Spot)
+	require.NoError(t, err)
+	require.NoError(t, e.ProcessOrderbook(d))
+
+	o, err := e.GetOrderInfo(ctx, resp.OrderID, p, asset.Spot)
+	require.NoError(t, err)
+	assert.Equal(t, order.Filled, o.Status)
+	btc := getBalance(t, e, currency.BTC)
+	assert.Zero(t, btc.Total)
+	assert.Zero(t, btc.Hold)
+	assert.InDelta(t, 1100.0, getBalance(t, e, currency.USD).Total, 1e-9)
+}
+
+func TestCancelAllOrders(t *testing.T) {
+	t.Parallel()
+	e, p := paperSetup(t, "papercancelall")
+	ctx := context.Background()
+	for _, price := range []float64{95, 96} {
+		_, err := e.SubmitOrder(ctx, paperSubmit(e, p, order.Buy, order.Limit, 1, price))
+		require.NoError(t, err)
+	}
+	resp, err := e.CancelAllOrders(ctx, &order.Cancel{Pair: p, AssetType: asset.Spot})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), resp.Count)
+	assert.Zero(t, getBalance(t, e, currency.USD).Hold)
+}
+
+func TestAccountInfo(t *testing.T) {
+	t.Parallel()
+	e, _ := paperSetup(t, "paperaccount")
+	ctx := context.Background()
+	h, err := e.UpdateAccountInfo(ctx, asset.Spot)
+	require.NoError(t, err)
+	require.Len(t, h.Accounts, 1)
+	assert.Equal(t, paperAccountID, h.Accounts[0].ID)
+	assert.Len(t, h.Accounts[0].Currencies, 2)
+
+	_, err = e.FetchAccountInfo(ctx, asset.Futures)
+	assert.ErrorIs(t, err, asset.ErrNotSupported)
+
+	creds, err := e.GetCredentials(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, paperAccountID, creds.Key)
+}
@@ -0,0 +1,67 @@
+package paper
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook/simulator"
+)
+
+// paperAccountID is the sub account ID of the simulated account
+const paperAccountID = "paper"
+
+var (
+	// ErrInsufficientFunds is returned when the simulated account cannot fund
+	// an order
+	ErrInsufficientFunds = errors.New("insufficient funds")
+
+	errNilExchange         = errors.New("exchange is nil")
+	errNilConfig           = errors.New("paper trading config is nil")
+	errAlreadyPaperTrading = errors.New("exchange is already paper trading")
+	errInvalidBalance      = errors.New("balance cannot be negative")
+
+	// credentials are placeholder credentials used to store the simulated
+	// account holdings, the wrapped exchange's credentials are never used
+	credentials = account.Credentials{Key: paperAccountID}
+)
+
+// Exchange wraps an exchange for paper trading. Public market data such as
+// tickers, orderbooks and klines are sourced from the wrapped exchange, while
+// orders and account queries are routed to a simulated spot account which
+// fills orders against the live orderbook
+type Exchange struct {
+	exchange.IBotExchange
+	m          sync.Mutex
+	fees       simulator.Fees
+	balances   map[*currency.Item]*balance
+	simulators map[key.PairAsset]*simulator.Simulator
+	orders     map[string]*paperOrder
+	simOrders  map[simOrderKey]*paperOrder
+}
+
+// balance is a simulated currency balance
+type balance struct {
+	total float64
+	hold  float64
+}
+
+// paperOrder links an order to its simulated counterpart along with the
+// funds reserved for its unfilled remainder
+type paperOrder struct {
+	detail       order.Detail
+	sim          *simulator.Simulator
+	simID        string
+	held         float64
+	heldCurrency currency.Code
+}
+
+// simOrderKey identifies an order within a simulator
+type simOrderKey struct {
+	sim *simulator.Simulator
+	id  string
+}
@@ -0,0 +1,15 @@
+//go:build windows
+
+package request
+
+import "os"
+
+const fileLockSupported = false
+
+func lockFile(*os.File) error {
+	return errSharedBackendUnsupported
+}
+
+func unlockFile(*os.File) error {
+	return errSharedBackendUnsupported
+}
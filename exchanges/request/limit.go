@@ -4,9 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
+	"net/http"
+	"strconv"
 	"sync/atomic"
 	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/log"
 	"golang.org/x/time/rate"
 )
 
@@ -14,6 +18,8 @@ import (
 var (
 	ErrRateLimiterAlreadyDisabled = errors.New("rate limiter already disabled")
 	ErrRateLimiterAlreadyEnabled  = errors.New("rate limiter already enabled")
+
+	errRateLimitNotDefined = errors.New("rate limit not defined for endpoint")
 )
 
 // Const here define individual functionality sub types for rate limiting
@@ -45,6 +51,237 @@ type Limiter interface {
 	Limit(context.Context, EndpointLimit) error
 }
 
+// ResponseLimiter is a Limiter which adjusts its remaining budget from the
+// headers of exchange responses
+type ResponseLimiter interface {
+	Limiter
+	UpdateFromResponse(EndpointLimit, *http.Response)
+}
+
+// RateLimiter is a token bucket which allows requests of varying weight. Its
+// state is held in memory, or by the shared backend when one is set up, so
+// that processes on the same host which use the same key share one budget
+type RateLimiter struct {
+	key      string
+	rate     float64
+	capacity float64
+	limit    float64
+	// usedWeightHeader is the response header an exchange reports the weight
+	// used within the interval in
+	usedWeightHeader string
+	// pool groups rate limiters of the same API whose used weight headers
+	// are reported together
+	pool    string
+	backend Backend
+}
+
+// RateLimiterOption configures a RateLimiter
+type RateLimiterOption func(*RateLimiter)
+
+// RateLimiterWithWeight couples a rate limiter with the weight of an endpoint
+type RateLimiterWithWeight struct {
+	*RateLimiter
+	Weight int
+}
+
+// RateLimitDefinitions maps endpoints to their weighted rate limiters.
+// Multiple endpoints may share a rate limiter
+type RateLimitDefinitions map[EndpointLimit]*RateLimiterWithWeight
+
+// NewRateLimiter returns a rate limiter allowing a total weight of actions per
+// interval. The key identifies the limiter within a shared backend and should
+// be unique to the exchange and limit e.g. "binance:spot"
+func NewRateLimiter(key string, interval time.Duration, actions int, opts ...RateLimiterOption) *RateLimiter {
+	l := &RateLimiter{
+		key:      key,
+		rate:     math.Inf(1),
+		capacity: 1,
+		limit:    float64(actions),
+		backend:  sharedBackend,
+	}
+	if actions > 0 && interval > 0 {
+		l.rate = float64(actions) / interval.Seconds()
+	}
+	for _, o := range opts {
+		o(l)
+	}
+	if l.backend == nil {
+		l.backend = newMemoryBackend()
+	}
+	return l
+}
+
+// WithUsedWeightHeader sets the response header which reports the weight used
+// within the limiter's interval, so that requests made elsewhere with the
+// same credentials or IP reduce the remaining budget
+func WithUsedWeightHeader(header string) RateLimiterOption {
+	return func(l *RateLimiter) {
+		l.usedWeightHeader = header
+	}
+}
+
+// WithPool groups rate limiters which are reported on by the same responses,
+// such as the request weight and order count limits of one API. Responses
+// adjust every rate limiter in the requesting endpoint's pool, limiters of
+// other pools are left untouched even when they use the same header
+func WithPool(pool string) RateLimiterOption {
+	return func(l *RateLimiter) {
+		l.pool = pool
+	}
+}
+
+// WithBurst sets the weight which can be consumed at once before requests are
+// spread across the interval. Burst defaults to one
+func WithBurst(burst int) RateLimiterOption {
+	return func(l *RateLimiter) {
+		if burst > 0 {
+			l.capacity = float64(burst)
+		}
+	}
+}
+
+// GetRateLimiterWithWeight couples a rate limiter with an endpoint weight
+func GetRateLimiterWithWeight(l *RateLimiter, weight int) *RateLimiterWithWeight {
+	return &RateLimiterWithWeight{RateLimiter: l, Weight: weight}
+}
+
+// Limit waits until the weight of the endpoint is available
+func (d RateLimitDefinitions) Limit(ctx context.Context, e EndpointLimit) error {
+	l, ok := d[e]
+	if !ok || l == nil || l.RateLimiter == nil {
+		return fmt.Errorf("%w: %v", errRateLimitNotDefined, e)
+	}
+	return l.Wait(ctx, l.Weight)
+}
+
+// UpdateFromResponse adjusts the budget of the endpoint's rate limiter, and
+// the rate limiters in its pool, from the response's used weight headers and
+// pauses the endpoint's rate limiter when the exchange responds with
+// Retry-After
+func (d RateLimitDefinitions) UpdateFromResponse(e EndpointLimit, resp *http.Response) {
+	if resp == nil {
+		return
+	}
+	l, ok := d[e]
+	if !ok || l == nil || l.RateLimiter == nil {
+		return
+	}
+	l.updateUsedWeight(resp.Header)
+	if l.pool != "" {
+		seen := map[*RateLimiter]bool{l.RateLimiter: true}
+		for _, p := range d {
+			if p == nil || p.RateLimiter == nil || p.pool != l.pool || seen[p.RateLimiter] {
+				continue
+			}
+			seen[p.RateLimiter] = true
+			p.updateUsedWeight(resp.Header)
+		}
+	}
+	if after := RetryAfter(resp, time.Now()); after > 0 {
+		l.pause(after)
+	}
+}
+
+// Wait waits until the weight is available or the context is done. Weights
+// below one are treated as one
+func (l *RateLimiter) Wait(ctx context.Context, weight int) error {
+	if math.IsInf(l.rate, 1) {
+		return nil
+	}
+	tokens := math.Max(float64(weight), 1)
+	var delay time.Duration
+	err := l.backend.Update(l.key, func(s *BucketState) {
+		now := l.refill(s)
+		s.Tokens -= tokens
+		if s.Tokens < 0 {
+			delay = time.Duration(-s.Tokens / l.rate * float64(time.Second))
+		}
+		if s.PausedUntil > now {
+			delay = max(delay, time.Duration(s.PausedUntil-now))
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("%s rate limiter: %w", l.key, err)
+	}
+	if delay <= 0 {
+		return nil
+	}
+	if dl, ok := ctx.Deadline(); ok && dl.Before(time.Now().Add(delay)) {
+		if err := l.refund(tokens); err != nil {
+			return err
+		}
+		return fmt.Errorf("rate limit delay of %s will exceed deadline: %w", delay, context.DeadlineExceeded)
+	}
+	t := time.NewTimer(delay)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		if err := l.refund(tokens); err != nil {
+			return err
+		}
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// refund returns tokens taken by a request which will not be made so that they
+// are available to other requests
+func (l *RateLimiter) refund(tokens float64) error {
+	if err := l.backend.Update(l.key, func(s *BucketState) {
+		l.refill(s)
+		s.Tokens = math.Min(s.Tokens+tokens, l.capacity)
+	}); err != nil {
+		return fmt.Errorf("%s rate limiter: %w", l.key, err)
+	}
+	return nil
+}
+
+// refill adds the tokens accrued since the state was last updated and returns
+// the current time in nanoseconds
+func (l *RateLimiter) refill(s *BucketState) int64 {
+	now := time.Now().UnixNano()
+	if s.Updated == 0 {
+		s.Tokens = l.capacity
+	} else if now > s.Updated {
+		s.Tokens = math.Min(s.Tokens+float64(now-s.Updated)/float64(time.Second)*l.rate, l.capacity)
+	}
+	s.Updated = now
+	return now
+}
+
+// updateUsedWeight reduces the remaining budget to the weight the exchange
+// reports as unused within the interval
+func (l *RateLimiter) updateUsedWeight(h http.Header) {
+	if l.usedWeightHeader == "" || math.IsInf(l.rate, 1) {
+		return
+	}
+	v := h.Get(l.usedWeightHeader)
+	if v == "" {
+		return
+	}
+	used, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return
+	}
+	if err := l.backend.Update(l.key, func(s *BucketState) {
+		l.refill(s)
+		s.Tokens = math.Min(s.Tokens, l.limit-used)
+	}); err != nil {
+		log.Errorf(log.RequestSys, "%s rate limiter unable to update used weight: %v", l.key, err)
+	}
+}
+
+// pause prevents the rate limiter allowing requests for the duration
+func (l *RateLimiter) pause(d time.Duration) {
+	if err := l.backend.Update(l.key, func(s *BucketState) {
+		l.refill(s)
+		s.PausedUntil = max(s.PausedUntil, time.Now().Add(d).UnixNano())
+	}); err != nil {
+		log.Errorf(log.RequestSys, "%s rate limiter unable to pause: %v", l.key, err)
+	}
+}
+
 // NewRateLimit creates a new RateLimit based of time interval and how many
 // actions allowed and breaks it down to an actions-per-second basis -- Burst
 // rate is kept as one as this is not supported for out-bound requests.
@@ -0,0 +1,128 @@
+package request
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+var (
+	sharedBackend Backend
+
+	errSharedBackendUnsupported = errors.New("shared rate limit backend is not supported on this platform")
+	errDirectoryRequired        = errors.New("shared rate limit directory required")
+)
+
+// Backend stores the state of rate limiters
+type Backend interface {
+	// Update atomically applies fn to the state stored under the key. Keys
+	// without state are passed a zero value
+	Update(key string, fn func(*BucketState)) error
+}
+
+// BucketState is the state of a rate limiter's token bucket. Times are in
+// Unix nanoseconds so that state can be shared between processes
+type BucketState struct {
+	Tokens      float64 `json:"tokens"`
+	Updated     int64   `json:"updated"`
+	PausedUntil int64   `json:"pausedUntil"`
+}
+
+// memoryBackend stores rate limiter state for a single process
+type memoryBackend struct {
+	m      sync.Mutex
+	states map[string]*BucketState
+}
+
+// FileBackend stores rate limiter state in a directory with one file per key.
+// Files are locked while updated so that multiple processes on the same host
+// share the same budget
+type FileBackend struct {
+	dir string
+}
+
+// SetupSharedRateLimitBackend sets the backend used by rate limiters created
+// from then on. This should be called before exchanges are set up
+func SetupSharedRateLimitBackend(b Backend) {
+	sharedBackend = b
+}
+
+func newMemoryBackend() *memoryBackend {
+	return &memoryBackend{states: make(map[string]*BucketState)}
+}
+
+// Update applies fn to the key's state
+func (m *memoryBackend) Update(key string, fn func(*BucketState)) error {
+	m.m.Lock()
+	defer m.m.Unlock()
+	s, ok := m.states[key]
+	if !ok {
+		s = &BucketState{}
+		m.states[key] = s
+	}
+	fn(s)
+	return nil
+}
+
+// NewFileBackend returns a backend storing rate limiter state in the
+// directory, creating it if needed
+func NewFileBackend(dir string) (*FileBackend, error) {
+	if !fileLockSupported {
+		return nil, errSharedBackendUnsupported
+	}
+	if dir == "" {
+		return nil, errDirectoryRequired
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	return &FileBackend{dir: dir}, nil
+}
+
+// Update applies fn to the key's state while holding an exclusive lock on its
+// file. Unreadable state is treated as empty
+func (f *FileBackend) Update(key string, fn func(*BucketState)) error {
+	fh, err := os.OpenFile(filepath.Join(f.dir, stateFileName(key)), os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return err
+	}
+	defer fh.Close()
+	if err = lockFile(fh); err != nil {
+		return err
+	}
+	defer unlockFile(fh) //nolint:errcheck // The lock is released when the file is closed
+
+	var s BucketState
+	b, err := io.ReadAll(fh)
+	if err != nil {
+		return err
+	}
+	if len(b) > 0 && json.Unmarshal(b, &s) != nil {
+		s = BucketState{}
+	}
+	fn(&s)
+	if b, err = json.Marshal(&s); err != nil {
+		return err
+	}
+	if err = fh.Truncate(0); err != nil {
+		return err
+	}
+	_, err = fh.WriteAt(b, 0)
+	return err
+}
+
+// stateFileName returns a file name for the key, replacing characters which
+// are not safe across platforms
+func stateFileName(key string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_', r == '.':
+			return r
+		}
+		return '_'
+	}, key) + ".json"
+}
@@ -0,0 +1,212 @@
+package request
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func bucketState(t *testing.T, l *RateLimiter) BucketState {
+	t.Helper()
+	var state BucketState
+	require.NoError(t, l.backend.Update(l.key, func(s *BucketState) {
+		l.refill(s)
+		state = *s
+	}))
+	return state
+}
+
+func TestNewRateLimiter(t *testing.T) {
+	t.Parallel()
+	l := NewRateLimiter("test", time.Second*10, 5)
+	assert.Equal(t, 0.5, l.rate)
+	assert.Equal(t, 1.0, l.capacity)
+	assert.IsType(t, &memoryBackend{}, l.backend)
+
+	l = NewRateLimiter("test", time.Minute, 1200, WithBurst(20), WithBurst(0), WithUsedWeightHeader("X-Used"))
+	assert.Equal(t, 20.0, l.capacity, "a burst below one should be ignored")
+	assert.Equal(t, "X-Used", l.usedWeightHeader)
+
+	l = NewRateLimiter("test", 0, 69)
+	assert.True(t, math.IsInf(l.rate, 1))
+	assert.NoError(t, l.Wait(context.Background(), 1000), "unrestricted limiters should not wait")
+}
+
+func TestRateLimitDefinitionsLimit(t *testing.T) {
+	t.Parallel()
+	l := NewRateLimiter("test", time.Second, 1000)
+	d := RateLimitDefinitions{
+		Auth:   GetRateLimiterWithWeight(l, 100),
+		UnAuth: GetRateLimiterWithWeight(l, 0),
+	}
+	assert.ErrorIs(t, d.Limit(context.Background(), Unset), errRateLimitNotDefined)
+
+	require.NoError(t, d.Limit(context.Background(), UnAuth))
+	start := time.Now()
+	require.NoError(t, d.Limit(context.Background(), Auth))
+	require.NoError(t, d.Limit(context.Background(), UnAuth))
+	assert.GreaterOrEqual(t, time.Since(start), time.Millisecond*90, "the weighted request should wait for 100 tokens")
+}
+
+func TestRateLimiterWaitDeadline(t *testing.T) {
+	t.Parallel()
+	l := NewRateLimiter("test", time.Minute, 1)
+	require.NoError(t, l.Wait(context.Background(), 1))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	assert.ErrorIs(t, l.Wait(ctx, 1), context.DeadlineExceeded)
+	assert.Less(t, bucketState(t, l).Tokens, 0.1, "tokens should be returned when the deadline would be exceeded")
+
+	ctx, cancel = context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(time.Millisecond * 50)
+		cancel()
+	}()
+	assert.ErrorIs(t, l.Wait(ctx, 1), context.Canceled)
+	assert.Less(t, bucketState(t, l).Tokens, 0.1, "tokens should be returned when the context is cancelled")
+	assert.Greater(t, bucketState(t, l).Tokens, -0.1, "tokens should be returned when the context is cancelled")
+}
+
+func TestUpdateFromResponse(t *testing.T) {
+	t.Parallel()
+	weight := NewRateLimiter("test:weight", time.Minute, 1200, WithBurst(1200), WithUsedWeightHeader("X-Used-Weight"))
+	orders := NewRateLimiter("test:orders", time.Minute, 100, WithBurst(100))
+	d := RateLimitDefinitions{
+		Auth:   GetRateLimiterWithWeight(weight, 1),
+		UnAuth: GetRateLimiterWithWeight(weight, 5),
+		Unset:  GetRateLimiterWithWeight(orders, 1),
+	}
+	d.UpdateFromResponse(Auth, nil)
+
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set("X-Used-Weight", "invalid")
+	d.UpdateFromResponse(Auth, resp)
+	assert.InDelta(t, 1200, bucketState(t, weight).Tokens, 1)
+
+	resp.Header.Set("X-Used-Weight", "1190")
+	d.UpdateFromResponse(Auth, resp)
+	assert.LessOrEqual(t, bucketState(t, weight).Tokens, 10.1, "tokens should be reduced to the unused weight reported")
+	assert.InDelta(t, 100, bucketState(t, orders).Tokens, 1, "limiters without a used weight header should not change")
+
+	resp.Header.Set("Retry-After", "2")
+	d.UpdateFromResponse(Unset, resp)
+	assert.Zero(t, bucketState(t, weight).PausedUntil, "only the responding endpoint's limiter should be paused")
+	assert.Greater(t, bucketState(t, orders).PausedUntil, time.Now().Add(time.Second).UnixNano())
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	assert.ErrorIs(t, d.Limit(ctx, Unset), context.DeadlineExceeded, "a paused limiter should wait until Retry-After")
+}
+
+func TestUpdateFromResponsePools(t *testing.T) {
+	t.Parallel()
+	spot := NewRateLimiter("test:spot", time.Minute, 1200, WithBurst(1200), WithUsedWeightHeader("X-Used-Weight"), WithPool("spot"))
+	spotOrders := NewRateLimiter("test:spot:orders", time.Minute, 100, WithBurst(100), WithUsedWeightHeader("X-Order-Count"), WithPool("spot"))
+	futures := NewRateLimiter("test:futures", time.Minute, 6000, WithBurst(6000), WithUsedWeightHeader("X-Used-Weight"), WithPool("futures"))
+	futuresOrders := NewRateLimiter("test:futures:orders", time.Minute, 1200, WithBurst(1200), WithUsedWeightHeader("X-Order-Count"), WithPool("futures"))
+	unpooled := NewRateLimiter("test:unpooled", time.Minute, 1200, WithBurst(1200), WithUsedWeightHeader("X-Used-Weight"))
+	d := RateLimitDefinitions{
+		Auth:   GetRateLimiterWithWeight(spot, 1),
+		UnAuth: GetRateLimiterWithWeight(spotOrders, 1),
+		Unset:  GetRateLimiterWithWeight(futures, 1),
+		3:      GetRateLimiterWithWeight(futuresOrders, 1),
+		4:      GetRateLimiterWithWeight(unpooled, 1),
+	}
+
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set("X-Used-Weight", "3000")
+	resp.Header.Set("X-Order-Count", "200")
+	d.UpdateFromResponse(Unset, resp)
+	assert.InDelta(t, 3000, bucketState(t, futures).Tokens, 1, "the requesting limiter should be adjusted")
+	assert.InDelta(t, 1000, bucketState(t, futuresOrders).Tokens, 1, "limiters in the requesting pool should be adjusted")
+	assert.InDelta(t, 1200, bucketState(t, spot).Tokens, 1, "limiters in other pools should not be adjusted")
+	assert.InDelta(t, 100, bucketState(t, spotOrders).Tokens, 1, "limiters in other pools should not be adjusted")
+	assert.InDelta(t, 1200, bucketState(t, unpooled).Tokens, 1, "limiters without a pool should not be adjusted")
+
+	resp.Header.Set("X-Used-Weight", "1100")
+	resp.Header.Set("X-Order-Count", "50")
+	d.UpdateFromResponse(UnAuth, resp)
+	assert.InDelta(t, 100, bucketState(t, spot).Tokens, 1)
+	assert.InDelta(t, 50, bucketState(t, spotOrders).Tokens, 1)
+	assert.InDelta(t, 3000, bucketState(t, futures).Tokens, 1)
+
+	d.UpdateFromResponse(4, resp)
+	assert.InDelta(t, 100, bucketState(t, unpooled).Tokens, 1, "limiters without a pool should adjust themselves")
+	assert.InDelta(t, 3000, bucketState(t, futures).Tokens, 1)
+}
+
+func TestRequesterUpdatesLimiterFromResponse(t *testing.T) {
+	t.Parallel()
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("X-Used-Weight", "100")
+		_, _ = w.Write([]byte(`{"response":true}`))
+	}))
+	defer s.Close()
+
+	l := NewRateLimiter("test", time.Minute, 100, WithBurst(100), WithUsedWeightHeader("X-Used-Weight"))
+	r, err := New("test", new(http.Client), WithLimiter(RateLimitDefinitions{Auth: GetRateLimiterWithWeight(l, 1)}))
+	require.NoError(t, err)
+	require.NoError(t, r.SendPayload(context.Background(), Auth, func() (*Item, error) {
+		return &Item{Method: http.MethodGet, Path: s.URL}, nil
+	}, UnauthenticatedRequest))
+	assert.Less(t, bucketState(t, l).Tokens, 1.0, "the exchange reported budget should be applied")
+}
+
+func TestFileBackend(t *testing.T) {
+	t.Parallel()
+	if !fileLockSupported {
+		_, err := NewFileBackend(t.TempDir())
+		assert.ErrorIs(t, err, errSharedBackendUnsupported)
+		t.Skip("file locks are not supported on this platform")
+	}
+	_, err := NewFileBackend("")
+	assert.ErrorIs(t, err, errDirectoryRequired)
+
+	dir := t.TempDir()
+	a, err := NewFileBackend(dir)
+	require.NoError(t, err)
+	b, err := NewFileBackend(dir)
+	require.NoError(t, err)
+
+	first := NewRateLimiter("test:shared", time.Minute, 10, WithBurst(10))
+	first.backend = a
+	second := NewRateLimiter("test:shared", time.Minute, 10, WithBurst(10))
+	second.backend = b
+	require.NoError(t, first.Wait(context.Background(), 6))
+	require.NoError(t, second.Wait(context.Background(), 4))
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	assert.ErrorIs(t, first.Wait(ctx, 1), context.DeadlineExceeded, "limiters with the same key should share a budget")
+
+	path := filepath.Join(dir, stateFileName("test:shared"))
+	require.NoError(t, os.WriteFile(path, []byte("corrupt"), 0o600))
+	assert.InDelta(t, 10, bucketState(t, first).Tokens, 0.1, "corrupt state should be reset")
+}
+
+func TestStateFileName(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "binance_spot_orders.json", stateFileName("binance:spot:orders"))
+	assert.Equal(t, "_.._etc_passwd.json", stateFileName("/../etc/passwd"))
+}
+
+// TestSetupSharedRateLimitBackend is not parallel as it sets the shared
+// backend
+func TestSetupSharedRateLimitBackend(t *testing.T) {
+	if !fileLockSupported {
+		t.Skip("file locks are not supported on this platform")
+	}
+	b, err := NewFileBackend(t.TempDir())
+	require.NoError(t, err)
+	SetupSharedRateLimitBackend(b)
+	defer SetupSharedRateLimitBackend(nil)
+	assert.Equal(t, b, NewRateLimiter("test", time.Second, 1).backend)
+}
@@ -10,6 +10,12 @@ type Reporter interface {
 	Latency(name, method, path string, t time.Duration)
 }
 
+// RateLimitReporter is an optional Reporter extension which observes the time
+// a request waited on the rate limiter before being sent
+type RateLimitReporter interface {
+	RateLimitWait(name string, t time.Duration)
+}
+
 // SetupGlobalReporter sets a reporter interface to be used
 // for all exchange requests
 func SetupGlobalReporter(r Reporter) {
@@ -13,6 +13,7 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/timedmutex"
+	"github.com/thrasher-corp/gocryptotrader/common/tracing"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/mock"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/nonce"
 	"github.com/thrasher-corp/gocryptotrader/log"
@@ -91,10 +92,16 @@ func (r *Requester) SendPayload(ctx context.Context, ep EndpointLimit, newReques
 		return errRequestFunctionIsNil
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "request.SendPayload", tracing.SpanKindInternal,
+		tracing.String("exchange", r.name),
+		tracing.Bool("authenticated", requestType == AuthenticatedRequest))
+	defer span.End()
+
 	err := r.doRequest(ctx, ep, newRequest)
 	if err != nil && requestType == AuthenticatedRequest {
 		err = common.AppendError(err, ErrAuthRequestFailed)
 	}
+	span.RecordError(err)
 	return err
 }
 
@@ -148,10 +155,17 @@ func (r *Requester) doRequest(ctx context.Context, endpoint EndpointLimit, newRe
 		}
 
 		// Initiate a rate limit reservation and sleep on requested endpoint
+		limitStart := time.Now()
+		_, limitSpan := tracing.StartSpan(ctx, "request.InitiateRateLimit", tracing.SpanKindInternal)
 		err := r.InitiateRateLimit(ctx, endpoint)
+		limitSpan.RecordError(err)
+		limitSpan.End()
 		if err != nil {
 			return fmt.Errorf("failed to rate limit HTTP request: %w", err)
 		}
+		if rep, ok := r.reporter.(RateLimitReporter); ok {
+			rep.RateLimitWait(r.name, time.Since(limitStart))
+		}
 
 		p, err := newRequest()
 		if err != nil {
@@ -188,14 +202,31 @@ func (r *Requester) doRequest(ctx context.Context, endpoint EndpointLimit, newRe
 			}
 		}
 
+		// The query is omitted from traces as it may contain signed
+		// parameters
+		_, httpSpan := tracing.StartSpan(ctx, "HTTP "+req.Method, tracing.SpanKindClient,
+			tracing.String("http.request.method", req.Method),
+			tracing.String("server.address", req.URL.Host),
+			tracing.String("url.path", req.URL.Path),
+			tracing.Int64("http.request.resend_count", int64(attempt-1)))
 		start := time.Now()
 
 		resp, err := r._HTTPClient.do(req)
+		if err != nil {
+			httpSpan.RecordError(err)
+		} else {
+			httpSpan.SetAttributes(tracing.Int64("http.response.status_code", int64(resp.StatusCode)))
+		}
+		httpSpan.End()
 
 		if r.reporter != nil && err == nil {
 			r.reporter.Latency(r.name, p.Method, p.Path, time.Since(start))
 		}
 
+		if rl, ok := r.limiter.(ResponseLimiter); ok && err == nil {
+			rl.UpdateFromResponse(endpoint, resp)
+		}
+
 		if retry, checkErr := r.retryPolicy(resp, err); checkErr != nil {
 			return checkErr
 		} else if retry {
@@ -21,6 +21,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/tracing"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/nonce"
 	"golang.org/x/time/rate"
 )
@@ -495,6 +496,88 @@ func TestDoRequest_NotRetryable(t *testing.T) {
 	}
 }
 
+type testReporter struct {
+	latencies int32
+	waits     int32
+}
+
+func (r *testReporter) Latency(string, string, string, time.Duration) {
+	atomic.AddInt32(&r.latencies, 1)
+}
+
+func (r *testReporter) RateLimitWait(string, time.Duration) {
+	atomic.AddInt32(&r.waits, 1)
+}
+
+func TestReporter(t *testing.T) {
+	t.Parallel()
+	rep := &testReporter{}
+	r, err := New("test", new(http.Client), WithReporter(rep), WithLimiter(NewBasicRateLimit(time.Second, 10)))
+	require.NoError(t, err)
+	err = r.SendPayload(context.Background(), Unset, func() (*Item, error) {
+		return &Item{
+			Method: http.MethodGet,
+			Path:   testURL,
+		}, nil
+	}, UnauthenticatedRequest)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&rep.latencies), "Latency should be reported")
+	assert.Equal(t, int32(1), atomic.LoadInt32(&rep.waits), "RateLimitWait should be reported")
+}
+
+type testSpanExporter struct {
+	m     sync.Mutex
+	spans []tracing.SpanData
+}
+
+func (e *testSpanExporter) ExportSpans(_ context.Context, _ string, spans []tracing.SpanData) error {
+	e.m.Lock()
+	e.spans = append(e.spans, spans...)
+	e.m.Unlock()
+	return nil
+}
+
+func (e *testSpanExporter) Shutdown(context.Context) error {
+	return nil
+}
+
+// TestSendPayloadTracing is not parallel as it sets the global tracer
+func TestSendPayloadTracing(t *testing.T) {
+	e := &testSpanExporter{}
+	tr, err := tracing.NewTracer("test", e)
+	require.NoError(t, err)
+	require.NoError(t, tr.Start())
+	tracing.SetGlobalTracer(tr)
+	defer tracing.SetGlobalTracer(nil)
+
+	r, err := New("test", new(http.Client), WithLimiter(NewBasicRateLimit(time.Second, 10)))
+	require.NoError(t, err)
+	ctx, parent := tracing.StartSpan(context.Background(), "parent", tracing.SpanKindInternal)
+	err = r.SendPayload(ctx, Unset, func() (*Item, error) {
+		return &Item{
+			Method: http.MethodGet,
+			Path:   testURL + "?signature=secret",
+		}, nil
+	}, AuthenticatedRequest)
+	require.NoError(t, err)
+	parent.End()
+	require.NoError(t, tr.Stop(context.Background()))
+
+	spans := make(map[string]tracing.SpanData)
+	for i := range e.spans {
+		spans[e.spans[i].Name] = e.spans[i]
+	}
+	require.Len(t, spans, 4)
+	send := spans["request.SendPayload"]
+	assert.Equal(t, parent.SpanContext().SpanID, send.ParentSpanID, "SendPayload should be a child of the caller's span")
+	assert.Equal(t, send.SpanContext.SpanID, spans["request.InitiateRateLimit"].ParentSpanID)
+	h := spans["HTTP GET"]
+	assert.Equal(t, send.SpanContext.SpanID, h.ParentSpanID)
+	assert.Equal(t, tracing.SpanKindClient, h.Kind)
+	assert.Contains(t, h.Attributes, tracing.Int64("http.response.status_code", http.StatusOK))
+	assert.NotContains(t, fmt.Sprint(h.Attributes), "secret", "the query should not be traced")
+}
+
 func TestGetNonce(t *testing.T) {
 	t.Parallel()
 	r, err := New("test", new(http.Client), WithLimiter(&globalshell))
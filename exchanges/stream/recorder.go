@@ -0,0 +1,127 @@
+package stream
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+)
+
+var errRecorderClosed = errors.New("websocket recorder closed")
+
+// RecordedMessage is a raw frame read from a websocket connection. Text frames
+// are stored as text so that recordings can be read and edited by hand, binary
+// frames are stored as received before decompression
+type RecordedMessage struct {
+	Time   time.Time `json:"time"`
+	Type   int       `json:"type"`
+	Text   string    `json:"text,omitempty"`
+	Binary []byte    `json:"binary,omitempty"`
+}
+
+// Recorder writes raw frames read from websocket connections to a file as
+// JSON, one frame per line
+type Recorder struct {
+	m   sync.Mutex
+	f   *os.File
+	enc *json.Encoder
+}
+
+// NewRecorder creates the file, replacing any existing recording
+func NewRecorder(path string) (*Recorder, error) {
+	if err := os.MkdirAll(filepath.Dir(path), file.DefaultPermissionOctal); err != nil {
+		return nil, err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	return &Recorder{f: f, enc: json.NewEncoder(f)}, nil
+}
+
+// Record writes a frame received at the time
+func (r *Recorder) Record(mType int, data []byte, received time.Time) error {
+	msg := RecordedMessage{Time: received, Type: mType}
+	if mType == websocket.TextMessage {
+		msg.Text = string(data)
+	} else {
+		msg.Binary = data
+	}
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.f == nil {
+		return errRecorderClosed
+	}
+	return r.enc.Encode(&msg)
+}
+
+// Close closes the file. Frames recorded afterwards return an error
+func (r *Recorder) Close() error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.f == nil {
+		return errRecorderClosed
+	}
+	err := r.f.Close()
+	r.f = nil
+	return err
+}
+
+// Payload returns the frame's data as it would be received from the
+// connection. Binary frames are decompressed
+func (m *RecordedMessage) Payload() ([]byte, error) {
+	if m.Type == websocket.BinaryMessage {
+		return new(WebsocketConnection).parseBinaryResponse(m.Binary)
+	}
+	return []byte(m.Text), nil
+}
+
+// ReadRecording reads the frames of a recording in the order received
+func ReadRecording(path string) ([]RecordedMessage, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var msgs []RecordedMessage
+	s := bufio.NewScanner(f)
+	s.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	for line := 1; s.Scan(); line++ {
+		if len(s.Bytes()) == 0 {
+			continue
+		}
+		var msg RecordedMessage
+		if err = json.Unmarshal(s.Bytes(), &msg); err != nil {
+			return nil, fmt.Errorf("%s line %d: %w", path, line, err)
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs, s.Err()
+}
+
+// ReplayMessages passes the payload of every recorded frame to the handler,
+// such as an exchange's wsHandleData, without a connection. It stops at the
+// first error
+func ReplayMessages(path string, handler func([]byte) error) error {
+	msgs, err := ReadRecording(path)
+	if err != nil {
+		return err
+	}
+	for i := range msgs {
+		var payload []byte
+		if payload, err = msgs[i].Payload(); err != nil {
+			return fmt.Errorf("frame %d: %w", i, err)
+		}
+		if err = handler(payload); err != nil {
+			return fmt.Errorf("frame %d: %w", i, err)
+		}
+	}
+	return nil
+}
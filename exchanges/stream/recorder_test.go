@@ -0,0 +1,130 @@
+package stream
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestRecording(t *testing.T, path string) {
+	t.Helper()
+	var b bytes.Buffer
+	gz := gzip.NewWriter(&b)
+	_, err := gz.Write([]byte(`{"compressed":true}`))
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+
+	r, err := NewRecorder(path)
+	require.NoError(t, err)
+	start := time.Now()
+	require.NoError(t, r.Record(websocket.TextMessage, []byte(`{"event":"subscribed"}`), start))
+	require.NoError(t, r.Record(websocket.BinaryMessage, b.Bytes(), start.Add(time.Millisecond*100)))
+	require.NoError(t, r.Record(websocket.TextMessage, []byte(`{"event":"trade"}`), start.Add(time.Millisecond*300)))
+	require.NoError(t, r.Close())
+	assert.ErrorIs(t, r.Record(websocket.TextMessage, nil, start), errRecorderClosed)
+	assert.ErrorIs(t, r.Close(), errRecorderClosed)
+}
+
+func TestRecorder(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "recordings", "test.json")
+	writeTestRecording(t, path)
+
+	msgs, err := ReadRecording(path)
+	require.NoError(t, err)
+	require.Len(t, msgs, 3)
+	assert.Equal(t, `{"event":"subscribed"}`, msgs[0].Text)
+	assert.Empty(t, msgs[0].Binary)
+	assert.Equal(t, time.Millisecond*300, msgs[2].Time.Sub(msgs[0].Time))
+	payload, err := msgs[1].Payload()
+	require.NoError(t, err)
+	assert.Equal(t, `{"compressed":true}`, string(payload), "binary payloads should be decompressed")
+
+	_, err = ReadRecording(filepath.Join(t.TempDir(), "missing.json"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+	require.NoError(t, os.WriteFile(path, []byte("{\"type\":1}\n\nnot json\n"), 0o600))
+	_, err = ReadRecording(path)
+	assert.ErrorContains(t, err, "line 3")
+}
+
+func TestReplayMessages(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "test.json")
+	writeTestRecording(t, path)
+
+	var received []string
+	require.NoError(t, ReplayMessages(path, func(b []byte) error {
+		received = append(received, string(b))
+		return nil
+	}))
+	assert.Equal(t, []string{`{"event":"subscribed"}`, `{"compressed":true}`, `{"event":"trade"}`}, received)
+
+	err := ReplayMessages(path, func([]byte) error { return errDastardlyReason })
+	assert.ErrorIs(t, err, errDastardlyReason)
+	assert.ErrorContains(t, err, "frame 0")
+}
+
+func TestReplayServer(t *testing.T) {
+	t.Parallel()
+	_, err := NewReplayServer(filepath.Join(t.TempDir(), "missing.json"), 1)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+	empty := filepath.Join(t.TempDir(), "empty.json")
+	require.NoError(t, os.WriteFile(empty, nil, 0o600))
+	_, err = NewReplayServer(empty, 1)
+	assert.ErrorIs(t, err, errNoRecordedMessages)
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "test.json")
+	writeTestRecording(t, path)
+	s, err := NewReplayServer(path, 2)
+	require.NoError(t, err)
+	defer s.Close()
+
+	rec, err := NewRecorder(filepath.Join(dir, "rerecorded.json"))
+	require.NoError(t, err)
+	c := &WebsocketConnection{
+		ExchangeName:      "test",
+		URL:               s.URL,
+		Traffic:           make(chan struct{}, 1),
+		readMessageErrors: make(chan error, 1),
+		Recorder:          rec,
+	}
+	require.NoError(t, c.Dial(&websocket.Dialer{}, http.Header{}))
+	require.NoError(t, c.SendJSONMessage(map[string]string{"op": "subscribe"}), "client messages should be accepted")
+
+	start := time.Now()
+	var received []string
+	for i := 0; i < 3; i++ {
+		received = append(received, string(c.ReadMessage().Raw))
+	}
+	elapsed := time.Since(start)
+	assert.Equal(t, []string{`{"event":"subscribed"}`, `{"compressed":true}`, `{"event":"trade"}`}, received)
+	assert.GreaterOrEqual(t, elapsed, time.Millisecond*140, "frames should be spaced by the recording divided by the speed")
+	assert.Less(t, elapsed, time.Millisecond*300, "frames should be spaced by the recording divided by the speed")
+	require.NoError(t, rec.Close())
+
+	original, err := ReadRecording(path)
+	require.NoError(t, err)
+	rerecorded, err := ReadRecording(filepath.Join(dir, "rerecorded.json"))
+	require.NoError(t, err)
+	require.Len(t, rerecorded, len(original))
+	for i := range original {
+		assert.Equal(t, original[i].Type, rerecorded[i].Type)
+		assert.Equal(t, original[i].Text, rerecorded[i].Text)
+		assert.Equal(t, original[i].Binary, rerecorded[i].Binary, "binary frames should be recorded before decompression")
+	}
+
+	s.Close()
+	assert.Empty(t, c.ReadMessage().Raw, "connections should be closed when the server closes")
+	err = <-c.readMessageErrors
+	assert.True(t, errors.As(err, new(*websocket.CloseError)), "the server should close connections normally")
+}
@@ -0,0 +1,139 @@
+package stream
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+var (
+	errNoRecordedMessages     = errors.New("recording has no messages")
+	errUnsupportedMessageType = errors.New("unsupported message type")
+)
+
+// ReplayServer is a local websocket server which sends every connection the
+// frames of a recording with their original spacing divided by a speed
+// factor. Messages sent by clients, such as subscriptions, are discarded
+type ReplayServer struct {
+	// URL is the ws:// address to connect to
+	URL string
+
+	server   *httptest.Server
+	upgrader websocket.Upgrader
+	messages []RecordedMessage
+	speed    float64
+	shutdown chan struct{}
+	once     sync.Once
+	wg       sync.WaitGroup
+}
+
+// NewReplayServer starts a server replaying the recording. A speed of 1
+// replays in real time, 2 at twice the speed and 0 or less sends frames
+// without delay
+func NewReplayServer(path string, speed float64) (*ReplayServer, error) {
+	msgs, err := ReadRecording(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 {
+		return nil, errNoRecordedMessages
+	}
+	s := &ReplayServer{
+		messages: msgs,
+		speed:    speed,
+		shutdown: make(chan struct{}),
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.handle))
+	s.URL = "ws" + strings.TrimPrefix(s.server.URL, "http")
+	return s, nil
+}
+
+// Close disconnects all clients and stops the server
+func (s *ReplayServer) Close() {
+	s.once.Do(func() {
+		close(s.shutdown)
+		s.server.Close()
+		s.wg.Wait()
+	})
+}
+
+func (s *ReplayServer) handle(w http.ResponseWriter, r *http.Request) {
+	s.wg.Add(1)
+	defer s.wg.Done()
+	conn, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Errorf(log.WebsocketMgr, "Replay server unable to upgrade connection: %v", err)
+		return
+	}
+	defer conn.Close()
+
+	// Reading handles control frames and detects the client disconnecting
+	disconnected := make(chan struct{})
+	go func() {
+		defer close(disconnected)
+		for {
+			if _, _, rErr := conn.ReadMessage(); rErr != nil {
+				return
+			}
+		}
+	}()
+
+	for i := range s.messages {
+		if delay := s.delay(i); delay > 0 {
+			t := time.NewTimer(delay)
+			select {
+			case <-t.C:
+			case <-disconnected:
+				t.Stop()
+				return
+			case <-s.shutdown:
+				t.Stop()
+				return
+			}
+		}
+		var data []byte
+		if data, err = s.messages[i].frame(); err != nil {
+			log.Errorf(log.WebsocketMgr, "Replay server unable to send frame %d: %v", i, err)
+			return
+		}
+		if err = conn.WriteMessage(s.messages[i].Type, data); err != nil {
+			return
+		}
+	}
+
+	// Connections are held open once replayed so that clients do not
+	// reconnect and receive the recording again
+	select {
+	case <-disconnected:
+	case <-s.shutdown:
+		_ = conn.WriteControl(websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""),
+			time.Now().Add(time.Second))
+	}
+}
+
+// delay returns the time to wait before sending the message
+func (s *ReplayServer) delay(i int) time.Duration {
+	if i == 0 || s.speed <= 0 {
+		return 0
+	}
+	return time.Duration(float64(s.messages[i].Time.Sub(s.messages[i-1].Time)) / s.speed)
+}
+
+// frame returns the raw frame as it was received
+func (m *RecordedMessage) frame() ([]byte, error) {
+	if m.Type == websocket.TextMessage {
+		return []byte(m.Text), nil
+	}
+	if m.Type != websocket.BinaryMessage {
+		return nil, fmt.Errorf("%w: %d", errUnsupportedMessageType, m.Type)
+	}
+	return m.Binary, nil
+}
@@ -1,6 +1,7 @@
 package stream
 
 import (
+	"context"
 	"net/http"
 	"time"
 
@@ -18,6 +19,7 @@ type Connection interface {
 	SetupPingHandler(PingHandler)
 	GenerateMessageID(highPrecision bool) int64
 	SendMessageReturnResponse(signature interface{}, request interface{}) ([]byte, error)
+	SendMessageReturnResponseContext(ctx context.Context, signature interface{}, request interface{}) ([]byte, error)
 	SendRawMessage(messageType int, message []byte) error
 	SetURL(string)
 	SetProxy(string)
@@ -96,3 +98,10 @@ type UnhandledMessageWarning struct {
 type Reporter interface {
 	Latency(name string, message []byte, t time.Duration)
 }
+
+// ConnectionReporter is an optional Reporter extension which observes
+// websocket reconnections and inbound message throughput
+type ConnectionReporter interface {
+	Reconnected(name string)
+	MessageReceived(name string, size int)
+}
@@ -247,6 +247,7 @@ func (w *Websocket) SetupNewConnection(c ConnectionSetup) error {
 		Match:             w.Match,
 		RateLimit:         c.RateLimit,
 		Reporter:          c.ConnectionLevelReporter,
+		Recorder:          w.Recorder,
 	}
 
 	if c.Authenticated {
@@ -292,6 +293,13 @@ func (w *Websocket) Connect() error {
 	}
 	w.setState(connected)
 
+	if w.hasConnected {
+		if rep, ok := w.reporter().(ConnectionReporter); ok {
+			rep.Reconnected(w.exchangeName)
+		}
+	}
+	w.hasConnected = true
+
 	if !w.IsConnectionMonitorRunning() {
 		err = w.connectionMonitor()
 		if err != nil {
@@ -600,6 +608,15 @@ func (w *Websocket) trafficMonitor() {
 	}()
 }
 
+// reporter returns the exchange level reporter, or the global reporter if
+// none is set
+func (w *Websocket) reporter() Reporter {
+	if w.ExchangeLevelReporter != nil {
+		return w.ExchangeLevelReporter
+	}
+	return globalReporter
+}
+
 func (w *Websocket) setState(s uint32) {
 	w.state.Store(s)
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"compress/flate"
 	"compress/gzip"
+	"context"
 	"crypto/rand"
 	"encoding/json"
 	"fmt"
@@ -16,12 +17,27 @@ import (
 	"time"
 
 	"github.com/gorilla/websocket"
+	"github.com/thrasher-corp/gocryptotrader/common/tracing"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
 // SendMessageReturnResponse will send a WS message to the connection and wait
 // for response
 func (w *WebsocketConnection) SendMessageReturnResponse(signature, request interface{}) ([]byte, error) {
+	return w.SendMessageReturnResponseContext(context.Background(), signature, request)
+}
+
+// SendMessageReturnResponseContext will send a WS message to the connection
+// and wait for response. The request is traced as a child of any span in the
+// context
+func (w *WebsocketConnection) SendMessageReturnResponseContext(ctx context.Context, signature, request interface{}) (resp []byte, err error) {
+	_, span := tracing.StartSpan(ctx, "stream.SendMessageReturnResponse", tracing.SpanKindClient,
+		tracing.String("exchange", w.ExchangeName))
+	defer func() {
+		span.RecordError(err)
+		span.End()
+	}()
+
 	m, err := w.Match.Set(signature)
 	if err != nil {
 		return nil, err
@@ -230,6 +246,16 @@ func (w *WebsocketConnection) ReadMessage() Response {
 	default: // Non-Blocking write ensures 1 buffered signal per trafficCheckInterval to avoid flooding
 	}
 
+	if rep, ok := w.Reporter.(ConnectionReporter); ok {
+		rep.MessageReceived(w.ExchangeName, len(resp))
+	}
+
+	if w.Recorder != nil {
+		if err = w.Recorder.Record(mType, resp, time.Now()); err != nil {
+			log.Errorf(log.WebsocketMgr, "%v websocket connection: unable to record message: %v", w.ExchangeName, err)
+		}
+	}
+
 	var standardMessage []byte
 	switch mType {
 	case websocket.TextMessage:
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"compress/flate"
 	"compress/gzip"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -20,6 +21,7 @@ import (
 	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common/tracing"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
@@ -771,6 +773,33 @@ func (r *reporter) Latency(name string, message []byte, t time.Duration) {
 	r.t = t
 }
 
+type connectionReporter struct {
+	reporter
+	reconnections int
+}
+
+func (r *connectionReporter) Reconnected(string) {
+	r.reconnections++
+}
+
+func (r *connectionReporter) MessageReceived(string, int) {}
+
+func TestReconnectionReporter(t *testing.T) {
+	t.Parallel()
+	ws := NewWebsocket()
+	require.NoError(t, ws.Setup(defaultSetup), "Setup must not error")
+	rep := &connectionReporter{}
+	ws.ExchangeLevelReporter = rep
+	ws.connectionMonitorRunning.Store(true)
+
+	require.NoError(t, ws.Connect(), "Connect must not error")
+	assert.Zero(t, rep.reconnections, "the first connection should not be reported as a reconnection")
+	require.NoError(t, ws.Shutdown(), "Shutdown must not error")
+	require.NoError(t, ws.Connect(), "Connect must not error")
+	assert.Equal(t, 1, rep.reconnections, "subsequent connections should be reported as reconnections")
+	require.NoError(t, ws.Shutdown(), "Shutdown must not error")
+}
+
 // readMessages helper func
 func readMessages(t *testing.T, wc *WebsocketConnection) {
 	t.Helper()
@@ -1255,6 +1284,44 @@ func TestLatency(t *testing.T) {
 	}
 }
 
+type testSpanExporter struct {
+	spans []tracing.SpanData
+}
+
+func (e *testSpanExporter) ExportSpans(_ context.Context, _ string, spans []tracing.SpanData) error {
+	e.spans = append(e.spans, spans...)
+	return nil
+}
+
+func (e *testSpanExporter) Shutdown(context.Context) error {
+	return nil
+}
+
+// TestSendMessageReturnResponseTracing is not parallel as it sets the global
+// tracer
+func TestSendMessageReturnResponseTracing(t *testing.T) {
+	e := &testSpanExporter{}
+	tr, err := tracing.NewTracer("test", e)
+	require.NoError(t, err)
+	require.NoError(t, tr.Start())
+	tracing.SetGlobalTracer(tr)
+	defer tracing.SetGlobalTracer(nil)
+
+	wc := &WebsocketConnection{ExchangeName: "test", Match: NewMatch()}
+	_, err = wc.Match.Set(1337)
+	require.NoError(t, err)
+	ctx, parent := tracing.StartSpan(context.Background(), "parent", tracing.SpanKindInternal)
+	_, err = wc.SendMessageReturnResponseContext(ctx, 1337, nil)
+	assert.Error(t, err, "SendMessageReturnResponseContext should error on a signature collision")
+	parent.End()
+	require.NoError(t, tr.Stop(context.Background()))
+
+	require.Len(t, e.spans, 2)
+	assert.Equal(t, "stream.SendMessageReturnResponse", e.spans[0].Name)
+	assert.Equal(t, parent.SpanContext().SpanID, e.spans[0].ParentSpanID, "the request should be a child of the caller's span")
+	assert.Equal(t, tracing.StatusError, e.spans[0].StatusCode)
+}
+
 func TestCheckSubscriptions(t *testing.T) {
 	t.Parallel()
 	ws := Websocket{}
@@ -51,6 +51,9 @@ type Websocket struct {
 	exchangeName                 string
 	m                            sync.Mutex
 	connector                    func() error
+	// hasConnected is set after the first successful connection so that
+	// later connections can be reported as reconnections
+	hasConnected bool
 
 	subscriptionMutex sync.RWMutex
 	subscriptions     subscriptionMap
@@ -100,6 +103,9 @@ type Websocket struct {
 	// Latency reporter
 	ExchangeLevelReporter Reporter
 
+	// Recorder records raw frames read by connections set up after it is set
+	Recorder *Recorder
+
 	// MaxSubScriptionsPerConnection defines the maximum number of
 	// subscriptions per connection that is allowed by the exchange.
 	MaxSubscriptionsPerConnection int
@@ -154,4 +160,6 @@ type WebsocketConnection struct {
 	readMessageErrors chan error
 
 	Reporter Reporter
+	// Recorder, when set, records every raw frame read from the connection
+	Recorder *Recorder
 }
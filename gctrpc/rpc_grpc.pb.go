@@ -41,6 +41,7 @@ const (
 	GoCryptoTraderService_GetConfig_FullMethodName                         = "/gctrpc.GoCryptoTraderService/GetConfig"
 	GoCryptoTraderService_GetPortfolio_FullMethodName                      = "/gctrpc.GoCryptoTraderService/GetPortfolio"
 	GoCryptoTraderService_GetPortfolioSummary_FullMethodName               = "/gctrpc.GoCryptoTraderService/GetPortfolioSummary"
+	GoCryptoTraderService_GetPortfolioPNL_FullMethodName                   = "/gctrpc.GoCryptoTraderService/GetPortfolioPNL"
 	GoCryptoTraderService_AddPortfolioAddress_FullMethodName               = "/gctrpc.GoCryptoTraderService/AddPortfolioAddress"
 	GoCryptoTraderService_RemovePortfolioAddress_FullMethodName            = "/gctrpc.GoCryptoTraderService/RemovePortfolioAddress"
 	GoCryptoTraderService_GetForexProviders_FullMethodName                 = "/gctrpc.GoCryptoTraderService/GetForexProviders"
@@ -48,14 +49,19 @@ const (
 	GoCryptoTraderService_GetOrders_FullMethodName                         = "/gctrpc.GoCryptoTraderService/GetOrders"
 	GoCryptoTraderService_GetOrder_FullMethodName                          = "/gctrpc.GoCryptoTraderService/GetOrder"
 	GoCryptoTraderService_SubmitOrder_FullMethodName                       = "/gctrpc.GoCryptoTraderService/SubmitOrder"
+	GoCryptoTraderService_SubmitSyntheticOrder_FullMethodName              = "/gctrpc.GoCryptoTraderService/SubmitSyntheticOrder"
+	GoCryptoTraderService_RouteOrder_FullMethodName                        = "/gctrpc.GoCryptoTraderService/RouteOrder"
 	GoCryptoTraderService_SimulateOrder_FullMethodName                     = "/gctrpc.GoCryptoTraderService/SimulateOrder"
 	GoCryptoTraderService_WhaleBomb_FullMethodName                         = "/gctrpc.GoCryptoTraderService/WhaleBomb"
 	GoCryptoTraderService_CancelOrder_FullMethodName                       = "/gctrpc.GoCryptoTraderService/CancelOrder"
 	GoCryptoTraderService_CancelBatchOrders_FullMethodName                 = "/gctrpc.GoCryptoTraderService/CancelBatchOrders"
 	GoCryptoTraderService_CancelAllOrders_FullMethodName                   = "/gctrpc.GoCryptoTraderService/CancelAllOrders"
+	GoCryptoTraderService_GetKillSwitch_FullMethodName                     = "/gctrpc.GoCryptoTraderService/GetKillSwitch"
+	GoCryptoTraderService_SetKillSwitch_FullMethodName                     = "/gctrpc.GoCryptoTraderService/SetKillSwitch"
 	GoCryptoTraderService_GetEvents_FullMethodName                         = "/gctrpc.GoCryptoTraderService/GetEvents"
 	GoCryptoTraderService_AddEvent_FullMethodName                          = "/gctrpc.GoCryptoTraderService/AddEvent"
 	GoCryptoTraderService_RemoveEvent_FullMethodName                       = "/gctrpc.GoCryptoTraderService/RemoveEvent"
+	GoCryptoTraderService_GetEventExecutions_FullMethodName                = "/gctrpc.GoCryptoTraderService/GetEventExecutions"
 	GoCryptoTraderService_GetCryptocurrencyDepositAddresses_FullMethodName = "/gctrpc.GoCryptoTraderService/GetCryptocurrencyDepositAddresses"
 	GoCryptoTraderService_GetCryptocurrencyDepositAddress_FullMethodName   = "/gctrpc.GoCryptoTraderService/GetCryptocurrencyDepositAddress"
 	GoCryptoTraderService_GetAvailableTransferChains_FullMethodName        = "/gctrpc.GoCryptoTraderService/GetAvailableTransferChains"
@@ -70,6 +76,8 @@ const (
 	GoCryptoTraderService_SetExchangePair_FullMethodName                   = "/gctrpc.GoCryptoTraderService/SetExchangePair"
 	GoCryptoTraderService_GetOrderbookStream_FullMethodName                = "/gctrpc.GoCryptoTraderService/GetOrderbookStream"
 	GoCryptoTraderService_GetExchangeOrderbookStream_FullMethodName        = "/gctrpc.GoCryptoTraderService/GetExchangeOrderbookStream"
+	GoCryptoTraderService_GetConsolidatedOrderbook_FullMethodName          = "/gctrpc.GoCryptoTraderService/GetConsolidatedOrderbook"
+	GoCryptoTraderService_GetConsolidatedOrderbookStream_FullMethodName    = "/gctrpc.GoCryptoTraderService/GetConsolidatedOrderbookStream"
 	GoCryptoTraderService_GetTickerStream_FullMethodName                   = "/gctrpc.GoCryptoTraderService/GetTickerStream"
 	GoCryptoTraderService_GetExchangeTickerStream_FullMethodName           = "/gctrpc.GoCryptoTraderService/GetExchangeTickerStream"
 	GoCryptoTraderService_GetAuditEvent_FullMethodName                     = "/gctrpc.GoCryptoTraderService/GetAuditEvent"
@@ -118,6 +126,13 @@ const (
 	GoCryptoTraderService_GetCollateral_FullMethodName                     = "/gctrpc.GoCryptoTraderService/GetCollateral"
 	GoCryptoTraderService_Shutdown_FullMethodName                          = "/gctrpc.GoCryptoTraderService/Shutdown"
 	GoCryptoTraderService_GetTechnicalAnalysis_FullMethodName              = "/gctrpc.GoCryptoTraderService/GetTechnicalAnalysis"
+	GoCryptoTraderService_GetTCAReport_FullMethodName                      = "/gctrpc.GoCryptoTraderService/GetTCAReport"
+	GoCryptoTraderService_GetArbitrageOpportunities_FullMethodName         = "/gctrpc.GoCryptoTraderService/GetArbitrageOpportunities"
+	GoCryptoTraderService_GetArbitrageOpportunitiesStream_FullMethodName   = "/gctrpc.GoCryptoTraderService/GetArbitrageOpportunitiesStream"
+	GoCryptoTraderService_StartLiveStrategy_FullMethodName                 = "/gctrpc.GoCryptoTraderService/StartLiveStrategy"
+	GoCryptoTraderService_StopLiveStrategy_FullMethodName                  = "/gctrpc.GoCryptoTraderService/StopLiveStrategy"
+	GoCryptoTraderService_GetLiveStrategies_FullMethodName                 = "/gctrpc.GoCryptoTraderService/GetLiveStrategies"
+	GoCryptoTraderService_GetLiveStrategyLogs_FullMethodName               = "/gctrpc.GoCryptoTraderService/GetLiveStrategyLogs"
 	GoCryptoTraderService_GetMarginRatesHistory_FullMethodName             = "/gctrpc.GoCryptoTraderService/GetMarginRatesHistory"
 	GoCryptoTraderService_GetManagedPosition_FullMethodName                = "/gctrpc.GoCryptoTraderService/GetManagedPosition"
 	GoCryptoTraderService_GetAllManagedPositions_FullMethodName            = "/gctrpc.GoCryptoTraderService/GetAllManagedPositions"
@@ -161,6 +176,7 @@ type GoCryptoTraderServiceClient interface {
 	GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*GetConfigResponse, error)
 	GetPortfolio(ctx context.Context, in *GetPortfolioRequest, opts ...grpc.CallOption) (*GetPortfolioResponse, error)
 	GetPortfolioSummary(ctx context.Context, in *GetPortfolioSummaryRequest, opts ...grpc.CallOption) (*GetPortfolioSummaryResponse, error)
+	GetPortfolioPNL(ctx context.Context, in *GetPortfolioPNLRequest, opts ...grpc.CallOption) (*GetPortfolioPNLResponse, error)
 	AddPortfolioAddress(ctx context.Context, in *AddPortfolioAddressRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 	RemovePortfolioAddress(ctx context.Context, in *RemovePortfolioAddressRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 	GetForexProviders(ctx context.Context, in *GetForexProvidersRequest, opts ...grpc.CallOption) (*GetForexProvidersResponse, error)
@@ -168,14 +184,19 @@ type GoCryptoTraderServiceClient interface {
 	GetOrders(ctx context.Context, in *GetOrdersRequest, opts ...grpc.CallOption) (*GetOrdersResponse, error)
 	GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*OrderDetails, error)
 	SubmitOrder(ctx context.Context, in *SubmitOrderRequest, opts ...grpc.CallOption) (*SubmitOrderResponse, error)
+	SubmitSyntheticOrder(ctx context.Context, in *SubmitSyntheticOrderRequest, opts ...grpc.CallOption) (*SubmitSyntheticOrderResponse, error)
+	RouteOrder(ctx context.Context, in *RouteOrderRequest, opts ...grpc.CallOption) (*RouteOrderResponse, error)
 	SimulateOrder(ctx context.Context, in *SimulateOrderRequest, opts ...grpc.CallOption) (*SimulateOrderResponse, error)
 	WhaleBomb(ctx context.Context, in *WhaleBombRequest, opts ...grpc.CallOption) (*SimulateOrderResponse, error)
 	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 	CancelBatchOrders(ctx context.Context, in *CancelBatchOrdersRequest, opts ...grpc.CallOption) (*CancelBatchOrdersResponse, error)
 	CancelAllOrders(ctx context.Context, in *CancelAllOrdersRequest, opts ...grpc.CallOption) (*CancelAllOrdersResponse, error)
+	GetKillSwitch(ctx context.Context, in *GetKillSwitchRequest, opts ...grpc.CallOption) (*KillSwitchResponse, error)
+	SetKillSwitch(ctx context.Context, in *SetKillSwitchRequest, opts ...grpc.CallOption) (*KillSwitchResponse, error)
 	GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error)
 	AddEvent(ctx context.Context, in *AddEventRequest, opts ...grpc.CallOption) (*AddEventResponse, error)
 	RemoveEvent(ctx context.Context, in *RemoveEventRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	GetEventExecutions(ctx context.Context, in *GetEventExecutionsRequest, opts ...grpc.CallOption) (*GetEventExecutionsResponse, error)
 	GetCryptocurrencyDepositAddresses(ctx context.Context, in *GetCryptocurrencyDepositAddressesRequest, opts ...grpc.CallOption) (*GetCryptocurrencyDepositAddressesResponse, error)
 	GetCryptocurrencyDepositAddress(ctx context.Context, in *GetCryptocurrencyDepositAddressRequest, opts ...grpc.CallOption) (*GetCryptocurrencyDepositAddressResponse, error)
 	GetAvailableTransferChains(ctx context.Context, in *GetAvailableTransferChainsRequest, opts ...grpc.CallOption) (*GetAvailableTransferChainsResponse, error)
@@ -190,6 +211,8 @@ type GoCryptoTraderServiceClient interface {
 	SetExchangePair(ctx context.Context, in *SetExchangePairRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 	GetOrderbookStream(ctx context.Context, in *GetOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTraderService_GetOrderbookStreamClient, error)
 	GetExchangeOrderbookStream(ctx context.Context, in *GetExchangeOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTraderService_GetExchangeOrderbookStreamClient, error)
+	GetConsolidatedOrderbook(ctx context.Context, in *GetConsolidatedOrderbookRequest, opts ...grpc.CallOption) (*ConsolidatedOrderbookResponse, error)
+	GetConsolidatedOrderbookStream(ctx context.Context, in *GetConsolidatedOrderbookRequest, opts ...grpc.CallOption) (GoCryptoTraderService_GetConsolidatedOrderbookStreamClient, error)
 	GetTickerStream(ctx context.Context, in *GetTickerStreamRequest, opts ...grpc.CallOption) (GoCryptoTraderService_GetTickerStreamClient, error)
 	GetExchangeTickerStream(ctx context.Context, in *GetExchangeTickerStreamRequest, opts ...grpc.CallOption) (GoCryptoTraderService_GetExchangeTickerStreamClient, error)
 	GetAuditEvent(ctx context.Context, in *GetAuditEventRequest, opts ...grpc.CallOption) (*GetAuditEventResponse, error)
@@ -238,6 +261,13 @@ type GoCryptoTraderServiceClient interface {
 	GetCollateral(ctx context.Context, in *GetCollateralRequest, opts ...grpc.CallOption) (*GetCollateralResponse, error)
 	Shutdown(ctx context.Context, in *ShutdownRequest, opts ...grpc.CallOption) (*ShutdownResponse, error)
 	GetTechnicalAnalysis(ctx context.Context, in *GetTechnicalAnalysisRequest, opts ...grpc.CallOption) (*GetTechnicalAnalysisResponse, error)
+	GetTCAReport(ctx context.Context, in *GetTCAReportRequest, opts ...grpc.CallOption) (*GetTCAReportResponse, error)
+	GetArbitrageOpportunities(ctx context.Context, in *GetArbitrageOpportunitiesRequest, opts ...grpc.CallOption) (*GetArbitrageOpportunitiesResponse, error)
+	GetArbitrageOpportunitiesStream(ctx context.Context, in *GetArbitrageOpportunitiesRequest, opts ...grpc.CallOption) (GoCryptoTraderService_GetArbitrageOpportunitiesStreamClient, error)
+	StartLiveStrategy(ctx context.Context, in *StartLiveStrategyRequest, opts ...grpc.CallOption) (*LiveStrategy, error)
+	StopLiveStrategy(ctx context.Context, in *StopLiveStrategyRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	GetLiveStrategies(ctx context.Context, in *GetLiveStrategiesRequest, opts ...grpc.CallOption) (*GetLiveStrategiesResponse, error)
+	GetLiveStrategyLogs(ctx context.Context, in *GetLiveStrategyLogsRequest, opts ...grpc.CallOption) (*GetLiveStrategyLogsResponse, error)
 	GetMarginRatesHistory(ctx context.Context, in *GetMarginRatesHistoryRequest, opts ...grpc.CallOption) (*GetMarginRatesHistoryResponse, error)
 	GetManagedPosition(ctx context.Context, in *GetManagedPositionRequest, opts ...grpc.CallOption) (*GetManagedPositionsResponse, error)
 	GetAllManagedPositions(ctx context.Context, in *GetAllManagedPositionsRequest, opts ...grpc.CallOption) (*GetManagedPositionsResponse, error)
@@ -484,6 +514,15 @@ func (c *goCryptoTraderServiceClient) GetPortfolioSummary(ctx context.Context, i
 	return out, nil
 }
 
+func (c *goCryptoTraderServiceClient) GetPortfolioPNL(ctx context.Context, in *GetPortfolioPNLRequest, opts ...grpc.CallOption) (*GetPortfolioPNLResponse, error) {
+	out := new(GetPortfolioPNLResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetPortfolioPNL_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goCryptoTraderServiceClient) AddPortfolioAddress(ctx context.Context, in *AddPortfolioAddressRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
 	out := new(GenericResponse)
 	err := c.cc.Invoke(ctx, GoCryptoTraderService_AddPortfolioAddress_FullMethodName, in, out, opts...)
@@ -547,6 +586,24 @@ func (c *goCryptoTraderServiceClient) SubmitOrder(ctx context.Context, in *Submi
 	return out, nil
 }
 
+func (c *goCryptoTraderServiceClient) SubmitSyntheticOrder(ctx context.Context, in *SubmitSyntheticOrderRequest, opts ...grpc.CallOption) (*SubmitSyntheticOrderResponse, error) {
+	out := new(SubmitSyntheticOrderResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_SubmitSyntheticOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) RouteOrder(ctx context.Context, in *RouteOrderRequest, opts ...grpc.CallOption) (*RouteOrderResponse, error) {
+	out := new(RouteOrderResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_RouteOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goCryptoTraderServiceClient) SimulateOrder(ctx context.Context, in *SimulateOrderRequest, opts ...grpc.CallOption) (*SimulateOrderResponse, error) {
 	out := new(SimulateOrderResponse)
 	err := c.cc.Invoke(ctx, GoCryptoTraderService_SimulateOrder_FullMethodName, in, out, opts...)
@@ -592,6 +649,24 @@ func (c *goCryptoTraderServiceClient) CancelAllOrders(ctx context.Context, in *C
 	return out, nil
 }
 
+func (c *goCryptoTraderServiceClient) GetKillSwitch(ctx context.Context, in *GetKillSwitchRequest, opts ...grpc.CallOption) (*KillSwitchResponse, error) {
+	out := new(KillSwitchResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetKillSwitch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) SetKillSwitch(ctx context.Context, in *SetKillSwitchRequest, opts ...grpc.CallOption) (*KillSwitchResponse, error) {
+	out := new(KillSwitchResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_SetKillSwitch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goCryptoTraderServiceClient) GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error) {
 	out := new(GetEventsResponse)
 	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetEvents_FullMethodName, in, out, opts...)
@@ -619,6 +694,15 @@ func (c *goCryptoTraderServiceClient) RemoveEvent(ctx context.Context, in *Remov
 	return out, nil
 }
 
+func (c *goCryptoTraderServiceClient) GetEventExecutions(ctx context.Context, in *GetEventExecutionsRequest, opts ...grpc.CallOption) (*GetEventExecutionsResponse, error) {
+	out := new(GetEventExecutionsResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetEventExecutions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goCryptoTraderServiceClient) GetCryptocurrencyDepositAddresses(ctx context.Context, in *GetCryptocurrencyDepositAddressesRequest, opts ...grpc.CallOption) (*GetCryptocurrencyDepositAddressesResponse, error) {
 	out := new(GetCryptocurrencyDepositAddressesResponse)
 	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetCryptocurrencyDepositAddresses_FullMethodName, in, out, opts...)
@@ -791,8 +875,49 @@ func (x *goCryptoTraderServiceGetExchangeOrderbookStreamClient) Recv() (*Orderbo
 	return m, nil
 }
 
+func (c *goCryptoTraderServiceClient) GetConsolidatedOrderbook(ctx context.Context, in *GetConsolidatedOrderbookRequest, opts ...grpc.CallOption) (*ConsolidatedOrderbookResponse, error) {
+	out := new(ConsolidatedOrderbookResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetConsolidatedOrderbook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetConsolidatedOrderbookStream(ctx context.Context, in *GetConsolidatedOrderbookRequest, opts ...grpc.CallOption) (GoCryptoTraderService_GetConsolidatedOrderbookStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTraderService_ServiceDesc.Streams[3], GoCryptoTraderService_GetConsolidatedOrderbookStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderServiceGetConsolidatedOrderbookStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoCryptoTraderService_GetConsolidatedOrderbookStreamClient interface {
+	Recv() (*ConsolidatedOrderbookResponse, error)
+	grpc.ClientStream
+}
+
+type goCryptoTraderServiceGetConsolidatedOrderbookStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *goCryptoTraderServiceGetConsolidatedOrderbookStreamClient) Recv() (*ConsolidatedOrderbookResponse, error) {
+	m := new(ConsolidatedOrderbookResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *goCryptoTraderServiceClient) GetTickerStream(ctx context.Context, in *GetTickerStreamRequest, opts ...grpc.CallOption) (GoCryptoTraderService_GetTickerStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &GoCryptoTraderService_ServiceDesc.Streams[3], GoCryptoTraderService_GetTickerStream_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTraderService_ServiceDesc.Streams[4], GoCryptoTraderService_GetTickerStream_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -824,7 +949,7 @@ func (x *goCryptoTraderServiceGetTickerStreamClient) Recv() (*TickerResponse, er
 }
 
 func (c *goCryptoTraderServiceClient) GetExchangeTickerStream(ctx context.Context, in *GetExchangeTickerStreamRequest, opts ...grpc.CallOption) (GoCryptoTraderService_GetExchangeTickerStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &GoCryptoTraderService_ServiceDesc.Streams[4], GoCryptoTraderService_GetExchangeTickerStream_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTraderService_ServiceDesc.Streams[5], GoCryptoTraderService_GetExchangeTickerStream_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1045,7 +1170,7 @@ func (c *goCryptoTraderServiceClient) GetRecentTrades(ctx context.Context, in *G
 }
 
 func (c *goCryptoTraderServiceClient) GetHistoricTrades(ctx context.Context, in *GetSavedTradesRequest, opts ...grpc.CallOption) (GoCryptoTraderService_GetHistoricTradesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &GoCryptoTraderService_ServiceDesc.Streams[5], GoCryptoTraderService_GetHistoricTrades_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTraderService_ServiceDesc.Streams[6], GoCryptoTraderService_GetHistoricTrades_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1292,6 +1417,92 @@ func (c *goCryptoTraderServiceClient) GetTechnicalAnalysis(ctx context.Context,
 	return out, nil
 }
 
+func (c *goCryptoTraderServiceClient) GetTCAReport(ctx context.Context, in *GetTCAReportRequest, opts ...grpc.CallOption) (*GetTCAReportResponse, error) {
+	out := new(GetTCAReportResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetTCAReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetArbitrageOpportunities(ctx context.Context, in *GetArbitrageOpportunitiesRequest, opts ...grpc.CallOption) (*GetArbitrageOpportunitiesResponse, error) {
+	out := new(GetArbitrageOpportunitiesResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetArbitrageOpportunities_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetArbitrageOpportunitiesStream(ctx context.Context, in *GetArbitrageOpportunitiesRequest, opts ...grpc.CallOption) (GoCryptoTraderService_GetArbitrageOpportunitiesStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTraderService_ServiceDesc.Streams[7], GoCryptoTraderService_GetArbitrageOpportunitiesStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderServiceGetArbitrageOpportunitiesStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoCryptoTraderService_GetArbitrageOpportunitiesStreamClient interface {
+	Recv() (*GetArbitrageOpportunitiesResponse, error)
+	grpc.ClientStream
+}
+
+type goCryptoTraderServiceGetArbitrageOpportunitiesStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *goCryptoTraderServiceGetArbitrageOpportunitiesStreamClient) Recv() (*GetArbitrageOpportunitiesResponse, error) {
+	m := new(GetArbitrageOpportunitiesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goCryptoTraderServiceClient) StartLiveStrategy(ctx context.Context, in *StartLiveStrategyRequest, opts ...grpc.CallOption) (*LiveStrategy, error) {
+	out := new(LiveStrategy)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_StartLiveStrategy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) StopLiveStrategy(ctx context.Context, in *StopLiveStrategyRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_StopLiveStrategy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetLiveStrategies(ctx context.Context, in *GetLiveStrategiesRequest, opts ...grpc.CallOption) (*GetLiveStrategiesResponse, error) {
+	out := new(GetLiveStrategiesResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetLiveStrategies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetLiveStrategyLogs(ctx context.Context, in *GetLiveStrategyLogsRequest, opts ...grpc.CallOption) (*GetLiveStrategyLogsResponse, error) {
+	out := new(GetLiveStrategyLogsResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetLiveStrategyLogs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goCryptoTraderServiceClient) GetMarginRatesHistory(ctx context.Context, in *GetMarginRatesHistoryRequest, opts ...grpc.CallOption) (*GetMarginRatesHistoryResponse, error) {
 	out := new(GetMarginRatesHistoryResponse)
 	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetMarginRatesHistory_FullMethodName, in, out, opts...)
@@ -1453,6 +1664,7 @@ type GoCryptoTraderServiceServer interface {
 	GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error)
 	GetPortfolio(context.Context, *GetPortfolioRequest) (*GetPortfolioResponse, error)
 	GetPortfolioSummary(context.Context, *GetPortfolioSummaryRequest) (*GetPortfolioSummaryResponse, error)
+	GetPortfolioPNL(context.Context, *GetPortfolioPNLRequest) (*GetPortfolioPNLResponse, error)
 	AddPortfolioAddress(context.Context, *AddPortfolioAddressRequest) (*GenericResponse, error)
 	RemovePortfolioAddress(context.Context, *RemovePortfolioAddressRequest) (*GenericResponse, error)
 	GetForexProviders(context.Context, *GetForexProvidersRequest) (*GetForexProvidersResponse, error)
@@ -1460,14 +1672,19 @@ type GoCryptoTraderServiceServer interface {
 	GetOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error)
 	GetOrder(context.Context, *GetOrderRequest) (*OrderDetails, error)
 	SubmitOrder(context.Context, *SubmitOrderRequest) (*SubmitOrderResponse, error)
+	SubmitSyntheticOrder(context.Context, *SubmitSyntheticOrderRequest) (*SubmitSyntheticOrderResponse, error)
+	RouteOrder(context.Context, *RouteOrderRequest) (*RouteOrderResponse, error)
 	SimulateOrder(context.Context, *SimulateOrderRequest) (*SimulateOrderResponse, error)
 	WhaleBomb(context.Context, *WhaleBombRequest) (*SimulateOrderResponse, error)
 	CancelOrder(context.Context, *CancelOrderRequest) (*GenericResponse, error)
 	CancelBatchOrders(context.Context, *CancelBatchOrdersRequest) (*CancelBatchOrdersResponse, error)
 	CancelAllOrders(context.Context, *CancelAllOrdersRequest) (*CancelAllOrdersResponse, error)
+	GetKillSwitch(context.Context, *GetKillSwitchRequest) (*KillSwitchResponse, error)
+	SetKillSwitch(context.Context, *SetKillSwitchRequest) (*KillSwitchResponse, error)
 	GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error)
 	AddEvent(context.Context, *AddEventRequest) (*AddEventResponse, error)
 	RemoveEvent(context.Context, *RemoveEventRequest) (*GenericResponse, error)
+	GetEventExecutions(context.Context, *GetEventExecutionsRequest) (*GetEventExecutionsResponse, error)
 	GetCryptocurrencyDepositAddresses(context.Context, *GetCryptocurrencyDepositAddressesRequest) (*GetCryptocurrencyDepositAddressesResponse, error)
 	GetCryptocurrencyDepositAddress(context.Context, *GetCryptocurrencyDepositAddressRequest) (*GetCryptocurrencyDepositAddressResponse, error)
 	GetAvailableTransferChains(context.Context, *GetAvailableTransferChainsRequest) (*GetAvailableTransferChainsResponse, error)
@@ -1482,6 +1699,8 @@ type GoCryptoTraderServiceServer interface {
 	SetExchangePair(context.Context, *SetExchangePairRequest) (*GenericResponse, error)
 	GetOrderbookStream(*GetOrderbookStreamRequest, GoCryptoTraderService_GetOrderbookStreamServer) error
 	GetExchangeOrderbookStream(*GetExchangeOrderbookStreamRequest, GoCryptoTraderService_GetExchangeOrderbookStreamServer) error
+	GetConsolidatedOrderbook(context.Context, *GetConsolidatedOrderbookRequest) (*ConsolidatedOrderbookResponse, error)
+	GetConsolidatedOrderbookStream(*GetConsolidatedOrderbookRequest, GoCryptoTraderService_GetConsolidatedOrderbookStreamServer) error
 	GetTickerStream(*GetTickerStreamRequest, GoCryptoTraderService_GetTickerStreamServer) error
 	GetExchangeTickerStream(*GetExchangeTickerStreamRequest, GoCryptoTraderService_GetExchangeTickerStreamServer) error
 	GetAuditEvent(context.Context, *GetAuditEventRequest) (*GetAuditEventResponse, error)
@@ -1530,6 +1749,13 @@ type GoCryptoTraderServiceServer interface {
 	GetCollateral(context.Context, *GetCollateralRequest) (*GetCollateralResponse, error)
 	Shutdown(context.Context, *ShutdownRequest) (*ShutdownResponse, error)
 	GetTechnicalAnalysis(context.Context, *GetTechnicalAnalysisRequest) (*GetTechnicalAnalysisResponse, error)
+	GetTCAReport(context.Context, *GetTCAReportRequest) (*GetTCAReportResponse, error)
+	GetArbitrageOpportunities(context.Context, *GetArbitrageOpportunitiesRequest) (*GetArbitrageOpportunitiesResponse, error)
+	GetArbitrageOpportunitiesStream(*GetArbitrageOpportunitiesRequest, GoCryptoTraderService_GetArbitrageOpportunitiesStreamServer) error
+	StartLiveStrategy(context.Context, *StartLiveStrategyRequest) (*LiveStrategy, error)
+	StopLiveStrategy(context.Context, *StopLiveStrategyRequest) (*GenericResponse, error)
+	GetLiveStrategies(context.Context, *GetLiveStrategiesRequest) (*GetLiveStrategiesResponse, error)
+	GetLiveStrategyLogs(context.Context, *GetLiveStrategyLogsRequest) (*GetLiveStrategyLogsResponse, error)
 	GetMarginRatesHistory(context.Context, *GetMarginRatesHistoryRequest) (*GetMarginRatesHistoryResponse, error)
 	GetManagedPosition(context.Context, *GetManagedPositionRequest) (*GetManagedPositionsResponse, error)
 	GetAllManagedPositions(context.Context, *GetAllManagedPositionsRequest) (*GetManagedPositionsResponse, error)
@@ -1618,6 +1844,9 @@ func (UnimplementedGoCryptoTraderServiceServer) GetPortfolio(context.Context, *G
 func (UnimplementedGoCryptoTraderServiceServer) GetPortfolioSummary(context.Context, *GetPortfolioSummaryRequest) (*GetPortfolioSummaryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolioSummary not implemented")
 }
+func (UnimplementedGoCryptoTraderServiceServer) GetPortfolioPNL(context.Context, *GetPortfolioPNLRequest) (*GetPortfolioPNLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolioPNL not implemented")
+}
 func (UnimplementedGoCryptoTraderServiceServer) AddPortfolioAddress(context.Context, *AddPortfolioAddressRequest) (*GenericResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddPortfolioAddress not implemented")
 }
@@ -1639,6 +1868,12 @@ func (UnimplementedGoCryptoTraderServiceServer) GetOrder(context.Context, *GetOr
 func (UnimplementedGoCryptoTraderServiceServer) SubmitOrder(context.Context, *SubmitOrderRequest) (*SubmitOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitOrder not implemented")
 }
+func (UnimplementedGoCryptoTraderServiceServer) SubmitSyntheticOrder(context.Context, *SubmitSyntheticOrderRequest) (*SubmitSyntheticOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitSyntheticOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) RouteOrder(context.Context, *RouteOrderRequest) (*RouteOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RouteOrder not implemented")
+}
 func (UnimplementedGoCryptoTraderServiceServer) SimulateOrder(context.Context, *SimulateOrderRequest) (*SimulateOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SimulateOrder not implemented")
 }
@@ -1654,6 +1889,12 @@ func (UnimplementedGoCryptoTraderServiceServer) CancelBatchOrders(context.Contex
 func (UnimplementedGoCryptoTraderServiceServer) CancelAllOrders(context.Context, *CancelAllOrdersRequest) (*CancelAllOrdersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelAllOrders not implemented")
 }
+func (UnimplementedGoCryptoTraderServiceServer) GetKillSwitch(context.Context, *GetKillSwitchRequest) (*KillSwitchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKillSwitch not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) SetKillSwitch(context.Context, *SetKillSwitchRequest) (*KillSwitchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetKillSwitch not implemented")
+}
 func (UnimplementedGoCryptoTraderServiceServer) GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEvents not implemented")
 }
@@ -1663,6 +1904,9 @@ func (UnimplementedGoCryptoTraderServiceServer) AddEvent(context.Context, *AddEv
 func (UnimplementedGoCryptoTraderServiceServer) RemoveEvent(context.Context, *RemoveEventRequest) (*GenericResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveEvent not implemented")
 }
+func (UnimplementedGoCryptoTraderServiceServer) GetEventExecutions(context.Context, *GetEventExecutionsRequest) (*GetEventExecutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventExecutions not implemented")
+}
 func (UnimplementedGoCryptoTraderServiceServer) GetCryptocurrencyDepositAddresses(context.Context, *GetCryptocurrencyDepositAddressesRequest) (*GetCryptocurrencyDepositAddressesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCryptocurrencyDepositAddresses not implemented")
 }
@@ -1705,6 +1949,12 @@ func (UnimplementedGoCryptoTraderServiceServer) GetOrderbookStream(*GetOrderbook
 func (UnimplementedGoCryptoTraderServiceServer) GetExchangeOrderbookStream(*GetExchangeOrderbookStreamRequest, GoCryptoTraderService_GetExchangeOrderbookStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetExchangeOrderbookStream not implemented")
 }
+func (UnimplementedGoCryptoTraderServiceServer) GetConsolidatedOrderbook(context.Context, *GetConsolidatedOrderbookRequest) (*ConsolidatedOrderbookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConsolidatedOrderbook not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetConsolidatedOrderbookStream(*GetConsolidatedOrderbookRequest, GoCryptoTraderService_GetConsolidatedOrderbookStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetConsolidatedOrderbookStream not implemented")
+}
 func (UnimplementedGoCryptoTraderServiceServer) GetTickerStream(*GetTickerStreamRequest, GoCryptoTraderService_GetTickerStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetTickerStream not implemented")
 }
@@ -1849,6 +2099,27 @@ func (UnimplementedGoCryptoTraderServiceServer) Shutdown(context.Context, *Shutd
 func (UnimplementedGoCryptoTraderServiceServer) GetTechnicalAnalysis(context.Context, *GetTechnicalAnalysisRequest) (*GetTechnicalAnalysisResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTechnicalAnalysis not implemented")
 }
+func (UnimplementedGoCryptoTraderServiceServer) GetTCAReport(context.Context, *GetTCAReportRequest) (*GetTCAReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTCAReport not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetArbitrageOpportunities(context.Context, *GetArbitrageOpportunitiesRequest) (*GetArbitrageOpportunitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArbitrageOpportunities not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetArbitrageOpportunitiesStream(*GetArbitrageOpportunitiesRequest, GoCryptoTraderService_GetArbitrageOpportunitiesStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetArbitrageOpportunitiesStream not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) StartLiveStrategy(context.Context, *StartLiveStrategyRequest) (*LiveStrategy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartLiveStrategy not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) StopLiveStrategy(context.Context, *StopLiveStrategyRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopLiveStrategy not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetLiveStrategies(context.Context, *GetLiveStrategiesRequest) (*GetLiveStrategiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLiveStrategies not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetLiveStrategyLogs(context.Context, *GetLiveStrategyLogsRequest) (*GetLiveStrategyLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLiveStrategyLogs not implemented")
+}
 func (UnimplementedGoCryptoTraderServiceServer) GetMarginRatesHistory(context.Context, *GetMarginRatesHistoryRequest) (*GetMarginRatesHistoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMarginRatesHistory not implemented")
 }
@@ -2306,6 +2577,24 @@ func _GoCryptoTraderService_GetPortfolioSummary_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GoCryptoTraderService_GetPortfolioPNL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortfolioPNLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetPortfolioPNL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetPortfolioPNL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetPortfolioPNL(ctx, req.(*GetPortfolioPNLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GoCryptoTraderService_AddPortfolioAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddPortfolioAddressRequest)
 	if err := dec(in); err != nil {
@@ -2432,6 +2721,42 @@ func _GoCryptoTraderService_SubmitOrder_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GoCryptoTraderService_SubmitSyntheticOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitSyntheticOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).SubmitSyntheticOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_SubmitSyntheticOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).SubmitSyntheticOrder(ctx, req.(*SubmitSyntheticOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_RouteOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RouteOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).RouteOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_RouteOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).RouteOrder(ctx, req.(*RouteOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GoCryptoTraderService_SimulateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SimulateOrderRequest)
 	if err := dec(in); err != nil {
@@ -2522,6 +2847,42 @@ func _GoCryptoTraderService_CancelAllOrders_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GoCryptoTraderService_GetKillSwitch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKillSwitchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetKillSwitch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetKillSwitch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetKillSwitch(ctx, req.(*GetKillSwitchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_SetKillSwitch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetKillSwitchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).SetKillSwitch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_SetKillSwitch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).SetKillSwitch(ctx, req.(*SetKillSwitchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GoCryptoTraderService_GetEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetEventsRequest)
 	if err := dec(in); err != nil {
@@ -2576,6 +2937,24 @@ func _GoCryptoTraderService_RemoveEvent_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GoCryptoTraderService_GetEventExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetEventExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetEventExecutions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetEventExecutions(ctx, req.(*GetEventExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GoCryptoTraderService_GetCryptocurrencyDepositAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCryptocurrencyDepositAddressesRequest)
 	if err := dec(in); err != nil {
@@ -2834,6 +3213,45 @@ func (x *goCryptoTraderServiceGetExchangeOrderbookStreamServer) Send(m *Orderboo
 	return x.ServerStream.SendMsg(m)
 }
 
+func _GoCryptoTraderService_GetConsolidatedOrderbook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConsolidatedOrderbookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetConsolidatedOrderbook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetConsolidatedOrderbook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetConsolidatedOrderbook(ctx, req.(*GetConsolidatedOrderbookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetConsolidatedOrderbookStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetConsolidatedOrderbookRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServiceServer).GetConsolidatedOrderbookStream(m, &goCryptoTraderServiceGetConsolidatedOrderbookStreamServer{stream})
+}
+
+type GoCryptoTraderService_GetConsolidatedOrderbookStreamServer interface {
+	Send(*ConsolidatedOrderbookResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderServiceGetConsolidatedOrderbookStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderServiceGetConsolidatedOrderbookStreamServer) Send(m *ConsolidatedOrderbookResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _GoCryptoTraderService_GetTickerStream_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(GetTickerStreamRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -3707,6 +4125,135 @@ func _GoCryptoTraderService_GetTechnicalAnalysis_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GoCryptoTraderService_GetTCAReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTCAReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetTCAReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetTCAReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetTCAReport(ctx, req.(*GetTCAReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetArbitrageOpportunities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArbitrageOpportunitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetArbitrageOpportunities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetArbitrageOpportunities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetArbitrageOpportunities(ctx, req.(*GetArbitrageOpportunitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetArbitrageOpportunitiesStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetArbitrageOpportunitiesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServiceServer).GetArbitrageOpportunitiesStream(m, &goCryptoTraderServiceGetArbitrageOpportunitiesStreamServer{stream})
+}
+
+type GoCryptoTraderService_GetArbitrageOpportunitiesStreamServer interface {
+	Send(*GetArbitrageOpportunitiesResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderServiceGetArbitrageOpportunitiesStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderServiceGetArbitrageOpportunitiesStreamServer) Send(m *GetArbitrageOpportunitiesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTraderService_StartLiveStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartLiveStrategyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).StartLiveStrategy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_StartLiveStrategy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).StartLiveStrategy(ctx, req.(*StartLiveStrategyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_StopLiveStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopLiveStrategyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).StopLiveStrategy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_StopLiveStrategy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).StopLiveStrategy(ctx, req.(*StopLiveStrategyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetLiveStrategies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLiveStrategiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetLiveStrategies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetLiveStrategies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetLiveStrategies(ctx, req.(*GetLiveStrategiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetLiveStrategyLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLiveStrategyLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetLiveStrategyLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetLiveStrategyLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetLiveStrategyLogs(ctx, req.(*GetLiveStrategyLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GoCryptoTraderService_GetMarginRatesHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetMarginRatesHistoryRequest)
 	if err := dec(in); err != nil {
@@ -4068,6 +4615,10 @@ var GoCryptoTraderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPortfolioSummary",
 			Handler:    _GoCryptoTraderService_GetPortfolioSummary_Handler,
 		},
+		{
+			MethodName: "GetPortfolioPNL",
+			Handler:    _GoCryptoTraderService_GetPortfolioPNL_Handler,
+		},
 		{
 			MethodName: "AddPortfolioAddress",
 			Handler:    _GoCryptoTraderService_AddPortfolioAddress_Handler,
@@ -4096,6 +4647,14 @@ var GoCryptoTraderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitOrder",
 			Handler:    _GoCryptoTraderService_SubmitOrder_Handler,
 		},
+		{
+			MethodName: "SubmitSyntheticOrder",
+			Handler:    _GoCryptoTraderService_SubmitSyntheticOrder_Handler,
+		},
+		{
+			MethodName: "RouteOrder",
+			Handler:    _GoCryptoTraderService_RouteOrder_Handler,
+		},
 		{
 			MethodName: "SimulateOrder",
 			Handler:    _GoCryptoTraderService_SimulateOrder_Handler,
@@ -4116,6 +4675,14 @@ var GoCryptoTraderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelAllOrders",
 			Handler:    _GoCryptoTraderService_CancelAllOrders_Handler,
 		},
+		{
+			MethodName: "GetKillSwitch",
+			Handler:    _GoCryptoTraderService_GetKillSwitch_Handler,
+		},
+		{
+			MethodName: "SetKillSwitch",
+			Handler:    _GoCryptoTraderService_SetKillSwitch_Handler,
+		},
 		{
 			MethodName: "GetEvents",
 			Handler:    _GoCryptoTraderService_GetEvents_Handler,
@@ -4128,6 +4695,10 @@ var GoCryptoTraderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveEvent",
 			Handler:    _GoCryptoTraderService_RemoveEvent_Handler,
 		},
+		{
+			MethodName: "GetEventExecutions",
+			Handler:    _GoCryptoTraderService_GetEventExecutions_Handler,
+		},
 		{
 			MethodName: "GetCryptocurrencyDepositAddresses",
 			Handler:    _GoCryptoTraderService_GetCryptocurrencyDepositAddresses_Handler,
@@ -4176,6 +4747,10 @@ var GoCryptoTraderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetExchangePair",
 			Handler:    _GoCryptoTraderService_SetExchangePair_Handler,
 		},
+		{
+			MethodName: "GetConsolidatedOrderbook",
+			Handler:    _GoCryptoTraderService_GetConsolidatedOrderbook_Handler,
+		},
 		{
 			MethodName: "GetAuditEvent",
 			Handler:    _GoCryptoTraderService_GetAuditEvent_Handler,
@@ -4356,6 +4931,30 @@ var GoCryptoTraderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTechnicalAnalysis",
 			Handler:    _GoCryptoTraderService_GetTechnicalAnalysis_Handler,
 		},
+		{
+			MethodName: "GetTCAReport",
+			Handler:    _GoCryptoTraderService_GetTCAReport_Handler,
+		},
+		{
+			MethodName: "GetArbitrageOpportunities",
+			Handler:    _GoCryptoTraderService_GetArbitrageOpportunities_Handler,
+		},
+		{
+			MethodName: "StartLiveStrategy",
+			Handler:    _GoCryptoTraderService_StartLiveStrategy_Handler,
+		},
+		{
+			MethodName: "StopLiveStrategy",
+			Handler:    _GoCryptoTraderService_StopLiveStrategy_Handler,
+		},
+		{
+			MethodName: "GetLiveStrategies",
+			Handler:    _GoCryptoTraderService_GetLiveStrategies_Handler,
+		},
+		{
+			MethodName: "GetLiveStrategyLogs",
+			Handler:    _GoCryptoTraderService_GetLiveStrategyLogs_Handler,
+		},
 		{
 			MethodName: "GetMarginRatesHistory",
 			Handler:    _GoCryptoTraderService_GetMarginRatesHistory_Handler,
@@ -4433,6 +5032,11 @@ var GoCryptoTraderService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _GoCryptoTraderService_GetExchangeOrderbookStream_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetConsolidatedOrderbookStream",
+			Handler:       _GoCryptoTraderService_GetConsolidatedOrderbookStream_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "GetTickerStream",
 			Handler:       _GoCryptoTraderService_GetTickerStream_Handler,
@@ -4448,6 +5052,11 @@ var GoCryptoTraderService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _GoCryptoTraderService_GetHistoricTrades_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetArbitrageOpportunitiesStream",
+			Handler:       _GoCryptoTraderService_GetArbitrageOpportunitiesStream_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "rpc.proto",
 }
@@ -8,6 +8,7 @@ import (
 	"runtime"
 	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies"
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/core"
@@ -59,6 +60,13 @@ func main() {
 	flag.BoolVar(&settings.EnableNTPClient, "ntpclient", true, "enables the NTP client to check system clock drift")
 	flag.BoolVar(&settings.EnableDispatcher, "dispatch", true, "enables the dispatch system")
 	flag.BoolVar(&settings.EnableCurrencyStateManager, "currencystatemanager", true, "enables the currency state manager")
+	flag.BoolVar(&settings.EnableMetricsManager, "metrics", false, "enables the Prometheus metrics endpoint")
+	flag.BoolVar(&settings.EnableTracing, "tracing", false, "enables exporting trace spans of gRPC calls, orders and exchange requests")
+	flag.BoolVar(&settings.EnableSharedRateLimits, "sharedratelimits", false, "shares exchange rate limits with other instances on this host")
+	flag.BoolVar(&settings.EnableMarketDataCapture, "marketdatacapture", false, "enables capturing orderbook and trade history to compressed files")
+	flag.BoolVar(&settings.EnableArbitrageManager, "arbitrage", false, "enables scanning orderbooks for cross-exchange and triangular arbitrage opportunities")
+	flag.BoolVar(&settings.EnableMarketMaker, "marketmaker", false, "enables continuous two-sided quoting of the configured markets")
+	flag.BoolVar(&settings.EnableStrategyManager, "strategymanager", false, "enables running backtester strategies against live market data")
 	flag.IntVar(&settings.DispatchMaxWorkerAmount, "dispatchworkers", dispatch.DefaultMaxWorkers, "sets the dispatch package max worker generation limit")
 	flag.IntVar(&settings.DispatchJobsLimit, "dispatchjobslimit", dispatch.DefaultJobsLimit, "sets the dispatch package max jobs limit")
 
@@ -138,6 +146,7 @@ func main() {
 	config.SetConfig(engine.Bot.Config)
 
 	gctscript.Setup()
+	engine.SetLiveStrategyLoader(strategies.LoadLiveStrategy)
 
 	engine.Bot.Settings.PrintLoadedSettings()
 
@@ -0,0 +1,412 @@
+package costbasis
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// NewTracker returns a tracker which values holdings in the reporting
+// currency using the supplied cost basis method
+func NewTracker(method Method, reporting currency.Code) (*Tracker, error) {
+	if method > AverageCost {
+		return nil, fmt.Errorf("%w %d", ErrUnknownMethod, method)
+	}
+	if reporting.IsEmpty() {
+		return nil, errEmptyReportingCurrency
+	}
+	return &Tracker{
+		method:    method,
+		reporting: reporting,
+		holdings:  make(map[*currency.Item]*holding),
+	}, nil
+}
+
+// MethodFromString converts a method name to a Method. An empty name returns
+// FIFO
+func MethodFromString(method string) (Method, error) {
+	switch strings.ToLower(method) {
+	case "", "fifo":
+		return FIFO, nil
+	case "lifo":
+		return LIFO, nil
+	case "average", "averagecost":
+		return AverageCost, nil
+	}
+	return 0, fmt.Errorf("%w %q", ErrUnknownMethod, method)
+}
+
+// String implements the stringer interface
+func (m Method) String() string {
+	switch m {
+	case FIFO:
+		return "FIFO"
+	case LIFO:
+		return "LIFO"
+	case AverageCost:
+		return "AVERAGE"
+	}
+	return "UNKNOWN"
+}
+
+// Method returns the cost basis method of the tracker
+func (t *Tracker) Method() Method {
+	return t.method
+}
+
+// Currency returns the reporting currency of the tracker
+func (t *Tracker) Currency() currency.Code {
+	return t.reporting
+}
+
+// AddTrade records a spot trade. Buying acquires the base currency and
+// disposes of the quote currency, selling does the reverse. The reporting
+// currency and fiat currencies are not tracked as holdings. Trades must be
+// added in the order they were executed
+func (t *Tracker) AddTrade(tr *Trade) error {
+	if err := validateTrade(tr); err != nil {
+		return err
+	}
+	quoteAmount := tr.Amount * tr.Price
+	t.m.Lock()
+	defer t.m.Unlock()
+	switch {
+	case tr.Side.IsLong():
+		t.acquire(tr.Pair.Base, tr, tr.Amount, tr.Value+tr.Fee)
+		t.dispose(tr.Pair.Quote, tr, quoteAmount, tr.Value, 0)
+	case tr.Side.IsShort():
+		t.dispose(tr.Pair.Base, tr, tr.Amount, tr.Value, tr.Fee)
+		t.acquire(tr.Pair.Quote, tr, quoteAmount, tr.Value)
+	default:
+		return fmt.Errorf("%w: %v", order.ErrSideIsInvalid, tr.Side)
+	}
+	return nil
+}
+
+// AddContractTrade records a trade of a linear derivative contract such as a
+// future. The contract is held in place of the base currency and the quote
+// currency is not exchanged. Buying covers short lots before opening a long
+// lot and selling closes long lots before opening a short lot. Short lots are
+// held with a negative amount and cost
+func (t *Tracker) AddContractTrade(contract currency.Code, tr *Trade) error {
+	if contract.IsEmpty() {
+		return errEmptyContract
+	}
+	if err := validateTrade(tr); err != nil {
+		return err
+	}
+	t.m.Lock()
+	defer t.m.Unlock()
+	h := t.holding(contract)
+	switch {
+	case tr.Side.IsLong():
+		if remaining := t.reduce(h, tr, tr.Amount, tr.Value, tr.Fee, true); remaining > dustAmount {
+			h.open(tr, remaining, (tr.Value+tr.Fee)*remaining/tr.Amount)
+		}
+	case tr.Side.IsShort():
+		if remaining := t.reduce(h, tr, tr.Amount, tr.Value, tr.Fee, false); remaining > dustAmount {
+			h.open(tr, -remaining, -(tr.Value-tr.Fee)*remaining/tr.Amount)
+		}
+	default:
+		return fmt.Errorf("%w: %v", order.ErrSideIsInvalid, tr.Side)
+	}
+	return nil
+}
+
+// AddFee records a fee paid in a tracked currency outside of a trade, such as
+// a withdrawal fee, as a disposal without proceeds
+func (t *Tracker) AddFee(f *Fee) error {
+	if f == nil {
+		return errNilFee
+	}
+	if f.Amount <= 0 {
+		return fmt.Errorf("%w: %v", errInvalidAmount, f.Amount)
+	}
+	t.m.Lock()
+	defer t.m.Unlock()
+	t.dispose(f.Currency, &Trade{Exchange: f.Exchange, ID: f.ID, Time: f.Time}, f.Amount, 0, 0)
+	return nil
+}
+
+// AddLot records a lot acquired outside of a recorded trade, such as a
+// holding which predates the trade history. Lots must be added in the order
+// they were acquired along with any trades
+func (t *Tracker) AddLot(l *Lot) error {
+	if l == nil {
+		return errNilLot
+	}
+	if l.Amount <= 0 {
+		return fmt.Errorf("%w: %v", errInvalidAmount, l.Amount)
+	}
+	if l.Cost < 0 {
+		return errInvalidValue
+	}
+	if !t.tracks(l.Currency) {
+		return fmt.Errorf("%w: %v", errUntrackedCurrency, l.Currency)
+	}
+	t.m.Lock()
+	defer t.m.Unlock()
+	h := t.holding(l.Currency)
+	lot := *l
+	lot.Currency = h.currency
+	h.lots = append(h.lots, lot)
+	return nil
+}
+
+// Tracks returns whether holdings of the currency carry a cost basis
+func (t *Tracker) Tracks(c currency.Code) bool {
+	return t.tracks(c)
+}
+
+// Lots returns the lots currently held
+func (t *Tracker) Lots() []Lot {
+	t.m.Lock()
+	defer t.m.Unlock()
+	var lots []Lot
+	for _, h := range t.holdings {
+		lots = append(lots, h.lots...)
+	}
+	sort.Slice(lots, func(i, j int) bool {
+		if !lots[i].Currency.Equal(lots[j].Currency) {
+			return lots[i].Currency.String() < lots[j].Currency.String()
+		}
+		return lots[i].Acquired.Before(lots[j].Acquired)
+	})
+	return lots
+}
+
+// Disposals returns all disposals in the order they were realised
+func (t *Tracker) Disposals() []Disposal {
+	t.m.Lock()
+	defer t.m.Unlock()
+	disposals := make([]Disposal, len(t.disposals))
+	copy(disposals, t.disposals)
+	return disposals
+}
+
+// Summary returns the positions of every tracked currency. Unrealised profit
+// and loss is calculated using the supplied prices, positions which cannot be
+// priced are still returned and their errors are joined
+func (t *Tracker) Summary(price PriceFunc) (*Summary, error) {
+	t.m.Lock()
+	defer t.m.Unlock()
+	s := &Summary{
+		Currency:  t.reporting,
+		Method:    t.method,
+		Positions: make([]Position, 0, len(t.holdings)),
+	}
+	var errs error
+	for _, h := range t.holdings {
+		p := Position{
+			Currency:          h.currency,
+			RealisedPNL:       h.realised,
+			UnmatchedAmount:   h.unmatchedAmount,
+			UnmatchedProceeds: h.unmatchedProceeds,
+		}
+		p.Amount, p.CostBasis = h.totals()
+		if p.Amount != 0 {
+			p.AverageCost = p.CostBasis / p.Amount
+			if price != nil {
+				var err error
+				p.Price, err = price(h.currency)
+				if err != nil {
+					errs = common.AppendError(errs, err)
+					p.Price = 0
+				} else {
+					p.MarketValue = p.Price * p.Amount
+					p.UnrealisedPNL = p.MarketValue - p.CostBasis
+				}
+			}
+		}
+		s.CostBasis += p.CostBasis
+		s.MarketValue += p.MarketValue
+		s.RealisedPNL += p.RealisedPNL
+		s.UnrealisedPNL += p.UnrealisedPNL
+		s.UnmatchedProceeds += p.UnmatchedProceeds
+		s.Positions = append(s.Positions, p)
+	}
+	sort.Slice(s.Positions, func(i, j int) bool {
+		return s.Positions[i].Currency.String() < s.Positions[j].Currency.String()
+	})
+	return s, errs
+}
+
+func validateTrade(tr *Trade) error {
+	if tr == nil {
+		return errNilTrade
+	}
+	if tr.Pair.IsEmpty() {
+		return currency.ErrCurrencyPairEmpty
+	}
+	if tr.Amount <= 0 {
+		return fmt.Errorf("%w: %v", errInvalidAmount, tr.Amount)
+	}
+	if tr.Price <= 0 {
+		return fmt.Errorf("%w: %v", errInvalidPrice, tr.Price)
+	}
+	if tr.Value < 0 || tr.Fee < 0 {
+		return errInvalidValue
+	}
+	return nil
+}
+
+// tracks returns whether holdings of the currency carry a cost basis
+func (t *Tracker) tracks(c currency.Code) bool {
+	return !c.IsEmpty() && !c.Equal(t.reporting) && !c.IsFiatCurrency()
+}
+
+func (t *Tracker) holding(c currency.Code) *holding {
+	h, ok := t.holdings[c.Item]
+	if !ok {
+		h = &holding{currency: c.Upper()}
+		t.holdings[c.Item] = h
+	}
+	return h
+}
+
+func (t *Tracker) acquire(c currency.Code, tr *Trade, amount, cost float64) {
+	if !t.tracks(c) {
+		return
+	}
+	t.holding(c).open(tr, amount, cost)
+}
+
+// dispose closes held lots of a currency. Any amount exceeding the holding is
+// recorded as unmatched with a zero cost basis
+func (t *Tracker) dispose(c currency.Code, tr *Trade, amount, proceeds, fee float64) {
+	if !t.tracks(c) {
+		return
+	}
+	h := t.holding(c)
+	if remaining := t.reduce(h, tr, amount, proceeds, fee, false); remaining > dustAmount {
+		t.record(h, tr, Disposal{
+			Amount:    remaining,
+			Proceeds:  proceeds * remaining / amount,
+			Fee:       fee * remaining / amount,
+			Unmatched: true,
+		})
+	}
+}
+
+// reduce matches the traded amount against the lots it closes, buying closes
+// short lots and selling closes long lots. The value and fee of the trade are
+// apportioned to each matched lot by amount. It returns the amount which could
+// not be matched
+func (t *Tracker) reduce(h *holding, tr *Trade, amount, value, fee float64, buying bool) float64 {
+	remaining := amount
+	matches := func(l *Lot) bool {
+		return (buying && l.Amount < 0) || (!buying && l.Amount > 0)
+	}
+	closeLot := func(acquired time.Time, matched, lotCost float64) {
+		d := Disposal{
+			Acquired: acquired,
+			Amount:   matched,
+			Fee:      fee * matched / amount,
+		}
+		if buying {
+			d.Proceeds = -lotCost
+			d.CostBasis = value * matched / amount
+		} else {
+			d.Proceeds = value * matched / amount
+			d.CostBasis = lotCost
+		}
+		t.record(h, tr, d)
+		remaining -= matched
+	}
+
+	if t.method == AverageCost {
+		held, cost := h.totals()
+		if held == 0 || !matches(&Lot{Amount: held}) {
+			return remaining
+		}
+		held = math.Abs(held)
+		matched := min(remaining, held)
+		retained := 1 - matched/held
+		for i := range h.lots {
+			h.lots[i].Amount *= retained
+			h.lots[i].Cost *= retained
+		}
+		h.prune()
+		closeLot(time.Time{}, matched, cost*matched/held)
+		return remaining
+	}
+
+	for remaining > dustAmount && len(h.lots) > 0 {
+		i := 0
+		if t.method == LIFO {
+			i = len(h.lots) - 1
+		}
+		lot := &h.lots[i]
+		if !matches(lot) {
+			break
+		}
+		held := math.Abs(lot.Amount)
+		matched := min(remaining, held)
+		lotCost := lot.Cost * matched / held
+		acquired := lot.Acquired
+		if lot.Amount > 0 {
+			lot.Amount -= matched
+		} else {
+			lot.Amount += matched
+		}
+		lot.Cost -= lotCost
+		h.prune()
+		closeLot(acquired, matched, lotCost)
+	}
+	return remaining
+}
+
+// record completes and stores a disposal. Unmatched disposals are kept apart
+// from the realised profit and loss as their cost basis is unknown
+func (t *Tracker) record(h *holding, tr *Trade, d Disposal) {
+	d.Currency = h.currency
+	d.Exchange = tr.Exchange
+	d.TradeID = tr.ID
+	d.Disposed = tr.Time
+	d.Gain = d.Proceeds - d.Fee - d.CostBasis
+	if d.Unmatched {
+		h.unmatchedAmount += d.Amount
+		h.unmatchedProceeds += d.Gain
+	} else {
+		h.realised += d.Gain
+	}
+	t.disposals = append(t.disposals, d)
+}
+
+// open adds a lot to the holding, short lots have a negative amount and cost
+func (h *holding) open(tr *Trade, amount, cost float64) {
+	h.lots = append(h.lots, Lot{
+		Currency: h.currency,
+		Exchange: tr.Exchange,
+		TradeID:  tr.ID,
+		Acquired: tr.Time,
+		Amount:   amount,
+		Cost:     cost,
+	})
+}
+
+// totals returns the amount held and its cost basis
+func (h *holding) totals() (amount, cost float64) {
+	for i := range h.lots {
+		amount += h.lots[i].Amount
+		cost += h.lots[i].Cost
+	}
+	return amount, cost
+}
+
+// prune removes fully closed lots
+func (h *holding) prune() {
+	lots := h.lots[:0]
+	for i := range h.lots {
+		if math.Abs(h.lots[i].Amount) > dustAmount {
+			lots = append(lots, h.lots[i])
+		}
+	}
+	h.lots = lots
+}
@@ -0,0 +1,255 @@
+package costbasis
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+var (
+	tradeTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	btcusd    = currency.NewPair(currency.BTC, currency.USD)
+)
+
+func buySellBuySell(t *testing.T, method Method) *Tracker {
+	t.Helper()
+	tr, err := NewTracker(method, currency.USD)
+	require.NoError(t, err)
+	trades := []Trade{
+		{Exchange: "a", ID: "1", Side: order.Buy, Amount: 1, Price: 100, Value: 100, Fee: 1},
+		{Exchange: "b", ID: "2", Side: order.Buy, Amount: 1, Price: 200, Value: 200, Fee: 1},
+		{Exchange: "a", ID: "3", Side: order.Sell, Amount: 1.5, Price: 300, Value: 450, Fee: 3},
+	}
+	for i := range trades {
+		trades[i].Pair = btcusd
+		trades[i].Time = tradeTime.Add(time.Duration(i) * time.Hour)
+		require.NoError(t, tr.AddTrade(&trades[i]))
+	}
+	return tr
+}
+
+func TestNewTracker(t *testing.T) {
+	t.Parallel()
+	_, err := NewTracker(AverageCost+1, currency.USD)
+	assert.ErrorIs(t, err, ErrUnknownMethod)
+	_, err = NewTracker(FIFO, currency.EMPTYCODE)
+	assert.ErrorIs(t, err, errEmptyReportingCurrency)
+	tr, err := NewTracker(LIFO, currency.EUR)
+	require.NoError(t, err)
+	assert.Equal(t, LIFO, tr.Method())
+	assert.Equal(t, currency.EUR, tr.Currency())
+}
+
+func TestMethodFromString(t *testing.T) {
+	t.Parallel()
+	for name, expected := range map[string]Method{"": FIFO, "FIFO": FIFO, "lifo": LIFO, "Average": AverageCost} {
+		m, err := MethodFromString(name)
+		require.NoError(t, err)
+		assert.Equal(t, expected, m)
+	}
+	_, err := MethodFromString("hifo")
+	assert.ErrorIs(t, err, ErrUnknownMethod)
+	assert.Equal(t, "AVERAGE", AverageCost.String())
+	assert.Equal(t, "UNKNOWN", Method(99).String())
+}
+
+func TestAddTrade(t *testing.T) {
+	t.Parallel()
+	tr, err := NewTracker(FIFO, currency.USD)
+	require.NoError(t, err)
+	assert.ErrorIs(t, tr.AddTrade(nil), errNilTrade)
+	assert.ErrorIs(t, tr.AddTrade(&Trade{}), currency.ErrCurrencyPairEmpty)
+	assert.ErrorIs(t, tr.AddTrade(&Trade{Pair: btcusd}), errInvalidAmount)
+	assert.ErrorIs(t, tr.AddTrade(&Trade{Pair: btcusd, Amount: 1}), errInvalidPrice)
+	assert.ErrorIs(t, tr.AddTrade(&Trade{Pair: btcusd, Amount: 1, Price: 1, Value: -1}), errInvalidValue)
+	assert.ErrorIs(t, tr.AddTrade(&Trade{Pair: btcusd, Amount: 1, Price: 1, Side: order.AnySide}), order.ErrSideIsInvalid)
+}
+
+func TestFIFO(t *testing.T) {
+	t.Parallel()
+	tr := buySellBuySell(t, FIFO)
+	d := tr.Disposals()
+	require.Len(t, d, 2)
+	assert.Equal(t, tradeTime, d[0].Acquired)
+	assert.Equal(t, 1.0, d[0].Amount)
+	assert.Equal(t, 300.0, d[0].Proceeds)
+	assert.Equal(t, 2.0, d[0].Fee)
+	assert.Equal(t, 101.0, d[0].CostBasis)
+	assert.Equal(t, 197.0, d[0].Gain)
+	assert.Equal(t, tradeTime.Add(time.Hour), d[1].Acquired)
+	assert.Equal(t, 0.5, d[1].Amount)
+	assert.Equal(t, 100.5, d[1].CostBasis)
+	assert.Equal(t, 48.5, d[1].Gain)
+
+	lots := tr.Lots()
+	require.Len(t, lots, 1)
+	assert.Equal(t, "2", lots[0].TradeID)
+	assert.Equal(t, 0.5, lots[0].Amount)
+	assert.Equal(t, 100.5, lots[0].Cost)
+}
+
+func TestLIFO(t *testing.T) {
+	t.Parallel()
+	tr := buySellBuySell(t, LIFO)
+	d := tr.Disposals()
+	require.Len(t, d, 2)
+	assert.Equal(t, tradeTime.Add(time.Hour), d[0].Acquired)
+	assert.Equal(t, 201.0, d[0].CostBasis)
+	assert.Equal(t, tradeTime, d[1].Acquired)
+	assert.Equal(t, 50.5, d[1].CostBasis)
+
+	lots := tr.Lots()
+	require.Len(t, lots, 1)
+	assert.Equal(t, "1", lots[0].TradeID)
+	assert.Equal(t, 50.5, lots[0].Cost)
+}
+
+func TestAverageCost(t *testing.T) {
+	t.Parallel()
+	tr := buySellBuySell(t, AverageCost)
+	d := tr.Disposals()
+	require.Len(t, d, 1)
+	assert.True(t, d[0].Acquired.IsZero())
+	assert.Equal(t, 1.5, d[0].Amount)
+	assert.Equal(t, 226.5, d[0].CostBasis)
+	assert.Equal(t, 220.5, d[0].Gain)
+
+	_, cost := tr.holdings[currency.BTC.Item].totals()
+	assert.InDelta(t, 75.5, cost, 1e-9)
+}
+
+func TestCryptoQuotedTrades(t *testing.T) {
+	t.Parallel()
+	tr, err := NewTracker(FIFO, currency.USD)
+	require.NoError(t, err)
+	ethbtc := currency.NewPair(currency.ETH, currency.BTC)
+	require.NoError(t, tr.AddTrade(&Trade{Pair: btcusd, Side: order.Buy, Amount: 1, Price: 100, Value: 100}))
+	require.NoError(t, tr.AddTrade(&Trade{Pair: ethbtc, Side: order.Buy, Amount: 10, Price: 0.05, Value: 75, Fee: 1}))
+
+	d := tr.Disposals()
+	require.Len(t, d, 1, "buying ETH with BTC should dispose of BTC")
+	assert.True(t, d[0].Currency.Equal(currency.BTC))
+	assert.Equal(t, 0.5, d[0].Amount)
+	assert.Equal(t, 25.0, d[0].Gain)
+
+	require.NoError(t, tr.AddTrade(&Trade{Pair: ethbtc, Side: order.Sell, Amount: 20, Price: 0.05, Value: 200}))
+	d = tr.Disposals()
+	require.Len(t, d, 3)
+	assert.Equal(t, 10.0, d[1].Amount)
+	assert.Equal(t, 24.0, d[1].Gain)
+	assert.True(t, d[2].Unmatched, "disposing more than is held should be unmatched")
+	assert.Equal(t, 10.0, d[2].Amount)
+	assert.Zero(t, d[2].CostBasis)
+	assert.Equal(t, 100.0, d[2].Gain)
+
+	s, err := tr.Summary(func(currency.Code) (float64, error) { return 150, nil })
+	require.NoError(t, err)
+	require.Len(t, s.Positions, 2)
+	assert.True(t, s.Positions[0].Currency.Equal(currency.BTC))
+	assert.Equal(t, 1.5, s.Positions[0].Amount)
+	assert.Equal(t, 250.0, s.Positions[0].CostBasis)
+	assert.Equal(t, 225.0, s.Positions[0].MarketValue)
+	assert.Equal(t, -25.0, s.Positions[0].UnrealisedPNL)
+	assert.Equal(t, 25.0, s.Positions[0].RealisedPNL)
+	assert.True(t, s.Positions[1].Currency.Equal(currency.ETH))
+	assert.Zero(t, s.Positions[1].Amount)
+	assert.Equal(t, 24.0, s.Positions[1].RealisedPNL, "unmatched disposals should not be realised")
+	assert.Equal(t, 10.0, s.Positions[1].UnmatchedAmount)
+	assert.Equal(t, 100.0, s.Positions[1].UnmatchedProceeds)
+	assert.Equal(t, 49.0, s.RealisedPNL)
+	assert.Equal(t, 100.0, s.UnmatchedProceeds)
+	assert.Equal(t, -25.0, s.UnrealisedPNL)
+	assert.Equal(t, currency.USD, s.Currency)
+
+	s, err = tr.Summary(func(currency.Code) (float64, error) { return 0, errors.New("no price") })
+	assert.Error(t, err, "positions which cannot be priced should return an error")
+	require.Len(t, s.Positions, 2, "positions should be returned when they cannot be priced")
+	assert.Zero(t, s.MarketValue)
+	assert.Equal(t, 250.0, s.CostBasis)
+}
+
+func TestAddContractTrade(t *testing.T) {
+	t.Parallel()
+	contract := currency.NewCode("BTCUSD-PERP")
+	tr, err := NewTracker(FIFO, currency.USD)
+	require.NoError(t, err)
+	assert.ErrorIs(t, tr.AddContractTrade(currency.EMPTYCODE, &Trade{}), errEmptyContract)
+	assert.ErrorIs(t, tr.AddContractTrade(contract, nil), errNilTrade)
+
+	require.NoError(t, tr.AddContractTrade(contract, &Trade{Pair: btcusd, Side: order.Sell, Amount: 2, Price: 100, Value: 200, Fee: 2, Time: tradeTime}))
+	lots := tr.Lots()
+	require.Len(t, lots, 1)
+	assert.Equal(t, -2.0, lots[0].Amount, "selling without a position should open a short lot")
+	assert.Equal(t, -198.0, lots[0].Cost)
+
+	s, err := tr.Summary(func(currency.Code) (float64, error) { return 90, nil })
+	require.NoError(t, err)
+	require.Len(t, s.Positions, 1)
+	assert.Equal(t, 99.0, s.Positions[0].AverageCost)
+	assert.Equal(t, 18.0, s.Positions[0].UnrealisedPNL)
+
+	require.NoError(t, tr.AddContractTrade(contract, &Trade{Pair: btcusd, Side: order.Buy, Amount: 3, Price: 90, Value: 270, Fee: 3, Time: tradeTime.Add(time.Hour)}))
+	d := tr.Disposals()
+	require.Len(t, d, 1)
+	assert.Equal(t, tradeTime, d[0].Acquired)
+	assert.Equal(t, 2.0, d[0].Amount)
+	assert.Equal(t, 198.0, d[0].Proceeds)
+	assert.Equal(t, 180.0, d[0].CostBasis)
+	assert.Equal(t, 2.0, d[0].Fee)
+	assert.Equal(t, 16.0, d[0].Gain)
+	lots = tr.Lots()
+	require.Len(t, lots, 1)
+	assert.Equal(t, 1.0, lots[0].Amount, "buying more than the short should open a long lot")
+	assert.Equal(t, 91.0, lots[0].Cost)
+}
+
+func TestAddFee(t *testing.T) {
+	t.Parallel()
+	tr, err := NewTracker(AverageCost, currency.USD)
+	require.NoError(t, err)
+	assert.ErrorIs(t, tr.AddFee(nil), errNilFee)
+	assert.ErrorIs(t, tr.AddFee(&Fee{Currency: currency.BTC}), errInvalidAmount)
+
+	require.NoError(t, tr.AddTrade(&Trade{Pair: btcusd, Side: order.Buy, Amount: 2, Price: 100, Value: 200}))
+	require.NoError(t, tr.AddFee(&Fee{Exchange: "a", ID: "withdrawal", Currency: currency.BTC, Amount: 0.5, Time: tradeTime}))
+	d := tr.Disposals()
+	require.Len(t, d, 1)
+	assert.Equal(t, "withdrawal", d[0].TradeID)
+	assert.Zero(t, d[0].Proceeds)
+	assert.Equal(t, 50.0, d[0].CostBasis)
+	assert.Equal(t, -50.0, d[0].Gain)
+
+	require.NoError(t, tr.AddFee(&Fee{Currency: currency.USD, Amount: 1}))
+	assert.Len(t, tr.Disposals(), 1, "fees in the reporting currency should not be disposals")
+}
+
+func TestAddLot(t *testing.T) {
+	t.Parallel()
+	tr, err := NewTracker(FIFO, currency.USD)
+	require.NoError(t, err)
+	assert.ErrorIs(t, tr.AddLot(nil), errNilLot)
+	assert.ErrorIs(t, tr.AddLot(&Lot{Currency: currency.BTC}), errInvalidAmount)
+	assert.ErrorIs(t, tr.AddLot(&Lot{Currency: currency.BTC, Amount: 1, Cost: -1}), errInvalidValue)
+	assert.ErrorIs(t, tr.AddLot(&Lot{Currency: currency.USD, Amount: 1}), errUntrackedCurrency)
+	assert.True(t, tr.Tracks(currency.BTC))
+	assert.False(t, tr.Tracks(currency.EUR), "fiat currencies should not be tracked")
+
+	require.NoError(t, tr.AddLot(&Lot{Currency: currency.NewCode("btc"), Exchange: "a", TradeID: "opening", Acquired: tradeTime, Amount: 2, Cost: 200}))
+	require.NoError(t, tr.AddTrade(&Trade{Exchange: "a", ID: "1", Pair: btcusd, Side: order.Sell, Amount: 1, Price: 150, Value: 150, Time: tradeTime.Add(time.Hour)}))
+	d := tr.Disposals()
+	require.Len(t, d, 1)
+	assert.False(t, d[0].Unmatched, "selling a seeded lot should be matched")
+	assert.Equal(t, 100.0, d[0].CostBasis)
+	assert.Equal(t, 50.0, d[0].Gain)
+	assert.Equal(t, tradeTime, d[0].Acquired)
+
+	lots := tr.Lots()
+	require.Len(t, lots, 1)
+	assert.Equal(t, currency.BTC, lots[0].Currency)
+	assert.Equal(t, 1.0, lots[0].Amount)
+}
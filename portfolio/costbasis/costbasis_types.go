@@ -0,0 +1,147 @@
+package costbasis
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// Method defines how disposals are matched against acquired lots
+type Method uint8
+
+// Supported cost basis methods
+const (
+	FIFO Method = iota
+	LIFO
+	AverageCost
+)
+
+// dustAmount is the remaining lot amount below which a lot is considered
+// fully disposed
+const dustAmount = 1e-12
+
+var (
+	// ErrUnknownMethod is returned when a cost basis method is not supported
+	ErrUnknownMethod = errors.New("unknown cost basis method")
+
+	errNilTrade               = errors.New("trade is nil")
+	errNilFee                 = errors.New("fee is nil")
+	errEmptyContract          = errors.New("contract is empty")
+	errInvalidAmount          = errors.New("trade amount must be greater than zero")
+	errInvalidPrice           = errors.New("trade price must be greater than zero")
+	errInvalidValue           = errors.New("trade value and fee cannot be negative")
+	errEmptyReportingCurrency = errors.New("reporting currency is empty")
+	errNilLot                 = errors.New("lot is nil")
+	errUntrackedCurrency      = errors.New("currency does not carry a cost basis")
+)
+
+// Trade is an executed spot trade valued in the tracker's reporting currency
+type Trade struct {
+	Exchange string
+	ID       string
+	Pair     currency.Pair
+	Side     order.Side
+	// Amount is the base currency amount traded
+	Amount float64
+	// Price is the price of the base currency in the quote currency
+	Price float64
+	// Value is the worth of the traded amount in the reporting currency
+	Value float64
+	// Fee is the fee paid in the reporting currency
+	Fee  float64
+	Time time.Time
+}
+
+// Fee is a fee paid in a tracked currency outside of a trade
+type Fee struct {
+	Exchange string
+	ID       string
+	Currency currency.Code
+	Amount   float64
+	Time     time.Time
+}
+
+// Lot is a quantity of a currency acquired in a single trade which has not
+// been disposed of. Short contract lots have a negative amount and cost
+type Lot struct {
+	Currency currency.Code
+	Exchange string
+	TradeID  string
+	Acquired time.Time
+	Amount   float64
+	// Cost is the remaining cost basis of the lot including acquisition fees
+	Cost float64
+}
+
+// Disposal is a realised gain or loss from disposing of a quantity of a
+// currency. Disposals matched against more than one lot are recorded once per
+// lot
+type Disposal struct {
+	Currency currency.Code
+	Exchange string
+	TradeID  string
+	// Acquired is the time the matched lot was opened and is zero when using
+	// average cost
+	Acquired  time.Time
+	Disposed  time.Time
+	Amount    float64
+	Proceeds  float64
+	Fee       float64
+	CostBasis float64
+	Gain      float64
+	// Unmatched is set when the disposed amount exceeded the tracked holdings
+	// and was given a zero cost basis
+	Unmatched bool
+}
+
+// Position is the holding of a single currency and its profit and loss in
+// the reporting currency. Unmatched disposals have no cost basis so their
+// proceeds, net of fees, are reported apart from the realised profit and loss
+type Position struct {
+	Currency          currency.Code
+	Amount            float64
+	CostBasis         float64
+	AverageCost       float64
+	Price             float64
+	MarketValue       float64
+	RealisedPNL       float64
+	UnrealisedPNL     float64
+	UnmatchedAmount   float64
+	UnmatchedProceeds float64
+}
+
+// Summary holds all positions and their totals in the reporting currency
+type Summary struct {
+	Currency          currency.Code
+	Method            Method
+	Positions         []Position
+	CostBasis         float64
+	MarketValue       float64
+	RealisedPNL       float64
+	UnrealisedPNL     float64
+	UnmatchedProceeds float64
+}
+
+// PriceFunc returns the current price of a currency in the reporting currency
+type PriceFunc func(currency.Code) (float64, error)
+
+// Tracker matches spot trades against acquired lots to track the cost basis
+// and profit and loss of holdings
+type Tracker struct {
+	method    Method
+	reporting currency.Code
+	holdings  map[*currency.Item]*holding
+	disposals []Disposal
+	m         sync.Mutex
+}
+
+type holding struct {
+	currency          currency.Code
+	lots              []Lot
+	realised          float64
+	unmatchedAmount   float64
+	unmatchedProceeds float64
+}
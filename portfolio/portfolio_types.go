@@ -10,6 +10,9 @@ import (
 type Base struct {
 	Addresses []Address `json:"addresses"`
 	Verbose   bool
+	// CostBasisMethod is the lot matching method used to calculate the
+	// profit and loss of spot holdings: FIFO, LIFO or average
+	CostBasisMethod string `json:"costBasisMethod,omitempty"`
 }
 
 // Address sub type holding address information for portfolio